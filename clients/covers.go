@@ -0,0 +1,60 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxCoverSize is the size of the largest cover that is downloaded.
+const maxCoverSize = 5 << 20
+
+// CoversClient fetches book covers from the Open Library covers API.
+type CoversClient struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+// NewCoversClient creates a new covers API client for the API at baseURL.
+func NewCoversClient(baseURL string) *CoversClient {
+	return &CoversClient{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		Client:  NewHTTPClient(),
+	}
+}
+
+// Name returns the name fetched covers are attributed to.
+func (c *CoversClient) Name() string {
+	return "openlibrary"
+}
+
+// GetCover fetches the large cover of the edition with an ISBN. It returns ErrNotFound
+// when the API has no cover for the ISBN, rather than its blank placeholder image.
+func (c *CoversClient) GetCover(ctx context.Context, isbn string) ([]byte, error) {
+	url := c.BaseURL + "/b/isbn/" + isbn + "-L.jpg?default=false"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return nil, ErrNotFound
+	case res.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("covers: unexpected status %d for %s", res.StatusCode, isbn)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxCoverSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxCoverSize {
+		return nil, fmt.Errorf("covers: cover for %s is larger than %d bytes", isbn, maxCoverSize)
+	}
+	return body, nil
+}
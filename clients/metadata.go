@@ -0,0 +1,252 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+// ErrUnavailable is returned when no metadata provider could be reached.
+var ErrUnavailable = errors.New("metadata providers unavailable")
+
+const (
+	// breakerThreshold is the number of consecutive failures after which a provider
+	// is skipped for breakerCooldown.
+	breakerThreshold = 5
+	breakerCooldown  = time.Minute
+	// notFoundTTL caps how long an ISBN unknown to every provider is cached, since
+	// catalogues add new editions all the time.
+	notFoundTTL = 15 * time.Minute
+	// maxCacheEntries bounds the number of cached ISBNs.
+	maxCacheEntries = 10000
+)
+
+// MetadataProvider is an external catalogue that book metadata can be fetched from.
+// LookupISBN returns ErrNotFound when the catalogue has no edition with an ISBN.
+type MetadataProvider interface {
+	Name() string
+	LookupISBN(ctx context.Context, isbn string) (*data.BookMetadata, error)
+	SearchBooks(ctx context.Context, title, author string) ([]*data.BookMetadata, error)
+}
+
+// ProviderChain is a MetadataProvider that queries its providers in order, falling back
+// to the next provider when one doesn't know a book or fails. Each provider is given
+// its own timeout and is skipped for a while after repeated failures. ISBN lookups are
+// cached.
+type ProviderChain struct {
+	providers []*chainedProvider
+	timeout   time.Duration
+	cacheTTL  time.Duration
+	mu        sync.Mutex
+	cache     map[string]cacheEntry
+}
+
+type chainedProvider struct {
+	MetadataProvider
+	breaker circuitBreaker
+}
+
+type cacheEntry struct {
+	metadata *data.BookMetadata
+	expiry   time.Time
+}
+
+// NewProviderChain creates a new provider chain querying providers in order, each with
+// timeout. ISBN lookups are cached for cacheTTL, or not at all when cacheTTL is 0.
+func NewProviderChain(timeout, cacheTTL time.Duration, providers ...MetadataProvider) *ProviderChain {
+	chain := &ProviderChain{
+		timeout:  timeout,
+		cacheTTL: cacheTTL,
+		cache:    make(map[string]cacheEntry),
+	}
+	for _, provider := range providers {
+		chain.providers = append(chain.providers, &chainedProvider{MetadataProvider: provider})
+	}
+	return chain
+}
+
+// Name returns the names of the chained providers.
+func (c *ProviderChain) Name() string {
+	names := make([]string, len(c.providers))
+	for i, provider := range c.providers {
+		names[i] = provider.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+// LookupISBN returns the metadata of the first provider that knows the edition with an
+// ISBN. The metadata's Source is set to the provider's name. It returns ErrNotFound when
+// no provider knows the ISBN, or ErrUnavailable when a provider that might have known it
+// failed.
+func (c *ProviderChain) LookupISBN(ctx context.Context, isbn string) (*data.BookMetadata, error) {
+	key := isbn
+	if _, isbn13, ok := validator.ParseISBN(isbn); ok {
+		key = isbn13
+	}
+	if metadata, ok := c.cached(key); ok {
+		if metadata == nil {
+			return nil, ErrNotFound
+		}
+		return metadata, nil
+	}
+	var lastErr error
+	for _, provider := range c.providers {
+		if !provider.breaker.allow() {
+			lastErr = fmt.Errorf("%s: circuit open", provider.Name())
+			continue
+		}
+		providerCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		metadata, err := provider.LookupISBN(providerCtx, isbn)
+		cancel()
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				provider.breaker.success()
+				continue
+			}
+			provider.breaker.failure()
+			lastErr = fmt.Errorf("%s: %w", provider.Name(), err)
+			continue
+		}
+		provider.breaker.success()
+		metadata.Source = provider.Name()
+		c.store(key, metadata, c.cacheTTL)
+		return copyMetadata(metadata), nil
+	}
+	if lastErr != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnavailable, lastErr)
+	}
+	ttl := c.cacheTTL
+	if ttl > notFoundTTL {
+		ttl = notFoundTTL
+	}
+	c.store(key, nil, ttl)
+	return nil, ErrNotFound
+}
+
+// SearchBooks returns the results of the first provider that finds books matching a
+// title and an author. It returns ErrUnavailable when a provider that might have found
+// books failed.
+func (c *ProviderChain) SearchBooks(ctx context.Context, title, author string) ([]*data.BookMetadata, error) {
+	var lastErr error
+	for _, provider := range c.providers {
+		if !provider.breaker.allow() {
+			lastErr = fmt.Errorf("%s: circuit open", provider.Name())
+			continue
+		}
+		providerCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		results, err := provider.SearchBooks(providerCtx, title, author)
+		cancel()
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			provider.breaker.failure()
+			lastErr = fmt.Errorf("%s: %w", provider.Name(), err)
+			continue
+		}
+		provider.breaker.success()
+		if len(results) == 0 {
+			continue
+		}
+		for _, metadata := range results {
+			metadata.Source = provider.Name()
+		}
+		return results, nil
+	}
+	if lastErr != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnavailable, lastErr)
+	}
+	return []*data.BookMetadata{}, nil
+}
+
+// cached returns the cached metadata for an ISBN, which is nil when no provider knew it.
+func (c *ProviderChain) cached(key string) (*data.BookMetadata, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.cache[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiry) {
+		delete(c.cache, key)
+		return nil, false
+	}
+	return copyMetadata(entry.metadata), true
+}
+
+// store caches the metadata for an ISBN, evicting expired entries when the cache is full.
+func (c *ProviderChain) store(key string, metadata *data.BookMetadata, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.cache) >= maxCacheEntries {
+		now := time.Now()
+		for k, entry := range c.cache {
+			if now.After(entry.expiry) {
+				delete(c.cache, k)
+			}
+		}
+		// Evict arbitrary entries when none have expired
+		for k := range c.cache {
+			if len(c.cache) < maxCacheEntries {
+				break
+			}
+			delete(c.cache, k)
+		}
+	}
+	c.cache[key] = cacheEntry{metadata: copyMetadata(metadata), expiry: time.Now().Add(ttl)}
+}
+
+// copyMetadata copies metadata so that callers can't modify cached metadata.
+func copyMetadata(metadata *data.BookMetadata) *data.BookMetadata {
+	if metadata == nil {
+		return nil
+	}
+	m := *metadata
+	m.Authors = append([]string(nil), metadata.Authors...)
+	m.Subjects = append([]string(nil), metadata.Subjects...)
+	return &m
+}
+
+// circuitBreaker stops calls to a provider for breakerCooldown after breakerThreshold
+// consecutive failures. Once the cooldown has passed a single call is let through, and
+// the circuit opens again straight away if it fails.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < breakerThreshold {
+		return true
+	}
+	if time.Now().Before(b.openUntil) {
+		return false
+	}
+	// Let a single trial call through while the circuit is half open
+	b.openUntil = time.Now().Add(breakerCooldown)
+	return true
+}
+
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+}
+
+func (b *circuitBreaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.failures >= breakerThreshold {
+		b.openUntil = time.Now().Add(breakerCooldown)
+	}
+}
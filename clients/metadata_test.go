@@ -0,0 +1,101 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+)
+
+type fakeProvider struct {
+	name  string
+	books map[string]*data.BookMetadata
+	err   error
+	calls int
+}
+
+func (p *fakeProvider) Name() string {
+	return p.name
+}
+
+func (p *fakeProvider) LookupISBN(ctx context.Context, isbn string) (*data.BookMetadata, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	metadata, ok := p.books[isbn]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	m := *metadata
+	return &m, nil
+}
+
+func (p *fakeProvider) SearchBooks(ctx context.Context, title, author string) ([]*data.BookMetadata, error) {
+	p.calls++
+	return nil, p.err
+}
+
+func TestProviderChain(t *testing.T) {
+	t.Run("Falls back to the next provider", func(t *testing.T) {
+		failing := &fakeProvider{name: "failing", err: errors.New("connection refused")}
+		empty := &fakeProvider{name: "empty"}
+		full := &fakeProvider{name: "full", books: map[string]*data.BookMetadata{"9780140328721": {Title: "Fantastic Mr. Fox"}}}
+		chain := NewProviderChain(time.Second, time.Hour, failing, empty, full)
+		metadata, err := chain.LookupISBN(context.Background(), "9780140328721")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if metadata.Title != "Fantastic Mr. Fox" || metadata.Source != "full" {
+			t.Errorf("unexpected title %q or source %q", metadata.Title, metadata.Source)
+		}
+	})
+
+	t.Run("Not found by any provider", func(t *testing.T) {
+		chain := NewProviderChain(time.Second, time.Hour, &fakeProvider{name: "empty"})
+		_, err := chain.LookupISBN(context.Background(), "9780140328721")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected ErrNotFound; got %v", err)
+		}
+	})
+
+	t.Run("Unavailable when a provider fails", func(t *testing.T) {
+		chain := NewProviderChain(time.Second, time.Hour, &fakeProvider{name: "empty"}, &fakeProvider{name: "failing", err: errors.New("timeout")})
+		_, err := chain.LookupISBN(context.Background(), "9780140328721")
+		if !errors.Is(err, ErrUnavailable) {
+			t.Errorf("expected ErrUnavailable; got %v", err)
+		}
+	})
+
+	t.Run("Caches lookups by ISBN-13", func(t *testing.T) {
+		provider := &fakeProvider{name: "full", books: map[string]*data.BookMetadata{"0140328726": {Title: "Fantastic Mr. Fox"}}}
+		chain := NewProviderChain(time.Second, time.Hour, provider)
+		for _, isbn := range []string{"0140328726", "9780140328721"} {
+			metadata, err := chain.LookupISBN(context.Background(), isbn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			metadata.Title = "modified"
+		}
+		metadata, err := chain.LookupISBN(context.Background(), "0140328726")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if provider.calls != 1 || metadata.Title != "Fantastic Mr. Fox" {
+			t.Errorf("expected 1 call and an unmodified title; got %d calls and %q", provider.calls, metadata.Title)
+		}
+	})
+
+	t.Run("Skips a provider after repeated failures", func(t *testing.T) {
+		failing := &fakeProvider{name: "failing", err: errors.New("timeout")}
+		chain := NewProviderChain(time.Second, 0, failing)
+		for i := 0; i < breakerThreshold+3; i++ {
+			chain.LookupISBN(context.Background(), "9780140328721")
+		}
+		if failing.calls != breakerThreshold {
+			t.Errorf("expected %d calls; got %d", breakerThreshold, failing.calls)
+		}
+	})
+}
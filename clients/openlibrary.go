@@ -0,0 +1,254 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/emzola/bibliotheca/data"
+)
+
+// ErrNotFound is returned when a remote API has no record for a request.
+var ErrNotFound = errors.New("remote record not found")
+
+var digitsRX = regexp.MustCompile(`\d+`)
+
+// OpenLibraryClient fetches book metadata from the Open Library API.
+type OpenLibraryClient struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+// NewOpenLibraryClient creates a new Open Library API client for the API at baseURL.
+func NewOpenLibraryClient(baseURL string) *OpenLibraryClient {
+	return &OpenLibraryClient{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		Client:  NewHTTPClient(),
+	}
+}
+
+// openLibraryText is a text field that Open Library returns either as a string or as
+// an object with a value.
+type openLibraryText string
+
+func (t *openLibraryText) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*t = openLibraryText(s)
+		return nil
+	}
+	var v struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*t = openLibraryText(v.Value)
+	return nil
+}
+
+type openLibraryKey struct {
+	Key string `json:"key"`
+}
+
+type openLibraryEdition struct {
+	Title         string           `json:"title"`
+	Publishers    []string         `json:"publishers"`
+	PublishDate   string           `json:"publish_date"`
+	NumberOfPages int32            `json:"number_of_pages"`
+	Description   openLibraryText  `json:"description"`
+	Subjects      []string         `json:"subjects"`
+	Isbn10        []string         `json:"isbn_10"`
+	Isbn13        []string         `json:"isbn_13"`
+	Authors       []openLibraryKey `json:"authors"`
+	Works         []openLibraryKey `json:"works"`
+}
+
+type openLibraryWork struct {
+	Description openLibraryText `json:"description"`
+	Subjects    []string        `json:"subjects"`
+	Authors     []struct {
+		Author openLibraryKey `json:"author"`
+	} `json:"authors"`
+}
+
+type openLibraryAuthor struct {
+	Name string `json:"name"`
+}
+
+type openLibrarySearchResult struct {
+	Docs []struct {
+		Title            string   `json:"title"`
+		AuthorName       []string `json:"author_name"`
+		Publisher        []string `json:"publisher"`
+		FirstPublishYear int32    `json:"first_publish_year"`
+		NumberOfPages    int32    `json:"number_of_pages_median"`
+		Subject          []string `json:"subject"`
+		Isbn             []string `json:"isbn"`
+	} `json:"docs"`
+}
+
+// Name returns the name Open Library metadata is attributed to.
+func (c *OpenLibraryClient) Name() string {
+	return "openlibrary"
+}
+
+// LookupISBN fetches the metadata of the edition with an ISBN, completed with the
+// description, subjects and authors of its work when the edition doesn't have them.
+func (c *OpenLibraryClient) LookupISBN(ctx context.Context, isbn string) (*data.BookMetadata, error) {
+	var edition openLibraryEdition
+	err := c.get(ctx, "/isbn/"+isbn+".json", &edition)
+	if err != nil {
+		return nil, err
+	}
+	metadata := &data.BookMetadata{
+		Title:       edition.Title,
+		PageCount:   edition.NumberOfPages,
+		Description: string(edition.Description),
+		Subjects:    edition.Subjects,
+	}
+	if len(edition.Publishers) > 0 {
+		metadata.Publisher = edition.Publishers[0]
+	}
+	metadata.Year = parsePublishYear(edition.PublishDate)
+	if len(edition.Isbn10) > 0 {
+		metadata.Isbn10 = edition.Isbn10[0]
+	}
+	if len(edition.Isbn13) > 0 {
+		metadata.Isbn13 = edition.Isbn13[0]
+	}
+	authorKeys := make([]string, len(edition.Authors))
+	for i, author := range edition.Authors {
+		authorKeys[i] = author.Key
+	}
+	if len(edition.Works) > 0 {
+		var work openLibraryWork
+		err := c.get(ctx, edition.Works[0].Key+".json", &work)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+		if metadata.Description == "" {
+			metadata.Description = string(work.Description)
+		}
+		if len(metadata.Subjects) == 0 {
+			metadata.Subjects = work.Subjects
+		}
+		if len(authorKeys) == 0 {
+			for _, author := range work.Authors {
+				authorKeys = append(authorKeys, author.Author.Key)
+			}
+		}
+	}
+	for i, key := range authorKeys {
+		if i == 5 {
+			break
+		}
+		var author openLibraryAuthor
+		err := c.get(ctx, key+".json", &author)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		if author.Name != "" {
+			metadata.Authors = append(metadata.Authors, author.Name)
+		}
+	}
+	return metadata, nil
+}
+
+// SearchBooks searches Open Library for works matching a title and an author, returning
+// at most 10 results.
+func (c *OpenLibraryClient) SearchBooks(ctx context.Context, title, author string) ([]*data.BookMetadata, error) {
+	qs := url.Values{}
+	if title != "" {
+		qs.Set("title", title)
+	}
+	if author != "" {
+		qs.Set("author", author)
+	}
+	qs.Set("limit", "10")
+	qs.Set("fields", "title,author_name,publisher,first_publish_year,number_of_pages_median,subject,isbn")
+	var result openLibrarySearchResult
+	err := c.get(ctx, "/search.json?"+qs.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*data.BookMetadata, 0, len(result.Docs))
+	for _, doc := range result.Docs {
+		metadata := &data.BookMetadata{
+			Title:     doc.Title,
+			Authors:   doc.AuthorName,
+			Year:      doc.FirstPublishYear,
+			PageCount: doc.NumberOfPages,
+			Subjects:  doc.Subject,
+		}
+		if len(doc.Publisher) > 0 {
+			metadata.Publisher = doc.Publisher[0]
+		}
+		for _, isbn := range doc.Isbn {
+			switch {
+			case len(isbn) == 13 && metadata.Isbn13 == "":
+				metadata.Isbn13 = isbn
+			case len(isbn) == 10 && metadata.Isbn10 == "":
+				metadata.Isbn10 = isbn
+			}
+		}
+		results = append(results, metadata)
+	}
+	return results, nil
+}
+
+// parsePublishYear returns the year of a free-form publish date such as "1999", "March 1999",
+// "1999-03-01", "October 1, 1988" or "c1988", or 0 when the date has no year. The last
+// four-digit number is used, since days and months come before the year in most formats.
+func parsePublishYear(date string) int32 {
+	numbers := digitsRX.FindAllString(date, -1)
+	for i := len(numbers) - 1; i >= 0; i-- {
+		if len(numbers[i]) != 4 {
+			continue
+		}
+		year, err := strconv.Atoi(numbers[i])
+		if err == nil {
+			return int32(year)
+		}
+	}
+	return 0
+}
+
+// get fetches a resource of the Open Library API and decodes it into dst.
+func (c *OpenLibraryClient) get(ctx context.Context, path string, dst interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case res.StatusCode != http.StatusOK:
+		return fmt.Errorf("open library: unexpected status %d for %s", res.StatusCode, path)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1_048_576))
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(body, dst)
+	if err != nil {
+		return fmt.Errorf("open library: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestOpenLibraryClient(t *testing.T) {
+	responses := map[string]string{
+		"/isbn/9780140328721.json": `{
+			"title": "Fantastic Mr. Fox",
+			"publishers": ["Puffin"],
+			"publish_date": "October 1, 1988",
+			"number_of_pages": 96,
+			"isbn_10": ["0140328726"],
+			"isbn_13": ["9780140328721"],
+			"works": [{"key": "/works/OL45804W"}]
+		}`,
+		"/works/OL45804W.json": `{
+			"description": {"type": "/type/text", "value": "The Boggis, Bunce and Bean story."},
+			"subjects": ["Foxes", "Juvenile fiction"],
+			"authors": [{"author": {"key": "/authors/OL34184A"}}]
+		}`,
+		"/authors/OL34184A.json": `{"name": "Roald Dahl"}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	defer ts.Close()
+	c := NewOpenLibraryClient(ts.URL + "/")
+
+	t.Run("Edition with work", func(t *testing.T) {
+		metadata, err := c.LookupISBN(context.Background(), "9780140328721")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if metadata.Title != "Fantastic Mr. Fox" || metadata.Publisher != "Puffin" {
+			t.Errorf("unexpected title %q or publisher %q", metadata.Title, metadata.Publisher)
+		}
+		if metadata.Year != 1988 || metadata.PageCount != 96 {
+			t.Errorf("expected year 1988 and 96 pages; got %d and %d", metadata.Year, metadata.PageCount)
+		}
+		if metadata.Description != "The Boggis, Bunce and Bean story." {
+			t.Errorf("unexpected description %q", metadata.Description)
+		}
+		if !reflect.DeepEqual(metadata.Authors, []string{"Roald Dahl"}) {
+			t.Errorf("unexpected authors %v", metadata.Authors)
+		}
+		if !reflect.DeepEqual(metadata.Subjects, []string{"Foxes", "Juvenile fiction"}) {
+			t.Errorf("unexpected subjects %v", metadata.Subjects)
+		}
+	})
+
+	t.Run("Unknown ISBN", func(t *testing.T) {
+		_, err := c.LookupISBN(context.Background(), "9780306406157")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected ErrNotFound; got %v", err)
+		}
+	})
+}
+
+func TestParsePublishYear(t *testing.T) {
+	tests := map[string]int32{
+		"1999":            1999,
+		"March 1999":      1999,
+		"1999-03-01":      1999,
+		"October 1, 1988": 1988,
+		"c1988":           1988,
+		"[1999?]":         1999,
+		"n.d.":            0,
+		"":                0,
+	}
+	for date, want := range tests {
+		if got := parsePublishYear(date); got != want {
+			t.Errorf("parsePublishYear(%q) = %d; want %d", date, got, want)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package config
 
-import "github.com/aws/aws-sdk-go-v2/service/s3"
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
 
 // Config defines the app configuration.
 type Config struct {
@@ -31,6 +35,32 @@ type Config struct {
 		Burst   int
 		Enabled bool
 	}
+	Recommendations struct {
+		RefreshInterval         time.Duration
+		MinCooccurrence         int
+		MaxPerBook              int
+		TrendingRefreshInterval time.Duration
+	}
+	SavedSearches struct {
+		NotifyInterval time.Duration
+	}
+	OpenLibrary struct {
+		BaseURL string
+	}
+	Covers struct {
+		BaseURL     string
+		BackfillRps float64
+	}
+	Metadata struct {
+		ProviderTimeout time.Duration
+		CacheTTL        time.Duration
+	}
+	Jobs struct {
+		ResetDownloadCountsSchedule   string
+		ExpireRequestsSchedule        string
+		DeleteExpiredTokensSchedule   string
+		RefreshBookPopularitySchedule string
+	}
 	Cors struct {
 		TrustedOrigins []string
 	}
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+// Author defines an author model.
+type Author struct {
+	ID             int64     `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	Name           string    `json:"name"`
+	SortName       string    `json:"sort_name"`
+	AlternateNames []string  `json:"alternate_names,omitempty"`
+	Bio            string    `json:"bio,omitempty"`
+	BirthYear      int32     `json:"birth_year,omitempty"`
+	DeathYear      int32     `json:"death_year,omitempty"`
+	BooksCount     int64     `json:"books_count"`
+	Version        int32     `json:"-"`
+}
+
+// NameKeys returns the lookup keys for an author's canonical and alternate names.
+func (a *Author) NameKeys() []string {
+	keys := []string{}
+	seen := make(map[string]bool)
+	for _, name := range append([]string{a.Name}, a.AlternateNames...) {
+		key := AuthorNameKey(name)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// NormalizeAuthorName trims and collapses whitespace in an author's name and turns
+// names written as "Last, First" into "First Last".
+func NormalizeAuthorName(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	if strings.Count(name, ",") == 1 {
+		parts := strings.Split(name, ",")
+		last, first := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if last != "" && first != "" {
+			name = first + " " + last
+		}
+	}
+	return name
+}
+
+// AuthorNameKey returns the key used to match author names. Case, whitespace and
+// punctuation are ignored so that "J.R.R. Tolkien", "J. R. R. Tolkien" and
+// "Tolkien, J.R.R." all produce the same key.
+func AuthorNameKey(name string) string {
+	var b strings.Builder
+	for _, r := range NormalizeAuthorName(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// AuthorSortName returns the name an author is sorted by, e.g. "Tolkien, J.R.R.".
+func AuthorSortName(name string) string {
+	fields := strings.Fields(NormalizeAuthorName(name))
+	if len(fields) < 2 {
+		return strings.Join(fields, " ")
+	}
+	return fields[len(fields)-1] + ", " + strings.Join(fields[:len(fields)-1], " ")
+}
+
+func ValidateAuthor(v *validator.Validator, author *Author) {
+	v.Check(author.Name != "", "name", "must be provided")
+	v.Check(len(author.Name) <= 500, "name", "must not be more than 500 bytes long")
+	v.Check(AuthorNameKey(author.Name) != "", "name", "must contain at least one letter or digit")
+	v.Check(len(author.SortName) <= 500, "sort_name", "must not be more than 500 bytes long")
+	v.Check(len(author.AlternateNames) <= 50, "alternate_names", "must not contain more than 50 names")
+	v.Check(validator.Unique(author.AlternateNames), "alternate_names", "must not contain duplicate values")
+	v.Check(len(author.Bio) <= 5000, "bio", "must not be more than 5000 bytes long")
+	v.Check(author.BirthYear >= 0, "birth_year", "must not be negative")
+	v.Check(author.BirthYear <= int32(time.Now().Year()), "birth_year", "must not be in the future")
+	v.Check(author.DeathYear >= 0, "death_year", "must not be negative")
+	v.Check(author.DeathYear <= int32(time.Now().Year()), "death_year", "must not be in the future")
+	if author.BirthYear != 0 && author.DeathYear != 0 {
+		v.Check(author.DeathYear >= author.BirthYear, "death_year", "must not be before birth year")
+	}
+}
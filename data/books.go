@@ -11,33 +11,44 @@ const (
 	ScopeBook  = "book"
 )
 
+// CoverSourceOwner is the cover source of covers uploaded by a book's owner. Fetched
+// covers are attributed to the service they were fetched from, and never replace an
+// owner's cover.
+const CoverSourceOwner = "owner"
+
 const DailyDownloadLimit int8 = 10
 
 // Book defines a book model.
 type Book struct {
-	ID          int64     `json:"id" `
-	UserID      int64     `json:"user_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	Title       string    `json:"title"`
-	Description string    `json:"description,omitempty"`
-	Author      []string  `json:"author,omitempty"`
-	Category    string    `json:"category,omitempty"`
-	Publisher   string    `json:"publisher,omitempty"`
-	Language    string    `json:"language,omitempty"`
-	Series      string    `json:"series,omitempty"`
-	Volume      int32     `json:"volume,omitempty"`
-	Edition     string    `json:"edition,omitempty"`
-	Year        int32     `json:"year,omitempty"`
-	PageCount   int32     `json:"page_count,omitempty"`
-	Isbn10      string    `json:"isbn_10,omitempty"`
-	Isbn13      string    `json:"isbn_13,omitempty"`
-	CoverPath   string    `json:"cover_path,omitempty"`
-	S3FileKey   string    `json:"s3_file_key"`
-	Filename    string    `json:"filename"`
-	Extension   string    `json:"extension"`
-	Size        int64     `json:"size"`
-	Popularity  float64   `json:"popularity,omitempty"`
-	Version     int32     `json:"-"`
+	ID           int64     `json:"id" `
+	UserID       int64     `json:"user_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description,omitempty"`
+	Author       []string  `json:"author,omitempty"`
+	AuthorIDs    []int64   `json:"author_ids,omitempty"`
+	Category     string    `json:"category,omitempty"`
+	Categories   []string  `json:"categories,omitempty"`
+	Tags         []string  `json:"tags,omitempty"`
+	Publisher    string    `json:"publisher,omitempty"`
+	Language     string    `json:"language,omitempty"`
+	LanguageName string    `json:"language_name,omitempty"`
+	Series       string    `json:"series,omitempty"`
+	Volume       int32     `json:"volume,omitempty"`
+	Edition      string    `json:"edition,omitempty"`
+	Year         int32     `json:"year,omitempty"`
+	PageCount    int32     `json:"page_count,omitempty"`
+	Isbn10       string    `json:"isbn_10,omitempty"`
+	Isbn13       string    `json:"isbn_13,omitempty"`
+	CoverPath    string    `json:"cover_path,omitempty"`
+	CoverSource  string    `json:"cover_source,omitempty"`
+	S3FileKey    string    `json:"s3_file_key"`
+	Filename     string    `json:"filename"`
+	Extension    string    `json:"extension"`
+	Size         int64     `json:"size"`
+	Checksum     string    `json:"-"`
+	Popularity   float64   `json:"popularity,omitempty"`
+	Version      int32     `json:"-"`
 }
 
 func ValidateBook(v *validator.Validator, book *Book) {
@@ -49,11 +60,40 @@ func ValidateBook(v *validator.Validator, book *Book) {
 	v.Check(len(book.Author) >= 1, "author", "must contain at least 1 author")
 	v.Check(len(book.Author) <= 5, "author", "must not contain more than 5 authors")
 	v.Check(validator.Unique(book.Author), "author", "must not contain duplicate values")
+	authorKeys := make([]string, len(book.Author))
+	for i, name := range book.Author {
+		authorKeys[i] = AuthorNameKey(name)
+		v.Check(authorKeys[i] != "", "author", "must not contain empty names")
+	}
+	v.Check(validator.Unique(authorKeys), "author", "must not contain the same author more than once")
+	if book.AuthorIDs != nil {
+		// Names that do not match an existing author resolve to ID 0 until the author is created
+		resolvedIDs := []int64{}
+		for _, id := range book.AuthorIDs {
+			if id != 0 {
+				resolvedIDs = append(resolvedIDs, id)
+			}
+		}
+		v.Check(len(book.AuthorIDs) == len(book.Author), "author", "must resolve to an author record")
+		v.Check(validator.UniqueInt64(resolvedIDs), "author", "must not contain the same author more than once")
+	}
 	v.Check(book.Category != "", "category", "must be provided")
+	v.Check(len(book.Categories) <= 5, "categories", "must not contain more than 5 categories")
+	v.Check(validator.Unique(book.Categories), "categories", "must not contain duplicate values")
 	v.Check(book.Language != "", "language", "must be provided")
+	if book.Language != "" {
+		ValidateLanguage(v, "language", book.Language)
+	}
 	v.Check(book.Year != 0, "year", "must be provided")
 	v.Check(book.Year >= 1900, "year", "must be greater than 1900")
 	v.Check(book.Year <= int32(time.Now().Year()), "year", "must not be in the future")
-	v.Check(len(book.Isbn10) <= 13, "isbn10", "must not be more than 13 characters")
-	v.Check(len(book.Isbn13) <= 17, "isbn13", "must not be more than 17 characters")
+	if book.Isbn10 != "" {
+		v.Check(validator.ISBN10(book.Isbn10), "isbn10", "must be a valid ISBN-10")
+	}
+	if book.Isbn13 != "" {
+		v.Check(validator.ISBN13(book.Isbn13), "isbn13", "must be a valid ISBN-13")
+	}
+	if validator.ISBN10(book.Isbn10) && validator.ISBN13(book.Isbn13) {
+		v.Check(validator.ISBN10To13(book.Isbn10) == book.Isbn13, "isbn13", "must be the same ISBN as isbn10")
+	}
 }
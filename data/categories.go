@@ -1,8 +1,61 @@
 package data
 
-// Category defines a category.
+import (
+	"regexp"
+	"strings"
+
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+var (
+	SlugRX          = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	slugSeparatorRX = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Category defines a category. Categories without a parent have a ParentID of 0.
 type Category struct {
-	ID         int64  `json:"id"`
-	Name       string `json:"category"`
-	BooksCount int64  `json:"books_count"`
+	ID            int64       `json:"id"`
+	ParentID      int64       `json:"parent_id,omitempty"`
+	Name          string      `json:"category"`
+	Slug          string      `json:"slug"`
+	BooksCount    int64       `json:"books_count"`
+	Subcategories []*Category `json:"subcategories,omitempty"`
+	Version       int32       `json:"-"`
+}
+
+// Slugify turns a category name into a slug, e.g. "Science Fiction & Fantasy" becomes
+// "science-fiction-fantasy".
+func Slugify(name string) string {
+	return strings.Trim(slugSeparatorRX.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// CategoryTree nests a flat list of categories under their parents and returns the
+// categories without a parent. The order of the list is kept within each level.
+func CategoryTree(categories []*Category) []*Category {
+	byID := make(map[int64]*Category, len(categories))
+	for _, category := range categories {
+		byID[category.ID] = category
+	}
+	roots := []*Category{}
+	for _, category := range categories {
+		parent, ok := byID[category.ParentID]
+		if category.ParentID == 0 || !ok {
+			roots = append(roots, category)
+			continue
+		}
+		parent.Subcategories = append(parent.Subcategories, category)
+	}
+	return roots
+}
+
+func ValidateCategory(v *validator.Validator, category *Category) {
+	v.Check(category.Name != "", "name", "must be provided")
+	v.Check(len(category.Name) <= 100, "name", "must not be more than 100 bytes long")
+	v.Check(category.Slug != "", "slug", "must be provided")
+	v.Check(len(category.Slug) <= 100, "slug", "must not be more than 100 bytes long")
+	v.Check(validator.Matches(category.Slug, SlugRX), "slug", "must only contain lowercase letters, digits and single hyphens")
+	// Numeric slugs would be mistaken for category IDs in URLs
+	v.Check(strings.Trim(category.Slug, "0123456789-") != "", "slug", "must contain at least one letter")
+	v.Check(category.ParentID >= 0, "parent_id", "must not be negative")
+	v.Check(category.ParentID != category.ID || category.ID == 0, "parent_id", "must not be the category itself")
 }
@@ -6,18 +6,41 @@ import (
 	"github.com/emzola/bibliotheca/internal/validator"
 )
 
-// Comment defines a booklist comment.
+// CommentDeletedContent replaces the content of deleted comments that still have replies, so
+// that the replies keep their place in the conversation.
+const CommentDeletedContent = "[deleted]"
+
+// Comment defines a booklist comment. Replies holds the replies to the comment as a tree, down
+// to the depth requested, while ReplyCount is the number of direct replies whether or not they
+// are included.
 type Comment struct {
-	ID         int64     `json:"id"`
-	ParentID   int64     `json:"parent_id"`
-	BooklistID int64     `json:"booklist_id"`
-	UserID     int64     `json:"user_id"`
-	UserName   string    `json:"username"`
-	CreatedAt  time.Time `json:"created_at"`
-	Content    string    `json:"content"`
-	Version    int32     `json:"-"`
+	ID         int64            `json:"id"`
+	ParentID   int64            `json:"parent_id"`
+	BooklistID int64            `json:"booklist_id"`
+	UserID     int64            `json:"user_id,omitempty"`
+	UserName   string           `json:"username,omitempty"`
+	CreatedAt  time.Time        `json:"created_at"`
+	Content    string           `json:"content"`
+	Deleted    bool             `json:"deleted,omitempty"`
+	ReplyCount int              `json:"reply_count"`
+	Replies    []*Comment       `json:"replies,omitempty"`
+	Reactions  []*ReactionCount `json:"reactions,omitempty"`
+	Mentions   []*Mention       `json:"mentions,omitempty"`
+	Version    int32            `json:"-"`
 }
 
 func ValidateComment(v *validator.Validator, comment *Comment) {
 	v.Check(comment.Content != "", "content", "must be provided")
 }
+
+func ValidateCommentDepth(v *validator.Validator, depth int) {
+	v.Check(depth >= 0, "depth", "must not be negative")
+	v.Check(depth <= 10, "depth", "must be a maximum of 10")
+}
+
+// Redact hides the author and content of a deleted comment.
+func (c *Comment) Redact() {
+	c.UserID = 0
+	c.UserName = ""
+	c.Content = CommentDeletedContent
+}
@@ -0,0 +1,30 @@
+package dto
+
+import "github.com/emzola/bibliotheca/data"
+
+// QsListAuthors defines the query strings used for listing authors.
+type QsListAuthors struct {
+	Search  string
+	Filters data.Filters
+}
+
+// QsListAuthorBooks defines the query strings used for listing an author's books.
+type QsListAuthorBooks struct {
+	Filters data.Filters
+}
+
+// UpdateAuthorRequestBody defines the request body for UpdateAuthor service. The fields are
+// set to a pointer type to allow partial updates based on whether the value is set to nil.
+type UpdateAuthorRequestBody struct {
+	Name           *string  `json:"name"`
+	SortName       *string  `json:"sort_name"`
+	AlternateNames []string `json:"alternate_names"`
+	Bio            *string  `json:"bio"`
+	BirthYear      *int32   `json:"birth_year"`
+	DeathYear      *int32   `json:"death_year"`
+}
+
+// MergeAuthorsRequestBody defines the request body for MergeAuthors service.
+type MergeAuthorsRequestBody struct {
+	DuplicateIDs []int64 `json:"duplicate_ids"`
+}
@@ -9,16 +9,23 @@ type QsListBooks struct {
 	ToYear    int
 	Language  []string
 	Extension []string
+	Tags      []string
+	Isbn      string
 	Filters   data.Filters
 }
 
 // UpdateBookRequestBody defines the request body for UpdateBook service. The fields are set
 // to a pointer type to allow partial updates based on whether the value if set to nil.
+// Author names are resolved to author records, creating authors that don't exist yet.
+// Categories are names or slugs of existing categories; the first one is the book's main
+// category. Category sets the main category only and is ignored when Categories is set.
+// Language is an ISO 639 code or English language name.
 type UpdateBookRequestBody struct {
 	Title       *string  `json:"title"`
 	Description *string  `json:"description"`
 	Author      []string `json:"author"`
 	Category    *string  `json:"category"`
+	Categories  []string `json:"categories"`
 	Publisher   *string  `json:"publisher"`
 	Language    *string  `json:"language"`
 	Series      *string  `json:"series"`
@@ -28,10 +35,32 @@ type UpdateBookRequestBody struct {
 	PageCount   *int32   `json:"page_count"`
 	Isbn10      *string  `json:"isbn_10"`
 	Isbn13      *string  `json:"isbn_13"`
-	Popularity  *float64 `json:"popularity"`
+}
+
+// QsListTrendingBooks defines the query strings used for listing trending books.
+type QsListTrendingBooks struct {
+	Window     string
+	CategoryID int
+	Filters    data.Filters
 }
 
 // QsListUserBooks defines query strings for ListUserBooks service.
 type QsListUserBooks struct {
 	Filters data.Filters
 }
+
+// QsListBookDuplicates defines the query strings used for listing likely duplicate books.
+type QsListBookDuplicates struct {
+	Filters data.Filters
+}
+
+// AcceptBookEnrichmentRequestBody defines the request body for AcceptBookEnrichment service.
+// When no fields are given, all proposed fields are accepted.
+type AcceptBookEnrichmentRequestBody struct {
+	Fields []string `json:"fields"`
+}
+
+// MergeBooksRequestBody defines the request body for MergeBooks service.
+type MergeBooksRequestBody struct {
+	SourceIDs []int64 `json:"source_ids"`
+}
@@ -4,5 +4,33 @@ import "github.com/emzola/bibliotheca/data"
 
 // QsShowCategory defines the query strings used for showing a category.
 type QsShowCategory struct {
-	Filters data.Filters
+	IncludeSubcategories bool
+	Filters              data.Filters
+}
+
+// QsDeleteCategory defines the query strings used for deleting a category.
+type QsDeleteCategory struct {
+	MoveTo int
+}
+
+// CreateCategoryRequestBody defines the request body for CreateCategory service. The slug
+// is derived from the name when it isn't provided.
+type CreateCategoryRequestBody struct {
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	ParentID int64  `json:"parent_id"`
+}
+
+// UpdateCategoryRequestBody defines the request body for UpdateCategory service. The fields are
+// set to a pointer type to allow partial updates based on whether the value is set to nil.
+// A parent_id of 0 makes the category a top-level category.
+type UpdateCategoryRequestBody struct {
+	Name     *string `json:"name"`
+	Slug     *string `json:"slug"`
+	ParentID *int64  `json:"parent_id"`
+}
+
+// MergeCategoriesRequestBody defines the request body for MergeCategories service.
+type MergeCategoriesRequestBody struct {
+	SourceIDs []int64 `json:"source_ids"`
 }
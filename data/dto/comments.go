@@ -1,5 +1,7 @@
 package dto
 
+import "github.com/emzola/bibliotheca/data"
+
 // CreateCommentRequestBody defines the request body for CreateComment service.
 type CreateCommentRequestBody struct {
 	Content string `json:"content"`
@@ -14,3 +16,14 @@ type UpdateCommentRequestBody struct {
 type CreateCommentReplyRequestBody struct {
 	Content string `json:"content"`
 }
+
+// QsListComments defines the query strings used for listing the comments of a booklist.
+type QsListComments struct {
+	Depth   int
+	Filters data.Filters
+}
+
+// QsShowCommentThread defines the query strings used for showing a comment with its replies.
+type QsShowCommentThread struct {
+	Depth int
+}
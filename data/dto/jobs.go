@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/emzola/bibliotheca/data"
+
+// QsListJobRuns defines the query strings used for listing the runs of a job.
+type QsListJobRuns struct {
+	Filters data.Filters
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/emzola/bibliotheca/data"
+
+// QsListNotifications defines the query strings used for listing notifications.
+type QsListNotifications struct {
+	Unread  bool
+	Filters data.Filters
+}
+
+// MarkNotificationReadRequestBody defines the request body for MarkNotificationRead service.
+type MarkNotificationReadRequestBody struct {
+	Read *bool `json:"read"`
+}
+
+// UpdateNotificationPreferencesRequestBody defines the request body for UpdateNotificationPreferences service.
+type UpdateNotificationPreferencesRequestBody struct {
+	Preferences []*data.NotificationPreference `json:"preferences"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+// ReactRequestBody defines the request body for the services that add a reaction.
+type ReactRequestBody struct {
+	Reaction string `json:"reaction"`
+}
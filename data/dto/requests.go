@@ -2,19 +2,24 @@ package dto
 
 import "github.com/emzola/bibliotheca/data"
 
-// CreateRequestRequestBody defines a request body for CreateRequest service.
+// CreateRequestRequestBody defines a request body for CreateRequest service. Title, Author,
+// Publisher and Year are used for books that no metadata provider knows, and to fill in
+// details a provider doesn't have. CategoryID optionally files the request under a category.
 type CreateRequestRequestBody struct {
-	Isbn string `json:"isbn"`
+	Isbn       string   `json:"isbn"`
+	Title      string   `json:"title"`
+	Author     []string `json:"author"`
+	Publisher  string   `json:"publisher"`
+	Year       int32    `json:"year"`
+	CategoryID int64    `json:"category_id"`
 }
 
-// The OpenLibAPIRequestBody struct contains the expected JSON data that has
-// been decoded into a Go type from the openlibrary API.
-type OpenLibAPIRequestBody struct {
-	Title     string   `json:"title"`
-	Publisher []string `json:"publishers"`
-	Isbn10    []string `json:"isbn_10"`
-	Isbn13    []string `json:"isbn_13"`
-	Date      string   `json:"publish_date"`
+// TransitionRequestRequestBody defines a request body for TransitionRequest service.
+// BookID is the book that fulfils the request when Status is fulfilled.
+type TransitionRequestRequestBody struct {
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+	BookID int64  `json:"book_id"`
 }
 
 // QsListRequest defines query strings for ListRequest service.
@@ -23,3 +28,9 @@ type QsListRequest struct {
 	Status  string
 	Filters data.Filters
 }
+
+// QsListTopRequests defines query strings for ListTopRequests service.
+type QsListTopRequests struct {
+	CategoryID int
+	Filters    data.Filters
+}
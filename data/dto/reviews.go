@@ -18,3 +18,8 @@ type UpdateReviewRequestBody struct {
 type QsListReviews struct {
 	Filters data.Filters
 }
+
+// VoteReviewRequestBody defines a request body for VoteReview service.
+type VoteReviewRequestBody struct {
+	Helpful *bool `json:"helpful"`
+}
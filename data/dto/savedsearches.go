@@ -0,0 +1,22 @@
+package dto
+
+// CreateSavedSearchRequestBody defines the request body for CreateSavedSearch service.
+// The search text and filters are the same as the query strings of ListBooks.
+type CreateSavedSearchRequestBody struct {
+	Name      string   `json:"name"`
+	Search    string   `json:"search"`
+	FromYear  int      `json:"from_year"`
+	ToYear    int      `json:"to_year"`
+	Language  []string `json:"language"`
+	Extension []string `json:"extension"`
+	Tags      []string `json:"tags"`
+	Isbn      string   `json:"isbn"`
+	Notify    bool     `json:"notify"`
+}
+
+// UpdateSavedSearchRequestBody defines the request body for UpdateSavedSearch service. The fields are
+// set to a pointer type to allow partial updates based on whether the value is set to nil.
+type UpdateSavedSearchRequestBody struct {
+	Name   *string `json:"name"`
+	Notify *bool   `json:"notify"`
+}
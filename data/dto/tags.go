@@ -0,0 +1,24 @@
+package dto
+
+import "github.com/emzola/bibliotheca/data"
+
+// QsListTags defines the query strings used for listing tags.
+type QsListTags struct {
+	Prefix string
+	Limit  int
+}
+
+// QsListPendingBookTags defines the query strings used for listing tags awaiting moderation.
+type QsListPendingBookTags struct {
+	Filters data.Filters
+}
+
+// SuggestBookTagsRequestBody defines the request body for SuggestBookTags service.
+type SuggestBookTagsRequestBody struct {
+	Tags []string `json:"tags"`
+}
+
+// ModerateBookTagRequestBody defines the request body for ModerateBookTag service.
+type ModerateBookTagRequestBody struct {
+	Status string `json:"status"`
+}
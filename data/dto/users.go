@@ -5,6 +5,7 @@ import "github.com/emzola/bibliotheca/data"
 // RegisterUserRequestBody defines a request body for RegisterUser service.
 type RegisterUserRequestBody struct {
 	Name     string `json:"name"`
+	Username string `json:"username"`
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
@@ -22,8 +23,9 @@ type ResetUserPasswordRequestBody struct {
 
 // UpdateUserRequestBody defines a request body for UpdateUser service.
 type UpdateUserRequestBody struct {
-	Name  *string `json:"name"`
-	Email *string `json:"email"`
+	Name     *string `json:"name"`
+	Username *string `json:"username"`
+	Email    *string `json:"email"`
 }
 
 // UpdateUserPasswordRequestBody defines a request body for UpdateUserPassword service.
@@ -33,6 +35,11 @@ type UpdateUserPasswordRequestBody struct {
 	ConfirmNewPassword string `json:"confirm_new_password"`
 }
 
+// QsLookupUser defines query strings for LookupUser service.
+type QsLookupUser struct {
+	Username string
+}
+
 // QsListUserRequests defines query strings for QsListUserRequests service.
 type QsListUserRequests struct {
 	Status  string
@@ -0,0 +1,19 @@
+package data
+
+import "time"
+
+const (
+	DuplicateReasonSameFile            = "same_file"
+	DuplicateReasonSameIsbn            = "same_isbn"
+	DuplicateReasonSameTitleAuthorYear = "same_title_author_year"
+)
+
+// BookDuplicate defines a pair of books that are likely the same book.
+type BookDuplicate struct {
+	BookID             int64     `json:"book_id"`
+	BookTitle          string    `json:"book_title"`
+	DuplicateBookID    int64     `json:"duplicate_book_id"`
+	DuplicateBookTitle string    `json:"duplicate_book_title"`
+	Reason             string    `json:"reason"`
+	CreatedAt          time.Time `json:"created_at"`
+}
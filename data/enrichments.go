@@ -0,0 +1,110 @@
+package data
+
+import "time"
+
+// BookMetadata defines the metadata of a book edition fetched from an external catalogue.
+type BookMetadata struct {
+	Title       string   `json:"title,omitempty"`
+	Authors     []string `json:"author,omitempty"`
+	Publisher   string   `json:"publisher,omitempty"`
+	Year        int32    `json:"year,omitempty"`
+	PageCount   int32    `json:"page_count,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Subjects    []string `json:"subjects,omitempty"`
+	Isbn10      string   `json:"isbn_10,omitempty"`
+	Isbn13      string   `json:"isbn_13,omitempty"`
+	Source      string   `json:"source,omitempty"`
+}
+
+// BookEnrichment defines metadata proposed for the empty fields of a book, awaiting
+// the owner's acceptance. Changes lists each proposed field with the book's current value.
+type BookEnrichment struct {
+	BookID    int64             `json:"book_id"`
+	Source    string            `json:"source"`
+	Metadata  BookMetadata      `json:"-"`
+	Changes   []BookFieldChange `json:"changes"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// BookFieldChange defines the current and proposed value of a book field.
+type BookFieldChange struct {
+	Field    string      `json:"field"`
+	Current  interface{} `json:"current"`
+	Proposed interface{} `json:"proposed"`
+}
+
+// EnrichableBookFields are the book fields that can be filled from external metadata.
+var EnrichableBookFields = []string{"author", "publisher", "year", "page_count", "description", "isbn_10", "isbn_13", "tags"}
+
+// ProposeBookMetadata returns the metadata for the fields of a book that are empty, leaving out
+// the subjects the book is already tagged with. Subjects are returned as normalized tags.
+func ProposeBookMetadata(book *Book, metadata BookMetadata) BookMetadata {
+	var proposal BookMetadata
+	if len(book.Author) == 0 {
+		proposal.Authors = metadata.Authors
+	}
+	if book.Publisher == "" {
+		proposal.Publisher = metadata.Publisher
+	}
+	if book.Year == 0 {
+		proposal.Year = metadata.Year
+	}
+	if book.PageCount == 0 {
+		proposal.PageCount = metadata.PageCount
+	}
+	if book.Description == "" {
+		proposal.Description = metadata.Description
+	}
+	if book.Isbn10 == "" {
+		proposal.Isbn10 = metadata.Isbn10
+	}
+	if book.Isbn13 == "" {
+		proposal.Isbn13 = metadata.Isbn13
+	}
+	tagged := make(map[string]bool)
+	for _, tag := range book.Tags {
+		tagged[tag] = true
+	}
+	for _, subject := range metadata.Subjects {
+		tag := NormalizeTag(subject)
+		if tag == "" || len(tag) > 50 || tagged[tag] {
+			continue
+		}
+		tagged[tag] = true
+		proposal.Subjects = append(proposal.Subjects, tag)
+		if len(proposal.Subjects) == 10 {
+			break
+		}
+	}
+	return proposal
+}
+
+// BookFieldChanges lists the fields a metadata proposal fills on a book.
+func BookFieldChanges(book *Book, proposal BookMetadata) []BookFieldChange {
+	changes := []BookFieldChange{}
+	if len(proposal.Authors) > 0 {
+		changes = append(changes, BookFieldChange{"author", book.Author, proposal.Authors})
+	}
+	if proposal.Publisher != "" {
+		changes = append(changes, BookFieldChange{"publisher", book.Publisher, proposal.Publisher})
+	}
+	if proposal.Year != 0 {
+		changes = append(changes, BookFieldChange{"year", book.Year, proposal.Year})
+	}
+	if proposal.PageCount != 0 {
+		changes = append(changes, BookFieldChange{"page_count", book.PageCount, proposal.PageCount})
+	}
+	if proposal.Description != "" {
+		changes = append(changes, BookFieldChange{"description", book.Description, proposal.Description})
+	}
+	if proposal.Isbn10 != "" {
+		changes = append(changes, BookFieldChange{"isbn_10", book.Isbn10, proposal.Isbn10})
+	}
+	if proposal.Isbn13 != "" {
+		changes = append(changes, BookFieldChange{"isbn_13", book.Isbn13, proposal.Isbn13})
+	}
+	if len(proposal.Subjects) > 0 {
+		changes = append(changes, BookFieldChange{"tags", book.Tags, proposal.Subjects})
+	}
+	return changes
+}
@@ -0,0 +1,39 @@
+package data
+
+import "time"
+
+// Job run statuses.
+const (
+	JobRunStatusRunning   = "running"
+	JobRunStatusSucceeded = "succeeded"
+	JobRunStatusFailed    = "failed"
+)
+
+// Job run triggers.
+const (
+	JobTriggerSchedule = "schedule"
+	JobTriggerManual   = "manual"
+)
+
+// Job defines a scheduled background job.
+type Job struct {
+	Name     string    `json:"name"`
+	Schedule string    `json:"schedule"`
+	NextRun  time.Time `json:"next_run"`
+	LastRun  *JobRun   `json:"last_run,omitempty"`
+}
+
+// JobRun defines a run of a scheduled job. ScheduledAt is the time the run was due, which
+// is used to make sure that only one replica runs a job at each scheduled time. UserID is
+// the admin who triggered a manual run.
+type JobRun struct {
+	ID          int64      `json:"id"`
+	JobName     string     `json:"job_name"`
+	Trigger     string     `json:"trigger"`
+	UserID      int64      `json:"user_id,omitempty"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error,omitempty"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	StartedAt   time.Time  `json:"started_at"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+}
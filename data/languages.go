@@ -0,0 +1,38 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/emzola/bibliotheca/internal/iso639"
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+// LanguageCount defines the number of books in a language.
+type LanguageCount struct {
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Books int64  `json:"books"`
+}
+
+// NormalizeLanguage returns the ISO 639 code of a language given by its ISO 639 code
+// or English name. Values that aren't a known language are returned trimmed, so that
+// ValidateLanguage can reject them.
+func NormalizeLanguage(language string) string {
+	if l, ok := iso639.Lookup(language); ok {
+		return l.Code
+	}
+	return strings.TrimSpace(language)
+}
+
+// NormalizeLanguages normalizes each language of a language filter.
+func NormalizeLanguages(languages []string) []string {
+	normalized := make([]string, len(languages))
+	for i, language := range languages {
+		normalized[i] = NormalizeLanguage(language)
+	}
+	return normalized
+}
+
+func ValidateLanguage(v *validator.Validator, key, language string) {
+	v.Check(iso639.Name(language) != "", key, "must be an ISO 639 language code or English language name")
+}
@@ -0,0 +1,90 @@
+package data
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxMentions is the number of users a comment or review can mention. Mentions past it are
+// left as plain text.
+const MaxMentions = 10
+
+// mentionRX matches a mention: an @ followed by a username.
+var mentionRX = regexp.MustCompile(`@[a-zA-Z0-9_]{3,30}`)
+
+// Mention defines a user mentioned in the content of a comment or review. Offset and Length
+// locate the mention, including its @, in the content, counted in characters.
+type Mention struct {
+	UserID   int64  `json:"user_id"`
+	Username string `json:"username"`
+	Offset   int    `json:"offset"`
+	Length   int    `json:"length"`
+}
+
+// MentionedUser defines a user mentioned by a comment or review. Handle is the username the
+// user was mentioned by, which stays in the content if the user later changes their username.
+type MentionedUser struct {
+	UserID   int64
+	Username string
+	Handle   string
+}
+
+// FindMentions finds the mentions in content, in order, with the username each one was written
+// with. A mention must not be part of a longer word, so email addresses aren't mentions.
+func FindMentions(content string) []*Mention {
+	mentions := []*Mention{}
+	for _, loc := range mentionRX.FindAllStringIndex(content, -1) {
+		start, end := loc[0], loc[1]
+		if start > 0 && (isUsernameByte(content[start-1]) || content[start-1] == '@') {
+			continue
+		}
+		if end < len(content) && isUsernameByte(content[end]) {
+			continue
+		}
+		mentions = append(mentions, &Mention{
+			Username: content[start+1 : end],
+			Offset:   utf8.RuneCountInString(content[:start]),
+			Length:   end - start,
+		})
+	}
+	return mentions
+}
+
+// MentionedHandles returns the distinct usernames mentioned in content, lowercased, up to
+// MaxMentions of them.
+func MentionedHandles(content string) []string {
+	handles := []string{}
+	seen := make(map[string]bool)
+	for _, mention := range FindMentions(content) {
+		handle := strings.ToLower(mention.Username)
+		if !seen[handle] && len(handles) < MaxMentions {
+			seen[handle] = true
+			handles = append(handles, handle)
+		}
+	}
+	return handles
+}
+
+// ResolveMentions finds the mentions of users in content, skipping mentions of anyone else.
+// Each mention has the user's current username.
+func ResolveMentions(content string, users []*MentionedUser) []*Mention {
+	byHandle := make(map[string]*MentionedUser, len(users))
+	for _, user := range users {
+		byHandle[strings.ToLower(user.Handle)] = user
+	}
+	mentions := []*Mention{}
+	for _, mention := range FindMentions(content) {
+		if user, ok := byHandle[strings.ToLower(mention.Username)]; ok {
+			mention.UserID = user.UserID
+			mention.Username = user.Username
+			mentions = append(mentions, mention)
+		}
+	}
+	return mentions
+}
+
+// isUsernameByte checks whether b can be part of a username.
+func isUsernameByte(b byte) bool {
+	return b == '_' || b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
@@ -0,0 +1,48 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		mentions []Mention
+	}{
+		{"Single mention", "thanks @jane_doe!", []Mention{{Username: "jane_doe", Offset: 7, Length: 9}}},
+		{"Mention at start", "@bob agreed", []Mention{{Username: "bob", Offset: 0, Length: 4}}},
+		{"Offset counted in characters", "très bien @Ana_1", []Mention{{Username: "Ana_1", Offset: 10, Length: 6}}},
+		{"Several mentions", "@ann and @bob", []Mention{{Username: "ann", Offset: 0, Length: 4}, {Username: "bob", Offset: 9, Length: 4}}},
+		{"Email address", "mail bob@example.com", nil},
+		{"Double @", "@@bob", nil},
+		{"Too short", "@al", nil},
+		{"Too long", "@abcdefghijklmnopqrstuvwxyz012345", nil},
+		{"No mentions", "a plain comment", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := FindMentions(tt.content)
+			mentions := []Mention{}
+			for _, mention := range found {
+				mentions = append(mentions, *mention)
+			}
+			if len(mentions) == 0 && len(tt.mentions) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(mentions, tt.mentions) {
+				t.Errorf("expected %+v; got %+v", tt.mentions, mentions)
+			}
+		})
+	}
+}
+
+func TestResolveMentions(t *testing.T) {
+	users := []*MentionedUser{{UserID: 7, Username: "jane", Handle: "JaneD"}}
+	mentions := ResolveMentions("hi @janed and @bob", users)
+	expected := []*Mention{{UserID: 7, Username: "jane", Offset: 3, Length: 6}}
+	if !reflect.DeepEqual(mentions, expected) {
+		t.Errorf("expected %+v; got %+v", expected, mentions)
+	}
+}
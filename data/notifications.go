@@ -0,0 +1,82 @@
+package data
+
+import (
+	"strings"
+	"time"
+
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+// Events users are notified of.
+const (
+	NotificationCommentReply     = "comment_reply"
+	NotificationBooklistComment  = "booklist_comment"
+	NotificationBookReview       = "book_review"
+	NotificationBooklistBook     = "booklist_book"
+	NotificationRequestFulfilled = "request_fulfilled"
+	NotificationMention          = "mention"
+)
+
+// NotificationEvents are all the events users are notified of.
+var NotificationEvents = []string{
+	NotificationCommentReply,
+	NotificationBooklistComment,
+	NotificationBookReview,
+	NotificationBooklistBook,
+	NotificationRequestFulfilled,
+	NotificationMention,
+}
+
+// Notification defines an in-app notification of an event. ActorID is the user who caused
+// the event, if any, and the other IDs point to the records the event concerns.
+type Notification struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"-"`
+	Event      string    `json:"event"`
+	ActorID    int64     `json:"actor_id,omitempty"`
+	ActorName  string    `json:"actor_name,omitempty"`
+	Message    string    `json:"message"`
+	BookID     int64     `json:"book_id,omitempty"`
+	BooklistID int64     `json:"booklist_id,omitempty"`
+	CommentID  int64     `json:"comment_id,omitempty"`
+	ReviewID   int64     `json:"review_id,omitempty"`
+	RequestID  int64     `json:"request_id,omitempty"`
+	Read       bool      `json:"read"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// NotificationPreference defines how a user is notified of an event.
+type NotificationPreference struct {
+	Event string `json:"event"`
+	InApp bool   `json:"in_app"`
+	Email bool   `json:"email"`
+}
+
+// DefaultNotificationPreference returns how users who haven't set a preference for an event
+// are notified of it: in the app, and by email only for fulfilled requests.
+func DefaultNotificationPreference(event string) *NotificationPreference {
+	return &NotificationPreference{
+		Event: event,
+		InApp: true,
+		Email: event == NotificationRequestFulfilled,
+	}
+}
+
+// NotificationRecipient defines a user to notify of an event, and how.
+type NotificationRecipient struct {
+	UserID    int64
+	UserName  string
+	UserEmail string
+	InApp     bool
+	Email     bool
+}
+
+func ValidateNotificationPreferences(v *validator.Validator, preferences []*NotificationPreference) {
+	v.Check(len(preferences) > 0, "preferences", "must contain at least one preference")
+	events := make([]string, len(preferences))
+	for i, preference := range preferences {
+		events[i] = preference.Event
+		v.Check(validator.In(preference.Event, NotificationEvents...), "event", "must be one of "+strings.Join(NotificationEvents, ", "))
+	}
+	v.Check(validator.Unique(events), "preferences", "must not contain duplicate events")
+}
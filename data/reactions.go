@@ -0,0 +1,32 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+// Reactions users can add to booklist comments and book reviews.
+const (
+	ReactionLike       = "like"
+	ReactionInsightful = "insightful"
+	ReactionFunny      = "funny"
+	ReactionLove       = "love"
+	ReactionSad        = "sad"
+)
+
+// Reactions are all the reactions, in the order they are listed in.
+var Reactions = []string{ReactionLike, ReactionInsightful, ReactionFunny, ReactionLove, ReactionSad}
+
+// ReactionCount defines the number of users who reacted to a comment or review with a
+// reaction, and whether the current user is one of them.
+type ReactionCount struct {
+	Reaction string `json:"reaction"`
+	Count    int64  `json:"count"`
+	Reacted  bool   `json:"reacted"`
+}
+
+func ValidateReaction(v *validator.Validator, reaction string) {
+	v.Check(reaction != "", "reaction", "must be provided")
+	v.Check(reaction == "" || validator.In(reaction, Reactions...), "reaction", "must be one of "+strings.Join(Reactions, ", "))
+}
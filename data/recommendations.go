@@ -0,0 +1,65 @@
+package data
+
+import "time"
+
+const (
+	ReasonAlsoDownloaded = "readers_also_downloaded"
+	ReasonSameAuthor     = "same_author"
+	ReasonSameCategory   = "same_category"
+	ReasonSimilarContent = "similar_content"
+)
+
+// RelatedBook defines a book related to another book and why it is related.
+type RelatedBook struct {
+	*Book
+	Score  float64 `json:"score"`
+	Reason string  `json:"reason"`
+}
+
+const (
+	ReasonBecauseYouLiked   = "because_you_liked"
+	ReasonPopularInCategory = "popular_in_category"
+	ReasonPopular           = "popular"
+)
+
+// Recommendation defines a book recommended to a user and why it was picked. Source is
+// the title of the book or the name of the category the recommendation comes from.
+type Recommendation struct {
+	*Book
+	Score       float64 `json:"score"`
+	Reason      string  `json:"reason"`
+	Source      string  `json:"source,omitempty"`
+	Explanation string  `json:"explanation"`
+}
+
+// TrendingWindow defines a period books are ranked over. Period is a PostgreSQL interval,
+// empty for all time, and activity loses half its weight every HalfLife.
+type TrendingWindow struct {
+	Name     string
+	Period   string
+	HalfLife time.Duration
+}
+
+var TrendingWindows = []TrendingWindow{
+	{Name: "7d", Period: "7 days", HalfLife: 2 * 24 * time.Hour},
+	{Name: "30d", Period: "30 days", HalfLife: 7 * 24 * time.Hour},
+	{Name: "all", Period: "", HalfLife: 30 * 24 * time.Hour},
+}
+
+// TrendingWindowNames returns the names of the trending windows.
+func TrendingWindowNames() []string {
+	names := make([]string, len(TrendingWindows))
+	for i, window := range TrendingWindows {
+		names[i] = window.Name
+	}
+	return names
+}
+
+// TrendingBook defines a book ranked by recent activity within a trending window.
+type TrendingBook struct {
+	*Book
+	Score      float64 `json:"score"`
+	Downloads  int64   `json:"downloads"`
+	Favourites int64   `json:"favourites"`
+	Reviews    int64   `json:"reviews"`
+}
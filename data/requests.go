@@ -6,21 +6,160 @@ import (
 	"github.com/emzola/bibliotheca/internal/validator"
 )
 
+// Request statuses. Open, in progress and reopened requests are active: they can be claimed
+// by a volunteer, and are fulfilled when a matching book is uploaded.
+const (
+	RequestStatusOpen       = "open"
+	RequestStatusInProgress = "in_progress"
+	RequestStatusFulfilled  = "fulfilled"
+	RequestStatusRejected   = "rejected"
+	RequestStatusExpired    = "expired"
+	RequestStatusReopened   = "reopened"
+)
+
+// RequestStatuses are all the statuses of a request.
+var RequestStatuses = []string{RequestStatusOpen, RequestStatusInProgress, RequestStatusFulfilled, RequestStatusRejected, RequestStatusExpired, RequestStatusReopened}
+
+// RequestActiveStatuses are the statuses of requests that are still wanted.
+var RequestActiveStatuses = []string{RequestStatusOpen, RequestStatusInProgress, RequestStatusReopened}
+
+// requestTransitions lists the statuses a request can move to from each status.
+var requestTransitions = map[string][]string{
+	RequestStatusOpen:       {RequestStatusInProgress, RequestStatusFulfilled, RequestStatusRejected, RequestStatusExpired},
+	RequestStatusReopened:   {RequestStatusInProgress, RequestStatusFulfilled, RequestStatusRejected, RequestStatusExpired},
+	RequestStatusInProgress: {RequestStatusOpen, RequestStatusFulfilled, RequestStatusRejected, RequestStatusExpired},
+	RequestStatusFulfilled:  {RequestStatusReopened},
+	RequestStatusRejected:   {RequestStatusReopened},
+	RequestStatusExpired:    {RequestStatusReopened},
+}
+
+// CanTransitionRequest reports whether a request can move from one status to another.
+func CanTransitionRequest(from, to string) bool {
+	return validator.In(to, requestTransitions[from]...)
+}
+
+// RequestSourceManual is the source of requests entered by hand for books that no
+// metadata provider knows.
+const RequestSourceManual = "manual"
+
 // Request defines a book request.
 type Request struct {
-	ID        int64     `json:"id,omitempty"`
-	UserID    int64     `json:"user_id,omitempty"`
-	Title     string    `json:"title,omitempty"`
-	Publisher string    `json:"publisher,omitempty"`
-	Isbn      string    `json:"isbn,omitempty"`
-	Year      int32     `json:"year,omitempty"`
-	Expiry    time.Time `json:"expiry,omitempty"`
-	Status    string    `json:"status,omitempty"`
-	Waitlist  int32     `json:"waitlist,omitempty"`
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	Version   int32     `json:"-"`
+	ID         int64           `json:"id,omitempty"`
+	UserID     int64           `json:"user_id,omitempty"`
+	Title      string          `json:"title,omitempty"`
+	Author     []string        `json:"author,omitempty"`
+	Publisher  string          `json:"publisher,omitempty"`
+	Isbn       string          `json:"isbn,omitempty"`
+	Year       int32           `json:"year,omitempty"`
+	CoverPath  string          `json:"cover_path,omitempty"`
+	Expiry     time.Time       `json:"expiry,omitempty"`
+	Status     string          `json:"status,omitempty"`
+	Waitlist   int32           `json:"waitlist,omitempty"`
+	BookID     int64           `json:"book_id,omitempty"`
+	ClaimedBy  int64           `json:"claimed_by,omitempty"`
+	CategoryID int64           `json:"category_id,omitempty"`
+	Source     string          `json:"source,omitempty"`
+	CreatedAt  time.Time       `json:"created_at,omitempty"`
+	Events     []*RequestEvent `json:"events,omitempty"`
+	Version    int32           `json:"-"`
+}
+
+// RequestEvent defines a change of status in the history of a request. UserID is 0 for
+// changes made automatically, such as fulfilment by an upload.
+type RequestEvent struct {
+	ID         int64     `json:"id"`
+	RequestID  int64     `json:"request_id"`
+	UserID     int64     `json:"user_id,omitempty"`
+	FromStatus string    `json:"from_status,omitempty"`
+	ToStatus   string    `json:"to_status"`
+	Reason     string    `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// RequestScoreHalfLife is the time after which a subscription to a request counts for half as
+// much when ranking the most wanted requests.
+const RequestScoreHalfLife = 30 * 24 * time.Hour
+
+// TopRequest defines an active request ranked by its subscribers, with recent subscriptions
+// weighing more.
+type TopRequest struct {
+	*Request
+	Score float64 `json:"score"`
+}
+
+// RequestCategoryDemand defines how wanted the active requests of a category are. Requests
+// without a category are counted under category 0.
+type RequestCategoryDemand struct {
+	CategoryID   int64   `json:"category_id"`
+	CategoryName string  `json:"category_name,omitempty"`
+	Requests     int64   `json:"requests"`
+	Subscribers  int64   `json:"subscribers"`
+	Score        float64 `json:"score"`
+}
+
+// RequestFulfilment defines a subscriber to a request that a book has fulfilled.
+type RequestFulfilment struct {
+	RequestID    int64
+	RequestTitle string
+	UserID       int64
+	UserName     string
+	UserEmail    string
+	BookID       int64
+	BookTitle    string
+	BookAuthor   []string
+}
+
+func ValidateRequest(v *validator.Validator, request *Request) {
+	v.Check(request.Title != "", "title", "must be provided")
+	v.Check(len(request.Title) <= 500, "title", "must not be more than 500 bytes long")
+	v.Check(len(request.Author) <= 5, "author", "must not contain more than 5 authors")
+	for _, name := range request.Author {
+		v.Check(AuthorNameKey(name) != "", "author", "must not contain empty names")
+	}
+	v.Check(len(request.Publisher) <= 500, "publisher", "must not be more than 500 bytes long")
+	v.Check(request.Year >= 0, "year", "must not be negative")
+	v.Check(request.Year <= int32(time.Now().Year()), "year", "must not be in the future")
+	// Manual requests can't be checked against a catalogue, so they need enough
+	// details to identify the book
+	if request.Source == RequestSourceManual {
+		v.Check(len(request.Author) >= 1, "author", "must contain at least 1 author")
+		v.Check(request.Year != 0, "year", "must be provided")
+	}
 }
 
 func ValidateRequestIsbn(v *validator.Validator, isbn string) {
 	v.Check(isbn != "", "isbn", "must be provided")
+	if isbn != "" {
+		_, _, ok := validator.ParseISBN(isbn)
+		v.Check(ok, "isbn", "must be a valid ISBN-10 or ISBN-13")
+	}
+}
+
+func ValidateRequestTransition(v *validator.Validator, request *Request, status, reason string) {
+	v.Check(status != "", "status", "must be provided")
+	v.Check(validator.In(status, RequestStatuses...), "status", "must be open, in_progress, fulfilled, rejected, expired or reopened")
+	if v.Valid() {
+		v.Check(CanTransitionRequest(request.Status, status), "status", "cannot change from "+request.Status+" to "+status)
+	}
+	v.Check(len(reason) <= 500, "reason", "must not be more than 500 bytes long")
+	if status == RequestStatusRejected {
+		v.Check(reason != "", "reason", "must be provided when rejecting a request")
+	}
+}
+
+// RequestStatusFilter returns the statuses that a status filter matches. The "active" filter
+// matches every active status, and an empty filter matches any status.
+func RequestStatusFilter(status string) []string {
+	switch status {
+	case "":
+		return []string{}
+	case "active":
+		return RequestActiveStatuses
+	default:
+		return []string{status}
+	}
+}
+
+func ValidateRequestStatusFilter(v *validator.Validator, status string) {
+	v.Check(status == "" || status == "active" || validator.In(status, RequestStatuses...), "status", "must be active, open, in_progress, fulfilled, rejected, expired or reopened")
 }
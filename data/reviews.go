@@ -6,7 +6,9 @@ import (
 	"github.com/emzola/bibliotheca/internal/validator"
 )
 
-// Rating defines the ratings for a book review.
+// Rating defines the ratings for a book review. Average is the plain average of a book's ratings,
+// while Weighted is its bayesian average, which pulls the ratings of books with few reviews towards
+// the average of all ratings. Weighted is the book's popularity.
 type Rating struct {
 	FiveStars  int64   `json:"fivestars"`
 	FourStars  int64   `json:"fourstars"`
@@ -14,19 +16,24 @@ type Rating struct {
 	TwoStars   int64   `json:"twostars"`
 	OneStar    int64   `json:"onestar"`
 	Average    float64 `json:"average"`
+	Weighted   float64 `json:"weighted"`
 	Total      int64   `json:"total"`
 }
 
 // Review defines a book review.
 type Review struct {
-	ID        int64     `json:"id"`
-	BookID    int64     `json:"book_id"`
-	UserID    int64     `json:"user_id"`
-	UserName  string    `json:"username"`
-	CreatedAt time.Time `json:"created_at"`
-	Rating    int8      `json:"rating"`
-	Comment   string    `json:"comment"`
-	Version   int32     `json:"-"`
+	ID             int64            `json:"id"`
+	BookID         int64            `json:"book_id"`
+	UserID         int64            `json:"user_id"`
+	UserName       string           `json:"username"`
+	CreatedAt      time.Time        `json:"created_at"`
+	Rating         int8             `json:"rating"`
+	Comment        string           `json:"comment"`
+	Reactions      []*ReactionCount `json:"reactions,omitempty"`
+	Mentions       []*Mention       `json:"mentions,omitempty"`
+	HelpfulVotes   int64            `json:"helpful_votes"`
+	UnhelpfulVotes int64            `json:"unhelpful_votes"`
+	Version        int32            `json:"-"`
 }
 
 func ValidateReview(v *validator.Validator, review *Review) {
@@ -0,0 +1,61 @@
+package data
+
+import (
+	"time"
+
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+const MaxSavedSearchesPerUser = 50
+
+// SavedSearch defines a named ListBooks query saved by a user.
+type SavedSearch struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name"`
+	Search    string    `json:"search"`
+	FromYear  int       `json:"from_year,omitempty"`
+	ToYear    int       `json:"to_year,omitempty"`
+	Language  []string  `json:"language"`
+	Extension []string  `json:"extension"`
+	Tags      []string  `json:"tags"`
+	Isbns     []string  `json:"isbns"`
+	Notify    bool      `json:"notify"`
+	Version   int32     `json:"-"`
+}
+
+// SavedSearchMatch defines a book that matched a saved search and hasn't been notified yet.
+type SavedSearchMatch struct {
+	UserID          int64
+	UserName        string
+	UserEmail       string
+	SavedSearchID   int64
+	SavedSearchName string
+	BookID          int64
+	BookTitle       string
+	BookAuthor      []string
+}
+
+func ValidateSavedSearch(v *validator.Validator, savedSearch *SavedSearch) {
+	v.Check(savedSearch.Name != "", "name", "must be provided")
+	v.Check(len(savedSearch.Name) <= 200, "name", "must not be more than 200 bytes long")
+	v.Check(len(savedSearch.Search) <= 500, "search", "must not be more than 500 bytes long")
+	v.Check(savedSearch.FromYear >= 0, "from_year", "must not be negative")
+	v.Check(savedSearch.ToYear >= 0, "to_year", "must not be negative")
+	if savedSearch.FromYear != 0 && savedSearch.ToYear != 0 {
+		v.Check(savedSearch.ToYear >= savedSearch.FromYear, "to_year", "must not be before from_year")
+	}
+	v.Check(len(savedSearch.Language) <= 20, "language", "must not contain more than 20 values")
+	v.Check(validator.Unique(savedSearch.Language), "language", "must not contain duplicate values")
+	for _, language := range savedSearch.Language {
+		ValidateLanguage(v, "language", language)
+	}
+	v.Check(len(savedSearch.Extension) <= 20, "extension", "must not contain more than 20 values")
+	v.Check(validator.Unique(savedSearch.Extension), "extension", "must not contain duplicate values")
+	v.Check(len(savedSearch.Tags) <= 20, "tags", "must not contain more than 20 values")
+	v.Check(validator.Unique(savedSearch.Tags), "tags", "must not contain duplicate values")
+	// A saved search without any criteria would match every book
+	v.Check(savedSearch.Search != "" || savedSearch.FromYear != 0 || savedSearch.ToYear != 0 || len(savedSearch.Language) > 0 ||
+		len(savedSearch.Extension) > 0 || len(savedSearch.Tags) > 0 || len(savedSearch.Isbns) > 0, "search", "must provide search text or at least one filter")
+}
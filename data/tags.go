@@ -0,0 +1,57 @@
+package data
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+const (
+	TagStatusPending  = "pending"
+	TagStatusApproved = "approved"
+	TagStatusRejected = "rejected"
+)
+
+// Tag defines a free-form tag. UsageCount is the number of books the tag is approved on.
+type Tag struct {
+	ID         int64   `json:"id"`
+	Name       string  `json:"name"`
+	UsageCount int64   `json:"usage_count"`
+	Weight     float64 `json:"weight,omitempty"`
+}
+
+// BookTag defines a tag suggested for a book and its moderation status.
+type BookTag struct {
+	BookID    int64     `json:"book_id"`
+	BookTitle string    `json:"book_title,omitempty"`
+	Tag       string    `json:"tag"`
+	UserID    int64     `json:"user_id,omitempty"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NormalizeTag lowercases a tag and joins its words with hyphens, dropping any other
+// punctuation, so that "Science Fiction", "science-fiction" and "#science_fiction"
+// are the same tag.
+func NormalizeTag(tag string) string {
+	words := strings.FieldsFunc(strings.ToLower(tag), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
+
+func ValidateTags(v *validator.Validator, tags []string) {
+	v.Check(len(tags) >= 1, "tags", "must contain at least 1 tag")
+	v.Check(len(tags) <= 10, "tags", "must not contain more than 10 tags")
+	v.Check(validator.Unique(tags), "tags", "must not contain the same tag more than once")
+	for _, tag := range tags {
+		v.Check(tag != "", "tags", "must not contain empty tags")
+		v.Check(len(tag) <= 50, "tags", "must not contain tags more than 50 bytes long")
+	}
+}
+
+func ValidateTagStatus(v *validator.Validator, status string) {
+	v.Check(validator.In(status, TagStatusApproved, TagStatusRejected), "status", "must be approved or rejected")
+}
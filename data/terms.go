@@ -0,0 +1,51 @@
+package data
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// stopWords are common English words that say nothing about a book's content.
+var stopWords = map[string]bool{
+	"a": true, "about": true, "after": true, "all": true, "also": true, "an": true, "and": true,
+	"any": true, "are": true, "as": true, "at": true, "be": true, "been": true, "but": true,
+	"by": true, "can": true, "for": true, "from": true, "has": true, "have": true, "her": true,
+	"his": true, "how": true, "in": true, "into": true, "is": true, "it": true, "its": true,
+	"more": true, "new": true, "not": true, "of": true, "on": true, "one": true, "or": true,
+	"our": true, "she": true, "that": true, "the": true, "their": true, "them": true, "they": true,
+	"this": true, "to": true, "was": true, "we": true, "were": true, "what": true, "when": true,
+	"which": true, "who": true, "will": true, "with": true, "you": true, "your": true,
+}
+
+// BookTerms returns the terms of a book's title and description with their term frequency
+// weights. Title terms count twice, frequencies are dampened with 1 + ln(count) and the
+// weights are scaled to unit length so that long descriptions don't outweigh short ones.
+func BookTerms(title, description string) map[string]float64 {
+	counts := make(map[string]int)
+	tokenize := func(text string, n int) {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, word := range words {
+			if len([]rune(word)) < 3 || stopWords[word] {
+				continue
+			}
+			counts[word] += n
+		}
+	}
+	tokenize(title, 2)
+	tokenize(description, 1)
+	terms := make(map[string]float64, len(counts))
+	var norm float64
+	for term, count := range counts {
+		weight := 1 + math.Log(float64(count))
+		terms[term] = weight
+		norm += weight * weight
+	}
+	norm = math.Sqrt(norm)
+	for term := range terms {
+		terms[term] /= norm
+	}
+	return terms
+}
@@ -2,14 +2,24 @@ package data
 
 import (
 	"errors"
+	"regexp"
 	"time"
 
 	"github.com/emzola/bibliotheca/internal/validator"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
 var AnonymousUser = &User{}
 
+// UsernameRX matches usernames: 3 to 30 letters, digits or underscores. Usernames are unique
+// regardless of case.
+var UsernameRX = regexp.MustCompile("^[a-zA-Z0-9_]{3,30}$")
+
 // Check if a user instance is the anonymous user.
 func (u *User) IsAnonymous() bool {
 	return u == AnonymousUser
@@ -20,13 +30,27 @@ type User struct {
 	ID            int64     `json:"id"`
 	CreatedAt     time.Time `json:"created_at"`
 	Name          string    `json:"name"`
+	Username      string    `json:"username"`
 	Email         string    `json:"email"`
 	Password      password  `json:"-"`
 	Activated     bool      `json:"activated"`
 	DownloadCount int8      `json:"-"`
+	Role          string    `json:"-"`
 	Version       int32     `json:"-"`
 }
 
+// PublicUser defines the details of a user that other users can see.
+type PublicUser struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+// IsAdmin checks whether a user has the admin role.
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
 // password defines the plaintext and hashed versions of a user's password.
 // The plaintext field is a *pointer* to a string, so that we're able
 // to distinguish between a plaintext password not being present in the struct at
@@ -66,6 +90,11 @@ func ValidateName(v *validator.Validator, name string) {
 	v.Check(name != "", "name", "must be provided")
 	v.Check(len(name) <= 500, "name", "must not be more than 500 bytes long")
 }
+func ValidateUsername(v *validator.Validator, username string) {
+	v.Check(username != "", "username", "must be provided")
+	v.Check(username == "" || validator.Matches(username, UsernameRX), "username", "must be 3 to 30 letters, digits or underscores")
+}
+
 func ValidateEmail(v *validator.Validator, email string) {
 	v.Check(email != "", "email", "must be provided")
 	v.Check(validator.Matches(email, validator.EmailRX), "email", "must be a valid email address")
@@ -80,6 +109,7 @@ func ValidatePasswordPlaintext(v *validator.Validator, password string) {
 func ValidateUser(v *validator.Validator, user *User) {
 	v.Check(user.Name != "", "name", "must be provided")
 	v.Check(len(user.Name) <= 500, "name", "must not be more than 500 bytes long")
+	ValidateUsername(v, user.Username)
 	ValidateEmail(v, user.Email)
 	if user.Password.Plaintext != nil {
 		ValidatePasswordPlaintext(v, *user.Password.Plaintext)
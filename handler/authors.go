@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/service"
+)
+
+// ListAuthors godoc
+// @Summary List all authors
+// @Description This endpoint lists and searches all authors
+// @Tags authors
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param search query string false "Query string param to search canonical and alternate names"
+// @Param page query int false "Query string param for pagination (min 1)"
+// @Param page_size query int false "Query string param for pagination (max 100)"
+// @Param sort query string false "Sort by ascending or descending order. Asc: id, name, sort_name, books_count. Desc: -id, -name, -sort_name, -books_count"
+// @Success 200 {array} data.Author
+// @Failure 422
+// @Failure 500
+// @Router /v1/authors [get]
+func (h *Handler) listAuthorsHandler(w http.ResponseWriter, r *http.Request) {
+	var qsInput dto.QsListAuthors
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.Search = h.readString(qs, "search", "")
+	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
+	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
+	qsInput.Filters.Sort = h.readString(qs, "sort", "sort_name")
+	qsInput.Filters.SortSafeList = []string{"id", "name", "sort_name", "books_count", "-id", "-name", "-sort_name", "-books_count"}
+	authors, metadata, err := h.service.ListAuthors(qsInput.Search, qsInput.Filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"authors": authors, "metadata": metadata}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ShowAuthor godoc
+// @Summary Show details of an author
+// @Description This endpoint shows the details of a specific author
+// @Tags authors
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param authorId path int true "ID of author to show"
+// @Success 200 {object} data.Author
+// @Failure 404
+// @Failure 500
+// @Router /v1/authors/{authorId} [get]
+func (h *Handler) showAuthorHandler(w http.ResponseWriter, r *http.Request) {
+	authorID, err := h.readIDParam(r, "authorId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	author, err := h.service.GetAuthor(authorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"author": author}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ListAuthorBooks godoc
+// @Summary List an author's books
+// @Description This endpoint lists all books of a specific author
+// @Tags authors
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param authorId path int true "ID of author"
+// @Param page query int false "Query string param for pagination (min 1)"
+// @Param page_size query int false "Query string param for pagination (max 100)"
+// @Param sort query string false "Sort by ascending or descending order. Asc: title, year, created_at, popularity. Desc: -title, -year, -created_at, -popularity"
+// @Success 200 {array} data.Book
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/authors/{authorId}/books [get]
+func (h *Handler) listAuthorBooksHandler(w http.ResponseWriter, r *http.Request) {
+	var qsInput dto.QsListAuthorBooks
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
+	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
+	qsInput.Filters.Sort = h.readString(qs, "sort", "-year")
+	qsInput.Filters.SortSafeList = []string{"title", "year", "created_at", "popularity", "-title", "-year", "-created_at", "-popularity"}
+	authorID, err := h.readIDParam(r, "authorId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	books, metadata, err := h.service.ListAuthorBooks(authorID, qsInput.Filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"books": books, "metadata": metadata}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// UpdateAuthor godoc
+// @Summary Update the details of an author
+// @Description This endpoint updates the details of a specific author. It is restricted to admins.
+// @Tags authors
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param authorId path int true "ID of author to update"
+// @Param body body dto.UpdateAuthorRequestBody true "JSON payload required to update an author"
+// @Success 200 {object} data.Author
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Router /v1/authors/{authorId} [patch]
+func (h *Handler) updateAuthorHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody dto.UpdateAuthorRequestBody
+	err := h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	authorID, err := h.readIDParam(r, "authorId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	author, err := h.service.UpdateAuthor(authorID, requestBody)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrEditConflict):
+			h.editConflictResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"author": author}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// MergeAuthors godoc
+// @Summary Merge duplicate authors into an author
+// @Description This endpoint re-points the books of duplicate authors to a specific author and deletes the duplicates. It is restricted to admins.
+// @Tags authors
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param authorId path int true "ID of author to merge into"
+// @Param body body dto.MergeAuthorsRequestBody true "JSON payload required to merge authors"
+// @Success 200 {object} data.Author
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Router /v1/authors/{authorId}/merge [post]
+func (h *Handler) mergeAuthorsHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody dto.MergeAuthorsRequestBody
+	err := h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	authorID, err := h.readIDParam(r, "authorId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	author, err := h.service.MergeAuthors(authorID, requestBody.DuplicateIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrEditConflict):
+			h.editConflictResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"author": author}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
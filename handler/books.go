@@ -85,6 +85,28 @@ func (h *Handler) showBookHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ListLanguages godoc
+// @Summary List book languages
+// @Description This endpoint lists the ISO 639 code and name of each language books are in, with the number of books in it
+// @Tags books
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Success 200 {array} data.LanguageCount
+// @Failure 500
+// @Router /v1/languages [get]
+func (h *Handler) listLanguagesHandler(w http.ResponseWriter, r *http.Request) {
+	languages, err := h.service.ListLanguages()
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"languages": languages}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
 // ListBooks godoc
 // @Summary List all books
 // @Description This endpoint lists all books
@@ -95,8 +117,10 @@ func (h *Handler) showBookHandler(w http.ResponseWriter, r *http.Request) {
 // @Param search query string false "Query string param for search"
 // @Param from_year query string false "Query string param to filter by year"
 // @Param to_year query string false "Query string param to filter by year"
-// @Param language query string false "Query string param to filter by language"
+// @Param language query string false "Query string param to filter by language. Accepts ISO 639 codes or English language names"
 // @Param extension query string false "Query string param to filter by file extension"
+// @Param tags query string false "Query string param to filter by tags. Books must have all of the tags"
+// @Param isbn query string false "Query string param to find a book by its ISBN-10 or ISBN-13"
 // @Param page query int false "Query string param for pagination (min 1)"
 // @Param page_size query int false "Query string param for pagination (max 100)"
 // @Param sort query string false "Sort by ascending or descending order. Asc: id, title, year, size, created_at, popularity. Desc: -id, -title, -year, -size, -created_at, -popularity"
@@ -113,11 +137,13 @@ func (h *Handler) listBooksHandler(w http.ResponseWriter, r *http.Request) {
 	qsInput.ToYear = h.readInt(qs, "to_year", 0, v)
 	qsInput.Language = h.readCSV(qs, "language", []string{})
 	qsInput.Extension = h.readCSV(qs, "extension", []string{})
+	qsInput.Tags = h.readCSV(qs, "tags", []string{})
+	qsInput.Isbn = h.readString(qs, "isbn", "")
 	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
 	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
 	qsInput.Filters.Sort = h.readString(qs, "sort", "id")
 	qsInput.Filters.SortSafeList = []string{"id", "title", "year", "size", "created_at", "popularity", "-id", "-title", "-year", "-size", "-created_at", "-popularity"}
-	books, metadata, err := h.service.ListBooks(qsInput.Search, qsInput.FromYear, qsInput.ToYear, qsInput.Language, qsInput.Extension, qsInput.Filters)
+	books, metadata, err := h.service.ListBooks(qsInput.Search, qsInput.FromYear, qsInput.ToYear, qsInput.Language, qsInput.Extension, qsInput.Tags, qsInput.Isbn, qsInput.Filters)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrFailedValidation):
@@ -2,25 +2,36 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 
+	"github.com/emzola/bibliotheca/data"
 	"github.com/emzola/bibliotheca/data/dto"
 	"github.com/emzola/bibliotheca/internal/validator"
 	"github.com/emzola/bibliotheca/service"
+	"github.com/julienschmidt/httprouter"
 )
 
 // ListCategories godoc
-// @Summary List all booklists
-// @Description This endpoint lists all booklists
+// @Summary List all categories
+// @Description This endpoint lists all categories
 // @Tags categories
 // @Accept  json
 // @Produce json
 // @Param token header string true "Bearer token"
+// @Param tree query bool false "Query string param to nest subcategories under their parent categories"
 // @Success 200 {array} data.Category
+// @Failure 422
 // @Failure 500
 // @Router /v1/categories [get]
 func (h *Handler) listCategoriesHandler(w http.ResponseWriter, r *http.Request) {
-	categories, err := h.service.ListCategories()
+	v := validator.New()
+	tree := h.readBool(r.URL.Query(), "tree", false, v)
+	if !v.Valid() {
+		h.errorResponse(w, r, http.StatusUnprocessableEntity, v.Errors)
+		return
+	}
+	categories, err := h.service.ListCategories(tree)
 	if err != nil {
 		h.serverErrorResponse(w, r, err)
 		return
@@ -41,7 +52,8 @@ func (h *Handler) listCategoriesHandler(w http.ResponseWriter, r *http.Request)
 // @Param page query int false "Query string param for pagination (min 1)"
 // @Param page_size query int false "Query string param for pagination (max 100)"
 // @Param sort query string false "Sort by ascending or descending order. Asc: title, size, year, datetime. Desc: -title, -size, -year, -datetime"
-// @Param categoryId path int true "ID of category to show"
+// @Param include_subcategories query bool false "Query string param to include books in subcategories"
+// @Param categoryId path string true "ID or slug of category to show"
 // @Success 200 {array} data.Book
 // @Failure 404
 // @Failure 422
@@ -55,13 +67,98 @@ func (h *Handler) showCategoryHandler(w http.ResponseWriter, r *http.Request) {
 	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
 	qsInput.Filters.Sort = h.readString(qs, "sort", "-datetime")
 	qsInput.Filters.SortSafeList = []string{"title", "size", "year", "datetime", "-title", "-size", "-year", "-datetime"}
+	qsInput.IncludeSubcategories = h.readBool(qs, "include_subcategories", false, v)
+	if !v.Valid() {
+		h.errorResponse(w, r, http.StatusUnprocessableEntity, v.Errors)
+		return
+	}
+	// Fetch category in order to use it as field name in JSON reponse.
+	category, err := h.readCategoryParam(r)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	books, metadata, err := h.service.ShowCategory(category.ID, qsInput.IncludeSubcategories, qsInput.Filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{category.Name: books, "metadata": metadata}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// FollowCategory godoc
+// @Summary Follow a category
+// @Description This endpoint makes the user follow a specific category. Followed categories are used for recommendations
+// @Tags categories
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param categoryId path int true "ID of category to follow"
+// @Success 200
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Router /v1/categories/{categoryId}/follow [post]
+func (h *Handler) followCategoryHandler(w http.ResponseWriter, r *http.Request) {
 	categoryID, err := h.readIDParam(r, "categoryId")
 	if err != nil {
 		h.notFoundResponse(w, r)
 		return
 	}
-	// Fetch category in order to use it as field name in JSON reponse.
-	category, err := h.service.GetCategory(categoryID)
+	user := h.contextGetUser(r)
+	err = h.service.FollowCategory(user.ID, categoryID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrDuplicateRecord):
+			h.recordAlreadyExistsResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"message": "category successfully followed"}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// UnfollowCategory godoc
+// @Summary Unfollow a category
+// @Description This endpoint makes the user stop following a specific category
+// @Tags categories
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param categoryId path int true "ID of category to unfollow"
+// @Success 200
+// @Failure 404
+// @Failure 500
+// @Router /v1/categories/{categoryId}/follow [delete]
+func (h *Handler) unfollowCategoryHandler(w http.ResponseWriter, r *http.Request) {
+	categoryID, err := h.readIDParam(r, "categoryId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	user := h.contextGetUser(r)
+	err = h.service.UnfollowCategory(user.ID, categoryID)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRecordNotFound):
@@ -71,18 +168,207 @@ func (h *Handler) showCategoryHandler(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
-	books, metadata, err := h.service.ShowCategory(category.ID, qsInput.Filters)
+	err = h.encodeJSON(w, http.StatusOK, envelope{"message": "category successfully unfollowed"}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// CreateCategory godoc
+// @Summary Create a new category
+// @Description This endpoint creates a new category, optionally as a subcategory of another category. It is restricted to admins.
+// @Tags categories
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param body body dto.CreateCategoryRequestBody true "JSON payload required to create a category"
+// @Success 201 {object} data.Category
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Router /v1/categories [post]
+func (h *Handler) createCategoryHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody dto.CreateCategoryRequestBody
+	err := h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	category, err := h.service.CreateCategory(requestBody)
 	if err != nil {
 		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
 		case errors.Is(err, service.ErrFailedValidation):
 			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrDuplicateRecord):
+			h.recordAlreadyExistsResponse(w, r)
 		default:
 			h.serverErrorResponse(w, r, err)
 		}
 		return
 	}
-	err = h.encodeJSON(w, http.StatusOK, envelope{category.Name: books, "metadata": metadata}, nil)
+	headers := make(http.Header)
+	headers.Set("Location", fmt.Sprintf("/v1/categories/%s", category.Slug))
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"category": category}, headers)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// UpdateCategory godoc
+// @Summary Update a category
+// @Description This endpoint renames a category, changes its slug or moves it under another parent category. It is restricted to admins.
+// @Tags categories
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param categoryId path string true "ID or slug of category to update"
+// @Param body body dto.UpdateCategoryRequestBody true "JSON payload required to update a category"
+// @Success 200 {object} data.Category
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Router /v1/categories/{categoryId} [patch]
+func (h *Handler) updateCategoryHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody dto.UpdateCategoryRequestBody
+	err := h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	category, err := h.readCategoryParam(r)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	category, err = h.service.UpdateCategory(category.ID, requestBody)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrEditConflict):
+			h.editConflictResponse(w, r)
+		case errors.Is(err, service.ErrDuplicateRecord):
+			h.recordAlreadyExistsResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"category": category}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// DeleteCategory godoc
+// @Summary Delete a category
+// @Description This endpoint deletes a category. Its books are moved to the move_to category or its parent category, and its subcategories are moved to its parent. It is restricted to admins.
+// @Tags categories
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param categoryId path string true "ID or slug of category to delete"
+// @Param move_to query int false "Query string param for the ID of the category to move the books to"
+// @Success 200
+// @Failure 403
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/categories/{categoryId} [delete]
+func (h *Handler) deleteCategoryHandler(w http.ResponseWriter, r *http.Request) {
+	var qsInput dto.QsDeleteCategory
+	v := validator.New()
+	qsInput.MoveTo = h.readInt(r.URL.Query(), "move_to", 0, v)
+	if !v.Valid() {
+		h.errorResponse(w, r, http.StatusUnprocessableEntity, v.Errors)
+		return
+	}
+	category, err := h.readCategoryParam(r)
+	if err == nil {
+		err = h.service.DeleteCategory(category.ID, int64(qsInput.MoveTo))
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"message": "category successfully deleted"}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// MergeCategories godoc
+// @Summary Merge categories into a category
+// @Description This endpoint moves the books and subcategories of the source categories to a specific category and deletes the sources. It is restricted to admins.
+// @Tags categories
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param categoryId path string true "ID or slug of category to merge into"
+// @Param body body dto.MergeCategoriesRequestBody true "JSON payload required to merge categories"
+// @Success 200 {object} data.Category
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/categories/{categoryId}/merge [post]
+func (h *Handler) mergeCategoriesHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody dto.MergeCategoriesRequestBody
+	err := h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	category, err := h.readCategoryParam(r)
+	if err == nil {
+		category, err = h.service.MergeCategories(category.ID, requestBody.SourceIDs)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"category": category}, nil)
 	if err != nil {
 		h.serverErrorResponse(w, r, err)
 	}
 }
+
+// readCategoryParam retrieves the category identified by the categoryId URL parameter,
+// which is either the category's ID or its slug.
+func (h *Handler) readCategoryParam(r *http.Request) (*data.Category, error) {
+	categoryID, err := h.readIDParam(r, "categoryId")
+	if err == nil {
+		return h.service.GetCategory(categoryID)
+	}
+	return h.service.GetCategoryBySlug(httprouter.ParamsFromContext(r.Context()).ByName("categoryId"))
+}
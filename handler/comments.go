@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/validator"
 	"github.com/emzola/bibliotheca/service"
 )
 
@@ -40,6 +41,8 @@ func (h *Handler) createCommentHandler(w http.ResponseWriter, r *http.Request) {
 	comment, err := h.service.CreateComment(user.ID, booklistID, user.Name, requestBody.Content)
 	if err != nil {
 		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
 		case errors.Is(err, service.ErrFailedValidation):
 			h.failedValidationResponse(w, r, err)
 		default:
@@ -63,13 +66,15 @@ func (h *Handler) createCommentHandler(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param token header string true "Bearer token"
 // @Param comment body dto.UpdateCommentRequestBody true "JSON Payload required to update a booklist comment"
+// @Param booklistId path int true "ID of booklist"
 // @Param commentId path int true "ID of comment to update"
 // @Success 200 {object} data.Comment
 // @Failure 400
 // @Failure 404
 // @Failure 409
+// @Failure 422
 // @Failure 500
-// @Router /v1/booklists/{booklistId}/comments [patch]
+// @Router /v1/booklists/{booklistId}/comments/{commentId} [patch]
 func (h *Handler) updateCommentHandler(w http.ResponseWriter, r *http.Request) {
 	var requestBody dto.UpdateCommentRequestBody
 	err := h.decodeJSON(w, r, &requestBody)
@@ -85,6 +90,8 @@ func (h *Handler) updateCommentHandler(w http.ResponseWriter, r *http.Request) {
 	comment, err := h.service.UpdateComment(commentID, requestBody.Content)
 	if err != nil {
 		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
 		case errors.Is(err, service.ErrFailedValidation):
 			h.failedValidationResponse(w, r, err)
 		case errors.Is(err, service.ErrEditConflict):
@@ -102,16 +109,17 @@ func (h *Handler) updateCommentHandler(w http.ResponseWriter, r *http.Request) {
 
 // DeleteComment godoc
 // @Summary Delete a booklist comment
-// @Description This endpoint deletes a specific booklist comment
+// @Description This endpoint deletes a specific booklist comment. A comment with replies is replaced by a "[deleted]" placeholder so that the conversation stays readable
 // @Tags comments
 // @Accept  json
 // @Produce json
 // @Param token header string true "Bearer token"
+// @Param booklistId path int true "ID of booklist"
 // @Param commentId path int true "ID of booklist comment to delete"
 // @Success 200
 // @Failure 404
 // @Failure 500
-// @Router /v1/booklists/{booklistId}/comments [delete]
+// @Router /v1/booklists/{booklistId}/comments/{commentId} [delete]
 func (h *Handler) deleteCommentHandler(w http.ResponseWriter, r *http.Request) {
 	commentID, err := h.readIDParam(r, "commentId")
 	if err != nil {
@@ -135,14 +143,20 @@ func (h *Handler) deleteCommentHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 // ListComments godoc
-// @Summary List all comments
-// @Description This endpoint lists all comments
+// @Summary List the comments of a booklist
+// @Description This endpoint lists the top-level comments of a booklist, each with its replies nested below it. Replies deeper than the requested depth are left out, but every comment has its number of replies so that its thread can be fetched separately. Deleted comments that have replies are shown as placeholders
 // @Tags comments
 // @Accept  json
 // @Produce json
 // @Param token header string true "Bearer token"
+// @Param booklistId path int true "ID of booklist"
+// @Param depth query int false "Query string param for the levels of replies to include (max 10)"
+// @Param page query int false "Query string param for pagination (min 1)"
+// @Param page_size query int false "Query string param for pagination (max 100)"
+// @Param sort query string false "Sort by ascending or descending order. Asc: created_at. Desc: -created_at"
 // @Success 200 {array} data.Comment
 // @Failure 404
+// @Failure 422
 // @Failure 500
 // @Router /v1/booklists/{booklistId}/comments [get]
 func (h *Handler) listCommentsHandler(w http.ResponseWriter, r *http.Request) {
@@ -151,12 +165,74 @@ func (h *Handler) listCommentsHandler(w http.ResponseWriter, r *http.Request) {
 		h.notFoundResponse(w, r)
 		return
 	}
-	comments, err := h.service.ListComments(booklistID)
+	var qsInput dto.QsListComments
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.Depth = h.readInt(qs, "depth", 3, v)
+	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
+	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
+	qsInput.Filters.Sort = h.readString(qs, "sort", "-created_at")
+	qsInput.Filters.SortSafeList = []string{"created_at", "-created_at"}
+	user := h.contextGetUser(r)
+	comments, metadata, err := h.service.ListComments(booklistID, user.ID, qsInput.Depth, qsInput.Filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"comments": comments, "metadata": metadata}, nil)
 	if err != nil {
 		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ShowCommentThread godoc
+// @Summary Show a comment with its replies
+// @Description This endpoint shows a booklist comment with its replies nested below it, down to the requested depth
+// @Tags comments
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param booklistId path int true "ID of booklist"
+// @Param commentId path int true "ID of comment"
+// @Param depth query int false "Query string param for the levels of replies to include (max 10)"
+// @Success 200 {object} data.Comment
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/booklists/{booklistId}/comments/{commentId} [get]
+func (h *Handler) showCommentThreadHandler(w http.ResponseWriter, r *http.Request) {
+	booklistID, err := h.readIDParam(r, "booklistId")
+	if err != nil {
+		h.notFoundResponse(w, r)
 		return
 	}
-	err = h.encodeJSON(w, http.StatusOK, envelope{"comments": comments}, nil)
+	commentID, err := h.readIDParam(r, "commentId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	var qsInput dto.QsShowCommentThread
+	v := validator.New()
+	qsInput.Depth = h.readInt(r.URL.Query(), "depth", 3, v)
+	user := h.contextGetUser(r)
+	comment, err := h.service.GetCommentThread(booklistID, commentID, user.ID, qsInput.Depth)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"comment": comment}, nil)
 	if err != nil {
 		h.serverErrorResponse(w, r, err)
 	}
@@ -199,6 +275,8 @@ func (h *Handler) createCommentReplyHandler(w http.ResponseWriter, r *http.Reque
 	comment, err := h.service.CreateCommentReply(user.ID, booklistID, commentID, requestBody.Content)
 	if err != nil {
 		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
 		case errors.Is(err, service.ErrFailedValidation):
 			h.failedValidationResponse(w, r, err)
 		default:
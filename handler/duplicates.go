@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/service"
+)
+
+// ListBookDuplicates godoc
+// @Summary List likely duplicate books
+// @Description This endpoint lists pairs of books that are likely the same book because their files are identical, they share an ISBN, or their titles, authors and years match. Users see the pairs involving their books, while admins see all pairs
+// @Tags books
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param page query int false "Query string param for pagination (min 1)"
+// @Param page_size query int false "Query string param for pagination (max 100)"
+// @Param sort query string false "Sort by ascending or descending order. Asc: created_at. Desc: -created_at"
+// @Success 200 {array} data.BookDuplicate
+// @Failure 422
+// @Failure 500
+// @Router /v1/duplicates/books [get]
+func (h *Handler) listBookDuplicatesHandler(w http.ResponseWriter, r *http.Request) {
+	var qsInput dto.QsListBookDuplicates
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
+	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
+	qsInput.Filters.Sort = h.readString(qs, "sort", "-created_at")
+	qsInput.Filters.SortSafeList = []string{"created_at", "-created_at"}
+	user := h.contextGetUser(r)
+	bookDuplicates, metadata, err := h.service.ListBookDuplicates(user, qsInput.Filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"duplicates": bookDuplicates, "metadata": metadata}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// MergeBooks godoc
+// @Summary Merge books into a book
+// @Description This endpoint moves the reviews, favourites, downloads, booklist memberships, categories, tags and fulfilled requests of the source books to a specific book and deletes the sources. Users who reviewed more than one of the books keep a single review. It is restricted to admins.
+// @Tags books
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book to merge into"
+// @Param body body dto.MergeBooksRequestBody true "JSON payload required to merge books"
+// @Success 200 {object} data.Book
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/books/{bookId}/merge [post]
+func (h *Handler) mergeBooksHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	var requestBody dto.MergeBooksRequestBody
+	if err := h.decodeJSON(w, r, &requestBody); err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	book, err := h.service.MergeBooks(bookID, requestBody.SourceIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"book": book}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/service"
+)
+
+// EnrichBook godoc
+// @Summary Propose metadata for a book from external catalogues
+// @Description This endpoint looks up a book on external catalogues such as Open Library by its ISBN and proposes the metadata found for the book's empty fields. The proposal is only applied once it is accepted. It is restricted to the book's owner and admins
+// @Tags books
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book to enrich"
+// @Success 200 {object} data.BookEnrichment
+// @Failure 403
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Failure 502
+// @Router /v1/books/{bookId}/enrich [post]
+func (h *Handler) enrichBookHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	user := h.contextGetUser(r)
+	enrichment, err := h.service.EnrichBook(user, bookID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrNotPermitted):
+			h.notPermittedResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrMetadataNotFound):
+			h.metadataNotFoundResponse(w, r)
+		case errors.Is(err, service.ErrMetadataUnavailable):
+			h.metadataUnavailableResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"enrichment": enrichment}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ShowBookEnrichment godoc
+// @Summary Show the metadata proposed for a book
+// @Description This endpoint shows the changes that the metadata proposed for a book would make. It is restricted to the book's owner and admins
+// @Tags books
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book"
+// @Success 200 {object} data.BookEnrichment
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /v1/books/{bookId}/enrich [get]
+func (h *Handler) showBookEnrichmentHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	user := h.contextGetUser(r)
+	enrichment, err := h.service.GetBookEnrichment(user, bookID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrNotPermitted):
+			h.notPermittedResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"enrichment": enrichment}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// AcceptBookEnrichment godoc
+// @Summary Accept the metadata proposed for a book
+// @Description This endpoint applies the selected fields of the metadata proposed for a book, or all of them when no fields are given, and discards the proposal. It is restricted to the book's owner and admins
+// @Tags books
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book"
+// @Param body body dto.AcceptBookEnrichmentRequestBody false "JSON payload to select the fields to accept"
+// @Success 200 {object} data.Book
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Router /v1/books/{bookId}/enrich/accept [post]
+func (h *Handler) acceptBookEnrichmentHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	var requestBody dto.AcceptBookEnrichmentRequestBody
+	if r.ContentLength != 0 {
+		if err := h.decodeJSON(w, r, &requestBody); err != nil {
+			h.badRequestResponse(w, r, err)
+			return
+		}
+	}
+	user := h.contextGetUser(r)
+	book, err := h.service.AcceptBookEnrichment(user, bookID, requestBody)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrNotPermitted):
+			h.notPermittedResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrEditConflict):
+			h.editConflictResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"book": book}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// DeleteBookEnrichment godoc
+// @Summary Discard the metadata proposed for a book
+// @Description This endpoint discards the metadata proposed for a book. It is restricted to the book's owner and admins
+// @Tags books
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book"
+// @Success 200
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /v1/books/{bookId}/enrich [delete]
+func (h *Handler) deleteBookEnrichmentHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	user := h.contextGetUser(r)
+	err = h.service.DeleteBookEnrichment(user, bookID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrNotPermitted):
+			h.notPermittedResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"message": "metadata proposal successfully discarded"}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
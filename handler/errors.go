@@ -108,3 +108,24 @@ func (h *Handler) rateLimitExceededResponse(w http.ResponseWriter, r *http.Reque
 	message := "rate limit exceeded"
 	h.errorResponse(w, r, http.StatusTooManyRequests, message)
 }
+
+func (h *Handler) metadataNotFoundResponse(w http.ResponseWriter, r *http.Request) {
+	message := "no metadata could be found for this isbn"
+	h.errorResponse(w, r, http.StatusNotFound, message)
+}
+
+func (h *Handler) metadataUnavailableResponse(w http.ResponseWriter, r *http.Request, err error) {
+	h.logError(r, err)
+	message := "the metadata provider is unavailable, please try again later"
+	h.errorResponse(w, r, http.StatusBadGateway, message)
+}
+
+func (h *Handler) requestMetadataNotFoundResponse(w http.ResponseWriter, r *http.Request) {
+	message := "no book could be found for this isbn, provide its title, author and year to request it manually"
+	h.errorResponse(w, r, http.StatusNotFound, message)
+}
+
+func (h *Handler) jobRunningResponse(w http.ResponseWriter, r *http.Request) {
+	message := "this job is already running, please try again once it has finished"
+	h.errorResponse(w, r, http.StatusConflict, message)
+}
@@ -122,3 +122,20 @@ func (h *Handler) readInt(qs url.Values, key string, defaultValue int, v *valida
 	}
 	return i
 }
+
+// readBool reads a string value from the query string and converts it to a boolean
+// before returning. If no matching key could be found it returns the provided default
+// value. If the value couldn't be converted to a boolean, then we record an error
+// message in the provided Validator instance.
+func (h *Handler) readBool(qs url.Values, key string, defaultValue bool, v *validator.Validator) bool {
+	s := qs.Get(key)
+	if s == "" {
+		return defaultValue
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		v.AddError(key, "must be a boolean value")
+		return defaultValue
+	}
+	return b
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/service"
+	"github.com/julienschmidt/httprouter"
+)
+
+// ListJobs godoc
+// @Summary List scheduled jobs
+// @Description This endpoint lists the background jobs run by the server, with their schedules, next run times and latest runs. It is restricted to admins
+// @Tags jobs
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Success 200 {array} data.Job
+// @Failure 403
+// @Failure 500
+// @Router /v1/jobs [get]
+func (h *Handler) listJobsHandler(w http.ResponseWriter, r *http.Request) {
+	jobs, err := h.service.ListJobs()
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"jobs": jobs}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ListJobRuns godoc
+// @Summary List the runs of a job
+// @Description This endpoint lists the run history of a scheduled job, including the status and error of each run. It is restricted to admins
+// @Tags jobs
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param name path string true "Name of job"
+// @Param page query int false "Query string param for pagination (min 1)"
+// @Param page_size query int false "Query string param for pagination (max 100)"
+// @Param sort query string false "Sort by ascending or descending order. Asc: started_at. Desc: -started_at"
+// @Success 200 {array} data.JobRun
+// @Failure 403
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/jobs/{name}/runs [get]
+func (h *Handler) listJobRunsHandler(w http.ResponseWriter, r *http.Request) {
+	name := httprouter.ParamsFromContext(r.Context()).ByName("name")
+	var qsInput dto.QsListJobRuns
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
+	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
+	qsInput.Filters.Sort = h.readString(qs, "sort", "-started_at")
+	qsInput.Filters.SortSafeList = []string{"started_at", "-started_at"}
+	runs, metadata, err := h.service.ListJobRuns(name, qsInput.Filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"runs": runs, "metadata": metadata}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// RunJob godoc
+// @Summary Run a job now
+// @Description This endpoint starts a run of a scheduled job outside of its schedule. The job runs in the background, so the run is returned while it is still running. It is restricted to admins
+// @Tags jobs
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param name path string true "Name of job"
+// @Success 202 {object} data.JobRun
+// @Failure 403
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Router /v1/jobs/{name}/run [post]
+func (h *Handler) runJobHandler(w http.ResponseWriter, r *http.Request) {
+	name := httprouter.ParamsFromContext(r.Context()).ByName("name")
+	user := h.contextGetUser(r)
+	run, err := h.service.TriggerJob(user.ID, name)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrJobRunning):
+			h.jobRunningResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusAccepted, envelope{"run": run}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
@@ -182,6 +182,19 @@ func (h *Handler) requireActivatedUser(next http.HandlerFunc) http.HandlerFunc {
 	return h.requireAuthenticatedUser(fn)
 }
 
+// requireAdminUser middleware checks that a user is authenticated, activated and has the admin role.
+func (h *Handler) requireAdminUser(next http.HandlerFunc) http.HandlerFunc {
+	fn := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user := h.contextGetUser(r)
+		if !user.IsAdmin() {
+			h.notPermittedResponse(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+	return h.requireActivatedUser(fn)
+}
+
 // requireBookOwnerPermission middleware checks that a user is authenticated, activated and is the owner of the book.
 func (h *Handler) requireBookOwnerPermission(next http.HandlerFunc) http.HandlerFunc {
 	fn := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
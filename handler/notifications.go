@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/service"
+)
+
+// ListNotifications godoc
+// @Summary List the user's notifications
+// @Description This endpoint lists the user's notifications, newest first
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param unread query bool false "Query string param to list only unread notifications"
+// @Param page query int false "Query string param for pagination (min 1)"
+// @Param page_size query int false "Query string param for pagination (max 100)"
+// @Success 200 {array} data.Notification
+// @Failure 422
+// @Failure 500
+// @Router /v1/users/notifications [get]
+func (h *Handler) listNotificationsHandler(w http.ResponseWriter, r *http.Request) {
+	var qsInput dto.QsListNotifications
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.Unread = h.readBool(qs, "unread", false, v)
+	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
+	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 20, v)
+	qsInput.Filters.Sort = "-created_at"
+	qsInput.Filters.SortSafeList = []string{"-created_at"}
+	user := h.contextGetUser(r)
+	notifications, metadata, err := h.service.ListNotifications(user.ID, qsInput.Unread, qsInput.Filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"notifications": notifications, "metadata": metadata}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ShowUnreadNotificationCount godoc
+// @Summary Show the number of the user's unread notifications
+// @Description This endpoint shows the number of the user's unread notifications
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Success 200 {object} int
+// @Failure 500
+// @Router /v1/users/notifications/unread [get]
+func (h *Handler) showUnreadNotificationCountHandler(w http.ResponseWriter, r *http.Request) {
+	user := h.contextGetUser(r)
+	count, err := h.service.GetUnreadNotificationCount(user.ID)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"unread": count}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark a notification as read or unread
+// @Description This endpoint marks one of the user's notifications as read or unread
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param notificationId path int true "ID of notification to mark"
+// @Param body body dto.MarkNotificationReadRequestBody true "JSON payload required to mark a notification"
+// @Success 200 {object} data.Notification
+// @Failure 400
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/users/notifications/{notificationId} [patch]
+func (h *Handler) markNotificationReadHandler(w http.ResponseWriter, r *http.Request) {
+	notificationID, err := h.readIDParam(r, "notificationId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	var requestBody dto.MarkNotificationReadRequestBody
+	err = h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	user := h.contextGetUser(r)
+	notification, err := h.service.MarkNotificationRead(user.ID, notificationID, requestBody.Read)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"notification": notification}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Mark all notifications as read
+// @Description This endpoint marks all of the user's unread notifications as read
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Success 200 {object} int
+// @Failure 500
+// @Router /v1/users/notifications/read [put]
+func (h *Handler) markAllNotificationsReadHandler(w http.ResponseWriter, r *http.Request) {
+	user := h.contextGetUser(r)
+	count, err := h.service.MarkAllNotificationsRead(user.ID)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"marked": count}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ListNotificationPreferences godoc
+// @Summary List the user's notification preferences
+// @Description This endpoint lists how the user is notified of each event, in the app and by email
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Success 200 {array} data.NotificationPreference
+// @Failure 500
+// @Router /v1/users/notifications/preferences [get]
+func (h *Handler) listNotificationPreferencesHandler(w http.ResponseWriter, r *http.Request) {
+	user := h.contextGetUser(r)
+	preferences, err := h.service.ListNotificationPreferences(user.ID)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"preferences": preferences}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// UpdateNotificationPreferences godoc
+// @Summary Update the user's notification preferences
+// @Description This endpoint sets how the user is notified of some events, in the app and by email. Events left out keep their preferences
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param body body dto.UpdateNotificationPreferencesRequestBody true "JSON payload required to update notification preferences"
+// @Success 200 {array} data.NotificationPreference
+// @Failure 400
+// @Failure 422
+// @Failure 500
+// @Router /v1/users/notifications/preferences [put]
+func (h *Handler) updateNotificationPreferencesHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody dto.UpdateNotificationPreferencesRequestBody
+	err := h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	user := h.contextGetUser(r)
+	preferences, err := h.service.UpdateNotificationPreferences(user.ID, requestBody.Preferences)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"preferences": preferences}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
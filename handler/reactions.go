@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/service"
+	"github.com/julienschmidt/httprouter"
+)
+
+// ReactToComment godoc
+// @Summary React to a booklist comment
+// @Description This endpoint adds the user's reaction to a booklist comment. A user can react to a comment once with each reaction: like, insightful, funny, love or sad
+// @Tags reactions
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param booklistId path int true "ID of booklist"
+// @Param commentId path int true "ID of comment"
+// @Param body body dto.ReactRequestBody true "JSON payload required to react to a comment"
+// @Success 201 {array} data.ReactionCount
+// @Failure 400
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/booklists/{booklistId}/comments/{commentId}/reactions [post]
+func (h *Handler) reactToCommentHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody dto.ReactRequestBody
+	err := h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	booklistID, err := h.readIDParam(r, "booklistId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	commentID, err := h.readIDParam(r, "commentId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	user := h.contextGetUser(r)
+	reactions, err := h.service.ReactToComment(user.ID, booklistID, commentID, requestBody.Reaction)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrDuplicateRecord):
+			h.recordAlreadyExistsResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"reactions": reactions}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// UnreactToComment godoc
+// @Summary Remove a reaction from a booklist comment
+// @Description This endpoint removes the user's reaction from a booklist comment
+// @Tags reactions
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param booklistId path int true "ID of booklist"
+// @Param commentId path int true "ID of comment"
+// @Param reaction path string true "Reaction to remove"
+// @Success 200 {array} data.ReactionCount
+// @Failure 404
+// @Failure 500
+// @Router /v1/booklists/{booklistId}/comments/{commentId}/reactions/{reaction} [delete]
+func (h *Handler) unreactToCommentHandler(w http.ResponseWriter, r *http.Request) {
+	booklistID, err := h.readIDParam(r, "booklistId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	commentID, err := h.readIDParam(r, "commentId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	reaction := httprouter.ParamsFromContext(r.Context()).ByName("reaction")
+	user := h.contextGetUser(r)
+	reactions, err := h.service.UnreactToComment(user.ID, booklistID, commentID, reaction)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"reactions": reactions}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ReactToReview godoc
+// @Summary React to a book review
+// @Description This endpoint adds the user's reaction to a book review. A user can react to a review once with each reaction: like, insightful, funny, love or sad
+// @Tags reactions
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book"
+// @Param reviewId path int true "ID of review"
+// @Param body body dto.ReactRequestBody true "JSON payload required to react to a review"
+// @Success 201 {array} data.ReactionCount
+// @Failure 400
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/books/{bookId}/reviews/{reviewId}/reactions [post]
+func (h *Handler) reactToReviewHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody dto.ReactRequestBody
+	err := h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	reviewID, err := h.readIDParam(r, "reviewId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	user := h.contextGetUser(r)
+	reactions, err := h.service.ReactToReview(user.ID, bookID, reviewID, requestBody.Reaction)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrDuplicateRecord):
+			h.recordAlreadyExistsResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"reactions": reactions}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// UnreactToReview godoc
+// @Summary Remove a reaction from a book review
+// @Description This endpoint removes the user's reaction from a book review
+// @Tags reactions
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book"
+// @Param reviewId path int true "ID of review"
+// @Param reaction path string true "Reaction to remove"
+// @Success 200 {array} data.ReactionCount
+// @Failure 404
+// @Failure 500
+// @Router /v1/books/{bookId}/reviews/{reviewId}/reactions/{reaction} [delete]
+func (h *Handler) unreactToReviewHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	reviewID, err := h.readIDParam(r, "reviewId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	reaction := httprouter.ParamsFromContext(r.Context()).ByName("reaction")
+	user := h.contextGetUser(r)
+	reactions, err := h.service.UnreactToReview(user.ID, bookID, reviewID, reaction)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"reactions": reactions}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
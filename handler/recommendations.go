@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/service"
+)
+
+// ListRelatedBooks godoc
+// @Summary List books related to a book
+// @Description This endpoint lists the books most often downloaded or favourited by readers of a specific book, falling back to books by the same authors or in the same category
+// @Tags books
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book"
+// @Param limit query int false "Query string param for the number of books (max 50)"
+// @Success 200 {array} data.RelatedBook
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/books/{bookId}/related [get]
+func (h *Handler) listRelatedBooksHandler(w http.ResponseWriter, r *http.Request) {
+	v := validator.New()
+	qs := r.URL.Query()
+	limit := h.readInt(qs, "limit", 10, v)
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	books, err := h.service.ListRelatedBooks(bookID, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"books": books}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ListSimilarBooks godoc
+// @Summary List books like a book
+// @Description This endpoint lists the books whose authors, series, category, publisher, language, title and description are most like those of a specific book
+// @Tags books
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book"
+// @Param limit query int false "Query string param for the number of books (max 50)"
+// @Success 200 {array} data.RelatedBook
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/books/{bookId}/similar [get]
+func (h *Handler) listSimilarBooksHandler(w http.ResponseWriter, r *http.Request) {
+	v := validator.New()
+	qs := r.URL.Query()
+	limit := h.readInt(qs, "limit", 10, v)
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	books, err := h.service.ListSimilarBooks(bookID, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"books": books}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ListUserRecommendations godoc
+// @Summary List book recommendations for the user
+// @Description This endpoint lists books the user hasn't seen, ranked from their favourites, downloads, reviews and followed categories, with an explanation for each pick
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param limit query int false "Query string param for the number of books (max 50)"
+// @Success 200 {array} data.Recommendation
+// @Failure 422
+// @Failure 500
+// @Router /v1/users/recommendations [get]
+func (h *Handler) listUserRecommendationsHandler(w http.ResponseWriter, r *http.Request) {
+	v := validator.New()
+	qs := r.URL.Query()
+	limit := h.readInt(qs, "limit", 20, v)
+	user := h.contextGetUser(r)
+	recommendations, err := h.service.ListUserRecommendations(user.ID, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"recommendations": recommendations}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ListTrendingBooks godoc
+// @Summary List trending books
+// @Description This endpoint lists books ranked by downloads, favourites and reviews within a time window, with recent activity weighing more
+// @Tags books
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param window query string false "Query string param for the time window: 7d, 30d or all"
+// @Param category_id query int false "Query string param to only rank books in a category"
+// @Param page query int false "Query string param for pagination (min 1)"
+// @Param page_size query int false "Query string param for pagination (max 100)"
+// @Param sort query string false "Sort by descending order: -score, -downloads, -favourites, -reviews"
+// @Success 200 {array} data.TrendingBook
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/trending/books [get]
+func (h *Handler) listTrendingBooksHandler(w http.ResponseWriter, r *http.Request) {
+	var qsInput dto.QsListTrendingBooks
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.Window = h.readString(qs, "window", "7d")
+	qsInput.CategoryID = h.readInt(qs, "category_id", 0, v)
+	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
+	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
+	qsInput.Filters.Sort = h.readString(qs, "sort", "-score")
+	qsInput.Filters.SortSafeList = []string{"-score", "-downloads", "-favourites", "-reviews"}
+	books, metadata, err := h.service.ListTrendingBooks(qsInput.Window, int64(qsInput.CategoryID), qsInput.Filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"books": books, "metadata": metadata}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
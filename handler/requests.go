@@ -12,17 +12,19 @@ import (
 
 // CreateRequest godoc
 // @Summary Create a new book request
-// @Description This endpoint creates a new book request
+// @Description This endpoint creates a new book request. Books with an ISBN are looked up on external catalogues. Books that the catalogues don't know, or that have no ISBN, can be requested manually by their title, author and year. When the book is already in the catalogue it is returned instead, and when it is already requested the user is subscribed to the existing request, which is returned
 // @Tags requests
 // @Accept  json
 // @Produce json
 // @Param token header string true "Bearer token"
 // @Param body body dto.CreateRequestRequestBody true "JSON payload required to create a book request"
+// @Success 200 {object} data.Request
 // @Success 201 {object} data.Request
 // @Failure 400
 // @Failure 404
 // @Failure 422
 // @Failure 500
+// @Failure 502
 // @Router /v1/requests [post]
 func (h *Handler) createRequestHandler(w http.ResponseWriter, r *http.Request) {
 	var requestBody dto.CreateRequestRequestBody
@@ -32,7 +34,7 @@ func (h *Handler) createRequestHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	user := h.contextGetUser(r)
-	request, err := h.service.CreateRequest(user.ID, requestBody.Isbn)
+	request, book, created, err := h.service.CreateRequest(user.ID, requestBody)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrFailedValidation):
@@ -41,14 +43,27 @@ func (h *Handler) createRequestHandler(w http.ResponseWriter, r *http.Request) {
 			h.recordAlreadyExistsResponse(w, r)
 		case errors.Is(err, service.ErrRecordNotFound):
 			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrMetadataNotFound):
+			h.requestMetadataNotFoundResponse(w, r)
+		case errors.Is(err, service.ErrMetadataUnavailable):
+			h.metadataUnavailableResponse(w, r, err)
 		default:
 			h.serverErrorResponse(w, r, err)
 		}
 		return
 	}
 	headers := make(http.Header)
-	headers.Set("Location", fmt.Sprintf("/v1/requests/%d", request.ID))
-	err = h.encodeJSON(w, http.StatusCreated, envelope{"request": request}, headers)
+	switch {
+	case book != nil:
+		headers.Set("Location", fmt.Sprintf("/v1/books/%d", book.ID))
+		err = h.encodeJSON(w, http.StatusOK, envelope{"book": book}, headers)
+	case !created:
+		headers.Set("Location", fmt.Sprintf("/v1/requests/%d", request.ID))
+		err = h.encodeJSON(w, http.StatusOK, envelope{"request": request}, headers)
+	default:
+		headers.Set("Location", fmt.Sprintf("/v1/requests/%d", request.ID))
+		err = h.encodeJSON(w, http.StatusCreated, envelope{"request": request}, headers)
+	}
 	if err != nil {
 		h.serverErrorResponse(w, r, err)
 	}
@@ -56,7 +71,7 @@ func (h *Handler) createRequestHandler(w http.ResponseWriter, r *http.Request) {
 
 // ShowRequest godoc
 // @Summary Show details of a book request
-// @Description This endpoint shows the details of a specific book request
+// @Description This endpoint shows the details of a specific book request with the history of its status
 // @Tags requests
 // @Accept  json
 // @Produce json
@@ -98,7 +113,7 @@ func (h *Handler) showRequestHandler(w http.ResponseWriter, r *http.Request) {
 // @Param search query string false "Query string param for search"
 // @Param page query int false "Query string param for pagination (min 1)"
 // @Param page_size query int false "Query string param for pagination (max 100)"
-// @Param status query int false "Query string param for book status (options: active, expired, completed)"
+// @Param status query string false "Query string param for request status (options: active, open, in_progress, fulfilled, rejected, expired, reopened). Active matches open, in_progress and reopened requests"
 // @Param sort query string false "Sort by ascending or descending order. Asc: id. Desc: -id"
 // @Success 200 {array} data.Request
 // @Failure 422
@@ -210,3 +225,139 @@ func (h *Handler) unsubscribeRequestHandler(w http.ResponseWriter, r *http.Reque
 		h.serverErrorResponse(w, r, err)
 	}
 }
+
+// TransitionRequest godoc
+// @Summary Change the status of a book request
+// @Description This endpoint changes the status of a book request and records the change in its history. Any user can claim an active request by moving it to in_progress, and the claimant or an admin can move it back to open or mark it fulfilled by a book. Only admins can reject or expire requests. The requester or an admin can reopen a fulfilled or expired request, and admins can reopen rejected ones
+// @Tags requests
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param requestId path int true "ID of request"
+// @Param body body dto.TransitionRequestRequestBody true "JSON payload required to change the status of a book request"
+// @Success 200 {object} data.Request
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Router /v1/requests/{requestId}/status [patch]
+func (h *Handler) transitionRequestHandler(w http.ResponseWriter, r *http.Request) {
+	requestID, err := h.readIDParam(r, "requestId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	var requestBody dto.TransitionRequestRequestBody
+	err = h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	user := h.contextGetUser(r)
+	request, err := h.service.TransitionRequest(user, requestID, requestBody)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrNotPermitted):
+			h.notPermittedResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrEditConflict):
+			h.editConflictResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"request": request}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ListTopRequests godoc
+// @Summary List the most wanted book requests
+// @Description This endpoint ranks the active book requests by their subscribers, with recent subscriptions weighing more, and breaks the demand down by category
+// @Tags requests
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param category_id query int false "Query string param to only rank requests in a category"
+// @Param page query int false "Query string param for pagination (min 1)"
+// @Param page_size query int false "Query string param for pagination (max 100)"
+// @Param sort query string false "Sort by descending order: -score, -waitlist, -created_at"
+// @Success 200 {array} data.TopRequest
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/top/requests [get]
+func (h *Handler) listTopRequestsHandler(w http.ResponseWriter, r *http.Request) {
+	var qsInput dto.QsListTopRequests
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.CategoryID = h.readInt(qs, "category_id", 0, v)
+	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
+	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
+	qsInput.Filters.Sort = h.readString(qs, "sort", "-score")
+	qsInput.Filters.SortSafeList = []string{"-score", "-waitlist", "-created_at"}
+	requests, categories, metadata, err := h.service.ListTopRequests(int64(qsInput.CategoryID), qsInput.Filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"requests": requests, "categories": categories, "metadata": metadata}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ClaimRequest godoc
+// @Summary Claim a book request
+// @Description This endpoint marks an active book request as in progress by the user, to let other contributors know that the user is working on uploading the book. The claim is released by moving the request back to open
+// @Tags requests
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param requestId path int true "ID of request to claim"
+// @Success 200 {object} data.Request
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Router /v1/requests/{requestId}/claim [post]
+func (h *Handler) claimRequestHandler(w http.ResponseWriter, r *http.Request) {
+	requestID, err := h.readIDParam(r, "requestId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	user := h.contextGetUser(r)
+	request, err := h.service.ClaimRequest(user, requestID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrEditConflict):
+			h.editConflictResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"request": request}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
@@ -190,7 +190,7 @@ func (h *Handler) deleteReviewHandler(w http.ResponseWriter, r *http.Request) {
 
 // ListReviews godoc
 // @Summary List all reviews
-// @Description This endpoint lists all reviews
+// @Description This endpoint lists the ratings of a book and its reviews, each with its reactions. Reviews are sorted by how helpful users found them by default
 // @Tags reviews
 // @Accept  json
 // @Produce json
@@ -198,22 +198,31 @@ func (h *Handler) deleteReviewHandler(w http.ResponseWriter, r *http.Request) {
 // @Param bookId path int true "ID of book for review"
 // @Param page query int false "Query string param for pagination (min 1)"
 // @Param page_size query int false "Query string param for pagination (max 100)"
-// @Param sort query string false "Sort by ascending or descending order. Asc: id, vote. Desc: -id, -vote"
+// @Param sort query string false "Sort by ascending or descending order. Asc: id, created_at, likes, helpfulness. Desc: -id, -created_at, -likes, -helpfulness"
 // @Success 200 {array} data.Review
+// @Failure 404
 // @Failure 422
 // @Failure 500
 // @Router /v1/books/{bookId}/reviews [get]
 func (h *Handler) listReviewsHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
 	var qsInput dto.QsListReviews
 	v := validator.New()
 	qs := r.URL.Query()
 	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
 	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
-	qsInput.Filters.Sort = h.readString(qs, "sort", "id")
-	qsInput.Filters.SortSafeList = []string{"id", "vote", "-id", "-vote"}
-	ratings, reviews, metadata, err := h.service.ListReviews(qsInput.Filters)
+	qsInput.Filters.Sort = h.readString(qs, "sort", "-helpfulness")
+	qsInput.Filters.SortSafeList = []string{"id", "created_at", "likes", "helpfulness", "-id", "-created_at", "-likes", "-helpfulness"}
+	user := h.contextGetUser(r)
+	ratings, reviews, metadata, err := h.service.ListReviews(bookID, user.ID, qsInput.Filters)
 	if err != nil {
 		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
 		case errors.Is(err, service.ErrFailedValidation):
 			h.failedValidationResponse(w, r, err)
 		default:
@@ -226,3 +235,99 @@ func (h *Handler) listReviewsHandler(w http.ResponseWriter, r *http.Request) {
 		h.serverErrorResponse(w, r, err)
 	}
 }
+
+// VoteReview godoc
+// @Summary Vote on whether a book review was helpful
+// @Description This endpoint records whether the user found a book review helpful, replacing the user's previous vote. Users can't vote on their own reviews
+// @Tags reviews
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book for review"
+// @Param reviewId path int true "ID of review to vote on"
+// @Param body body dto.VoteReviewRequestBody true "JSON payload required to vote on a book review"
+// @Success 200 {object} data.Review
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/books/{bookId}/reviews/{reviewId}/vote [put]
+func (h *Handler) voteReviewHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody dto.VoteReviewRequestBody
+	err := h.decodeJSON(w, r, &requestBody)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	reviewID, err := h.readIDParam(r, "reviewId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	user := h.contextGetUser(r)
+	review, err := h.service.VoteReview(user.ID, bookID, reviewID, requestBody.Helpful)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrNotPermitted):
+			h.notPermittedResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"review": review}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// UnvoteReview godoc
+// @Summary Remove a vote on a book review
+// @Description This endpoint removes the user's vote on whether a book review was helpful
+// @Tags reviews
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book for review"
+// @Param reviewId path int true "ID of review to remove the vote from"
+// @Success 200 {object} data.Review
+// @Failure 404
+// @Failure 500
+// @Router /v1/books/{bookId}/reviews/{reviewId}/vote [delete]
+func (h *Handler) unvoteReviewHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	reviewID, err := h.readIDParam(r, "reviewId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	user := h.contextGetUser(r)
+	review, err := h.service.UnvoteReview(user.ID, bookID, reviewID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"review": review}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
@@ -18,20 +18,54 @@ func (h *Handler) Routes() http.Handler {
 	router.HandlerFunc(http.MethodGet, "/v1/books/:bookId", h.requireActivatedUser(h.showBookHandler))
 	router.HandlerFunc(http.MethodPatch, "/v1/books/:bookId", h.requireBookOwnerPermission(h.updateBookHandler))
 	router.HandlerFunc(http.MethodDelete, "/v1/books/:bookId", h.requireBookOwnerPermission(h.deleteBookHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/books/:bookId/merge", h.requireAdminUser(h.mergeBooksHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/books/:bookId/enrich", h.requireActivatedUser(h.enrichBookHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/books/:bookId/enrich", h.requireActivatedUser(h.showBookEnrichmentHandler))
+	router.HandlerFunc(http.MethodDelete, "/v1/books/:bookId/enrich", h.requireActivatedUser(h.deleteBookEnrichmentHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/books/:bookId/enrich/accept", h.requireActivatedUser(h.acceptBookEnrichmentHandler))
 	router.HandlerFunc(http.MethodPatch, "/v1/books/:bookId/cover", h.requireBookOwnerPermission(h.updateBookCoverHandler))
 	router.HandlerFunc(http.MethodGet, "/v1/books/:bookId/download", h.requireActivatedUser(h.downloadBookHandler))
 	router.HandlerFunc(http.MethodDelete, "/v1/books/:bookId/download", h.requireActivatedUser(h.deleteBookFromDownloadsHandler))
 	router.HandlerFunc(http.MethodPost, "/v1/books/:bookId/favourite", h.requireActivatedUser(h.favouriteBookHandler))
 	router.HandlerFunc(http.MethodDelete, "/v1/books/:bookId/favourite", h.requireActivatedUser(h.deleteFavouriteBookHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/books/:bookId/related", h.requireActivatedUser(h.listRelatedBooksHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/books/:bookId/similar", h.requireActivatedUser(h.listSimilarBooksHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/books/:bookId/tags", h.requireActivatedUser(h.suggestBookTagsHandler))
+	router.HandlerFunc(http.MethodPatch, "/v1/books/:bookId/tags/:tag", h.requireActivatedUser(h.moderateBookTagHandler))
+	router.HandlerFunc(http.MethodDelete, "/v1/books/:bookId/tags/:tag", h.requireActivatedUser(h.deleteBookTagHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/trending/books", h.requireActivatedUser(h.listTrendingBooksHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/duplicates/books", h.requireActivatedUser(h.listBookDuplicatesHandler))
+
+	router.HandlerFunc(http.MethodGet, "/v1/authors", h.requireActivatedUser(h.listAuthorsHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/authors/:authorId", h.requireActivatedUser(h.showAuthorHandler))
+	router.HandlerFunc(http.MethodPatch, "/v1/authors/:authorId", h.requireAdminUser(h.updateAuthorHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/authors/:authorId/books", h.requireActivatedUser(h.listAuthorBooksHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/authors/:authorId/merge", h.requireAdminUser(h.mergeAuthorsHandler))
+
+	router.HandlerFunc(http.MethodGet, "/v1/languages", h.requireActivatedUser(h.listLanguagesHandler))
+
+	router.HandlerFunc(http.MethodGet, "/v1/tags", h.requireActivatedUser(h.listTagsHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/tags/cloud", h.requireActivatedUser(h.tagCloudHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/tags/suggestions", h.requireActivatedUser(h.listPendingBookTagsHandler))
 
 	router.HandlerFunc(http.MethodGet, "/v1/categories", h.requireActivatedUser(h.listCategoriesHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/categories", h.requireAdminUser(h.createCategoryHandler))
 	router.HandlerFunc(http.MethodGet, "/v1/categories/:categoryId", h.requireActivatedUser(h.showCategoryHandler))
+	router.HandlerFunc(http.MethodPatch, "/v1/categories/:categoryId", h.requireAdminUser(h.updateCategoryHandler))
+	router.HandlerFunc(http.MethodDelete, "/v1/categories/:categoryId", h.requireAdminUser(h.deleteCategoryHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/categories/:categoryId/merge", h.requireAdminUser(h.mergeCategoriesHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/categories/:categoryId/follow", h.requireActivatedUser(h.followCategoryHandler))
+	router.HandlerFunc(http.MethodDelete, "/v1/categories/:categoryId/follow", h.requireActivatedUser(h.unfollowCategoryHandler))
 
 	router.HandlerFunc(http.MethodGet, "/v1/books/:bookId/reviews", h.requireActivatedUser(h.listReviewsHandler))
 	router.HandlerFunc(http.MethodPost, "/v1/books/:bookId/reviews", h.requireActivatedUser(h.createReviewHandler))
 	router.HandlerFunc(http.MethodGet, "/v1/books/:bookId/reviews/:reviewId", h.requireActivatedUser(h.showReviewHandler))
 	router.HandlerFunc(http.MethodPatch, "/v1/books/:bookId/reviews/:reviewId", h.requireReviewOwnerPermission(h.updateReviewHandler))
 	router.HandlerFunc(http.MethodDelete, "/v1/books/:bookId/reviews/:reviewId", h.requireReviewOwnerPermission(h.deleteReviewHandler))
+	router.HandlerFunc(http.MethodPut, "/v1/books/:bookId/reviews/:reviewId/vote", h.requireActivatedUser(h.voteReviewHandler))
+	router.HandlerFunc(http.MethodDelete, "/v1/books/:bookId/reviews/:reviewId/vote", h.requireActivatedUser(h.unvoteReviewHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/books/:bookId/reviews/:reviewId/reactions", h.requireActivatedUser(h.reactToReviewHandler))
+	router.HandlerFunc(http.MethodDelete, "/v1/books/:bookId/reviews/:reviewId/reactions/:reaction", h.requireActivatedUser(h.unreactToReviewHandler))
 
 	router.HandlerFunc(http.MethodGet, "/v1/booklists", h.requireActivatedUser(h.listBooklistsHandler))
 	router.HandlerFunc(http.MethodPost, "/v1/booklists", h.requireActivatedUser(h.createBooklistHandler))
@@ -47,21 +81,28 @@ func (h *Handler) Routes() http.Handler {
 
 	router.HandlerFunc(http.MethodGet, "/v1/booklists/:booklistId/comments", h.requireActivatedUser(h.listCommentsHandler))
 	router.HandlerFunc(http.MethodPost, "/v1/booklists/:booklistId/comments", h.requireActivatedUser(h.createCommentHandler))
-	router.HandlerFunc(http.MethodPatch, "/v1/booklists/:booklistId/comments", h.requireCommentOwnerPermission(h.updateCommentHandler))
-	router.HandlerFunc(http.MethodDelete, "/v1/booklists/:booklistId/comments", h.requireCommentOwnerPermission(h.deleteCommentHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/booklists/:booklistId/comments/:commentId", h.requireActivatedUser(h.showCommentThreadHandler))
 	router.HandlerFunc(http.MethodPost, "/v1/booklists/:booklistId/comments/:commentId", h.requireActivatedUser(h.createCommentReplyHandler))
+	router.HandlerFunc(http.MethodPatch, "/v1/booklists/:booklistId/comments/:commentId", h.requireCommentOwnerPermission(h.updateCommentHandler))
+	router.HandlerFunc(http.MethodDelete, "/v1/booklists/:booklistId/comments/:commentId", h.requireCommentOwnerPermission(h.deleteCommentHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/booklists/:booklistId/comments/:commentId/reactions", h.requireActivatedUser(h.reactToCommentHandler))
+	router.HandlerFunc(http.MethodDelete, "/v1/booklists/:booklistId/comments/:commentId/reactions/:reaction", h.requireActivatedUser(h.unreactToCommentHandler))
 
 	router.HandlerFunc(http.MethodGet, "/v1/requests", h.requireActivatedUser(h.listRequestsHandler))
 	router.HandlerFunc(http.MethodPost, "/v1/requests", h.requireActivatedUser(h.createRequestHandler))
 	router.HandlerFunc(http.MethodGet, "/v1/requests/:requestId", h.requireActivatedUser(h.showRequestHandler))
 	router.HandlerFunc(http.MethodPost, "/v1/requests/:requestId/subscribe", h.requireActivatedUser(h.subscribeRequestHandler))
 	router.HandlerFunc(http.MethodDelete, "/v1/requests/:requestId/unsubscribe", h.requireActivatedUser(h.unsubscribeRequestHandler))
+	router.HandlerFunc(http.MethodPatch, "/v1/requests/:requestId/status", h.requireActivatedUser(h.transitionRequestHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/requests/:requestId/claim", h.requireActivatedUser(h.claimRequestHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/top/requests", h.requireActivatedUser(h.listTopRequestsHandler))
 
 	router.HandlerFunc(http.MethodPost, "/v1/users", h.registerUserHandler)
 	router.HandlerFunc(http.MethodPut, "/v1/users/activated", h.activateUserHandler)
 	router.HandlerFunc(http.MethodPut, "/v1/users/password", h.resetUserPasswordHandler)
 
 	router.HandlerFunc(http.MethodGet, "/v1/users/profile", h.requireActivatedUser(h.showUserHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/users/lookup", h.requireActivatedUser(h.lookupUserHandler))
 	router.HandlerFunc(http.MethodPatch, "/v1/users/profile", h.requireActivatedUser(h.updateUserHandler))
 	router.HandlerFunc(http.MethodPut, "/v1/users/profile", h.requireActivatedUser(h.updateUserPasswordHandler))
 	router.HandlerFunc(http.MethodDelete, "/v1/users/profile", h.requireActivatedUser(h.deleteUserHandler))
@@ -72,12 +113,27 @@ func (h *Handler) Routes() http.Handler {
 	router.HandlerFunc(http.MethodGet, "/v1/users/booklists/favourite", h.requireActivatedUser(h.listUserFavouriteBooklistsHandler))
 	router.HandlerFunc(http.MethodGet, "/v1/users/downloads", h.requireActivatedUser(h.listUserDownloadsHandler))
 	router.HandlerFunc(http.MethodGet, "/v1/users/requests", h.requireActivatedUser(h.listUserRequestsHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/users/searches", h.requireActivatedUser(h.listSavedSearchesHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/users/searches", h.requireActivatedUser(h.createSavedSearchHandler))
+	router.HandlerFunc(http.MethodPatch, "/v1/users/searches/:searchId", h.requireActivatedUser(h.updateSavedSearchHandler))
+	router.HandlerFunc(http.MethodDelete, "/v1/users/searches/:searchId", h.requireActivatedUser(h.deleteSavedSearchHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/users/recommendations", h.requireActivatedUser(h.listUserRecommendationsHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/users/notifications", h.requireActivatedUser(h.listNotificationsHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/users/notifications/unread", h.requireActivatedUser(h.showUnreadNotificationCountHandler))
+	router.HandlerFunc(http.MethodPut, "/v1/users/notifications/read", h.requireActivatedUser(h.markAllNotificationsReadHandler))
+	router.HandlerFunc(http.MethodPatch, "/v1/users/notifications/:notificationId", h.requireActivatedUser(h.markNotificationReadHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/users/notifications/preferences", h.requireActivatedUser(h.listNotificationPreferencesHandler))
+	router.HandlerFunc(http.MethodPut, "/v1/users/notifications/preferences", h.requireActivatedUser(h.updateNotificationPreferencesHandler))
 
 	router.HandlerFunc(http.MethodPost, "/v1/tokens/activation", h.createActivationTokenHandler)
 	router.HandlerFunc(http.MethodPost, "/v1/tokens/authentication", h.createAuthenticationTokenHandler)
 	router.HandlerFunc(http.MethodDelete, "/v1/tokens/authentication", h.requireAuthenticatedUser(h.deleteAuthenticationTokenHandler))
 	router.HandlerFunc(http.MethodPost, "/v1/tokens/password-reset", h.createPasswordResetTokenHandler)
 
+	router.HandlerFunc(http.MethodGet, "/v1/jobs", h.requireAdminUser(h.listJobsHandler))
+	router.HandlerFunc(http.MethodGet, "/v1/jobs/:name/runs", h.requireAdminUser(h.listJobRunsHandler))
+	router.HandlerFunc(http.MethodPost, "/v1/jobs/:name/run", h.requireAdminUser(h.runJobHandler))
+
 	// router.HandlerFunc(http.MethodGet, "/debug/vars", app.basicAuth(expvar.Handler().ServeHTTP))
 	router.HandlerFunc(http.MethodGet, "/v1/healthcheck", h.healthcheckHandler)
 
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/service"
+)
+
+// CreateSavedSearch godoc
+// @Summary Save a book search
+// @Description This endpoint saves a book search under a name. When notify is true, the user is emailed when new or updated books match the search
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param body body dto.CreateSavedSearchRequestBody true "JSON payload required to save a search"
+// @Success 201 {object} data.SavedSearch
+// @Failure 400
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Router /v1/users/searches [post]
+func (h *Handler) createSavedSearchHandler(w http.ResponseWriter, r *http.Request) {
+	var requestBody dto.CreateSavedSearchRequestBody
+	if err := h.decodeJSON(w, r, &requestBody); err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	user := h.contextGetUser(r)
+	savedSearch, err := h.service.CreateSavedSearch(user.ID, requestBody)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrDuplicateRecord):
+			h.recordAlreadyExistsResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	headers := make(http.Header)
+	headers.Set("Location", fmt.Sprintf("/v1/users/searches/%d", savedSearch.ID))
+	if err := h.encodeJSON(w, http.StatusCreated, envelope{"saved_search": savedSearch}, headers); err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ListSavedSearches godoc
+// @Summary List the user's saved searches
+// @Description This endpoint lists the book searches saved by the user
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Success 200 {array} data.SavedSearch
+// @Failure 500
+// @Router /v1/users/searches [get]
+func (h *Handler) listSavedSearchesHandler(w http.ResponseWriter, r *http.Request) {
+	user := h.contextGetUser(r)
+	savedSearches, err := h.service.ListSavedSearches(user.ID)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"saved_searches": savedSearches}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// UpdateSavedSearch godoc
+// @Summary Update a saved search
+// @Description This endpoint renames a saved search or turns its email notifications on or off
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param searchId path int true "ID of saved search to update"
+// @Param body body dto.UpdateSavedSearchRequestBody true "JSON payload required to update a saved search"
+// @Success 200 {object} data.SavedSearch
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 422
+// @Failure 500
+// @Router /v1/users/searches/{searchId} [patch]
+func (h *Handler) updateSavedSearchHandler(w http.ResponseWriter, r *http.Request) {
+	savedSearchID, err := h.readIDParam(r, "searchId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	var requestBody dto.UpdateSavedSearchRequestBody
+	if err := h.decodeJSON(w, r, &requestBody); err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	user := h.contextGetUser(r)
+	savedSearch, err := h.service.UpdateSavedSearch(user.ID, savedSearchID, requestBody)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrEditConflict):
+			h.editConflictResponse(w, r)
+		case errors.Is(err, service.ErrDuplicateRecord):
+			h.recordAlreadyExistsResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"saved_search": savedSearch}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// DeleteSavedSearch godoc
+// @Summary Delete a saved search
+// @Description This endpoint deletes a saved search of the user
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param searchId path int true "ID of saved search to delete"
+// @Success 200
+// @Failure 404
+// @Failure 500
+// @Router /v1/users/searches/{searchId} [delete]
+func (h *Handler) deleteSavedSearchHandler(w http.ResponseWriter, r *http.Request) {
+	savedSearchID, err := h.readIDParam(r, "searchId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	user := h.contextGetUser(r)
+	err = h.service.DeleteSavedSearch(user.ID, savedSearchID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"message": "saved search successfully deleted"}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/service"
+	"github.com/julienschmidt/httprouter"
+)
+
+// SuggestBookTags godoc
+// @Summary Add tags to a book
+// @Description This endpoint adds tags to a book. Tags added by the book's owner or an admin are approved straight away, while tags suggested by other users await moderation
+// @Tags tags
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book to tag"
+// @Param body body dto.SuggestBookTagsRequestBody true "JSON payload required to tag a book"
+// @Success 201 {array} data.BookTag
+// @Failure 400
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/books/{bookId}/tags [post]
+func (h *Handler) suggestBookTagsHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	var requestBody dto.SuggestBookTagsRequestBody
+	if err := h.decodeJSON(w, r, &requestBody); err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	user := h.contextGetUser(r)
+	bookTags, err := h.service.SuggestBookTags(user, bookID, requestBody)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"tags": bookTags}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ModerateBookTag godoc
+// @Summary Approve or reject a tag of a book
+// @Description This endpoint approves or rejects a tag suggested for a book. Only the book's owner or an admin can moderate its tags
+// @Tags tags
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book"
+// @Param tag path string true "Tag to moderate"
+// @Param body body dto.ModerateBookTagRequestBody true "JSON payload required to moderate a tag"
+// @Success 200 {object} data.BookTag
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/books/{bookId}/tags/{tag} [patch]
+func (h *Handler) moderateBookTagHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	var requestBody dto.ModerateBookTagRequestBody
+	if err := h.decodeJSON(w, r, &requestBody); err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	tag := httprouter.ParamsFromContext(r.Context()).ByName("tag")
+	user := h.contextGetUser(r)
+	bookTag, err := h.service.ModerateBookTag(user, bookID, tag, requestBody)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrNotPermitted):
+			h.notPermittedResponse(w, r)
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"tag": bookTag}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// DeleteBookTag godoc
+// @Summary Remove a tag from a book
+// @Description This endpoint removes a tag from a book. Only the book's owner or an admin can remove its tags
+// @Tags tags
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param bookId path int true "ID of book"
+// @Param tag path string true "Tag to remove"
+// @Success 200
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /v1/books/{bookId}/tags/{tag} [delete]
+func (h *Handler) deleteBookTagHandler(w http.ResponseWriter, r *http.Request) {
+	bookID, err := h.readIDParam(r, "bookId")
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+	tag := httprouter.ParamsFromContext(r.Context()).ByName("tag")
+	user := h.contextGetUser(r)
+	err = h.service.DeleteBookTag(user, bookID, tag)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		case errors.Is(err, service.ErrNotPermitted):
+			h.notPermittedResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"message": "tag successfully removed"}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ListTags godoc
+// @Summary List tags
+// @Description This endpoint lists the most used tags starting with a prefix, for autocomplete
+// @Tags tags
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param prefix query string false "Query string param to filter by prefix"
+// @Param limit query int false "Query string param for the maximum number of tags (max 50)"
+// @Success 200 {array} data.Tag
+// @Failure 422
+// @Failure 500
+// @Router /v1/tags [get]
+func (h *Handler) listTagsHandler(w http.ResponseWriter, r *http.Request) {
+	var qsInput dto.QsListTags
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.Prefix = h.readString(qs, "prefix", "")
+	qsInput.Limit = h.readInt(qs, "limit", 10, v)
+	tags, err := h.service.ListTags(qsInput.Prefix, qsInput.Limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"tags": tags}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// TagCloud godoc
+// @Summary Show the tag cloud
+// @Description This endpoint lists the most used tags, each weighted between 0 and 1 by its usage
+// @Tags tags
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param limit query int false "Query string param for the maximum number of tags (max 200)"
+// @Success 200 {array} data.Tag
+// @Failure 422
+// @Failure 500
+// @Router /v1/tags/cloud [get]
+func (h *Handler) tagCloudHandler(w http.ResponseWriter, r *http.Request) {
+	var qsInput dto.QsListTags
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.Limit = h.readInt(qs, "limit", 50, v)
+	tags, err := h.service.TagCloud(qsInput.Limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"tags": tags}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ListPendingBookTags godoc
+// @Summary List tags awaiting moderation
+// @Description This endpoint lists the tags suggested for the user's books that await moderation. Admins see the tags suggested for all books
+// @Tags tags
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param page query int false "Query string param for pagination (min 1)"
+// @Param page_size query int false "Query string param for pagination (max 100)"
+// @Param sort query string false "Sort by ascending or descending order. Asc: datetime. Desc: -datetime"
+// @Success 200 {array} data.BookTag
+// @Failure 422
+// @Failure 500
+// @Router /v1/tags/suggestions [get]
+func (h *Handler) listPendingBookTagsHandler(w http.ResponseWriter, r *http.Request) {
+	var qsInput dto.QsListPendingBookTags
+	v := validator.New()
+	qs := r.URL.Query()
+	qsInput.Filters.Page = h.readInt(qs, "page", 1, v)
+	qsInput.Filters.PageSize = h.readInt(qs, "page_size", 10, v)
+	qsInput.Filters.Sort = h.readString(qs, "sort", "datetime")
+	qsInput.Filters.SortSafeList = []string{"datetime", "-datetime"}
+	user := h.contextGetUser(r)
+	bookTags, metadata, err := h.service.ListPendingBookTags(user, qsInput.Filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"tags": bookTags, "metadata": metadata}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
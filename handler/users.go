@@ -29,7 +29,7 @@ func (h *Handler) registerUserHandler(w http.ResponseWriter, r *http.Request) {
 		h.badRequestResponse(w, r, err)
 		return
 	}
-	user, err := h.service.RegisterUser(requestBody.Name, requestBody.Email, requestBody.Password)
+	user, err := h.service.RegisterUser(requestBody.Name, requestBody.Username, requestBody.Email, requestBody.Password)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrFailedValidation) || errors.Is(err, service.ErrDuplicateRecord):
@@ -112,6 +112,40 @@ func (h *Handler) showUserHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// LookupUser godoc
+// @Summary Look up a user by username
+// @Description This endpoint shows the public details of the user with a username
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param token header string true "Bearer token"
+// @Param username query string true "Query string param for username"
+// @Success 200 {object} data.PublicUser
+// @Failure 404
+// @Failure 422
+// @Failure 500
+// @Router /v1/users/lookup [get]
+func (h *Handler) lookupUserHandler(w http.ResponseWriter, r *http.Request) {
+	var qs dto.QsLookupUser
+	qs.Username = h.readString(r.URL.Query(), "username", "")
+	user, err := h.service.LookupUser(qs.Username)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFailedValidation):
+			h.failedValidationResponse(w, r, err)
+		case errors.Is(err, service.ErrRecordNotFound):
+			h.notFoundResponse(w, r)
+		default:
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+	err = h.encodeJSON(w, http.StatusOK, envelope{"user": user}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
 // UpdateUser godoc
 // @Summary Update a user
 // @Description This endpoint updates a user
@@ -134,7 +168,7 @@ func (h *Handler) updateUserHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	userID := h.contextGetUser(r).ID
-	user, err := h.service.UpdateUser(userID, requestBody.Name, requestBody.Email)
+	user, err := h.service.UpdateUser(userID, requestBody.Name, requestBody.Username, requestBody.Email)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRecordNotFound):
@@ -351,7 +385,7 @@ func (h *Handler) listUserBooklistsHandler(w http.ResponseWriter, r *http.Reques
 // @Param token header string true "Bearer token"
 // @Param page query int false "Query string param for pagination (min 1)"
 // @Param page_size query int false "Query string param for pagination (max 100)"
-// @Param status query int false "Query string param for book status (options: active, expired, completed)"
+// @Param status query string false "Query string param for request status (options: active, open, in_progress, fulfilled, rejected, expired, reopened). Active matches open, in_progress and reopened requests"
 // @Param sort query string false "Sort by ascending or descending order. Asc: datetime. Desc: -datetime"
 // @Success 200 {array} data.Request
 // @Failure 422
@@ -0,0 +1,101 @@
+// Package iso639 looks up languages by their ISO 639-1, ISO 639-2/B or ISO 639-3
+// code or by their English name. The language table is embedded from the ISO 639-3
+// code tables, so lookups don't need a network connection.
+package iso639
+
+import (
+	"bufio"
+	_ "embed"
+	"strings"
+)
+
+//go:embed languages.tsv
+var languagesTSV string
+
+// Language defines an ISO 639 language. Code is the ISO 639-1 code of the language,
+// or its ISO 639-3 code for languages without an ISO 639-1 code.
+type Language struct {
+	Code   string `json:"code"`
+	Alpha2 string `json:"-"`
+	Alpha3 string `json:"-"`
+	Name   string `json:"name"`
+}
+
+var (
+	// languages maps each lookup key to a language.
+	languages = make(map[string]*Language)
+	// names maps the code of each language to its name.
+	names = make(map[string]string)
+)
+
+func init() {
+	var others []*Language
+	var otherNames [][]string
+	scanner := bufio.NewScanner(strings.NewReader(languagesTSV))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		language := &Language{Code: fields[0], Alpha2: fields[1], Alpha3: fields[0], Name: fields[3]}
+		if language.Alpha2 != "" {
+			language.Code = language.Alpha2
+		}
+		names[language.Code] = language.Name
+		keys := []string{fields[0], fields[1], fields[2], fields[3]}
+		if fields[4] != "" {
+			keys = append(keys, strings.Split(fields[4], "; ")...)
+		}
+		// Languages with an ISO 639-1 code are the most widely used, so their names
+		// take precedence over the names of other languages
+		if language.Alpha2 == "" {
+			others = append(others, language)
+			otherNames = append(otherNames, keys)
+			continue
+		}
+		add(language, keys)
+	}
+	for i, language := range others {
+		add(language, otherNames[i])
+	}
+}
+
+// add indexes a language by its codes and names. Names are also indexed without a
+// parenthesized qualifier, and names such as "Greek, Modern" by their first part.
+func add(language *Language, keys []string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		variants := []string{key}
+		if i := strings.Index(key, " ("); i > 0 {
+			key = key[:i]
+			variants = append(variants, key)
+		}
+		if i := strings.Index(key, ", "); i > 0 {
+			variants = append(variants, key[:i])
+		}
+		for _, variant := range variants {
+			variant = strings.ToLower(variant)
+			if _, exists := languages[variant]; !exists {
+				languages[variant] = language
+			}
+		}
+	}
+}
+
+// Lookup returns the language with a case-insensitive code or English name.
+func Lookup(s string) (Language, bool) {
+	language, ok := languages[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		return Language{}, false
+	}
+	return *language, true
+}
+
+// Name returns the English name of the language with a code returned by Lookup, or
+// an empty string if there is no such language.
+func Name(code string) string {
+	return names[code]
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"embed"
 	"html/template"
+	"strings"
 	"time"
 
 	"gopkg.in/mail.v2"
@@ -12,6 +13,11 @@ import (
 //go:embed "templates"
 var templateFS embed.FS
 
+// functions are the helper functions available to email templates.
+var functions = template.FuncMap{
+	"join": strings.Join,
+}
+
 // The Mailer struct contains a mail.Dialer instance (used to connect to a
 // SMTP server) and the sender information for emails (the name and address you
 // want the email to be from, such as "Alice Smith <alice@example.com>").
@@ -35,7 +41,7 @@ func New(host string, port int, username, password, sender string) Mailer {
 // containing the templates, and any dynamic// data for the templates as an interface{}
 // parameter, and uses these to send an email.
 func (m Mailer) Send(recipient, templateFile string, data interface{}) error {
-	tmpl, err := template.New("email").ParseFS(templateFS, "templates/"+templateFile)
+	tmpl, err := template.New("email").Funcs(functions).ParseFS(templateFS, "templates/"+templateFile)
 	if err != nil {
 		return err
 	}
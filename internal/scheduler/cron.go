@@ -0,0 +1,189 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar record whether the day fields are unrestricted, since cron
+	// matches either day field when both are restricted.
+	domStar, dowStar bool
+	// every is the interval of "@every" schedules.
+	every time.Duration
+}
+
+type bounds struct {
+	min, max int
+}
+
+var (
+	minuteBounds = bounds{0, 59}
+	hourBounds   = bounds{0, 23}
+	domBounds    = bounds{1, 31}
+	monthBounds  = bounds{1, 12}
+	dowBounds    = bounds{0, 7}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a standard five field cron expression ("minute hour day-of-month month
+// day-of-week"), one of the descriptors @yearly, @monthly, @weekly, @daily and @hourly,
+// or "@every <duration>". Fields can be "*", numbers, ranges such as "1-5", lists such
+// as "1,15" and steps such as "*/5" or "0-30/10". Sunday is either 0 or 7.
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@every ") {
+		every, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+		}
+		if every < time.Second {
+			return nil, fmt.Errorf("invalid schedule %q: interval must be at least 1s", spec)
+		}
+		return &Schedule{every: every}, nil
+	}
+	if expr, ok := descriptors[spec]; ok {
+		spec = expr
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: expected 5 fields, got %d", spec, len(fields))
+	}
+	var s Schedule
+	var err error
+	if s.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: minute: %w", spec, err)
+	}
+	if s.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: hour: %w", spec, err)
+	}
+	if s.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: day of month: %w", spec, err)
+	}
+	if s.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: month: %w", spec, err)
+	}
+	if s.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: day of week: %w", spec, err)
+	}
+	// Sunday is both 0 and 7
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domStar = strings.HasPrefix(fields[2], "*")
+	s.dowStar = strings.HasPrefix(fields[4], "*")
+	return &s, nil
+}
+
+// parseField parses a comma separated list of values, ranges and steps into a bitset.
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		if part == "" {
+			return 0, errors.New("empty value")
+		}
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step %q", part[i+1:])
+			}
+			step = n
+			part = part[:i]
+		}
+		start, end := b.min, b.max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			bounds := strings.SplitN(part, "-", 2)
+			var err error
+			if start, err = parseValue(bounds[0], b); err != nil {
+				return 0, err
+			}
+			if end, err = parseValue(bounds[1], b); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+		default:
+			var err error
+			if start, err = parseValue(part, b); err != nil {
+				return 0, err
+			}
+			// "5/10" means every 10 starting at 5
+			if step == 1 {
+				end = start
+			}
+		}
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(s string, b bounds) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if n < b.min || n > b.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", n, b.min, b.max)
+	}
+	return n, nil
+}
+
+// Next returns the first time after t that the schedule runs at, or the zero time if it
+// never runs, such as on the 30th of February. "@every" schedules run at multiples of
+// their interval, so that every replica computes the same times.
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.every > 0 {
+		return t.Truncate(s.every).Add(s.every)
+	}
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleNext(t *testing.T) {
+	from := time.Date(2024, time.January, 31, 23, 58, 30, 0, time.UTC)
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.January, 31, 23, 59, 0, 0, time.UTC)},
+		{"*/5 * * * *", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"30 9 * * 1-5", time.Date(2024, time.February, 1, 9, 30, 0, 0, time.UTC)},
+		{"0 12 29 2 *", time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC)},
+		{"0 0 15 * 0", time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC)},
+		{"10-20/5,45 3 * * *", time.Date(2024, time.February, 1, 3, 10, 0, 0, time.UTC)},
+		{"@every 15m", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, tt := range tests {
+		s, err := Parse(tt.spec)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", tt.spec, err)
+			continue
+		}
+		if got := s.Next(from); !got.Equal(tt.want) {
+			t.Errorf("Parse(%q).Next() = %v; want %v", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	specs := []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "a * * * *", "@every 1ms", "@every soon"}
+	for _, spec := range specs {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parse(%q) expected an error", spec)
+		}
+	}
+}
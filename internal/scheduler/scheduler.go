@@ -0,0 +1,233 @@
+// Package scheduler runs background jobs on cron schedules inside the API server. When
+// several replicas of the server run, each scheduled run is only performed by one of them:
+// runs are guarded by a lock shared by the replicas, and recorded by their scheduled time.
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/jsonlog"
+)
+
+var (
+	// ErrUnknownJob is returned when triggering a job that isn't scheduled.
+	ErrUnknownJob = errors.New("unknown job")
+	// ErrJobRunning is returned when triggering a job that is already running.
+	ErrJobRunning = errors.New("job already running")
+)
+
+// Store locks jobs across replicas and records their runs.
+type Store interface {
+	// TryJobLock acquires the lock of a job without waiting, and returns a function that
+	// releases it. It returns false if another run of the job holds the lock.
+	TryJobLock(name string) (func(), bool, error)
+	// StartJobRun records the start of a run, returning false if a run of the job was
+	// already recorded for the same scheduled time.
+	StartJobRun(run *data.JobRun) (bool, error)
+	// FinishJobRun records the status and error of a finished run.
+	FinishJobRun(run *data.JobRun) error
+}
+
+type job struct {
+	name     string
+	spec     string
+	schedule *Schedule
+	fn       func() error
+}
+
+// Scheduler runs jobs on their schedules. Schedules are evaluated in UTC.
+type Scheduler struct {
+	store   Store
+	logger  *jsonlog.Logger
+	mu      sync.Mutex
+	jobs    map[string]*job
+	stop    chan struct{}
+	started bool
+	wg      sync.WaitGroup
+}
+
+// New creates a new scheduler that locks and records runs with store.
+func New(store Store, logger *jsonlog.Logger) *Scheduler {
+	return &Scheduler{
+		store:  store,
+		logger: logger,
+		jobs:   make(map[string]*job),
+		stop:   make(chan struct{}),
+	}
+}
+
+// Add schedules fn to run as the job name on the cron schedule spec. Jobs must be added
+// before the scheduler is started.
+func (s *Scheduler) Add(name, spec string, fn func() error) error {
+	schedule, err := Parse(spec)
+	if err != nil {
+		return fmt.Errorf("job %s: %w", name, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return fmt.Errorf("job %s: scheduler already started", name)
+	}
+	if _, exists := s.jobs[name]; exists {
+		return fmt.Errorf("job %s: already scheduled", name)
+	}
+	s.jobs[name] = &job{name: name, spec: spec, schedule: schedule, fn: fn}
+	return nil
+}
+
+// Start runs each job in a background goroutine at its scheduled times until Stop is called.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return
+	}
+	s.started = true
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(j)
+	}
+}
+
+// Stop stops scheduling jobs and waits for the running jobs to finish.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	if !s.started {
+		s.mu.Unlock()
+		return
+	}
+	s.started = false
+	close(s.stop)
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// Jobs returns the scheduled jobs sorted by name, with the time each is next due.
+func (s *Scheduler) Jobs() []*data.Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now().UTC()
+	jobs := make([]*data.Job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		jobs = append(jobs, &data.Job{Name: j.name, Schedule: j.spec, NextRun: j.schedule.Next(now)})
+	}
+	sort.Slice(jobs, func(i, k int) bool { return jobs[i].Name < jobs[k].Name })
+	return jobs
+}
+
+// Trigger starts a run of a job straight away on behalf of a user, and returns the run
+// while the job runs in a background goroutine. It returns ErrJobRunning if the job is
+// already running on any replica.
+func (s *Scheduler) Trigger(name string, userID int64) (*data.JobRun, error) {
+	s.mu.Lock()
+	j, ok := s.jobs[name]
+	s.mu.Unlock()
+	if !ok {
+		return nil, ErrUnknownJob
+	}
+	run := &data.JobRun{
+		JobName:     j.name,
+		Trigger:     data.JobTriggerManual,
+		UserID:      userID,
+		ScheduledAt: time.Now().UTC().Truncate(time.Second),
+	}
+	unlock, started, err := s.start(run)
+	if err != nil {
+		return nil, err
+	}
+	if !started {
+		return nil, ErrJobRunning
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer unlock()
+		s.execute(j, run)
+	}()
+	return run, nil
+}
+
+// loop runs a job at each of its scheduled times.
+func (s *Scheduler) loop(j *job) {
+	defer s.wg.Done()
+	for {
+		next := j.schedule.Next(time.Now().UTC())
+		if next.IsZero() {
+			s.logger.PrintError(errors.New("job will never run"), map[string]string{"job": j.name})
+			return
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-s.stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		run := &data.JobRun{
+			JobName:     j.name,
+			Trigger:     data.JobTriggerSchedule,
+			ScheduledAt: next,
+		}
+		unlock, started, err := s.start(run)
+		if err != nil {
+			s.logger.PrintError(err, map[string]string{"job": j.name})
+			continue
+		}
+		// Another replica is running the job or has already run it
+		if !started {
+			continue
+		}
+		s.execute(j, run)
+		unlock()
+	}
+}
+
+// start locks a job and records the start of a run. It returns false when the job is
+// locked by another run, or has already run at the run's scheduled time.
+func (s *Scheduler) start(run *data.JobRun) (func(), bool, error) {
+	unlock, locked, err := s.store.TryJobLock(run.JobName)
+	if err != nil || !locked {
+		return nil, false, err
+	}
+	run.Status = data.JobRunStatusRunning
+	started, err := s.store.StartJobRun(run)
+	if err != nil || !started {
+		unlock()
+		return nil, false, err
+	}
+	return unlock, true, nil
+}
+
+// execute runs a job and records its outcome. Errors and panics are logged so that a failed
+// run doesn't stop the job.
+func (s *Scheduler) execute(j *job, run *data.JobRun) {
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%s", r)
+			}
+		}()
+		return j.fn()
+	}()
+	run.Status = data.JobRunStatusSucceeded
+	if err != nil {
+		run.Status = data.JobRunStatusFailed
+		run.Error = err.Error()
+		s.logger.PrintError(err, map[string]string{"job": j.name})
+	}
+	if err := s.store.FinishJobRun(run); err != nil {
+		s.logger.PrintError(err, map[string]string{"job": j.name})
+	}
+	if run.Status == data.JobRunStatusSucceeded {
+		s.logger.PrintInfo("completed job", map[string]string{
+			"job":      j.name,
+			"trigger":  run.Trigger,
+			"duration": run.FinishedAt.Sub(run.StartedAt).String(),
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package validator
+
+import "strings"
+
+// CleanISBN strips the hyphens and spaces from an ISBN and upper-cases the
+// ISBN-10 check character X.
+func CleanISBN(isbn string) string {
+	isbn = strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, isbn)
+	return strings.ToUpper(isbn)
+}
+
+// ISBN10 returns true if a cleaned value is an ISBN-10 with a valid check character.
+func ISBN10(isbn string) bool {
+	if len(isbn) != 10 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		var digit int
+		switch c := isbn[i]; {
+		case c >= '0' && c <= '9':
+			digit = int(c - '0')
+		case c == 'X' && i == 9:
+			digit = 10
+		default:
+			return false
+		}
+		sum += digit * (10 - i)
+	}
+	return sum%11 == 0
+}
+
+// ISBN13 returns true if a cleaned value is an ISBN-13 with a 978 or 979 prefix and
+// a valid check digit.
+func ISBN13(isbn string) bool {
+	if len(isbn) != 13 || !(strings.HasPrefix(isbn, "978") || strings.HasPrefix(isbn, "979")) {
+		return false
+	}
+	for i := 0; i < 13; i++ {
+		if isbn[i] < '0' || isbn[i] > '9' {
+			return false
+		}
+	}
+	return isbn13CheckDigit(isbn[:12]) == isbn[12]
+}
+
+// ISBN10To13 converts a valid cleaned ISBN-10 to its ISBN-13 form.
+func ISBN10To13(isbn string) string {
+	isbn = "978" + isbn[:9]
+	return isbn + string(isbn13CheckDigit(isbn))
+}
+
+// ISBN13To10 converts a valid cleaned ISBN-13 to its ISBN-10 form. ISBNs with
+// the 979 prefix have no ISBN-10 form, so it returns false for them.
+func ISBN13To10(isbn string) (string, bool) {
+	if !strings.HasPrefix(isbn, "978") {
+		return "", false
+	}
+	isbn = isbn[3:12]
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(isbn[i]-'0') * (10 - i)
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return isbn + "X", true
+	}
+	return isbn + string(rune('0'+check)), true
+}
+
+// ParseISBN cleans an ISBN in either form and returns both of its forms. The ISBN-10
+// form is empty for ISBNs with the 979 prefix. It returns false if the ISBN is invalid.
+func ParseISBN(isbn string) (isbn10, isbn13 string, ok bool) {
+	isbn = CleanISBN(isbn)
+	switch {
+	case ISBN10(isbn):
+		return isbn, ISBN10To13(isbn), true
+	case ISBN13(isbn):
+		isbn10, _ = ISBN13To10(isbn)
+		return isbn10, isbn, true
+	default:
+		return "", "", false
+	}
+}
+
+// isbn13CheckDigit calculates the check digit of the first 12 digits of an ISBN-13.
+func isbn13CheckDigit(isbn string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		digit := int(isbn[i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
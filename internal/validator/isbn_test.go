@@ -0,0 +1,40 @@
+package validator
+
+import "testing"
+
+func TestParseISBN(t *testing.T) {
+	tests := []struct {
+		name   string
+		isbn   string
+		isbn10 string
+		isbn13 string
+		ok     bool
+	}{
+		{"ISBN-10", "0306406152", "0306406152", "9780306406157", true},
+		{"ISBN-10 with hyphens", "0-306-40615-2", "0306406152", "9780306406157", true},
+		{"ISBN-10 with X check character", "080442957x", "080442957X", "9780804429573", true},
+		{"ISBN-13", "9780306406157", "0306406152", "9780306406157", true},
+		{"ISBN-13 with spaces", "978 0 306 40615 7", "0306406152", "9780306406157", true},
+		{"ISBN-13 with 979 prefix", "979-10-90636-07-1", "", "9791090636071", true},
+		{"ISBN-10 with bad checksum", "0306406153", "", "", false},
+		{"ISBN-13 with bad checksum", "9780306406158", "", "", false},
+		{"ISBN-13 with bad prefix", "9770306406156", "", "", false},
+		{"X inside ISBN-10", "03064X6152", "", "", false},
+		{"Wrong length", "97803064061", "", "", false},
+		{"Empty", "", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isbn10, isbn13, ok := ParseISBN(tt.isbn)
+			if ok != tt.ok {
+				t.Fatalf("expected ok %t; got %t", tt.ok, ok)
+			}
+			if isbn10 != tt.isbn10 {
+				t.Errorf("expected ISBN-10 %q; got %q", tt.isbn10, isbn10)
+			}
+			if isbn13 != tt.isbn13 {
+				t.Errorf("expected ISBN-13 %q; got %q", tt.isbn13, isbn13)
+			}
+		})
+	}
+}
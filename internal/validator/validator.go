@@ -66,6 +66,15 @@ func Unique(values []string) bool {
 	return len(values) == len(uniqueValues)
 }
 
+// UniqueInt64 returns true if all int64 values in a slice are unique.
+func UniqueInt64(values []int64) bool {
+	uniqueValues := make(map[int64]bool)
+	for _, value := range values {
+		uniqueValues[value] = true
+	}
+	return len(values) == len(uniqueValues)
+}
+
 // Mime returns true if a specific mime type is in a list of strings.
 func Mime(value *mimetype.MIME, list ...string) bool {
 	for i := range list {
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/emzola/bibliotheca/internal/scheduler"
+)
+
+// scheduleJobs adds the app's background jobs to a scheduler. Jobs that used to run at an
+// interval keep their intervals, starting from the top of the hour.
+func (a *app) scheduleJobs(s *scheduler.Scheduler) error {
+	jobs := []struct {
+		name string
+		spec string
+		fn   func() error
+	}{
+		{"refresh-book-similarities", "@every " + a.config.Recommendations.RefreshInterval.String(), a.service.RefreshBookSimilarities},
+		{"refresh-trending-books", "@every " + a.config.Recommendations.TrendingRefreshInterval.String(), a.service.RefreshTrendingBooks},
+		{"refresh-missing-book-content", "@every " + a.config.Recommendations.RefreshInterval.String(), a.service.RefreshMissingBookContent},
+		{"notify-saved-search-matches", "@every " + a.config.SavedSearches.NotifyInterval.String(), a.service.NotifySavedSearchMatches},
+		{"reset-download-counts", a.config.Jobs.ResetDownloadCountsSchedule, a.service.ResetDownloadCounts},
+		{"expire-requests", a.config.Jobs.ExpireRequestsSchedule, a.service.ExpireRequests},
+		{"delete-expired-tokens", a.config.Jobs.DeleteExpiredTokensSchedule, a.service.DeleteExpiredTokens},
+		{"refresh-book-popularity", a.config.Jobs.RefreshBookPopularitySchedule, a.service.RefreshBookPopularity},
+	}
+	for _, job := range jobs {
+		err := s.Add(job.name, job.spec, job.fn)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 	"strconv"
@@ -12,6 +13,7 @@ import (
 	_ "github.com/emzola/bibliotheca/docs"
 	"github.com/emzola/bibliotheca/handler"
 	"github.com/emzola/bibliotheca/internal/jsonlog"
+	"github.com/emzola/bibliotheca/internal/scheduler"
 	"github.com/emzola/bibliotheca/repository"
 	"github.com/emzola/bibliotheca/repository/postgres.go"
 	"github.com/emzola/bibliotheca/service"
@@ -72,12 +74,37 @@ func main() {
 	flag.IntVar(&cfg.Limiter.Burst, "limiter-burst", 8, "Rate limiter maximum burst")
 	flag.BoolVar(&cfg.Limiter.Enabled, "limiter-enabled", true, "Enable rate limiter")
 
+	// Read the recommendation settings into the config
+	flag.DurationVar(&cfg.Recommendations.RefreshInterval, "recommendations-refresh-interval", time.Hour, "Interval between book similarity refreshes")
+	flag.IntVar(&cfg.Recommendations.MinCooccurrence, "recommendations-min-cooccurrence", 2, "Minimum number of shared readers for two books to be related")
+	flag.IntVar(&cfg.Recommendations.MaxPerBook, "recommendations-max-per-book", 50, "Maximum number of related books stored per book")
+	flag.DurationVar(&cfg.Recommendations.TrendingRefreshInterval, "recommendations-trending-refresh-interval", 15*time.Minute, "Interval between trending books refreshes")
+
+	// Read the saved search settings into the config
+	flag.DurationVar(&cfg.SavedSearches.NotifyInterval, "saved-searches-notify-interval", time.Hour, "Interval between saved search match notification emails")
+
+	// Read the Open Library settings into the config
+	flag.StringVar(&cfg.OpenLibrary.BaseURL, "openlibrary-base-url", "https://openlibrary.org", "Open Library API base URL")
+	flag.StringVar(&cfg.Covers.BaseURL, "covers-base-url", "https://covers.openlibrary.org", "Open Library covers API base URL")
+	flag.Float64Var(&cfg.Covers.BackfillRps, "covers-backfill-rps", 1, "Maximum covers fetched per second by the covers backfill")
+	flag.DurationVar(&cfg.Metadata.ProviderTimeout, "metadata-provider-timeout", 5*time.Second, "Timeout of each book metadata provider lookup")
+	flag.DurationVar(&cfg.Metadata.CacheTTL, "metadata-cache-ttl", 24*time.Hour, "Time book metadata looked up by ISBN is cached for")
+
+	// Read the job schedules into the config. Schedules are cron expressions evaluated in UTC
+	flag.StringVar(&cfg.Jobs.ResetDownloadCountsSchedule, "jobs-reset-download-counts-schedule", "0 0 * * *", "Schedule of the daily download count reset")
+	flag.StringVar(&cfg.Jobs.ExpireRequestsSchedule, "jobs-expire-requests-schedule", "*/5 * * * *", "Schedule of the expiry of old book requests")
+	flag.StringVar(&cfg.Jobs.DeleteExpiredTokensSchedule, "jobs-delete-expired-tokens-schedule", "0 * * * *", "Schedule of the deletion of expired tokens")
+	flag.StringVar(&cfg.Jobs.RefreshBookPopularitySchedule, "jobs-refresh-book-popularity-schedule", "30 0 * * *", "Schedule of the refresh of book popularity")
+
 	// Process the -cors-trusted-origins command line flag
 	flag.Func("cors-trusted-origin", "Trusted CORS origin (space separated)", func(s string) error {
 		cfg.Cors.TrustedOrigins = strings.Fields(s)
 		return nil
 	})
 
+	// Batch commands run instead of the HTTP server
+	backfillCovers := flag.Bool("backfill-covers", false, "Fetch missing book and request covers from the covers API, then exit")
+
 	flag.Parse()
 
 	// Initialize database connection
@@ -95,9 +122,18 @@ func main() {
 
 	// Application layers
 	repo := repository.New(db)
-	service := service.New(cfg, &wg, logger, repo)
+	scheduler := scheduler.New(repo, logger)
+	service := service.New(cfg, &wg, logger, repo, scheduler)
 	handler := handler.New(cfg, logger, cache, service)
 
+	if *backfillCovers {
+		err = service.BackfillCovers(context.Background())
+		if err != nil {
+			logger.PrintFatal(err, nil)
+		}
+		return
+	}
+
 	// Instantiate application
 	app := &app{
 		config:  cfg,
@@ -106,8 +142,16 @@ func main() {
 		handler: handler,
 	}
 
+	// Start scheduled jobs
+	err = app.scheduleJobs(scheduler)
+	if err != nil {
+		logger.PrintFatal(err, nil)
+	}
+	scheduler.Start()
+
 	// Start HTTP server
 	err = app.serve(&wg, logger)
+	scheduler.Stop()
 	if err != nil {
 		logger.PrintFatal(err, nil)
 	}
@@ -1,2 +1 @@
-SELECT cron.unschedule('dlcount-reset-everyday'); 
-SELECT cron.unschedule('expire-requests-every-5mins');
+-- Intentionally empty, see the up migration.
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS authors (
+    id bigserial PRIMARY KEY,
+    created_at timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    name text NOT NULL,
+    sort_name text NOT NULL DEFAULT '',
+    alternate_names text[] NOT NULL DEFAULT '{}',
+    name_keys text[] NOT NULL DEFAULT '{}',
+    bio text NOT NULL DEFAULT '',
+    birth_year integer NOT NULL DEFAULT 0,
+    death_year integer NOT NULL DEFAULT 0,
+    version integer NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS authors_name_idx ON authors USING GIN (to_tsvector('simple', name || ' ' || array_to_string(alternate_names, ' ')));
+CREATE INDEX IF NOT EXISTS authors_name_keys_idx ON authors USING GIN (name_keys);
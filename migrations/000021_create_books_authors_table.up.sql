@@ -0,0 +1,47 @@
+CREATE TABLE IF NOT EXISTS books_authors (
+    book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    author_id bigint NOT NULL REFERENCES authors ON DELETE CASCADE,
+    position integer NOT NULL DEFAULT 0,
+    datetime timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (book_id, author_id)
+);
+
+CREATE INDEX IF NOT EXISTS books_authors_author_idx ON books_authors (author_id);
+
+-- backfill authors from the existing books.author arrays. Names written as "Last, First"
+-- are inverted and the lookup key strips case, whitespace and punctuation so that
+-- "J.R.R. Tolkien", "J. R. R. Tolkien" and "Tolkien, J.R.R." resolve to the same author.
+WITH names AS (
+    SELECT DISTINCT
+        CASE
+            WHEN n ~ '^[^,]+,[^,]+$' THEN btrim(split_part(n, ',', 2)) || ' ' || btrim(split_part(n, ',', 1))
+            ELSE n
+        END AS name
+    FROM (
+        SELECT regexp_replace(btrim(unnest(author)), '\s+', ' ', 'g') AS n
+        FROM books
+    ) AS raw
+    WHERE n <> ''
+), keyed AS (
+    SELECT DISTINCT ON (key) name, key
+    FROM (
+        SELECT name, lower(regexp_replace(name, '[^[:alnum:]]', '', 'g')) AS key
+        FROM names
+    ) AS k
+    WHERE key <> ''
+    ORDER BY key, name
+)
+INSERT INTO authors (name, sort_name, name_keys)
+SELECT name, regexp_replace(name, '^(.*)\s+(\S+)$', '\2, \1'), ARRAY[key]
+FROM keyed;
+
+INSERT INTO books_authors (book_id, author_id, position)
+SELECT books.id, authors.id, t.ord
+FROM books
+CROSS JOIN LATERAL unnest(books.author) WITH ORDINALITY AS t(name, ord)
+INNER JOIN authors ON lower(regexp_replace(
+    CASE
+        WHEN t.name ~ '^[^,]+,[^,]+$' THEN btrim(split_part(t.name, ',', 2)) || ' ' || btrim(split_part(t.name, ',', 1))
+        ELSE t.name
+    END, '[^[:alnum:]]', '', 'g')) = ANY(authors.name_keys)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS book_similarities (
+    book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    similar_book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    score double precision NOT NULL DEFAULT 0,
+    co_occurrences integer NOT NULL DEFAULT 0,
+    updated_at timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (book_id, similar_book_id)
+);
+
+CREATE INDEX IF NOT EXISTS book_similarities_score_idx ON book_similarities (book_id, score DESC);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS users_followed_categories (
+    user_id bigint NOT NULL REFERENCES users ON DELETE CASCADE,
+    category_id bigint NOT NULL REFERENCES categories ON DELETE CASCADE,
+    datetime timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, category_id)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS book_trending_scores (
+    time_window text NOT NULL,
+    book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    score double precision NOT NULL DEFAULT 0,
+    downloads integer NOT NULL DEFAULT 0,
+    favourites integer NOT NULL DEFAULT 0,
+    reviews integer NOT NULL DEFAULT 0,
+    updated_at timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (time_window, book_id)
+);
+
+CREATE INDEX IF NOT EXISTS book_trending_scores_score_idx ON book_trending_scores (time_window, score DESC);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS book_terms (
+    book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    term text NOT NULL,
+    weight double precision NOT NULL,
+    PRIMARY KEY (book_id, term)
+);
+
+CREATE INDEX IF NOT EXISTS book_terms_term_idx ON book_terms (term);
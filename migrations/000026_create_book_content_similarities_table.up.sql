@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS book_content_similarities (
+    book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    similar_book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    score double precision NOT NULL DEFAULT 0,
+    updated_at timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (book_id, similar_book_id)
+);
+
+CREATE INDEX IF NOT EXISTS book_content_similarities_score_idx ON book_content_similarities (book_id, score DESC);
+CREATE INDEX IF NOT EXISTS book_content_similarities_similar_book_id_idx ON book_content_similarities (similar_book_id);
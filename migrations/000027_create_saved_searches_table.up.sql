@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS saved_searches (
+    id bigserial PRIMARY KEY,
+    user_id bigint NOT NULL REFERENCES users ON DELETE CASCADE,
+    created_at timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    name text NOT NULL,
+    search text NOT NULL DEFAULT '',
+    from_year integer NOT NULL DEFAULT 0,
+    to_year integer NOT NULL DEFAULT 0,
+    language text[] NOT NULL DEFAULT '{}',
+    extension text[] NOT NULL DEFAULT '{}',
+    notify bool NOT NULL DEFAULT false,
+    version integer NOT NULL DEFAULT 1,
+    CONSTRAINT saved_searches_user_id_name_key UNIQUE (user_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS saved_searches_notify_idx ON saved_searches (notify) WHERE notify;
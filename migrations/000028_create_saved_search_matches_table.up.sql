@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS saved_search_matches (
+    saved_search_id bigint NOT NULL REFERENCES saved_searches ON DELETE CASCADE,
+    book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    created_at timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    notified_at timestamp(0) with time zone,
+    PRIMARY KEY (saved_search_id, book_id)
+);
+
+CREATE INDEX IF NOT EXISTS saved_search_matches_pending_idx ON saved_search_matches (saved_search_id) WHERE notified_at IS NULL;
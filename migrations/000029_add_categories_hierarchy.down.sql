@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS books_categories_books_count ON books_categories;
+DROP FUNCTION IF EXISTS categories_update_books_count();
+DROP INDEX IF EXISTS categories_parent_id_idx;
+DROP INDEX IF EXISTS categories_name_idx;
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_slug_key;
+ALTER TABLE categories DROP COLUMN IF EXISTS version;
+ALTER TABLE categories DROP COLUMN IF EXISTS books_count;
+ALTER TABLE categories DROP COLUMN IF EXISTS slug;
+ALTER TABLE categories DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,33 @@
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS parent_id bigint REFERENCES categories ON DELETE RESTRICT;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS slug text;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS books_count integer NOT NULL DEFAULT 0;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS version integer NOT NULL DEFAULT 1;
+
+UPDATE categories
+SET slug = trim(BOTH '-' FROM regexp_replace(lower(name), '[^a-z0-9]+', '-', 'g'))
+WHERE slug IS NULL;
+
+UPDATE categories
+SET books_count = (SELECT count(*) FROM books_categories WHERE books_categories.category_id = categories.id);
+
+ALTER TABLE categories ALTER COLUMN slug SET NOT NULL;
+ALTER TABLE categories ADD CONSTRAINT categories_slug_key UNIQUE (slug);
+CREATE UNIQUE INDEX IF NOT EXISTS categories_name_idx ON categories (lower(name));
+CREATE INDEX IF NOT EXISTS categories_parent_id_idx ON categories (parent_id);
+
+-- keep categories.books_count in step with books_categories instead of counting on every read
+CREATE OR REPLACE FUNCTION categories_update_books_count() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('DELETE', 'UPDATE') THEN
+        UPDATE categories SET books_count = books_count - 1 WHERE id = OLD.category_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE categories SET books_count = books_count + 1 WHERE id = NEW.category_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER books_categories_books_count
+AFTER INSERT OR DELETE OR UPDATE OF category_id ON books_categories
+FOR EACH ROW EXECUTE FUNCTION categories_update_books_count();
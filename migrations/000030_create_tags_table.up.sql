@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS tags (
+    id bigserial PRIMARY KEY,
+    created_at timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    name text UNIQUE NOT NULL,
+    usage_count integer NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS tags_name_prefix_idx ON tags (name text_pattern_ops);
+CREATE INDEX IF NOT EXISTS tags_usage_count_idx ON tags (usage_count DESC);
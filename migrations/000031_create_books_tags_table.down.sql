@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS books_tags_usage_count ON books_tags;
+DROP FUNCTION IF EXISTS tags_update_usage_count();
+DROP TABLE IF EXISTS books_tags;
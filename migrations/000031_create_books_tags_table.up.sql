@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS books_tags (
+    book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    tag_id bigint NOT NULL REFERENCES tags ON DELETE CASCADE,
+    user_id bigint REFERENCES users ON DELETE SET NULL,
+    status text NOT NULL DEFAULT 'pending',
+    datetime timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (book_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS books_tags_tag_id_idx ON books_tags (tag_id);
+CREATE INDEX IF NOT EXISTS books_tags_pending_idx ON books_tags (datetime) WHERE status = 'pending';
+
+-- keep tags.usage_count equal to the number of books a tag is approved on
+CREATE OR REPLACE FUNCTION tags_update_usage_count() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('DELETE', 'UPDATE') AND OLD.status = 'approved' THEN
+        UPDATE tags SET usage_count = usage_count - 1 WHERE id = OLD.tag_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NEW.status = 'approved' THEN
+        UPDATE tags SET usage_count = usage_count + 1 WHERE id = NEW.tag_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER books_tags_usage_count
+AFTER INSERT OR DELETE OR UPDATE OF status, tag_id ON books_tags
+FOR EACH ROW EXECUTE FUNCTION tags_update_usage_count();
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS books_isbn_13_idx;
+DROP INDEX IF EXISTS books_isbn_10_idx;
@@ -0,0 +1,43 @@
+-- store ISBNs without hyphens or spaces
+UPDATE books
+SET isbn_10 = upper(regexp_replace(isbn_10, '[\s-]', '', 'g')), isbn_13 = regexp_replace(isbn_13, '[\s-]', '', 'g')
+WHERE isbn_10 ~ '[\s\-x]' OR isbn_13 ~ '[\s-]';
+
+UPDATE requests
+SET isbn = upper(regexp_replace(isbn, '[\s-]', '', 'g'))
+WHERE isbn ~ '[\s\-x]';
+
+-- isbn13_check_digit calculates the check digit of the first 12 digits of an ISBN-13
+CREATE OR REPLACE FUNCTION isbn13_check_digit(isbn text) RETURNS text AS $$
+    SELECT ((10 - sum(substr(isbn, i, 1)::int * CASE WHEN i % 2 = 0 THEN 3 ELSE 1 END) % 10) % 10)::text
+    FROM generate_series(1, 12) AS i;
+$$ LANGUAGE sql IMMUTABLE;
+
+-- isbn10_check_digit calculates the check character of the first 9 digits of an ISBN-10
+CREATE OR REPLACE FUNCTION isbn10_check_digit(isbn text) RETURNS text AS $$
+    SELECT CASE WHEN check_digit = 10 THEN 'X' ELSE check_digit::text END
+    FROM (
+        SELECT (11 - sum(substr(isbn, i, 1)::int * (11 - i)) % 11) % 11 AS check_digit
+        FROM generate_series(1, 9) AS i
+    ) AS digits;
+$$ LANGUAGE sql IMMUTABLE;
+
+-- derive the missing form of each ISBN, and store requests by their ISBN-13
+UPDATE books
+SET isbn_13 = '978' || left(isbn_10, 9) || isbn13_check_digit('978' || left(isbn_10, 9))
+WHERE isbn_13 = '' AND isbn_10 ~ '^\d{9}[\dX]$' AND isbn10_check_digit(isbn_10) = right(isbn_10, 1);
+
+UPDATE books
+SET isbn_10 = substr(isbn_13, 4, 9) || isbn10_check_digit(substr(isbn_13, 4, 9))
+WHERE isbn_10 = '' AND isbn_13 ~ '^978\d{10}$' AND isbn13_check_digit(isbn_13) = right(isbn_13, 1);
+
+UPDATE requests
+SET isbn = '978' || left(isbn, 9) || isbn13_check_digit('978' || left(isbn, 9))
+WHERE isbn ~ '^\d{9}[\dX]$' AND isbn10_check_digit(isbn) = right(isbn, 1);
+
+DROP FUNCTION isbn13_check_digit(text);
+DROP FUNCTION isbn10_check_digit(text);
+
+-- ?isbn= lookups match either form exactly
+CREATE INDEX IF NOT EXISTS books_isbn_10_idx ON books (isbn_10) WHERE isbn_10 <> '';
+CREATE INDEX IF NOT EXISTS books_isbn_13_idx ON books (isbn_13) WHERE isbn_13 <> '';
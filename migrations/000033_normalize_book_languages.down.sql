@@ -0,0 +1 @@
+DROP INDEX IF EXISTS books_language_code_idx;
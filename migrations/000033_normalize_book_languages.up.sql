@@ -0,0 +1,16099 @@
+-- map the ISO 639 codes and English names of every language to its ISO 639-1 code, or to its
+-- ISO 639-3 code when it has no ISO 639-1 code, as internal/iso639 does
+CREATE TEMPORARY TABLE language_codes (name text PRIMARY KEY, code text NOT NULL);
+
+INSERT INTO language_codes (name, code) VALUES
+    ('aa', 'aa'),
+    ('aar', 'aa'),
+    ('afar', 'aa'),
+    ('aaa', 'aaa'),
+    ('ghotuo', 'aaa'),
+    ('aab', 'aab'),
+    ('alumu-tesu', 'aab'),
+    ('aac', 'aac'),
+    ('ari', 'aac'),
+    ('aad', 'aad'),
+    ('amal', 'aad'),
+    ('aae', 'aae'),
+    ('arbëreshë albanian', 'aae'),
+    ('aaf', 'aaf'),
+    ('aranadan', 'aaf'),
+    ('aag', 'aag'),
+    ('ambrak', 'aag'),
+    ('aah', 'aah'),
+    ('abu'' arapesh', 'aah'),
+    ('aai', 'aai'),
+    ('arifama-miniafia', 'aai'),
+    ('aak', 'aak'),
+    ('ankave', 'aak'),
+    ('aal', 'aal'),
+    ('afade', 'aal'),
+    ('aan', 'aan'),
+    ('anambé', 'aan'),
+    ('aao', 'aao'),
+    ('algerian saharan arabic', 'aao'),
+    ('aap', 'aap'),
+    ('pará arára', 'aap'),
+    ('aaq', 'aaq'),
+    ('eastern abnaki', 'aaq'),
+    ('aas', 'aas'),
+    ('aasáx', 'aas'),
+    ('aat', 'aat'),
+    ('arvanitika albanian', 'aat'),
+    ('aau', 'aau'),
+    ('abau', 'aau'),
+    ('aaw', 'aaw'),
+    ('solong', 'aaw'),
+    ('aax', 'aax'),
+    ('mandobo atas', 'aax'),
+    ('aaz', 'aaz'),
+    ('amarasi', 'aaz'),
+    ('ab', 'ab'),
+    ('abk', 'ab'),
+    ('abkhazian', 'ab'),
+    ('aba', 'aba'),
+    ('abé', 'aba'),
+    ('abb', 'abb'),
+    ('bankon', 'abb'),
+    ('abc', 'abc'),
+    ('ambala ayta', 'abc'),
+    ('abd', 'abd'),
+    ('manide', 'abd'),
+    ('abe', 'abe'),
+    ('western abnaki', 'abe'),
+    ('abai sungai', 'abf'),
+    ('abf', 'abf'),
+    ('abaga', 'abg'),
+    ('abg', 'abg'),
+    ('abh', 'abh'),
+    ('tajiki arabic', 'abh'),
+    ('abi', 'abi'),
+    ('abidji', 'abi'),
+    ('abj', 'abj'),
+    ('aka-bea', 'abj'),
+    ('abl', 'abl'),
+    ('lampung nyo', 'abl'),
+    ('abanyom', 'abm'),
+    ('abm', 'abm'),
+    ('abn', 'abn'),
+    ('abua', 'abn'),
+    ('abo', 'abo'),
+    ('abon', 'abo'),
+    ('abellen ayta', 'abp'),
+    ('abp', 'abp'),
+    ('abaza', 'abq'),
+    ('abq', 'abq'),
+    ('abr', 'abr'),
+    ('abron', 'abr'),
+    ('abs', 'abs'),
+    ('ambonese malay', 'abs'),
+    ('abt', 'abt'),
+    ('ambulas', 'abt'),
+    ('abu', 'abu'),
+    ('abure', 'abu'),
+    ('abv', 'abv'),
+    ('baharna arabic', 'abv'),
+    ('abw', 'abw'),
+    ('pal', 'abw'),
+    ('abx', 'abx'),
+    ('inabaknon', 'abx'),
+    ('aby', 'aby'),
+    ('aneme wake', 'aby'),
+    ('abui', 'abz'),
+    ('abz', 'abz'),
+    ('aca', 'aca'),
+    ('achagua', 'aca'),
+    ('acb', 'acb'),
+    ('áncá', 'acb'),
+    ('acd', 'acd'),
+    ('gikyode', 'acd'),
+    ('ace', 'ace'),
+    ('achinese', 'ace'),
+    ('acf', 'acf'),
+    ('saint lucian creole french', 'acf'),
+    ('ach', 'ach'),
+    ('acoli', 'ach'),
+    ('aci', 'aci'),
+    ('aka-cari', 'aci'),
+    ('ack', 'ack'),
+    ('aka-kora', 'ack'),
+    ('acl', 'acl'),
+    ('akar-bale', 'acl'),
+    ('acm', 'acm'),
+    ('mesopotamian arabic', 'acm'),
+    ('achang', 'acn'),
+    ('acn', 'acn'),
+    ('acp', 'acp'),
+    ('eastern acipa', 'acp'),
+    ('acq', 'acq'),
+    ('ta''izzi-adeni arabic', 'acq'),
+    ('achi', 'acr'),
+    ('acr', 'acr'),
+    ('acroá', 'acs'),
+    ('acs', 'acs'),
+    ('achterhoeks', 'act'),
+    ('act', 'act'),
+    ('achuar-shiwiar', 'acu'),
+    ('acu', 'acu'),
+    ('achumawi', 'acv'),
+    ('acv', 'acv'),
+    ('acw', 'acw'),
+    ('hijazi arabic', 'acw'),
+    ('acx', 'acx'),
+    ('omani arabic', 'acx'),
+    ('acy', 'acy'),
+    ('cypriot arabic', 'acy'),
+    ('acheron', 'acz'),
+    ('acz', 'acz'),
+    ('ada', 'ada'),
+    ('adangme', 'ada'),
+    ('adb', 'adb'),
+    ('atauran', 'adb'),
+    ('add', 'add'),
+    ('lidzonka', 'add'),
+    ('ade', 'ade'),
+    ('adele', 'ade'),
+    ('adf', 'adf'),
+    ('dhofari arabic', 'adf'),
+    ('adg', 'adg'),
+    ('andegerebinha', 'adg'),
+    ('adh', 'adh'),
+    ('adhola', 'adh'),
+    ('adi', 'adi'),
+    ('adioukrou', 'adj'),
+    ('adj', 'adj'),
+    ('adl', 'adl'),
+    ('galo', 'adl'),
+    ('adang', 'adn'),
+    ('adn', 'adn'),
+    ('ado', 'ado'),
+    ('adangbe', 'adq'),
+    ('adq', 'adq'),
+    ('adonara', 'adr'),
+    ('adr', 'adr'),
+    ('adamorobe sign language', 'ads'),
+    ('ads', 'ads'),
+    ('adnyamathanha', 'adt'),
+    ('adt', 'adt'),
+    ('adu', 'adu'),
+    ('aduge', 'adu'),
+    ('adw', 'adw'),
+    ('amundava', 'adw'),
+    ('adx', 'adx'),
+    ('amdo tibetan', 'adx'),
+    ('ady', 'ady'),
+    ('adygei', 'ady'),
+    ('adyghe', 'ady'),
+    ('adz', 'adz'),
+    ('adzera', 'adz'),
+    ('ae', 'ae'),
+    ('ave', 'ae'),
+    ('avestan', 'ae'),
+    ('aea', 'aea'),
+    ('areba', 'aea'),
+    ('aeb', 'aeb'),
+    ('tunisian arabic', 'aeb'),
+    ('aec', 'aec'),
+    ('saidi arabic', 'aec'),
+    ('aed', 'aed'),
+    ('argentine sign language', 'aed'),
+    ('aee', 'aee'),
+    ('northeast pashai', 'aee'),
+    ('aek', 'aek'),
+    ('haeke', 'aek'),
+    ('ael', 'ael'),
+    ('ambele', 'ael'),
+    ('aem', 'aem'),
+    ('arem', 'aem'),
+    ('aen', 'aen'),
+    ('armenian sign language', 'aen'),
+    ('aeq', 'aeq'),
+    ('aer', 'aeq'),
+    ('eastern arrernte', 'aer'),
+    ('aes', 'aes'),
+    ('alsea', 'aes'),
+    ('aeu', 'aeu'),
+    ('akeu', 'aeu'),
+    ('aew', 'aew'),
+    ('ambakich', 'aew'),
+    ('aey', 'aey'),
+    ('amele', 'aey'),
+    ('aeka', 'aez'),
+    ('aez', 'aez'),
+    ('af', 'af'),
+    ('afr', 'af'),
+    ('afrikaans', 'af'),
+    ('afb', 'afb'),
+    ('gulf arabic', 'afb'),
+    ('afd', 'afd'),
+    ('andai', 'afd'),
+    ('afe', 'afe'),
+    ('putukwam', 'afe'),
+    ('afg', 'afg'),
+    ('afghan sign language', 'afg'),
+    ('afh', 'afh'),
+    ('afrihili', 'afh'),
+    ('afi', 'afi'),
+    ('akrukay', 'afi'),
+    ('afk', 'afk'),
+    ('nanubae', 'afk'),
+    ('afn', 'afn'),
+    ('defaka', 'afn'),
+    ('afo', 'afo'),
+    ('eloyi', 'afo'),
+    ('afp', 'afp'),
+    ('tapei', 'afp'),
+    ('afro-seminole creole', 'afs'),
+    ('afs', 'afs'),
+    ('afitti', 'aft'),
+    ('aft', 'aft'),
+    ('afu', 'afu'),
+    ('awutu', 'afu'),
+    ('afz', 'afz'),
+    ('obokuitai', 'afz'),
+    ('aga', 'aga'),
+    ('aguano', 'aga'),
+    ('agb', 'agb'),
+    ('legbo', 'agb'),
+    ('agatu', 'agc'),
+    ('agc', 'agc'),
+    ('agarabi', 'agd'),
+    ('agd', 'agd'),
+    ('age', 'age'),
+    ('angal', 'age'),
+    ('agf', 'agf'),
+    ('arguni', 'agf'),
+    ('agg', 'agg'),
+    ('angor', 'agg'),
+    ('agh', 'agh'),
+    ('ngelima', 'agh'),
+    ('agariya', 'agi'),
+    ('agi', 'agi'),
+    ('agj', 'agj'),
+    ('argobba', 'agj'),
+    ('agk', 'agk'),
+    ('isarog agta', 'agk'),
+    ('agl', 'agl'),
+    ('fembe', 'agl'),
+    ('agm', 'agm'),
+    ('angaataha', 'agm'),
+    ('agn', 'agn'),
+    ('agutaynen', 'agn'),
+    ('ago', 'ago'),
+    ('tainae', 'ago'),
+    ('aghem', 'agq'),
+    ('agq', 'agq'),
+    ('agr', 'agr'),
+    ('aguaruna', 'agr'),
+    ('ags', 'ags'),
+    ('esimbi', 'ags'),
+    ('agt', 'agt'),
+    ('central cagayan agta', 'agt'),
+    ('agu', 'agu'),
+    ('aguacateco', 'agu'),
+    ('agv', 'agv'),
+    ('remontado dumagat', 'agv'),
+    ('agw', 'agw'),
+    ('kahua', 'agw'),
+    ('aghul', 'agx'),
+    ('agx', 'agx'),
+    ('agy', 'agy'),
+    ('southern alta', 'agy'),
+    ('agz', 'agz'),
+    ('mt. iriga agta', 'agz'),
+    ('aha', 'aha'),
+    ('ahanta', 'aha'),
+    ('ahb', 'ahb'),
+    ('axamb', 'ahb'),
+    ('ahg', 'ahg'),
+    ('qimant', 'ahg'),
+    ('aghu', 'ahh'),
+    ('ahh', 'ahh'),
+    ('ahi', 'ahi'),
+    ('tiagbamrin aizi', 'ahi'),
+    ('ahk', 'ahk'),
+    ('akha', 'ahk'),
+    ('ahl', 'ahl'),
+    ('igo', 'ahl'),
+    ('ahm', 'ahm'),
+    ('mobumrin aizi', 'ahm'),
+    ('ahn', 'ahn'),
+    ('àhàn', 'ahn'),
+    ('aho', 'aho'),
+    ('ahom', 'aho'),
+    ('ahp', 'ahp'),
+    ('aproumu aizi', 'ahp'),
+    ('ahirani', 'ahr'),
+    ('ahr', 'ahr'),
+    ('ahs', 'ahs'),
+    ('ashe', 'ahs'),
+    ('aht', 'aht'),
+    ('ahtena', 'aht'),
+    ('aia', 'aia'),
+    ('arosi', 'aia'),
+    ('aib', 'aib'),
+    ('ainu', 'aib'),
+    ('ainu (china)', 'aib'),
+    ('aic', 'aic'),
+    ('ainbai', 'aic'),
+    ('aid', 'aid'),
+    ('alngith', 'aid'),
+    ('aie', 'aie'),
+    ('amara', 'aie'),
+    ('aif', 'aif'),
+    ('aig', 'aig'),
+    ('antigua and barbuda creole english', 'aig'),
+    ('ai-cham', 'aih'),
+    ('aih', 'aih'),
+    ('aii', 'aii'),
+    ('assyrian neo-aramaic', 'aii'),
+    ('aij', 'aij'),
+    ('lishanid noshan', 'aij'),
+    ('aik', 'aik'),
+    ('ake', 'aik'),
+    ('ail', 'ail'),
+    ('aimele', 'ail'),
+    ('aim', 'aim'),
+    ('aimol', 'aim'),
+    ('ain', 'ain'),
+    ('ainu (japan)', 'ain'),
+    ('aio', 'aio'),
+    ('aiton', 'aio'),
+    ('aip', 'aip'),
+    ('burumakok', 'aip'),
+    ('aimaq', 'aiq'),
+    ('aiq', 'aiq'),
+    ('air', 'air'),
+    ('airoran', 'air'),
+    ('ait', 'ait'),
+    ('arikem', 'ait'),
+    ('aari', 'aiw'),
+    ('aiw', 'aiw'),
+    ('aighon', 'aix'),
+    ('aix', 'aix'),
+    ('aiy', 'aiy'),
+    ('ali', 'aiy'),
+    ('aja', 'aja'),
+    ('aja (south sudan)', 'aja'),
+    ('aja (benin)', 'ajg'),
+    ('ajg', 'ajg'),
+    ('aji', 'aji'),
+    ('ajië', 'aji'),
+    ('ajn', 'ajn'),
+    ('andajin', 'ajn'),
+    ('ajp', 'ajp'),
+    ('south levantine arabic', 'ajp'),
+    ('ajs', 'ajs'),
+    ('algerian jewish sign language', 'ajs'),
+    ('aju', 'aju'),
+    ('judeo-moroccan arabic', 'aju'),
+    ('ajawa', 'ajw'),
+    ('ajw', 'ajw'),
+    ('ajz', 'ajz'),
+    ('amri karbi', 'ajz'),
+    ('ak', 'ak'),
+    ('aka', 'ak'),
+    ('akan', 'ak'),
+    ('akb', 'akb'),
+    ('batak angkola', 'akb'),
+    ('akc', 'akc'),
+    ('mpur', 'akc'),
+    ('akd', 'akd'),
+    ('ukpet-ehom', 'akd'),
+    ('akawaio', 'ake'),
+    ('akf', 'akf'),
+    ('akpa', 'akf'),
+    ('akg', 'akg'),
+    ('anakalangu', 'akg'),
+    ('akh', 'akh'),
+    ('angal heneng', 'akh'),
+    ('aiome', 'aki'),
+    ('aki', 'aki'),
+    ('aka-jeru', 'akj'),
+    ('akj', 'akj'),
+    ('akk', 'akk'),
+    ('akkadian', 'akk'),
+    ('akl', 'akl'),
+    ('aklanon', 'akl'),
+    ('aka-bo', 'akm'),
+    ('akm', 'akm'),
+    ('ako', 'ako'),
+    ('akurio', 'ako'),
+    ('akp', 'akp'),
+    ('siwu', 'akp'),
+    ('akq', 'akq'),
+    ('akr', 'akr'),
+    ('araki', 'akr'),
+    ('akaselem', 'aks'),
+    ('aks', 'aks'),
+    ('akolet', 'akt'),
+    ('akt', 'akt'),
+    ('aku', 'aku'),
+    ('akum', 'aku'),
+    ('akhvakh', 'akv'),
+    ('akv', 'akv'),
+    ('akw', 'akw'),
+    ('akwa', 'akw'),
+    ('aka-kede', 'akx'),
+    ('akx', 'akx'),
+    ('aka-kol', 'aky'),
+    ('aky', 'aky'),
+    ('akz', 'akz'),
+    ('alabama', 'akz'),
+    ('ala', 'ala'),
+    ('alago', 'ala'),
+    ('alc', 'alc'),
+    ('qawasqar', 'alc'),
+    ('ald', 'ald'),
+    ('alladian', 'ald'),
+    ('ale', 'ale'),
+    ('aleut', 'ale'),
+    ('alege', 'alf'),
+    ('alf', 'alf'),
+    ('alawa', 'alh'),
+    ('alh', 'alh'),
+    ('amaimon', 'ali'),
+    ('alangan', 'alj'),
+    ('alj', 'alj'),
+    ('alak', 'alk'),
+    ('alk', 'alk'),
+    ('all', 'all'),
+    ('allar', 'all'),
+    ('alm', 'alm'),
+    ('amblong', 'alm'),
+    ('aln', 'aln'),
+    ('gheg albanian', 'aln'),
+    ('alo', 'alo'),
+    ('larike-wakasihu', 'alo'),
+    ('alp', 'alp'),
+    ('alune', 'alp'),
+    ('algonquin', 'alq'),
+    ('alq', 'alq'),
+    ('alr', 'alr'),
+    ('alutor', 'alr'),
+    ('als', 'als'),
+    ('tosk albanian', 'als'),
+    ('alt', 'alt'),
+    ('southern altai', 'alt'),
+    ('''are''are', 'alu'),
+    ('alu', 'alu'),
+    ('alaba-k’abeena', 'alw'),
+    ('alw', 'alw'),
+    ('alx', 'alx'),
+    ('amol', 'alx'),
+    ('aly', 'aly'),
+    ('alyawarr', 'aly'),
+    ('alur', 'alz'),
+    ('alz', 'alz'),
+    ('am', 'am'),
+    ('amh', 'am'),
+    ('amharic', 'am'),
+    ('ama', 'ama'),
+    ('amanayé', 'ama'),
+    ('amb', 'amb'),
+    ('ambo', 'amb'),
+    ('amahuaca', 'amc'),
+    ('amc', 'amc'),
+    ('ame', 'ame'),
+    ('yanesha''', 'ame'),
+    ('amf', 'amf'),
+    ('hamer-banna', 'amf'),
+    ('amg', 'amg'),
+    ('amurdak', 'amg'),
+    ('ami', 'ami'),
+    ('amis', 'ami'),
+    ('amdang', 'amj'),
+    ('amj', 'amj'),
+    ('ambai', 'amk'),
+    ('amk', 'amk'),
+    ('aml', 'aml'),
+    ('war-jaintia', 'aml'),
+    ('ama (papua new guinea)', 'amm'),
+    ('amm', 'amm'),
+    ('amanab', 'amn'),
+    ('amn', 'amn'),
+    ('amo', 'amo'),
+    ('alamblak', 'amp'),
+    ('amp', 'amp'),
+    ('amahai', 'amq'),
+    ('amq', 'amq'),
+    ('amarakaeri', 'amr'),
+    ('amr', 'amr'),
+    ('ams', 'ams'),
+    ('southern amami-oshima', 'ams'),
+    ('amt', 'amt'),
+    ('amto', 'amt'),
+    ('amu', 'amu'),
+    ('guerrero amuzgo', 'amu'),
+    ('ambelau', 'amv'),
+    ('amv', 'amv'),
+    ('amw', 'amw'),
+    ('western neo-aramaic', 'amw'),
+    ('amx', 'amx'),
+    ('anmatyerre', 'amx'),
+    ('amy', 'amy'),
+    ('amz', 'amz'),
+    ('atampaya', 'amz'),
+    ('an', 'an'),
+    ('aragonese', 'an'),
+    ('arg', 'an'),
+    ('ana', 'ana'),
+    ('andaqui', 'ana'),
+    ('anb', 'anb'),
+    ('andoa', 'anb'),
+    ('anc', 'anc'),
+    ('ngas', 'anc'),
+    ('and', 'and'),
+    ('ansus', 'and'),
+    ('ane', 'ane'),
+    ('xârâcùù', 'ane'),
+    ('anf', 'anf'),
+    ('animere', 'anf'),
+    ('ang', 'ang'),
+    ('english, old', 'ang'),
+    ('english, old (ca. 450-1100)', 'ang'),
+    ('old english', 'ang'),
+    ('old english (ca. 450-1100)', 'ang'),
+    ('anh', 'anh'),
+    ('nend', 'anh'),
+    ('andi', 'ani'),
+    ('ani', 'ani'),
+    ('anj', 'anj'),
+    ('anor', 'anj'),
+    ('ank', 'ank'),
+    ('goemai', 'ank'),
+    ('anl', 'anl'),
+    ('anu-hkongso chin', 'anl'),
+    ('anal', 'anm'),
+    ('anm', 'anm'),
+    ('ann', 'ann'),
+    ('obolo', 'ann'),
+    ('andoque', 'ano'),
+    ('ano', 'ano'),
+    ('angika', 'anp'),
+    ('anp', 'anp'),
+    ('anq', 'anq'),
+    ('jarawa', 'anq'),
+    ('jarawa (india)', 'anq'),
+    ('andh', 'anr'),
+    ('anr', 'anr'),
+    ('ans', 'ans'),
+    ('anserma', 'ans'),
+    ('ant', 'ant'),
+    ('antakarinya', 'ant'),
+    ('anu', 'anu'),
+    ('anuak', 'anu'),
+    ('anv', 'anv'),
+    ('denya', 'anv'),
+    ('anaang', 'anw'),
+    ('anw', 'anw'),
+    ('andra-hus', 'anx'),
+    ('anx', 'anx'),
+    ('any', 'any'),
+    ('anyin', 'any'),
+    ('anem', 'anz'),
+    ('anz', 'anz'),
+    ('angolar', 'aoa'),
+    ('aoa', 'aoa'),
+    ('abom', 'aob'),
+    ('aob', 'aob'),
+    ('aoc', 'aoc'),
+    ('pemon', 'aoc'),
+    ('andarum', 'aod'),
+    ('aod', 'aod'),
+    ('angal enen', 'aoe'),
+    ('aoe', 'aoe'),
+    ('aof', 'aof'),
+    ('bragat', 'aof'),
+    ('angoram', 'aog'),
+    ('aog', 'aog'),
+    ('anindilyakwa', 'aoi'),
+    ('aoi', 'aoi'),
+    ('aoj', 'aoj'),
+    ('mufian', 'aoj'),
+    ('aok', 'aok'),
+    ('arhö', 'aok'),
+    ('alor', 'aol'),
+    ('aol', 'aol'),
+    ('aom', 'aom'),
+    ('ömie', 'aom'),
+    ('aon', 'aon'),
+    ('bumbita arapesh', 'aon'),
+    ('aor', 'aor'),
+    ('aore', 'aor'),
+    ('aos', 'aos'),
+    ('taikat', 'aos'),
+    ('aot', 'aot'),
+    ('atong', 'aot'),
+    ('atong (india)', 'aot'),
+    ('a''ou', 'aou'),
+    ('aou', 'aou'),
+    ('aox', 'aox'),
+    ('atorada', 'aox'),
+    ('aoz', 'aoz'),
+    ('uab meto', 'aoz'),
+    ('apb', 'apb'),
+    ('sa''a', 'apb'),
+    ('apc', 'apc'),
+    ('north levantine arabic', 'apc'),
+    ('apd', 'apd'),
+    ('sudanese arabic', 'apd'),
+    ('ape', 'ape'),
+    ('bukiyip', 'ape'),
+    ('apf', 'apf'),
+    ('pahanan agta', 'apf'),
+    ('ampanang', 'apg'),
+    ('apg', 'apg'),
+    ('aph', 'aph'),
+    ('athpariya', 'aph'),
+    ('api', 'api'),
+    ('apiaká', 'api'),
+    ('apj', 'apj'),
+    ('jicarilla apache', 'apj'),
+    ('apk', 'apk'),
+    ('kiowa apache', 'apk'),
+    ('apl', 'apl'),
+    ('lipan apache', 'apl'),
+    ('apm', 'apm'),
+    ('mescalero-chiricahua apache', 'apm'),
+    ('apinayé', 'apn'),
+    ('apn', 'apn'),
+    ('ambul', 'apo'),
+    ('apo', 'apo'),
+    ('apma', 'app'),
+    ('app', 'app'),
+    ('a-pucikwar', 'apq'),
+    ('apq', 'apq'),
+    ('apr', 'apr'),
+    ('arop-lokep', 'apr'),
+    ('aps', 'aps'),
+    ('arop-sissano', 'aps'),
+    ('apatani', 'apt'),
+    ('apt', 'apt'),
+    ('apu', 'apu'),
+    ('apurinã', 'apu'),
+    ('alapmunte', 'apv'),
+    ('apv', 'apv'),
+    ('apw', 'apw'),
+    ('western apache', 'apw'),
+    ('aputai', 'apx'),
+    ('apx', 'apx'),
+    ('apalaí', 'apy'),
+    ('apy', 'apy'),
+    ('apz', 'apz'),
+    ('safeyoka', 'apz'),
+    ('aqc', 'aqc'),
+    ('archi', 'aqc'),
+    ('ampari dogon', 'aqd'),
+    ('aqd', 'aqd'),
+    ('aqg', 'aqg'),
+    ('arigidi', 'aqg'),
+    ('aninka', 'aqk'),
+    ('aqk', 'aqk'),
+    ('aqm', 'aqm'),
+    ('atohwaim', 'aqm'),
+    ('aqn', 'aqn'),
+    ('northern alta', 'aqn'),
+    ('aqp', 'aqp'),
+    ('atakapa', 'aqp'),
+    ('aqr', 'aqr'),
+    ('arhâ', 'aqr'),
+    ('angaité', 'aqt'),
+    ('aqt', 'aqt'),
+    ('akuntsu', 'aqz'),
+    ('aqz', 'aqz'),
+    ('ar', 'ar'),
+    ('ara', 'ar'),
+    ('arabic', 'ar'),
+    ('arb', 'arb'),
+    ('standard arabic', 'arb'),
+    ('arc', 'arc'),
+    ('imperial aramaic', 'arc'),
+    ('imperial aramaic (700-300 bce)', 'arc'),
+    ('official aramaic', 'arc'),
+    ('official aramaic (700-300 bce)', 'arc'),
+    ('arabana', 'ard'),
+    ('ard', 'ard'),
+    ('are', 'are'),
+    ('western arrarnta', 'are'),
+    ('arh', 'arh'),
+    ('arhuaco', 'arh'),
+    ('arikara', 'ari'),
+    ('arapaso', 'arj'),
+    ('arj', 'arj'),
+    ('arikapú', 'ark'),
+    ('ark', 'ark'),
+    ('arabela', 'arl'),
+    ('arl', 'arl'),
+    ('arn', 'arn'),
+    ('mapuche', 'arn'),
+    ('mapudungun', 'arn'),
+    ('araona', 'aro'),
+    ('aro', 'aro'),
+    ('arapaho', 'arp'),
+    ('arp', 'arp'),
+    ('algerian arabic', 'arq'),
+    ('arq', 'arq'),
+    ('arr', 'arr'),
+    ('karo', 'arr'),
+    ('karo (brazil)', 'arr'),
+    ('ars', 'ars'),
+    ('najdi arabic', 'ars'),
+    ('aru', 'aru'),
+    ('aruá', 'aru'),
+    ('aruá (amazonas state)', 'aru'),
+    ('arbore', 'arv'),
+    ('arv', 'arv'),
+    ('arawak', 'arw'),
+    ('arw', 'arw'),
+    ('aruá (rodonia state)', 'arx'),
+    ('arx', 'arx'),
+    ('ary', 'ary'),
+    ('moroccan arabic', 'ary'),
+    ('arz', 'arz'),
+    ('egyptian arabic', 'arz'),
+    ('as', 'as'),
+    ('asm', 'as'),
+    ('assamese', 'as'),
+    ('asa', 'asa'),
+    ('asu', 'asa'),
+    ('asu (tanzania)', 'asa'),
+    ('asb', 'asb'),
+    ('assiniboine', 'asb'),
+    ('asc', 'asc'),
+    ('casuarina coast asmat', 'asc'),
+    ('american sign language', 'ase'),
+    ('ase', 'ase'),
+    ('asf', 'asf'),
+    ('auslan', 'asf'),
+    ('asg', 'asg'),
+    ('cishingini', 'asg'),
+    ('abishira', 'ash'),
+    ('ash', 'ash'),
+    ('asi', 'asi'),
+    ('buruwai', 'asi'),
+    ('asj', 'asj'),
+    ('sari', 'asj'),
+    ('ashkun', 'ask'),
+    ('ask', 'ask'),
+    ('asilulu', 'asl'),
+    ('asl', 'asl'),
+    ('asn', 'asn'),
+    ('xingú asuriní', 'asn'),
+    ('aso', 'aso'),
+    ('dano', 'aso'),
+    ('algerian sign language', 'asp'),
+    ('asp', 'asp'),
+    ('asq', 'asq'),
+    ('austrian sign language', 'asq'),
+    ('asr', 'asr'),
+    ('asuri', 'asr'),
+    ('ass', 'ass'),
+    ('ipulo', 'ass'),
+    ('ast', 'ast'),
+    ('asturian', 'ast'),
+    ('asturleonese', 'ast'),
+    ('bable', 'ast'),
+    ('leonese', 'ast'),
+    ('tocantins asurini', 'asu'),
+    ('asoa', 'asv'),
+    ('asv', 'asv'),
+    ('asw', 'asw'),
+    ('australian aborigines sign language', 'asw'),
+    ('asx', 'asx'),
+    ('muratayak', 'asx'),
+    ('asy', 'asy'),
+    ('yaosakor asmat', 'asy'),
+    ('asz', 'asz'),
+    ('ata', 'ata'),
+    ('pele-ata', 'ata'),
+    ('atb', 'atb'),
+    ('zaiwa', 'atb'),
+    ('atc', 'atc'),
+    ('atsahuaca', 'atc'),
+    ('ata manobo', 'atd'),
+    ('atd', 'atd'),
+    ('ate', 'ate'),
+    ('atemble', 'ate'),
+    ('atg', 'atg'),
+    ('ivbie north-okpela-arhe', 'atg'),
+    ('ati', 'ati'),
+    ('attié', 'ati'),
+    ('atikamekw', 'atj'),
+    ('atj', 'atj'),
+    ('atk', 'atk'),
+    ('atl', 'atl'),
+    ('mt. iraya agta', 'atl'),
+    ('atm', 'atm'),
+    ('ashtiani', 'atn'),
+    ('atn', 'atn'),
+    ('ato', 'ato'),
+    ('atong (cameroon)', 'ato'),
+    ('atp', 'atp'),
+    ('pudtol atta', 'atp'),
+    ('aralle-tabulahan', 'atq'),
+    ('atq', 'atq'),
+    ('atr', 'atr'),
+    ('waimiri-atroari', 'atr'),
+    ('ats', 'ats'),
+    ('gros ventre', 'ats'),
+    ('att', 'att'),
+    ('pamplona atta', 'att'),
+    ('atu', 'atu'),
+    ('reel', 'atu'),
+    ('atv', 'atv'),
+    ('northern altai', 'atv'),
+    ('atsugewi', 'atw'),
+    ('atw', 'atw'),
+    ('arutani', 'atx'),
+    ('atx', 'atx'),
+    ('aneityum', 'aty'),
+    ('aty', 'aty'),
+    ('arta', 'atz'),
+    ('atz', 'atz'),
+    ('asumboa', 'aua'),
+    ('aua', 'aua'),
+    ('alugu', 'aub'),
+    ('aub', 'aub'),
+    ('auc', 'auc'),
+    ('waorani', 'auc'),
+    ('anuta', 'aud'),
+    ('aud', 'aud'),
+    ('aguna', 'aug'),
+    ('aug', 'aug'),
+    ('auh', 'auh'),
+    ('aushi', 'auh'),
+    ('anuki', 'aui'),
+    ('aui', 'aui'),
+    ('auj', 'auj'),
+    ('awjilah', 'auj'),
+    ('auk', 'auk'),
+    ('heyo', 'auk'),
+    ('aul', 'aul'),
+    ('aulua', 'aul'),
+    ('asu (nigeria)', 'aum'),
+    ('aum', 'aum'),
+    ('aun', 'aun'),
+    ('molmo one', 'aun'),
+    ('auo', 'auo'),
+    ('auyokawa', 'auo'),
+    ('aup', 'aup'),
+    ('makayam', 'aup'),
+    ('anus', 'auq'),
+    ('auq', 'auq'),
+    ('aruek', 'aur'),
+    ('aur', 'aur'),
+    ('austral', 'aut'),
+    ('aut', 'aut'),
+    ('auu', 'auu'),
+    ('auye', 'auu'),
+    ('auw', 'auw'),
+    ('awyi', 'auw'),
+    ('aurá', 'aux'),
+    ('aux', 'aux'),
+    ('auy', 'auy'),
+    ('awiyaana', 'auy'),
+    ('auz', 'auz'),
+    ('uzbeki arabic', 'auz'),
+    ('av', 'av'),
+    ('ava', 'av'),
+    ('avaric', 'av'),
+    ('avau', 'avb'),
+    ('avb', 'avb'),
+    ('alviri-vidari', 'avd'),
+    ('avd', 'avd'),
+    ('avi', 'avi'),
+    ('avikam', 'avi'),
+    ('avk', 'avk'),
+    ('kotava', 'avk'),
+    ('avl', 'avl'),
+    ('eastern egyptian bedawi arabic', 'avl'),
+    ('angkamuthi', 'avm'),
+    ('avm', 'avm'),
+    ('avatime', 'avn'),
+    ('avn', 'avn'),
+    ('agavotaguerra', 'avo'),
+    ('avo', 'avo'),
+    ('aushiri', 'avs'),
+    ('avs', 'avs'),
+    ('au', 'avt'),
+    ('avt', 'avt'),
+    ('avokaya', 'avu'),
+    ('avu', 'avu'),
+    ('avv', 'avv'),
+    ('avá-canoeiro', 'avv'),
+    ('awa', 'awa'),
+    ('awadhi', 'awa'),
+    ('awa (papua new guinea)', 'awb'),
+    ('awb', 'awb'),
+    ('awc', 'awc'),
+    ('cicipu', 'awc'),
+    ('awe', 'awe'),
+    ('awetí', 'awe'),
+    ('anguthimri', 'awg'),
+    ('awg', 'awg'),
+    ('awbono', 'awh'),
+    ('awh', 'awh'),
+    ('aekyom', 'awi'),
+    ('awi', 'awi'),
+    ('awabakal', 'awk'),
+    ('awk', 'awk'),
+    ('arawum', 'awm'),
+    ('awm', 'awm'),
+    ('awn', 'awn'),
+    ('awngi', 'awn'),
+    ('awak', 'awo'),
+    ('awo', 'awo'),
+    ('awera', 'awr'),
+    ('awr', 'awr'),
+    ('aws', 'aws'),
+    ('south awyu', 'aws'),
+    ('araweté', 'awt'),
+    ('awt', 'awt'),
+    ('awu', 'awu'),
+    ('central awyu', 'awu'),
+    ('awv', 'awv'),
+    ('jair awyu', 'awv'),
+    ('awun', 'aww'),
+    ('aww', 'aww'),
+    ('awara', 'awx'),
+    ('awx', 'awx'),
+    ('awy', 'awy'),
+    ('edera awyu', 'awy'),
+    ('abipon', 'axb'),
+    ('axb', 'axb'),
+    ('axe', 'axe'),
+    ('ayerrerenge', 'axe'),
+    ('axg', 'axg'),
+    ('mato grosso arára', 'axg'),
+    ('axk', 'axk'),
+    ('yaka', 'axk'),
+    ('yaka (central african republic)', 'axk'),
+    ('axl', 'axl'),
+    ('lower southern aranda', 'axl'),
+    ('axm', 'axm'),
+    ('middle armenian', 'axm'),
+    ('axx', 'axx'),
+    ('xârâgurè', 'axx'),
+    ('ay', 'ay'),
+    ('aym', 'ay'),
+    ('aymara', 'ay'),
+    ('awar', 'aya'),
+    ('aya', 'aya'),
+    ('ayb', 'ayb'),
+    ('ayizo gbe', 'ayb'),
+    ('ayc', 'ayc'),
+    ('southern aymara', 'ayc'),
+    ('ayabadhu', 'ayd'),
+    ('ayd', 'ayd'),
+    ('aye', 'aye'),
+    ('ayere', 'aye'),
+    ('ayg', 'ayg'),
+    ('ginyanga', 'ayg'),
+    ('ayh', 'ayh'),
+    ('hadrami arabic', 'ayh'),
+    ('ayi', 'ayi'),
+    ('leyigha', 'ayi'),
+    ('akuku', 'ayk'),
+    ('ayk', 'ayk'),
+    ('ayl', 'ayl'),
+    ('libyan arabic', 'ayl'),
+    ('ayn', 'ayn'),
+    ('sanaani arabic', 'ayn'),
+    ('ayo', 'ayo'),
+    ('ayoreo', 'ayo'),
+    ('ayp', 'ayp'),
+    ('north mesopotamian arabic', 'ayp'),
+    ('ayi (papua new guinea)', 'ayq'),
+    ('ayq', 'ayq'),
+    ('ayr', 'ayr'),
+    ('central aymara', 'ayr'),
+    ('ays', 'ays'),
+    ('sorsogon ayta', 'ays'),
+    ('ayt', 'ayt'),
+    ('magbukun ayta', 'ayt'),
+    ('ayu', 'ayu'),
+    ('ayz', 'ayz'),
+    ('mai brat', 'ayz'),
+    ('az', 'az'),
+    ('aze', 'az'),
+    ('azerbaijani', 'az'),
+    ('aza', 'aza'),
+    ('azha', 'aza'),
+    ('azb', 'azb'),
+    ('south azerbaijani', 'azb'),
+    ('azd', 'azd'),
+    ('eastern durango nahuatl', 'azd'),
+    ('azg', 'azg'),
+    ('san pedro amuzgos amuzgo', 'azg'),
+    ('azj', 'azj'),
+    ('north azerbaijani', 'azj'),
+    ('azm', 'azm'),
+    ('ipalapa amuzgo', 'azm'),
+    ('azn', 'azn'),
+    ('western durango nahuatl', 'azn'),
+    ('awing', 'azo'),
+    ('azo', 'azo'),
+    ('azt', 'azt'),
+    ('faire atta', 'azt'),
+    ('azz', 'azz'),
+    ('highland puebla nahuatl', 'azz'),
+    ('ba', 'ba'),
+    ('bak', 'ba'),
+    ('bashkir', 'ba'),
+    ('baa', 'baa'),
+    ('babatana', 'baa'),
+    ('bab', 'bab'),
+    ('bainouk-gunyuño', 'bab'),
+    ('bac', 'bac'),
+    ('badui', 'bac'),
+    ('bae', 'bae'),
+    ('baré', 'bae'),
+    ('baf', 'baf'),
+    ('nubaca', 'baf'),
+    ('bag', 'bag'),
+    ('tuki', 'bag'),
+    ('bah', 'bah'),
+    ('bahamas creole english', 'bah'),
+    ('baj', 'baj'),
+    ('barakai', 'baj'),
+    ('bal', 'bal'),
+    ('baluchi', 'bal'),
+    ('balinese', 'ban'),
+    ('ban', 'ban'),
+    ('bao', 'bao'),
+    ('waimaha', 'bao'),
+    ('bantawa', 'bap'),
+    ('bap', 'bap'),
+    ('bar', 'bar'),
+    ('bavarian', 'bar'),
+    ('bas', 'bas'),
+    ('basa', 'bas'),
+    ('basa (cameroon)', 'bas'),
+    ('bada', 'bau'),
+    ('bada (nigeria)', 'bau'),
+    ('bau', 'bau'),
+    ('bav', 'bav'),
+    ('vengo', 'bav'),
+    ('bambili-bambui', 'baw'),
+    ('baw', 'baw'),
+    ('bamun', 'bax'),
+    ('bax', 'bax'),
+    ('batuley', 'bay'),
+    ('bay', 'bay'),
+    ('baatonum', 'bba'),
+    ('bba', 'bba'),
+    ('barai', 'bbb'),
+    ('bbb', 'bbb'),
+    ('batak toba', 'bbc'),
+    ('bbc', 'bbc'),
+    ('bbd', 'bbd'),
+    ('bangba', 'bbe'),
+    ('bbe', 'bbe'),
+    ('baibai', 'bbf'),
+    ('bbf', 'bbf'),
+    ('barama', 'bbg'),
+    ('bbg', 'bbg'),
+    ('bbh', 'bbh'),
+    ('bugan', 'bbh'),
+    ('barombi', 'bbi'),
+    ('bbi', 'bbi'),
+    ('bbj', 'bbj'),
+    ('ghomálá''', 'bbj'),
+    ('babanki', 'bbk'),
+    ('bbk', 'bbk'),
+    ('bats', 'bbl'),
+    ('bbl', 'bbl'),
+    ('babango', 'bbm'),
+    ('bbm', 'bbm'),
+    ('bbn', 'bbn'),
+    ('uneapa', 'bbn'),
+    ('bbo', 'bbo'),
+    ('northern bobo madaré', 'bbo'),
+    ('bbp', 'bbp'),
+    ('west central banda', 'bbp'),
+    ('bamali', 'bbq'),
+    ('bbq', 'bbq'),
+    ('bbr', 'bbr'),
+    ('girawa', 'bbr'),
+    ('bakpinka', 'bbs'),
+    ('bbs', 'bbs'),
+    ('bbt', 'bbt'),
+    ('mburku', 'bbt'),
+    ('bbu', 'bbu'),
+    ('kulung', 'bbu'),
+    ('kulung (nigeria)', 'bbu'),
+    ('bbv', 'bbv'),
+    ('karnai', 'bbv'),
+    ('baba', 'bbw'),
+    ('bbw', 'bbw'),
+    ('bbx', 'bbx'),
+    ('bubia', 'bbx'),
+    ('bby', 'bby'),
+    ('befang', 'bby'),
+    ('bca', 'bca'),
+    ('central bai', 'bca'),
+    ('bainouk-samik', 'bcb'),
+    ('bcb', 'bcb'),
+    ('bcc', 'bcc'),
+    ('southern balochi', 'bcc'),
+    ('bcd', 'bcd'),
+    ('north babar', 'bcd'),
+    ('bamenyam', 'bce'),
+    ('bce', 'bce'),
+    ('bamu', 'bcf'),
+    ('bcf', 'bcf'),
+    ('baga pokur', 'bcg'),
+    ('bcg', 'bcg'),
+    ('bariai', 'bch'),
+    ('bch', 'bch'),
+    ('baoulé', 'bci'),
+    ('bci', 'bci'),
+    ('bardi', 'bcj'),
+    ('bcj', 'bcj'),
+    ('bck', 'bck'),
+    ('bunuba', 'bck'),
+    ('bcl', 'bcl'),
+    ('central bikol', 'bcl'),
+    ('bannoni', 'bcm'),
+    ('bcm', 'bcm'),
+    ('bali', 'bcn'),
+    ('bali (nigeria)', 'bcn'),
+    ('bcn', 'bcn'),
+    ('bco', 'bco'),
+    ('kaluli', 'bco'),
+    ('bali (democratic republic of congo)', 'bcp'),
+    ('bcp', 'bcp'),
+    ('bcq', 'bcq'),
+    ('bench', 'bcq'),
+    ('babine', 'bcr'),
+    ('bcr', 'bcr'),
+    ('bcs', 'bcs'),
+    ('kohumono', 'bcs'),
+    ('bct', 'bct'),
+    ('bendi', 'bct'),
+    ('awad bing', 'bcu'),
+    ('bcu', 'bcu'),
+    ('bcv', 'bcv'),
+    ('shoo-minda-nye', 'bcv'),
+    ('bana', 'bcw'),
+    ('bcw', 'bcw'),
+    ('bacama', 'bcy'),
+    ('bcy', 'bcy'),
+    ('bainouk-gunyaamolo', 'bcz'),
+    ('bcz', 'bcz'),
+    ('bayot', 'bda'),
+    ('bda', 'bda'),
+    ('basap', 'bdb'),
+    ('bdb', 'bdb'),
+    ('bdc', 'bdc'),
+    ('emberá-baudó', 'bdc'),
+    ('bdd', 'bdd'),
+    ('bunama', 'bdd'),
+    ('bade', 'bde'),
+    ('bde', 'bde'),
+    ('bdf', 'bdf'),
+    ('biage', 'bdf'),
+    ('bdg', 'bdg'),
+    ('bonggi', 'bdg'),
+    ('baka', 'bdh'),
+    ('baka (south sudan)', 'bdh'),
+    ('bdh', 'bdh'),
+    ('bdi', 'bdi'),
+    ('burun', 'bdi'),
+    ('bai', 'bdj'),
+    ('bai (south sudan)', 'bdj'),
+    ('bdj', 'bdj'),
+    ('bdk', 'bdk'),
+    ('budukh', 'bdk'),
+    ('bdl', 'bdl'),
+    ('indonesian bajau', 'bdl'),
+    ('bdm', 'bdm'),
+    ('buduma', 'bdm'),
+    ('baldemu', 'bdn'),
+    ('bdn', 'bdn'),
+    ('bdo', 'bdo'),
+    ('morom', 'bdo'),
+    ('bdp', 'bdp'),
+    ('bende', 'bdp'),
+    ('bahnar', 'bdq'),
+    ('bdq', 'bdq'),
+    ('bdr', 'bdr'),
+    ('west coast bajau', 'bdr'),
+    ('bds', 'bds'),
+    ('burunge', 'bds'),
+    ('bdt', 'bdt'),
+    ('bokoto', 'bdt'),
+    ('bdu', 'bdu'),
+    ('oroko', 'bdu'),
+    ('bdv', 'bdv'),
+    ('bodo parja', 'bdv'),
+    ('baham', 'bdw'),
+    ('bdw', 'bdw'),
+    ('bdx', 'bdx'),
+    ('budong-budong', 'bdx'),
+    ('bandjalang', 'bdy'),
+    ('bdy', 'bdy'),
+    ('badeshi', 'bdz'),
+    ('bdz', 'bdz'),
+    ('be', 'be'),
+    ('bel', 'be'),
+    ('belarusian', 'be'),
+    ('bea', 'bea'),
+    ('beaver', 'bea'),
+    ('beb', 'beb'),
+    ('bebele', 'beb'),
+    ('bec', 'bec'),
+    ('iceve-maci', 'bec'),
+    ('bed', 'bed'),
+    ('bedoanas', 'bed'),
+    ('bee', 'bee'),
+    ('byangsi', 'bee'),
+    ('bef', 'bef'),
+    ('benabena', 'bef'),
+    ('beg', 'beg'),
+    ('belait', 'beg'),
+    ('beh', 'beh'),
+    ('biali', 'beh'),
+    ('bei', 'bei'),
+    ('bekati''', 'bei'),
+    ('bedawiyet', 'bej'),
+    ('bej', 'bej'),
+    ('beja', 'bej'),
+    ('bebeli', 'bek'),
+    ('bek', 'bek'),
+    ('bem', 'bem'),
+    ('bemba', 'bem'),
+    ('bemba (zambia)', 'bem'),
+    ('beami', 'beo'),
+    ('beo', 'beo'),
+    ('bep', 'bep'),
+    ('besoa', 'bep'),
+    ('beembe', 'beq'),
+    ('beq', 'beq'),
+    ('bes', 'bes'),
+    ('besme', 'bes'),
+    ('bet', 'bet'),
+    ('guiberoua béte', 'bet'),
+    ('beu', 'beu'),
+    ('blagar', 'beu'),
+    ('bev', 'bev'),
+    ('daloa bété', 'bev'),
+    ('betawi', 'bew'),
+    ('bew', 'bew'),
+    ('bex', 'bex'),
+    ('jur modo', 'bex'),
+    ('beli', 'bey'),
+    ('beli (papua new guinea)', 'bey'),
+    ('bey', 'bey'),
+    ('bena', 'bez'),
+    ('bena (tanzania)', 'bez'),
+    ('bez', 'bez'),
+    ('bari', 'bfa'),
+    ('bfa', 'bfa'),
+    ('bfb', 'bfb'),
+    ('pauri bareli', 'bfb'),
+    ('bfc', 'bfc'),
+    ('panyi bai', 'bfc'),
+    ('bafut', 'bfd'),
+    ('bfd', 'bfd'),
+    ('betaf', 'bfe'),
+    ('bfe', 'bfe'),
+    ('bff', 'bff'),
+    ('bofi', 'bff'),
+    ('bfg', 'bfg'),
+    ('busang kayan', 'bfg'),
+    ('bfh', 'bfh'),
+    ('blafe', 'bfh'),
+    ('bfi', 'bfi'),
+    ('british sign language', 'bfi'),
+    ('bafanji', 'bfj'),
+    ('bfj', 'bfj'),
+    ('ban khor sign language', 'bfk'),
+    ('bfk', 'bfk'),
+    ('banda-ndélé', 'bfl'),
+    ('bfl', 'bfl'),
+    ('bfm', 'bfm'),
+    ('mmen', 'bfm'),
+    ('bfn', 'bfn'),
+    ('bunak', 'bfn'),
+    ('bfo', 'bfo'),
+    ('malba birifor', 'bfo'),
+    ('beba', 'bfp'),
+    ('bfp', 'bfp'),
+    ('badaga', 'bfq'),
+    ('bfq', 'bfq'),
+    ('bazigar', 'bfr'),
+    ('bfr', 'bfr'),
+    ('bfs', 'bfs'),
+    ('southern bai', 'bfs'),
+    ('balti', 'bft'),
+    ('bft', 'bft'),
+    ('bfu', 'bfu'),
+    ('gahri', 'bfu'),
+    ('bfw', 'bfw'),
+    ('bondo', 'bfw'),
+    ('bantayanon', 'bfx'),
+    ('bfx', 'bfx'),
+    ('bagheli', 'bfy'),
+    ('bfy', 'bfy'),
+    ('bfz', 'bfz'),
+    ('mahasu pahari', 'bfz'),
+    ('bg', 'bg'),
+    ('bul', 'bg'),
+    ('bulgarian', 'bg'),
+    ('bga', 'bga'),
+    ('gwamhi-wuri', 'bga'),
+    ('bgb', 'bgb'),
+    ('bobongko', 'bgb'),
+    ('bgc', 'bgc'),
+    ('haryanvi', 'bgc'),
+    ('bgd', 'bgd'),
+    ('rathwi bareli', 'bgd'),
+    ('bauria', 'bge'),
+    ('bge', 'bge'),
+    ('bangandu', 'bgf'),
+    ('bgf', 'bgf'),
+    ('bgg', 'bgg'),
+    ('bugun', 'bgg'),
+    ('bgi', 'bgi'),
+    ('giangan', 'bgi'),
+    ('bangolan', 'bgj'),
+    ('bgj', 'bgj'),
+    ('bgk', 'bgk'),
+    ('bit', 'bgk'),
+    ('bgl', 'bgl'),
+    ('bo (laos)', 'bgl'),
+    ('bgn', 'bgn'),
+    ('western balochi', 'bgn'),
+    ('baga koga', 'bgo'),
+    ('bgo', 'bgo'),
+    ('bgp', 'bgp'),
+    ('eastern balochi', 'bgp'),
+    ('bagri', 'bgq'),
+    ('bgq', 'bgq'),
+    ('bawm chin', 'bgr'),
+    ('bgr', 'bgr'),
+    ('bgs', 'bgs'),
+    ('tagabawa', 'bgs'),
+    ('bgt', 'bgt'),
+    ('bughotu', 'bgt'),
+    ('bgu', 'bgu'),
+    ('mbongno', 'bgu'),
+    ('bgv', 'bgv'),
+    ('warkay-bipim', 'bgv'),
+    ('bgw', 'bgw'),
+    ('bhatri', 'bgw'),
+    ('balkan gagauz turkish', 'bgx'),
+    ('bgx', 'bgx'),
+    ('benggoi', 'bgy'),
+    ('bgy', 'bgy'),
+    ('banggai', 'bgz'),
+    ('bgz', 'bgz'),
+    ('bha', 'bha'),
+    ('bharia', 'bha'),
+    ('bhb', 'bhb'),
+    ('bhili', 'bhb'),
+    ('bhc', 'bhc'),
+    ('biga', 'bhc'),
+    ('bhadrawahi', 'bhd'),
+    ('bhd', 'bhd'),
+    ('bhaya', 'bhe'),
+    ('bhe', 'bhe'),
+    ('bhf', 'bhf'),
+    ('odiai', 'bhf'),
+    ('bhg', 'bhg'),
+    ('binandere', 'bhg'),
+    ('bhh', 'bhh'),
+    ('bukharic', 'bhh'),
+    ('bhi', 'bhi'),
+    ('bhilali', 'bhi'),
+    ('bahing', 'bhj'),
+    ('bhj', 'bhj'),
+    ('bhl', 'bhl'),
+    ('bimin', 'bhl'),
+    ('bathari', 'bhm'),
+    ('bhm', 'bhm'),
+    ('bhn', 'bhn'),
+    ('bohtan neo-aramaic', 'bhn'),
+    ('bho', 'bho'),
+    ('bhojpuri', 'bho'),
+    ('bhp', 'bhp'),
+    ('bima', 'bhp'),
+    ('bhq', 'bhq'),
+    ('tukang besi south', 'bhq'),
+    ('bara malagasy', 'bhr'),
+    ('bhr', 'bhr'),
+    ('bhs', 'bhs'),
+    ('buwal', 'bhs'),
+    ('bhattiyali', 'bht'),
+    ('bht', 'bht'),
+    ('bhu', 'bhu'),
+    ('bhunjia', 'bhu'),
+    ('bahau', 'bhv'),
+    ('bhv', 'bhv'),
+    ('bhw', 'bhw'),
+    ('biak', 'bhw'),
+    ('bhalay', 'bhx'),
+    ('bhx', 'bhx'),
+    ('bhele', 'bhy'),
+    ('bhy', 'bhy'),
+    ('bada (indonesia)', 'bhz'),
+    ('bhz', 'bhz'),
+    ('bi', 'bi'),
+    ('bis', 'bi'),
+    ('bislama', 'bi'),
+    ('badimaya', 'bia'),
+    ('bia', 'bia'),
+    ('bib', 'bib'),
+    ('bissa', 'bib'),
+    ('bid', 'bid'),
+    ('bidiyo', 'bid'),
+    ('bepour', 'bie'),
+    ('bie', 'bie'),
+    ('biafada', 'bif'),
+    ('bif', 'bif'),
+    ('biangai', 'big'),
+    ('big', 'big'),
+    ('bik', 'bik'),
+    ('bikol', 'bik'),
+    ('bil', 'bil'),
+    ('bile', 'bil'),
+    ('bim', 'bim'),
+    ('bimoba', 'bim'),
+    ('bin', 'bin'),
+    ('bini', 'bin'),
+    ('edo', 'bin'),
+    ('bio', 'bio'),
+    ('nai', 'bio'),
+    ('bila', 'bip'),
+    ('bip', 'bip'),
+    ('bipi', 'biq'),
+    ('biq', 'biq'),
+    ('bir', 'bir'),
+    ('bisorio', 'bir'),
+    ('berinomo', 'bit'),
+    ('biete', 'biu'),
+    ('biu', 'biu'),
+    ('biv', 'biv'),
+    ('southern birifor', 'biv'),
+    ('biw', 'biw'),
+    ('kol', 'biw'),
+    ('kol (cameroon)', 'biw'),
+    ('bijori', 'bix'),
+    ('bix', 'bix'),
+    ('birhor', 'biy'),
+    ('biy', 'biy'),
+    ('baloi', 'biz'),
+    ('biz', 'biz'),
+    ('bja', 'bja'),
+    ('budza', 'bja'),
+    ('banggarla', 'bjb'),
+    ('bjb', 'bjb'),
+    ('bariji', 'bjc'),
+    ('bjc', 'bjc'),
+    ('biao-jiao mien', 'bje'),
+    ('bje', 'bje'),
+    ('barzani jewish neo-aramaic', 'bjf'),
+    ('bjf', 'bjf'),
+    ('bidyogo', 'bjg'),
+    ('bjg', 'bjg'),
+    ('bahinemo', 'bjh'),
+    ('bjh', 'bjh'),
+    ('bji', 'bji'),
+    ('burji', 'bji'),
+    ('bjj', 'bjj'),
+    ('kanauji', 'bjj'),
+    ('barok', 'bjk'),
+    ('bjk', 'bjk'),
+    ('bjl', 'bjl'),
+    ('bulu', 'bjl'),
+    ('bulu (papua new guinea)', 'bjl'),
+    ('bajelani', 'bjm'),
+    ('bjm', 'bjm'),
+    ('banjar', 'bjn'),
+    ('bjn', 'bjn'),
+    ('bjo', 'bjo'),
+    ('mid-southern banda', 'bjo'),
+    ('bjp', 'bjp'),
+    ('fanamaket', 'bjp'),
+    ('binumarien', 'bjr'),
+    ('bjr', 'bjr'),
+    ('bajan', 'bjs'),
+    ('bjs', 'bjs'),
+    ('balanta-ganja', 'bjt'),
+    ('bjt', 'bjt'),
+    ('bju', 'bju'),
+    ('busuu', 'bju'),
+    ('bedjond', 'bjv'),
+    ('bjv', 'bjv'),
+    ('bakwé', 'bjw'),
+    ('bjw', 'bjw'),
+    ('banao itneg', 'bjx'),
+    ('bjx', 'bjx'),
+    ('bayali', 'bjy'),
+    ('bjy', 'bjy'),
+    ('baruga', 'bjz'),
+    ('bjz', 'bjz'),
+    ('bka', 'bka'),
+    ('kyak', 'bka'),
+    ('baka (cameroon)', 'bkc'),
+    ('bkc', 'bkc'),
+    ('binukid', 'bkd'),
+    ('bkd', 'bkd'),
+    ('beeke', 'bkf'),
+    ('bkf', 'bkf'),
+    ('bkg', 'bkg'),
+    ('buraka', 'bkg'),
+    ('bakoko', 'bkh'),
+    ('bkh', 'bkh'),
+    ('baki', 'bki'),
+    ('bki', 'bki'),
+    ('bkj', 'bkj'),
+    ('pande', 'bkj'),
+    ('bkk', 'bkk'),
+    ('brokskat', 'bkk'),
+    ('berik', 'bkl'),
+    ('bkl', 'bkl'),
+    ('bkm', 'bkm'),
+    ('kom (cameroon)', 'bkm'),
+    ('bkn', 'bkn'),
+    ('bukitan', 'bkn'),
+    ('bko', 'bko'),
+    ('kwa''', 'bko'),
+    ('bkp', 'bkp'),
+    ('boko', 'bkp'),
+    ('boko (democratic republic of congo)', 'bkp'),
+    ('bakairí', 'bkq'),
+    ('bkq', 'bkq'),
+    ('bakumpai', 'bkr'),
+    ('bkr', 'bkr'),
+    ('bks', 'bks'),
+    ('northern sorsoganon', 'bks'),
+    ('bkt', 'bkt'),
+    ('boloki', 'bkt'),
+    ('bku', 'bku'),
+    ('buhid', 'bku'),
+    ('bekwarra', 'bkv'),
+    ('bkv', 'bkv'),
+    ('bekwel', 'bkw'),
+    ('bkw', 'bkw'),
+    ('baikeno', 'bkx'),
+    ('bkx', 'bkx'),
+    ('bky', 'bky'),
+    ('bokyi', 'bky'),
+    ('bkz', 'bkz'),
+    ('bungku', 'bkz'),
+    ('bla', 'bla'),
+    ('siksika', 'bla'),
+    ('bilua', 'blb'),
+    ('blb', 'blb'),
+    ('bella coola', 'blc'),
+    ('blc', 'blc'),
+    ('bld', 'bld'),
+    ('bolango', 'bld'),
+    ('balanta-kentohe', 'ble'),
+    ('ble', 'ble'),
+    ('blf', 'blf'),
+    ('buol', 'blf'),
+    ('blh', 'blh'),
+    ('kuwaa', 'blh'),
+    ('bli', 'bli'),
+    ('bolia', 'bli'),
+    ('blj', 'blj'),
+    ('bolongan', 'blj'),
+    ('blk', 'blk'),
+    ('pa''o karen', 'blk'),
+    ('biloxi', 'bll'),
+    ('bll', 'bll'),
+    ('beli (south sudan)', 'blm'),
+    ('blm', 'blm'),
+    ('bln', 'bln'),
+    ('southern catanduanes bikol', 'bln'),
+    ('anii', 'blo'),
+    ('blo', 'blo'),
+    ('blablanga', 'blp'),
+    ('blp', 'blp'),
+    ('baluan-pam', 'blq'),
+    ('blq', 'blq'),
+    ('blang', 'blr'),
+    ('blr', 'blr'),
+    ('balaesang', 'bls'),
+    ('bls', 'bls'),
+    ('blt', 'blt'),
+    ('tai dam', 'blt'),
+    ('blv', 'blv'),
+    ('kibala', 'blv'),
+    ('balangao', 'blw'),
+    ('blw', 'blw'),
+    ('blx', 'blx'),
+    ('mag-indi ayta', 'blx'),
+    ('bly', 'bly'),
+    ('notre', 'bly'),
+    ('balantak', 'blz'),
+    ('blz', 'blz'),
+    ('bam', 'bm'),
+    ('bambara', 'bm'),
+    ('bm', 'bm'),
+    ('bma', 'bma'),
+    ('lame', 'bma'),
+    ('bembe', 'bmb'),
+    ('bmb', 'bmb'),
+    ('biem', 'bmc'),
+    ('bmc', 'bmc'),
+    ('baga manduri', 'bmd'),
+    ('bmd', 'bmd'),
+    ('bme', 'bme'),
+    ('limassa', 'bme'),
+    ('bmf', 'bmf'),
+    ('bom-kim', 'bmf'),
+    ('bamwe', 'bmg'),
+    ('bmg', 'bmg'),
+    ('bmh', 'bmh'),
+    ('kein', 'bmh'),
+    ('bagirmi', 'bmi'),
+    ('bmi', 'bmi'),
+    ('bmj', 'bmj'),
+    ('bote-majhi', 'bmj'),
+    ('bmk', 'bmk'),
+    ('ghayavi', 'bmk'),
+    ('bml', 'bml'),
+    ('bomboli', 'bml'),
+    ('bmm', 'bmm'),
+    ('northern betsimisaraka malagasy', 'bmm'),
+    ('bina', 'bmn'),
+    ('bina (papua new guinea)', 'bmn'),
+    ('bmn', 'bmn'),
+    ('bambalang', 'bmo'),
+    ('bmo', 'bmo'),
+    ('bmp', 'bmp'),
+    ('bulgebi', 'bmp'),
+    ('bmq', 'bmq'),
+    ('bomu', 'bmq'),
+    ('bmr', 'bmr'),
+    ('muinane', 'bmr'),
+    ('bilma kanuri', 'bms'),
+    ('bms', 'bms'),
+    ('biao mon', 'bmt'),
+    ('bmt', 'bmt'),
+    ('bmu', 'bmu'),
+    ('somba-siawari', 'bmu'),
+    ('bmv', 'bmv'),
+    ('bum', 'bmv'),
+    ('bmw', 'bmw'),
+    ('bomwali', 'bmw'),
+    ('baimak', 'bmx'),
+    ('bmx', 'bmx'),
+    ('baramu', 'bmz'),
+    ('bmz', 'bmz'),
+    ('bangla', 'bn'),
+    ('ben', 'bn'),
+    ('bengali', 'bn'),
+    ('bn', 'bn'),
+    ('bna', 'bna'),
+    ('bonerate', 'bna'),
+    ('bnb', 'bnb'),
+    ('bookan', 'bnb'),
+    ('bnc', 'bnc'),
+    ('bontok', 'bnc'),
+    ('banda', 'bnd'),
+    ('banda (indonesia)', 'bnd'),
+    ('bnd', 'bnd'),
+    ('bintauna', 'bne'),
+    ('bne', 'bne'),
+    ('bnf', 'bnf'),
+    ('masiwang', 'bnf'),
+    ('benga', 'bng'),
+    ('bng', 'bng'),
+    ('bangi', 'bni'),
+    ('bni', 'bni'),
+    ('bnj', 'bnj'),
+    ('eastern tawbuid', 'bnj'),
+    ('bierebo', 'bnk'),
+    ('bnk', 'bnk'),
+    ('bnl', 'bnl'),
+    ('boon', 'bnl'),
+    ('batanga', 'bnm'),
+    ('bnm', 'bnm'),
+    ('bnn', 'bnn'),
+    ('bunun', 'bnn'),
+    ('bantoanon', 'bno'),
+    ('bno', 'bno'),
+    ('bnp', 'bnp'),
+    ('bola', 'bnp'),
+    ('bantik', 'bnq'),
+    ('bnq', 'bnq'),
+    ('bnr', 'bnr'),
+    ('butmas-tur', 'bnr'),
+    ('bns', 'bns'),
+    ('bundeli', 'bns'),
+    ('bentong', 'bnu'),
+    ('bnu', 'bnu'),
+    ('bnv', 'bnv'),
+    ('bonerif', 'bnv'),
+    ('bisis', 'bnw'),
+    ('bnw', 'bnw'),
+    ('bangubangu', 'bnx'),
+    ('bnx', 'bnx'),
+    ('bintulu', 'bny'),
+    ('bny', 'bny'),
+    ('beezen', 'bnz'),
+    ('bnz', 'bnz'),
+    ('bo', 'bo'),
+    ('bod', 'bo'),
+    ('tib', 'bo'),
+    ('tibetan', 'bo'),
+    ('boa', 'boa'),
+    ('bora', 'boa'),
+    ('aweer', 'bob'),
+    ('bob', 'bob'),
+    ('boe', 'boe'),
+    ('mundabli', 'boe'),
+    ('bof', 'bof'),
+    ('bolon', 'bof'),
+    ('bamako sign language', 'bog'),
+    ('bog', 'bog'),
+    ('boh', 'boh'),
+    ('boma', 'boh'),
+    ('barbareño', 'boi'),
+    ('boi', 'boi'),
+    ('anjam', 'boj'),
+    ('boj', 'boj'),
+    ('bok', 'bok'),
+    ('bonjo', 'bok'),
+    ('bol', 'bol'),
+    ('bole', 'bol'),
+    ('berom', 'bom'),
+    ('bom', 'bom'),
+    ('bine', 'bon'),
+    ('bon', 'bon'),
+    ('boo', 'boo'),
+    ('tiemacèwè bozo', 'boo'),
+    ('bonkiman', 'bop'),
+    ('bop', 'bop'),
+    ('bogaya', 'boq'),
+    ('boq', 'boq'),
+    ('bor', 'bor'),
+    ('borôro', 'bor'),
+    ('bongo', 'bot'),
+    ('bot', 'bot'),
+    ('bondei', 'bou'),
+    ('bou', 'bou'),
+    ('bov', 'bov'),
+    ('tuwuli', 'bov'),
+    ('bow', 'bow'),
+    ('rema', 'bow'),
+    ('box', 'box'),
+    ('buamu', 'box'),
+    ('bodo', 'boy'),
+    ('bodo (central african republic)', 'boy'),
+    ('boy', 'boy'),
+    ('boz', 'boz'),
+    ('tiéyaxo bozo', 'boz'),
+    ('bpa', 'bpa'),
+    ('daakaka', 'bpa'),
+    ('bpc', 'bpc'),
+    ('mbuk', 'bpc'),
+    ('banda-banda', 'bpd'),
+    ('bpd', 'bpd'),
+    ('bauni', 'bpe'),
+    ('bpe', 'bpe'),
+    ('bonggo', 'bpg'),
+    ('bpg', 'bpg'),
+    ('botlikh', 'bph'),
+    ('bph', 'bph'),
+    ('bagupi', 'bpi'),
+    ('bpi', 'bpi'),
+    ('binji', 'bpj'),
+    ('bpj', 'bpj'),
+    ('bpk', 'bpk'),
+    ('orowe', 'bpk'),
+    ('bpl', 'bpl'),
+    ('broome pearling lugger pidgin', 'bpl'),
+    ('biyom', 'bpm'),
+    ('bpm', 'bpm'),
+    ('bpn', 'bpn'),
+    ('dzao min', 'bpn'),
+    ('anasi', 'bpo'),
+    ('bpo', 'bpo'),
+    ('bpp', 'bpp'),
+    ('kaure', 'bpp'),
+    ('banda malay', 'bpq'),
+    ('bpq', 'bpq'),
+    ('bpr', 'bpr'),
+    ('koronadal blaan', 'bpr'),
+    ('bps', 'bps'),
+    ('sarangani blaan', 'bps'),
+    ('barrow point', 'bpt'),
+    ('bpt', 'bpt'),
+    ('bongu', 'bpu'),
+    ('bpu', 'bpu'),
+    ('bian marind', 'bpv'),
+    ('bpv', 'bpv'),
+    ('bo (papua new guinea)', 'bpw'),
+    ('bpw', 'bpw'),
+    ('bpx', 'bpx'),
+    ('palya bareli', 'bpx'),
+    ('bishnupriya', 'bpy'),
+    ('bpy', 'bpy'),
+    ('bilba', 'bpz'),
+    ('bpz', 'bpz'),
+    ('bqa', 'bqa'),
+    ('tchumbuli', 'bqa'),
+    ('bagusa', 'bqb'),
+    ('bqb', 'bqb'),
+    ('boko (benin)', 'bqc'),
+    ('bqc', 'bqc'),
+    ('bqd', 'bqd'),
+    ('bung', 'bqd'),
+    ('baga kaloum', 'bqf'),
+    ('bqf', 'bqf'),
+    ('bago-kusuntu', 'bqg'),
+    ('bqg', 'bqg'),
+    ('baima', 'bqh'),
+    ('bqh', 'bqh'),
+    ('bakhtiari', 'bqi'),
+    ('bqi', 'bqi'),
+    ('bandial', 'bqj'),
+    ('bqj', 'bqj'),
+    ('banda-mbrès', 'bqk'),
+    ('bqk', 'bqk'),
+    ('bilakura', 'bql'),
+    ('bql', 'bql'),
+    ('bqm', 'bqm'),
+    ('wumboko', 'bqm'),
+    ('bqn', 'bqn'),
+    ('bulgarian sign language', 'bqn'),
+    ('balo', 'bqo'),
+    ('bqo', 'bqo'),
+    ('bqp', 'bqp'),
+    ('busa', 'bqp'),
+    ('biritai', 'bqq'),
+    ('bqq', 'bqq'),
+    ('bqr', 'bqr'),
+    ('burusu', 'bqr'),
+    ('bosngun', 'bqs'),
+    ('bqs', 'bqs'),
+    ('bamukumbit', 'bqt'),
+    ('bqt', 'bqt'),
+    ('boguru', 'bqu'),
+    ('bqu', 'bqu'),
+    ('bqv', 'bqv'),
+    ('koro wachi', 'bqv'),
+    ('bqw', 'bqw'),
+    ('buru', 'bqw'),
+    ('buru (nigeria)', 'bqw'),
+    ('baangi', 'bqx'),
+    ('bqx', 'bqx'),
+    ('bengkala sign language', 'bqy'),
+    ('bqy', 'bqy'),
+    ('bakaka', 'bqz'),
+    ('bqz', 'bqz'),
+    ('br', 'br'),
+    ('bre', 'br'),
+    ('breton', 'br'),
+    ('bra', 'bra'),
+    ('braj', 'bra'),
+    ('brao', 'brb'),
+    ('brb', 'brb'),
+    ('berbice creole dutch', 'brc'),
+    ('brc', 'brc'),
+    ('baraamu', 'brd'),
+    ('brd', 'brd'),
+    ('bira', 'brf'),
+    ('brf', 'brf'),
+    ('baure', 'brg'),
+    ('brg', 'brg'),
+    ('brahui', 'brh'),
+    ('brh', 'brh'),
+    ('bri', 'bri'),
+    ('mokpwe', 'bri'),
+    ('bieria', 'brj'),
+    ('brj', 'brj'),
+    ('birked', 'brk'),
+    ('brk', 'brk'),
+    ('birwa', 'brl'),
+    ('brl', 'brl'),
+    ('barambu', 'brm'),
+    ('brm', 'brm'),
+    ('boruca', 'brn'),
+    ('brn', 'brn'),
+    ('bro', 'bro'),
+    ('brokkat', 'bro'),
+    ('barapasi', 'brp'),
+    ('brp', 'brp'),
+    ('breri', 'brq'),
+    ('brq', 'brq'),
+    ('birao', 'brr'),
+    ('brr', 'brr'),
+    ('baras', 'brs'),
+    ('brs', 'brs'),
+    ('bitare', 'brt'),
+    ('brt', 'brt'),
+    ('bru', 'bru'),
+    ('eastern bru', 'bru'),
+    ('brv', 'brv'),
+    ('western bru', 'brv'),
+    ('bellari', 'brw'),
+    ('brw', 'brw'),
+    ('bodo (india)', 'brx'),
+    ('brx', 'brx'),
+    ('bry', 'bry'),
+    ('burui', 'bry'),
+    ('bilbil', 'brz'),
+    ('brz', 'brz'),
+    ('bos', 'bs'),
+    ('bosnian', 'bs'),
+    ('bs', 'bs'),
+    ('abinomn', 'bsa'),
+    ('bsa', 'bsa'),
+    ('brunei bisaya', 'bsb'),
+    ('bsb', 'bsb'),
+    ('bassari', 'bsc'),
+    ('bsc', 'bsc'),
+    ('bse', 'bse'),
+    ('wushi', 'bse'),
+    ('bauchi', 'bsf'),
+    ('bsf', 'bsf'),
+    ('bashkardi', 'bsg'),
+    ('bsg', 'bsg'),
+    ('bsh', 'bsh'),
+    ('kati', 'bsh'),
+    ('bassossi', 'bsi'),
+    ('bsi', 'bsi'),
+    ('bangwinji', 'bsj'),
+    ('bsj', 'bsj'),
+    ('bsk', 'bsk'),
+    ('burushaski', 'bsk'),
+    ('basa-gumna', 'bsl'),
+    ('bsl', 'bsl'),
+    ('bsm', 'bsm'),
+    ('busami', 'bsm'),
+    ('barasana-eduria', 'bsn'),
+    ('bsn', 'bsn'),
+    ('bso', 'bso'),
+    ('buso', 'bso'),
+    ('baga sitemu', 'bsp'),
+    ('bsp', 'bsp'),
+    ('bassa', 'bsq'),
+    ('bsq', 'bsq'),
+    ('bassa-kontagora', 'bsr'),
+    ('bsr', 'bsr'),
+    ('akoose', 'bss'),
+    ('bss', 'bss'),
+    ('basketo', 'bst'),
+    ('bst', 'bst'),
+    ('bahonsuai', 'bsu'),
+    ('bsu', 'bsu'),
+    ('baga sobané', 'bsv'),
+    ('bsv', 'bsv'),
+    ('baiso', 'bsw'),
+    ('bsw', 'bsw'),
+    ('bsx', 'bsx'),
+    ('yangkam', 'bsx'),
+    ('bsy', 'bsy'),
+    ('sabah bisaya', 'bsy'),
+    ('bata', 'bta'),
+    ('bta', 'bta'),
+    ('bati', 'btc'),
+    ('bati (cameroon)', 'btc'),
+    ('btc', 'btc'),
+    ('batak dairi', 'btd'),
+    ('btd', 'btd'),
+    ('bte', 'bte'),
+    ('gamo-ningi', 'bte'),
+    ('birgit', 'btf'),
+    ('btf', 'btf'),
+    ('btg', 'btg'),
+    ('gagnoa bété', 'btg'),
+    ('biatah bidayuh', 'bth'),
+    ('bth', 'bth'),
+    ('bti', 'bti'),
+    ('burate', 'bti'),
+    ('bacanese malay', 'btj'),
+    ('btj', 'btj'),
+    ('batak mandailing', 'btm'),
+    ('btm', 'btm'),
+    ('btn', 'btn'),
+    ('ratagnon', 'btn'),
+    ('bto', 'bto'),
+    ('rinconada bikol', 'bto'),
+    ('btp', 'btp'),
+    ('budibud', 'btp'),
+    ('batek', 'btq'),
+    ('btq', 'btq'),
+    ('baetora', 'btr'),
+    ('btr', 'btr'),
+    ('batak simalungun', 'bts'),
+    ('bts', 'bts'),
+    ('bete-bendi', 'btt'),
+    ('btt', 'btt'),
+    ('batu', 'btu'),
+    ('btu', 'btu'),
+    ('bateri', 'btv'),
+    ('btv', 'btv'),
+    ('btw', 'btw'),
+    ('butuanon', 'btw'),
+    ('batak karo', 'btx'),
+    ('btx', 'btx'),
+    ('bobot', 'bty'),
+    ('bty', 'bty'),
+    ('batak alas-kluet', 'btz'),
+    ('btz', 'btz'),
+    ('bua', 'bua'),
+    ('buriat', 'bua'),
+    ('bub', 'bub'),
+    ('buc', 'buc'),
+    ('bushi', 'buc'),
+    ('bud', 'bud'),
+    ('ntcham', 'bud'),
+    ('beothuk', 'bue'),
+    ('bue', 'bue'),
+    ('buf', 'buf'),
+    ('bushoong', 'buf'),
+    ('bug', 'bug'),
+    ('buginese', 'bug'),
+    ('buh', 'buh'),
+    ('younuo bunu', 'buh'),
+    ('bongili', 'bui'),
+    ('bui', 'bui'),
+    ('basa-gurmana', 'buj'),
+    ('buj', 'buj'),
+    ('bugawac', 'buk'),
+    ('buk', 'buk'),
+    ('bulu (cameroon)', 'bum'),
+    ('bun', 'bun'),
+    ('sherbro', 'bun'),
+    ('buo', 'buo'),
+    ('terei', 'buo'),
+    ('bup', 'bup'),
+    ('busoa', 'bup'),
+    ('brem', 'buq'),
+    ('buq', 'buq'),
+    ('bokobaru', 'bus'),
+    ('bus', 'bus'),
+    ('bungain', 'but'),
+    ('but', 'but'),
+    ('budu', 'buu'),
+    ('buu', 'buu'),
+    ('buv', 'buv'),
+    ('bubi', 'buw'),
+    ('buw', 'buw'),
+    ('boghom', 'bux'),
+    ('bux', 'bux'),
+    ('bullom so', 'buy'),
+    ('buy', 'buy'),
+    ('bukwen', 'buz'),
+    ('buz', 'buz'),
+    ('barein', 'bva'),
+    ('bva', 'bva'),
+    ('bube', 'bvb'),
+    ('bvb', 'bvb'),
+    ('baelelea', 'bvc'),
+    ('bvc', 'bvc'),
+    ('baeggu', 'bvd'),
+    ('bvd', 'bvd'),
+    ('berau malay', 'bve'),
+    ('bve', 'bve'),
+    ('boor', 'bvf'),
+    ('bvf', 'bvf'),
+    ('bonkeng', 'bvg'),
+    ('bvg', 'bvg'),
+    ('bure', 'bvh'),
+    ('bvh', 'bvh'),
+    ('belanda viri', 'bvi'),
+    ('bvi', 'bvi'),
+    ('baan', 'bvj'),
+    ('bvj', 'bvj'),
+    ('bukat', 'bvk'),
+    ('bvk', 'bvk'),
+    ('bolivian sign language', 'bvl'),
+    ('bvl', 'bvl'),
+    ('bamunka', 'bvm'),
+    ('bvm', 'bvm'),
+    ('buna', 'bvn'),
+    ('bvn', 'bvn'),
+    ('bolgo', 'bvo'),
+    ('bvo', 'bvo'),
+    ('bumang', 'bvp'),
+    ('bvp', 'bvp'),
+    ('birri', 'bvq'),
+    ('bvq', 'bvq'),
+    ('burarra', 'bvr'),
+    ('bvr', 'bvr'),
+    ('bati (indonesia)', 'bvt'),
+    ('bvt', 'bvt'),
+    ('bukit malay', 'bvu'),
+    ('bvu', 'bvu'),
+    ('baniva', 'bvv'),
+    ('bvv', 'bvv'),
+    ('boga', 'bvw'),
+    ('bvw', 'bvw'),
+    ('bvx', 'bvx'),
+    ('dibole', 'bvx'),
+    ('baybayanon', 'bvy'),
+    ('bvy', 'bvy'),
+    ('bauzi', 'bvz'),
+    ('bvz', 'bvz'),
+    ('bwa', 'bwa'),
+    ('bwatoo', 'bwa'),
+    ('bwb', 'bwb'),
+    ('namosi-naitasiri-serua', 'bwb'),
+    ('bwc', 'bwc'),
+    ('bwile', 'bwc'),
+    ('bwaidoka', 'bwd'),
+    ('bwd', 'bwd'),
+    ('bwe', 'bwe'),
+    ('bwe karen', 'bwe'),
+    ('boselewa', 'bwf'),
+    ('bwf', 'bwf'),
+    ('barwe', 'bwg'),
+    ('bwg', 'bwg'),
+    ('bishuo', 'bwh'),
+    ('bwh', 'bwh'),
+    ('baniwa', 'bwi'),
+    ('bwi', 'bwi'),
+    ('bwj', 'bwj'),
+    ('láá láá bwamu', 'bwj'),
+    ('bauwaki', 'bwk'),
+    ('bwk', 'bwk'),
+    ('bwela', 'bwl'),
+    ('bwl', 'bwl'),
+    ('biwat', 'bwm'),
+    ('bwm', 'bwm'),
+    ('bwn', 'bwn'),
+    ('wunai bunu', 'bwn'),
+    ('boro', 'bwo'),
+    ('boro (ethiopia)', 'bwo'),
+    ('bwo', 'bwo'),
+    ('bwp', 'bwp'),
+    ('mandobo bawah', 'bwp'),
+    ('bwq', 'bwq'),
+    ('southern bobo madaré', 'bwq'),
+    ('bura-pabir', 'bwr'),
+    ('bwr', 'bwr'),
+    ('bomboma', 'bws'),
+    ('bws', 'bws'),
+    ('bafaw-balong', 'bwt'),
+    ('bwt', 'bwt'),
+    ('buli', 'bwu'),
+    ('buli (ghana)', 'bwu'),
+    ('bwu', 'bwu'),
+    ('bww', 'bww'),
+    ('bu-nao bunu', 'bwx'),
+    ('bwx', 'bwx'),
+    ('bwy', 'bwy'),
+    ('cwi bwamu', 'bwy'),
+    ('bwisi', 'bwz'),
+    ('bwz', 'bwz'),
+    ('bxa', 'bxa'),
+    ('tairaha', 'bxa'),
+    ('belanda bor', 'bxb'),
+    ('bxb', 'bxb'),
+    ('bxc', 'bxc'),
+    ('molengue', 'bxc'),
+    ('bxd', 'bxd'),
+    ('pela', 'bxd'),
+    ('birale', 'bxe'),
+    ('bxe', 'bxe'),
+    ('bilur', 'bxf'),
+    ('bxf', 'bxf'),
+    ('bangala', 'bxg'),
+    ('bxg', 'bxg'),
+    ('buhutu', 'bxh'),
+    ('bxh', 'bxh'),
+    ('bxi', 'bxi'),
+    ('pirlatapa', 'bxi'),
+    ('bayungu', 'bxj'),
+    ('bxj', 'bxj'),
+    ('bukusu', 'bxk'),
+    ('bxk', 'bxk'),
+    ('bxl', 'bxl'),
+    ('jalkunan', 'bxl'),
+    ('bxm', 'bxm'),
+    ('mongolia buriat', 'bxm'),
+    ('burduna', 'bxn'),
+    ('bxn', 'bxn'),
+    ('barikanchi', 'bxo'),
+    ('bxo', 'bxo'),
+    ('bebil', 'bxp'),
+    ('bxp', 'bxp'),
+    ('beele', 'bxq'),
+    ('bxq', 'bxq'),
+    ('bxr', 'bxr'),
+    ('russia buriat', 'bxr'),
+    ('busam', 'bxs'),
+    ('bxs', 'bxs'),
+    ('bxu', 'bxu'),
+    ('china buriat', 'bxu'),
+    ('berakou', 'bxv'),
+    ('bxv', 'bxv'),
+    ('bankagooma', 'bxw'),
+    ('bxw', 'bxw'),
+    ('binahari', 'bxz'),
+    ('bxz', 'bxz'),
+    ('batak', 'bya'),
+    ('bya', 'bya'),
+    ('bikya', 'byb'),
+    ('byb', 'byb'),
+    ('byc', 'byc'),
+    ('ubaghara', 'byc'),
+    ('benyadu''', 'byd'),
+    ('byd', 'byd'),
+    ('bye', 'bye'),
+    ('pouye', 'bye'),
+    ('bete', 'byf'),
+    ('byf', 'byf'),
+    ('baygo', 'byg'),
+    ('byg', 'byg'),
+    ('bhujel', 'byh'),
+    ('byh', 'byh'),
+    ('buyu', 'byi'),
+    ('byi', 'byi'),
+    ('bina (nigeria)', 'byj'),
+    ('byj', 'byj'),
+    ('biao', 'byk'),
+    ('byk', 'byk'),
+    ('bayono', 'byl'),
+    ('byl', 'byl'),
+    ('bidjara', 'bym'),
+    ('bym', 'bym'),
+    ('bilin', 'byn'),
+    ('blin', 'byn'),
+    ('byn', 'byn'),
+    ('biyo', 'byo'),
+    ('byo', 'byo'),
+    ('bumaji', 'byp'),
+    ('byp', 'byp'),
+    ('basay', 'byq'),
+    ('byq', 'byq'),
+    ('baruya', 'byr'),
+    ('byr', 'byr'),
+    ('burak', 'bys'),
+    ('bys', 'bys'),
+    ('berti', 'byt'),
+    ('byt', 'byt'),
+    ('byv', 'byv'),
+    ('medumba', 'byv'),
+    ('belhariya', 'byw'),
+    ('byw', 'byw'),
+    ('byx', 'byx'),
+    ('qaqet', 'byx'),
+    ('banaro', 'byz'),
+    ('byz', 'byz'),
+    ('bandi', 'bza'),
+    ('bza', 'bza'),
+    ('andio', 'bzb'),
+    ('bzb', 'bzb'),
+    ('bzc', 'bzc'),
+    ('southern betsimisaraka malagasy', 'bzc'),
+    ('bribri', 'bzd'),
+    ('bzd', 'bzd'),
+    ('bze', 'bze'),
+    ('jenaama bozo', 'bze'),
+    ('boikin', 'bzf'),
+    ('bzf', 'bzf'),
+    ('babuza', 'bzg'),
+    ('bzg', 'bzg'),
+    ('bzh', 'bzh'),
+    ('mapos buang', 'bzh'),
+    ('bisu', 'bzi'),
+    ('bzi', 'bzi'),
+    ('belize kriol english', 'bzj'),
+    ('bzj', 'bzj'),
+    ('bzk', 'bzk'),
+    ('nicaragua creole english', 'bzk'),
+    ('boano', 'bzl'),
+    ('boano (sulawesi)', 'bzl'),
+    ('bzl', 'bzl'),
+    ('bolondo', 'bzm'),
+    ('bzm', 'bzm'),
+    ('boano (maluku)', 'bzn'),
+    ('bzn', 'bzn'),
+    ('bozaba', 'bzo'),
+    ('bzo', 'bzo'),
+    ('bzp', 'bzp'),
+    ('kemberano', 'bzp'),
+    ('buli (indonesia)', 'bzq'),
+    ('bzq', 'bzq'),
+    ('biri', 'bzr'),
+    ('bzr', 'bzr'),
+    ('brazilian sign language', 'bzs'),
+    ('bzs', 'bzs'),
+    ('brithenig', 'bzt'),
+    ('bzt', 'bzt'),
+    ('burmeso', 'bzu'),
+    ('bzu', 'bzu'),
+    ('bzv', 'bzv'),
+    ('naami', 'bzv'),
+    ('basa (nigeria)', 'bzw'),
+    ('bzw', 'bzw'),
+    ('bzx', 'bzx'),
+    ('kɛlɛngaxo bozo', 'bzx'),
+    ('bzy', 'bzy'),
+    ('obanliku', 'bzy'),
+    ('bzz', 'bzz'),
+    ('evant', 'bzz'),
+    ('ca', 'ca'),
+    ('cat', 'ca'),
+    ('catalan', 'ca'),
+    ('valencian', 'ca'),
+    ('caa', 'caa'),
+    ('chortí', 'caa'),
+    ('cab', 'cab'),
+    ('garifuna', 'cab'),
+    ('cac', 'cac'),
+    ('chuj', 'cac'),
+    ('cad', 'cad'),
+    ('caddo', 'cad'),
+    ('cae', 'cae'),
+    ('lehar', 'cae'),
+    ('caf', 'caf'),
+    ('southern carrier', 'caf'),
+    ('cag', 'cag'),
+    ('nivaclé', 'cag'),
+    ('cah', 'cah'),
+    ('cahuarano', 'cah'),
+    ('caj', 'caj'),
+    ('chané', 'caj'),
+    ('cak', 'cak'),
+    ('kaqchikel', 'cak'),
+    ('cal', 'cal'),
+    ('carolinian', 'cal'),
+    ('cam', 'cam'),
+    ('cemuhî', 'cam'),
+    ('can', 'can'),
+    ('chambri', 'can'),
+    ('cao', 'cao'),
+    ('chácobo', 'cao'),
+    ('cap', 'cap'),
+    ('chipaya', 'cap'),
+    ('caq', 'caq'),
+    ('car nicobarese', 'caq'),
+    ('car', 'car'),
+    ('galibi carib', 'car'),
+    ('cas', 'cas'),
+    ('tsimané', 'cas'),
+    ('cav', 'cav'),
+    ('cavineña', 'cav'),
+    ('callawalla', 'caw'),
+    ('caw', 'caw'),
+    ('cax', 'cax'),
+    ('chiquitano', 'cax'),
+    ('cay', 'cay'),
+    ('cayuga', 'cay'),
+    ('canichana', 'caz'),
+    ('caz', 'caz'),
+    ('cabiyarí', 'cbb'),
+    ('cbb', 'cbb'),
+    ('carapana', 'cbc'),
+    ('cbc', 'cbc'),
+    ('carijona', 'cbd'),
+    ('cbd', 'cbd'),
+    ('cbg', 'cbg'),
+    ('chimila', 'cbg'),
+    ('cbi', 'cbi'),
+    ('chachi', 'cbi'),
+    ('cbj', 'cbj'),
+    ('ede cabe', 'cbj'),
+    ('cbk', 'cbk'),
+    ('chavacano', 'cbk'),
+    ('bualkhaw chin', 'cbl'),
+    ('cbl', 'cbl'),
+    ('cbn', 'cbn'),
+    ('nyahkur', 'cbn'),
+    ('cbo', 'cbo'),
+    ('izora', 'cbo'),
+    ('cbq', 'cbq'),
+    ('tsucuba', 'cbq'),
+    ('cashibo-cacataibo', 'cbr'),
+    ('cbr', 'cbr'),
+    ('cashinahua', 'cbs'),
+    ('cbs', 'cbs'),
+    ('cbt', 'cbt'),
+    ('chayahuita', 'cbt'),
+    ('candoshi-shapra', 'cbu'),
+    ('cbu', 'cbu'),
+    ('cacua', 'cbv'),
+    ('cbv', 'cbv'),
+    ('cbw', 'cbw'),
+    ('kinabalian', 'cbw'),
+    ('carabayo', 'cby'),
+    ('cby', 'cby'),
+    ('ccc', 'ccc'),
+    ('chamicuro', 'ccc'),
+    ('cafundo creole', 'ccd'),
+    ('ccd', 'ccd'),
+    ('cce', 'cce'),
+    ('chopi', 'cce'),
+    ('ccg', 'ccg'),
+    ('samba daka', 'ccg'),
+    ('atsam', 'cch'),
+    ('cch', 'cch'),
+    ('ccj', 'ccj'),
+    ('kasanga', 'ccj'),
+    ('ccl', 'ccl'),
+    ('cutchi-swahili', 'ccl'),
+    ('ccm', 'ccm'),
+    ('malaccan creole malay', 'ccm'),
+    ('cco', 'cco'),
+    ('comaltepec chinantec', 'cco'),
+    ('ccp', 'ccp'),
+    ('chakma', 'ccp'),
+    ('cacaopera', 'ccr'),
+    ('ccr', 'ccr'),
+    ('cda', 'cda'),
+    ('choni', 'cda'),
+    ('cde', 'cde'),
+    ('chenchu', 'cde'),
+    ('cdf', 'cdf'),
+    ('chiru', 'cdf'),
+    ('cdh', 'cdh'),
+    ('chambeali', 'cdh'),
+    ('cdi', 'cdi'),
+    ('chodri', 'cdi'),
+    ('cdj', 'cdj'),
+    ('churahi', 'cdj'),
+    ('cdm', 'cdm'),
+    ('chepang', 'cdm'),
+    ('cdn', 'cdn'),
+    ('chaudangsi', 'cdn'),
+    ('cdo', 'cdo'),
+    ('min dong chinese', 'cdo'),
+    ('cdr', 'cdr'),
+    ('cinda-regi-tiyal', 'cdr'),
+    ('cds', 'cds'),
+    ('chadian sign language', 'cds'),
+    ('cdy', 'cdy'),
+    ('chadong', 'cdy'),
+    ('cdz', 'cdz'),
+    ('koda', 'cdz'),
+    ('ce', 'ce'),
+    ('che', 'ce'),
+    ('chechen', 'ce'),
+    ('cea', 'cea'),
+    ('lower chehalis', 'cea'),
+    ('ceb', 'ceb'),
+    ('cebuano', 'ceb'),
+    ('ceg', 'ceg'),
+    ('chamacoco', 'ceg'),
+    ('cek', 'cek'),
+    ('eastern khumi chin', 'cek'),
+    ('cen', 'cen'),
+    ('centúúm', 'cet'),
+    ('cet', 'cet'),
+    ('cey', 'cey'),
+    ('ekai chin', 'cey'),
+    ('cfa', 'cfa'),
+    ('dijim-bwilim', 'cfa'),
+    ('cara', 'cfd'),
+    ('cfd', 'cfd'),
+    ('cfg', 'cfg'),
+    ('como karim', 'cfg'),
+    ('cfm', 'cfm'),
+    ('falam chin', 'cfm'),
+    ('cga', 'cga'),
+    ('changriwa', 'cga'),
+    ('cgc', 'cgc'),
+    ('kagayanen', 'cgc'),
+    ('cgg', 'cgg'),
+    ('chiga', 'cgg'),
+    ('cgk', 'cgk'),
+    ('chocangacakha', 'cgk'),
+    ('ch', 'ch'),
+    ('cha', 'ch'),
+    ('chamorro', 'ch'),
+    ('chb', 'chb'),
+    ('chibcha', 'chb'),
+    ('catawba', 'chc'),
+    ('chc', 'chc'),
+    ('chd', 'chd'),
+    ('highland oaxaca chontal', 'chd'),
+    ('chf', 'chf'),
+    ('tabasco chontal', 'chf'),
+    ('chagatai', 'chg'),
+    ('chg', 'chg'),
+    ('chh', 'chh'),
+    ('chinook', 'chh'),
+    ('chj', 'chj'),
+    ('ojitlán chinantec', 'chj'),
+    ('chk', 'chk'),
+    ('chuukese', 'chk'),
+    ('cahuilla', 'chl'),
+    ('chl', 'chl'),
+    ('chm', 'chm'),
+    ('mari', 'chm'),
+    ('mari (russia)', 'chm'),
+    ('chinook jargon', 'chn'),
+    ('chn', 'chn'),
+    ('cho', 'cho'),
+    ('choctaw', 'cho'),
+    ('chipewyan', 'chp'),
+    ('chp', 'chp'),
+    ('dene suline', 'chp'),
+    ('chq', 'chq'),
+    ('quiotepec chinantec', 'chq'),
+    ('cherokee', 'chr'),
+    ('chr', 'chr'),
+    ('cholón', 'cht'),
+    ('cht', 'cht'),
+    ('chuwabu', 'chw'),
+    ('chw', 'chw'),
+    ('chantyal', 'chx'),
+    ('chx', 'chx'),
+    ('cheyenne', 'chy'),
+    ('chy', 'chy'),
+    ('chz', 'chz'),
+    ('ozumacín chinantec', 'chz'),
+    ('cia', 'cia'),
+    ('cia-cia', 'cia'),
+    ('ci gbe', 'cib'),
+    ('cib', 'cib'),
+    ('chickasaw', 'cic'),
+    ('cic', 'cic'),
+    ('chimariko', 'cid'),
+    ('cid', 'cid'),
+    ('cie', 'cie'),
+    ('cineni', 'cie'),
+    ('chinali', 'cih'),
+    ('cih', 'cih'),
+    ('chitkuli kinnauri', 'cik'),
+    ('cik', 'cik'),
+    ('cim', 'cim'),
+    ('cimbrian', 'cim'),
+    ('cin', 'cin'),
+    ('cinta larga', 'cin'),
+    ('chiapanec', 'cip'),
+    ('cip', 'cip'),
+    ('cir', 'cir'),
+    ('tiri', 'cir'),
+    ('chippewa', 'ciw'),
+    ('ciw', 'ciw'),
+    ('chaima', 'ciy'),
+    ('ciy', 'ciy'),
+    ('cja', 'cja'),
+    ('western cham', 'cja'),
+    ('chru', 'cje'),
+    ('cje', 'cje'),
+    ('cjh', 'cjh'),
+    ('upper chehalis', 'cjh'),
+    ('chamalal', 'cji'),
+    ('cji', 'cji'),
+    ('chokwe', 'cjk'),
+    ('cjk', 'cjk'),
+    ('cjm', 'cjm'),
+    ('eastern cham', 'cjm'),
+    ('chenapian', 'cjn'),
+    ('cjn', 'cjn'),
+    ('ashéninka pajonal', 'cjo'),
+    ('cjo', 'cjo'),
+    ('cabécar', 'cjp'),
+    ('cjp', 'cjp'),
+    ('cjs', 'cjs'),
+    ('shor', 'cjs'),
+    ('chuave', 'cjv'),
+    ('cjv', 'cjv'),
+    ('cjy', 'cjy'),
+    ('jinyu chinese', 'cjy'),
+    ('central kurdish', 'ckb'),
+    ('ckb', 'ckb'),
+    ('chak', 'ckh'),
+    ('ckh', 'ckh'),
+    ('cibak', 'ckl'),
+    ('ckl', 'ckl'),
+    ('chakavian', 'ckm'),
+    ('ckm', 'ckm'),
+    ('ckn', 'ckn'),
+    ('kaang chin', 'ckn'),
+    ('anufo', 'cko'),
+    ('cko', 'cko'),
+    ('ckq', 'ckq'),
+    ('kajakse', 'ckq'),
+    ('ckr', 'ckr'),
+    ('kairak', 'ckr'),
+    ('cks', 'cks'),
+    ('tayo', 'cks'),
+    ('chukot', 'ckt'),
+    ('ckt', 'ckt'),
+    ('cku', 'cku'),
+    ('koasati', 'cku'),
+    ('ckv', 'ckv'),
+    ('kavalan', 'ckv'),
+    ('caka', 'ckx'),
+    ('ckx', 'ckx'),
+    ('cakfem-mushere', 'cky'),
+    ('cky', 'cky'),
+    ('cakchiquel-quiché mixed language', 'ckz'),
+    ('ckz', 'ckz'),
+    ('cla', 'cla'),
+    ('chilcotin', 'clc'),
+    ('clc', 'clc'),
+    ('chaldean neo-aramaic', 'cld'),
+    ('cld', 'cld'),
+    ('cle', 'cle'),
+    ('lealao chinantec', 'cle'),
+    ('chilisso', 'clh'),
+    ('clh', 'clh'),
+    ('chakali', 'cli'),
+    ('cli', 'cli'),
+    ('clj', 'clj'),
+    ('laitu chin', 'clj'),
+    ('clk', 'clk'),
+    ('idu-mishmi', 'clk'),
+    ('chala', 'cll'),
+    ('cll', 'cll'),
+    ('clallam', 'clm'),
+    ('clm', 'clm'),
+    ('clo', 'clo'),
+    ('lowland oaxaca chontal', 'clo'),
+    ('clt', 'clt'),
+    ('lautu chin', 'clt'),
+    ('caluyanun', 'clu'),
+    ('clu', 'clu'),
+    ('chulym', 'clw'),
+    ('clw', 'clw'),
+    ('cly', 'cly'),
+    ('eastern highland chatino', 'cly'),
+    ('cma', 'cma'),
+    ('maa', 'cma'),
+    ('cerma', 'cme'),
+    ('cme', 'cme'),
+    ('classical mongolian', 'cmg'),
+    ('cmg', 'cmg'),
+    ('cmi', 'cmi'),
+    ('emberá-chamí', 'cmi'),
+    ('campalagian', 'cml'),
+    ('cml', 'cml'),
+    ('cmm', 'cmm'),
+    ('michigamea', 'cmm'),
+    ('cmn', 'cmn'),
+    ('mandarin chinese', 'cmn'),
+    ('central mnong', 'cmo'),
+    ('cmo', 'cmo'),
+    ('cmr', 'cmr'),
+    ('mro-khimi chin', 'cmr'),
+    ('cms', 'cms'),
+    ('messapic', 'cms'),
+    ('camtho', 'cmt'),
+    ('cmt', 'cmt'),
+    ('changthang', 'cna'),
+    ('cna', 'cna'),
+    ('chinbon chin', 'cnb'),
+    ('cnb', 'cnb'),
+    ('cnc', 'cnc'),
+    ('côông', 'cnc'),
+    ('cng', 'cng'),
+    ('northern qiang', 'cng'),
+    ('cnh', 'cnh'),
+    ('hakha chin', 'cnh'),
+    ('asháninka', 'cni'),
+    ('cni', 'cni'),
+    ('cnk', 'cnk'),
+    ('khumi chin', 'cnk'),
+    ('cnl', 'cnl'),
+    ('lalana chinantec', 'cnl'),
+    ('cno', 'cno'),
+    ('con', 'cno'),
+    ('cnp', 'cnp'),
+    ('northern ping chinese', 'cnp'),
+    ('chung', 'cnq'),
+    ('cnq', 'cnq'),
+    ('cnr', 'cnr'),
+    ('montenegrin', 'cnr'),
+    ('central asmat', 'cns'),
+    ('cns', 'cns'),
+    ('cnt', 'cnt'),
+    ('tepetotutla chinantec', 'cnt'),
+    ('chenoua', 'cnu'),
+    ('cnu', 'cnu'),
+    ('cnw', 'cnw'),
+    ('ngawn chin', 'cnw'),
+    ('cnx', 'cnx'),
+    ('middle cornish', 'cnx'),
+    ('co', 'co'),
+    ('corsican', 'co'),
+    ('cos', 'co'),
+    ('coa', 'coa'),
+    ('cocos islands malay', 'coa'),
+    ('chicomuceltec', 'cob'),
+    ('cob', 'cob'),
+    ('coc', 'coc'),
+    ('cocopa', 'coc'),
+    ('cocama-cocamilla', 'cod'),
+    ('cod', 'cod'),
+    ('coe', 'coe'),
+    ('koreguaje', 'coe'),
+    ('cof', 'cof'),
+    ('colorado', 'cof'),
+    ('chong', 'cog'),
+    ('cog', 'cog'),
+    ('chonyi-dzihana-kauma', 'coh'),
+    ('coh', 'coh'),
+    ('cochimi', 'coj'),
+    ('coj', 'coj'),
+    ('cok', 'cok'),
+    ('santa teresa cora', 'cok'),
+    ('col', 'col'),
+    ('columbia-wenatchi', 'col'),
+    ('com', 'com'),
+    ('comanche', 'com'),
+    ('cofán', 'con'),
+    ('comox', 'coo'),
+    ('coo', 'coo'),
+    ('cop', 'cop'),
+    ('coptic', 'cop'),
+    ('coq', 'coq'),
+    ('coquille', 'coq'),
+    ('caquinte', 'cot'),
+    ('cot', 'cot'),
+    ('cou', 'cou'),
+    ('wamey', 'cou'),
+    ('cao miao', 'cov'),
+    ('cov', 'cov'),
+    ('cow', 'cow'),
+    ('cowlitz', 'cow'),
+    ('cox', 'cox'),
+    ('nanti', 'cox'),
+    ('chochotec', 'coz'),
+    ('coz', 'coz'),
+    ('cpa', 'cpa'),
+    ('palantla chinantec', 'cpa'),
+    ('cpb', 'cpb'),
+    ('ucayali-yurúa ashéninka', 'cpb'),
+    ('ajyíninka apurucayali', 'cpc'),
+    ('cpc', 'cpc'),
+    ('cappadocian greek', 'cpg'),
+    ('cpg', 'cpg'),
+    ('chinese pidgin english', 'cpi'),
+    ('cpi', 'cpi'),
+    ('cherepon', 'cpn'),
+    ('cpn', 'cpn'),
+    ('cpo', 'cpo'),
+    ('kpeego', 'cpo'),
+    ('capiznon', 'cps'),
+    ('cps', 'cps'),
+    ('cpu', 'cpu'),
+    ('pichis ashéninka', 'cpu'),
+    ('cpx', 'cpx'),
+    ('pu-xian chinese', 'cpx'),
+    ('cpy', 'cpy'),
+    ('south ucayali ashéninka', 'cpy'),
+    ('chuanqiandian cluster miao', 'cqd'),
+    ('cqd', 'cqd'),
+    ('cr', 'cr'),
+    ('cre', 'cr'),
+    ('cree', 'cr'),
+    ('chara', 'cra'),
+    ('cra', 'cra'),
+    ('crb', 'crb'),
+    ('island carib', 'crb'),
+    ('crc', 'crc'),
+    ('lonwolwol', 'crc'),
+    ('coeur d''alene', 'crd'),
+    ('crd', 'crd'),
+    ('caramanta', 'crf'),
+    ('crf', 'crf'),
+    ('crg', 'crg'),
+    ('michif', 'crg'),
+    ('crh', 'crh'),
+    ('crimean tatar', 'crh'),
+    ('crimean turkish', 'crh'),
+    ('cri', 'cri'),
+    ('sãotomense', 'cri'),
+    ('crj', 'crj'),
+    ('southern east cree', 'crj'),
+    ('crk', 'crk'),
+    ('plains cree', 'crk'),
+    ('crl', 'crl'),
+    ('northern east cree', 'crl'),
+    ('crm', 'crm'),
+    ('moose cree', 'crm'),
+    ('crn', 'crn'),
+    ('el nayar cora', 'crn'),
+    ('cro', 'cro'),
+    ('crow', 'cro'),
+    ('crq', 'crq'),
+    ('iyo''wujwa chorote', 'crq'),
+    ('carolina algonquian', 'crr'),
+    ('crr', 'crr'),
+    ('crs', 'crs'),
+    ('seselwa creole french', 'crs'),
+    ('crt', 'crt'),
+    ('iyojwa''ja chorote', 'crt'),
+    ('chaura', 'crv'),
+    ('crv', 'crv'),
+    ('chrau', 'crw'),
+    ('crw', 'crw'),
+    ('carrier', 'crx'),
+    ('crx', 'crx'),
+    ('cori', 'cry'),
+    ('cry', 'cry'),
+    ('cruzeño', 'crz'),
+    ('crz', 'crz'),
+    ('ces', 'cs'),
+    ('cs', 'cs'),
+    ('cze', 'cs'),
+    ('czech', 'cs'),
+    ('chiltepec chinantec', 'csa'),
+    ('csa', 'csa'),
+    ('csb', 'csb'),
+    ('kashubian', 'csb'),
+    ('catalan sign language', 'csc'),
+    ('csc', 'csc'),
+    ('chiangmai sign language', 'csd'),
+    ('csd', 'csd'),
+    ('cse', 'cse'),
+    ('czech sign language', 'cse'),
+    ('csf', 'csf'),
+    ('cuba sign language', 'csf'),
+    ('chilean sign language', 'csg'),
+    ('csg', 'csg'),
+    ('asho chin', 'csh'),
+    ('csh', 'csh'),
+    ('coast miwok', 'csi'),
+    ('csi', 'csi'),
+    ('csj', 'csj'),
+    ('songlai chin', 'csj'),
+    ('csk', 'csk'),
+    ('jola-kasa', 'csk'),
+    ('chinese sign language', 'csl'),
+    ('csl', 'csl'),
+    ('central sierra miwok', 'csm'),
+    ('csm', 'csm'),
+    ('colombian sign language', 'csn'),
+    ('csn', 'csn'),
+    ('cso', 'cso'),
+    ('sochiapam chinantec', 'cso'),
+    ('csp', 'csp'),
+    ('southern ping chinese', 'csp'),
+    ('croatia sign language', 'csq'),
+    ('csq', 'csq'),
+    ('costa rican sign language', 'csr'),
+    ('csr', 'csr'),
+    ('css', 'css'),
+    ('southern ohlone', 'css'),
+    ('cst', 'cst'),
+    ('northern ohlone', 'cst'),
+    ('csv', 'csv'),
+    ('sumtu chin', 'csv'),
+    ('csw', 'csw'),
+    ('swampy cree', 'csw'),
+    ('cambodian sign language', 'csx'),
+    ('csx', 'csx'),
+    ('csy', 'csy'),
+    ('siyin chin', 'csy'),
+    ('coos', 'csz'),
+    ('csz', 'csz'),
+    ('cta', 'cta'),
+    ('tataltepec chatino', 'cta'),
+    ('chetco', 'ctc'),
+    ('ctc', 'ctc'),
+    ('ctd', 'ctd'),
+    ('tedim chin', 'ctd'),
+    ('cte', 'cte'),
+    ('tepinapa chinantec', 'cte'),
+    ('chittagonian', 'ctg'),
+    ('ctg', 'ctg'),
+    ('cth', 'cth'),
+    ('thaiphum chin', 'cth'),
+    ('ctl', 'ctl'),
+    ('tlacoatzintepec chinantec', 'ctl'),
+    ('chitimacha', 'ctm'),
+    ('ctm', 'ctm'),
+    ('chhintange', 'ctn'),
+    ('ctn', 'ctn'),
+    ('cto', 'cto'),
+    ('emberá-catío', 'cto'),
+    ('ctp', 'ctp'),
+    ('western highland chatino', 'ctp'),
+    ('cts', 'cts'),
+    ('northern catanduanes bikol', 'cts'),
+    ('ctt', 'ctt'),
+    ('wayanad chetti', 'ctt'),
+    ('chol', 'ctu'),
+    ('ctu', 'ctu'),
+    ('cty', 'cty'),
+    ('moundadan chetty', 'cty'),
+    ('ctz', 'ctz'),
+    ('zacatepec chatino', 'ctz'),
+    ('chu', 'cu'),
+    ('church slavic', 'cu'),
+    ('church slavonic', 'cu'),
+    ('cu', 'cu'),
+    ('old bulgarian', 'cu'),
+    ('old church slavonic', 'cu'),
+    ('old slavonic', 'cu'),
+    ('cua', 'cua'),
+    ('cub', 'cub'),
+    ('cubeo', 'cub'),
+    ('cuc', 'cuc'),
+    ('usila chinantec', 'cuc'),
+    ('chuka', 'cuh'),
+    ('cuh', 'cuh'),
+    ('cui', 'cui'),
+    ('cuiba', 'cui'),
+    ('cuj', 'cuj'),
+    ('mashco piro', 'cuj'),
+    ('cuk', 'cuk'),
+    ('san blas kuna', 'cuk'),
+    ('cul', 'cul'),
+    ('culina', 'cul'),
+    ('cumanagoto', 'cuo'),
+    ('cuo', 'cuo'),
+    ('cup', 'cup'),
+    ('cupeño', 'cup'),
+    ('cun', 'cuq'),
+    ('cuq', 'cuq'),
+    ('chhulung', 'cur'),
+    ('cur', 'cur'),
+    ('cut', 'cut'),
+    ('teutila cuicatec', 'cut'),
+    ('cuu', 'cuu'),
+    ('tai ya', 'cuu'),
+    ('cuv', 'cuv'),
+    ('cuvok', 'cuv'),
+    ('chukwa', 'cuw'),
+    ('cuw', 'cuw'),
+    ('cux', 'cux'),
+    ('tepeuxila cuicatec', 'cux'),
+    ('cuitlatec', 'cuy'),
+    ('cuy', 'cuy'),
+    ('chuvash', 'cv'),
+    ('chv', 'cv'),
+    ('cv', 'cv'),
+    ('chug', 'cvg'),
+    ('cvg', 'cvg'),
+    ('cvn', 'cvn'),
+    ('valle nacional chinantec', 'cvn'),
+    ('cwa', 'cwa'),
+    ('kabwa', 'cwa'),
+    ('cwb', 'cwb'),
+    ('maindo', 'cwb'),
+    ('cwd', 'cwd'),
+    ('woods cree', 'cwd'),
+    ('cwe', 'cwe'),
+    ('kwere', 'cwe'),
+    ('chewong', 'cwg'),
+    ('cwg', 'cwg'),
+    ('cwt', 'cwt'),
+    ('kuwaataay', 'cwt'),
+    ('cy', 'cy'),
+    ('cym', 'cy'),
+    ('wel', 'cy'),
+    ('welsh', 'cy'),
+    ('cya', 'cya'),
+    ('nopala chatino', 'cya'),
+    ('cayubaba', 'cyb'),
+    ('cyb', 'cyb'),
+    ('cuyonon', 'cyo'),
+    ('cyo', 'cyo'),
+    ('czh', 'czh'),
+    ('huizhou chinese', 'czh'),
+    ('czk', 'czk'),
+    ('knaanic', 'czk'),
+    ('czn', 'czn'),
+    ('zenzontepec chatino', 'czn'),
+    ('czo', 'czo'),
+    ('min zhong chinese', 'czo'),
+    ('czt', 'czt'),
+    ('zotung chin', 'czt'),
+    ('da', 'da'),
+    ('dan', 'da'),
+    ('danish', 'da'),
+    ('daa', 'daa'),
+    ('dangaléat', 'daa'),
+    ('dac', 'dac'),
+    ('dambi', 'dac'),
+    ('dad', 'dad'),
+    ('marik', 'dad'),
+    ('dae', 'dae'),
+    ('duupa', 'dae'),
+    ('dag', 'dag'),
+    ('dagbani', 'dag'),
+    ('dah', 'dah'),
+    ('gwahatike', 'dah'),
+    ('dai', 'dai'),
+    ('day', 'dai'),
+    ('daj', 'daj'),
+    ('dar fur daju', 'daj'),
+    ('dak', 'dak'),
+    ('dakota', 'dak'),
+    ('dahalo', 'dal'),
+    ('dal', 'dal'),
+    ('dam', 'dam'),
+    ('damakawa', 'dam'),
+    ('daai chin', 'dao'),
+    ('dao', 'dao'),
+    ('dandami maria', 'daq'),
+    ('daq', 'daq'),
+    ('dar', 'dar'),
+    ('dargwa', 'dar'),
+    ('daho-doo', 'das'),
+    ('das', 'das'),
+    ('dar sila daju', 'dau'),
+    ('dau', 'dau'),
+    ('dav', 'dav'),
+    ('taita', 'dav'),
+    ('davawenyo', 'daw'),
+    ('daw', 'daw'),
+    ('dax', 'dax'),
+    ('dayi', 'dax'),
+    ('daz', 'daz'),
+    ('bangime', 'dba'),
+    ('dba', 'dba'),
+    ('dbb', 'dbb'),
+    ('deno', 'dbb'),
+    ('dadiya', 'dbd'),
+    ('dbd', 'dbd'),
+    ('dabe', 'dbe'),
+    ('dbe', 'dbe'),
+    ('dbf', 'dbf'),
+    ('edopi', 'dbf'),
+    ('dbg', 'dbg'),
+    ('dogul dom dogon', 'dbg'),
+    ('dbi', 'dbi'),
+    ('doka', 'dbi'),
+    ('dbj', 'dbj'),
+    ('ida''an', 'dbj'),
+    ('dbl', 'dbl'),
+    ('dyirbal', 'dbl'),
+    ('dbm', 'dbm'),
+    ('duguri', 'dbm'),
+    ('dbn', 'dbn'),
+    ('duriankere', 'dbn'),
+    ('dbo', 'dbo'),
+    ('dulbu', 'dbo'),
+    ('dbp', 'dbp'),
+    ('duwai', 'dbp'),
+    ('daba', 'dbq'),
+    ('dbq', 'dbq'),
+    ('dabarre', 'dbr'),
+    ('dbr', 'dbr'),
+    ('ben tey dogon', 'dbt'),
+    ('dbt', 'dbt'),
+    ('bondum dom dogon', 'dbu'),
+    ('dbu', 'dbu'),
+    ('dbv', 'dbv'),
+    ('dungu', 'dbv'),
+    ('bankan tey dogon', 'dbw'),
+    ('dbw', 'dbw'),
+    ('dby', 'dby'),
+    ('dibiyaso', 'dby'),
+    ('dcc', 'dcc'),
+    ('deccan', 'dcc'),
+    ('dcr', 'dcr'),
+    ('negerhollands', 'dcr'),
+    ('dadi dadi', 'dda'),
+    ('dda', 'dda'),
+    ('ddd', 'ddd'),
+    ('dongotono', 'ddd'),
+    ('dde', 'dde'),
+    ('doondo', 'dde'),
+    ('ddg', 'ddg'),
+    ('fataluku', 'ddg'),
+    ('ddi', 'ddi'),
+    ('west goodenough', 'ddi'),
+    ('ddj', 'ddj'),
+    ('jaru', 'ddj'),
+    ('ddn', 'ddn'),
+    ('dendi', 'ddn'),
+    ('dendi (benin)', 'ddn'),
+    ('ddo', 'ddo'),
+    ('dido', 'ddo'),
+    ('ddr', 'ddr'),
+    ('dhudhuroa', 'ddr'),
+    ('dds', 'dds'),
+    ('donno so dogon', 'dds'),
+    ('dawera-daweloor', 'ddw'),
+    ('ddw', 'ddw'),
+    ('de', 'de'),
+    ('deu', 'de'),
+    ('ger', 'de'),
+    ('german', 'de'),
+    ('dagik', 'dec'),
+    ('dec', 'dec'),
+    ('ded', 'ded'),
+    ('dedua', 'ded'),
+    ('dee', 'dee'),
+    ('dewoin', 'dee'),
+    ('def', 'def'),
+    ('dezfuli', 'def'),
+    ('deg', 'deg'),
+    ('degema', 'deg'),
+    ('deh', 'deh'),
+    ('dehwari', 'deh'),
+    ('dei', 'dei'),
+    ('demisa', 'dei'),
+    ('dek', 'dek'),
+    ('del', 'del'),
+    ('delaware', 'del'),
+    ('dem', 'dem'),
+    ('den', 'den'),
+    ('slave', 'den'),
+    ('slave (athapascan)', 'den'),
+    ('dep', 'dep'),
+    ('pidgin delaware', 'dep'),
+    ('dendi (central african republic)', 'deq'),
+    ('deq', 'deq'),
+    ('deori', 'der'),
+    ('der', 'der'),
+    ('des', 'des'),
+    ('desano', 'des'),
+    ('dev', 'dev'),
+    ('domung', 'dev'),
+    ('dengese', 'dez'),
+    ('dez', 'dez'),
+    ('dga', 'dga'),
+    ('southern dagaare', 'dga'),
+    ('bunoge dogon', 'dgb'),
+    ('dgb', 'dgb'),
+    ('casiguran dumagat agta', 'dgc'),
+    ('dgc', 'dgc'),
+    ('dagaari dioula', 'dgd'),
+    ('dgd', 'dgd'),
+    ('degenan', 'dge'),
+    ('dge', 'dge'),
+    ('dgg', 'dgg'),
+    ('doga', 'dgg'),
+    ('dgh', 'dgh'),
+    ('dghwede', 'dgh'),
+    ('dgi', 'dgi'),
+    ('northern dagara', 'dgi'),
+    ('dagba', 'dgk'),
+    ('dgk', 'dgk'),
+    ('andaandi', 'dgl'),
+    ('dgl', 'dgl'),
+    ('dagoman', 'dgn'),
+    ('dgn', 'dgn'),
+    ('dgo', 'dgo'),
+    ('dogri', 'dgo'),
+    ('dogri (individual language)', 'dgo'),
+    ('dgr', 'dgr'),
+    ('dogrib', 'dgr'),
+    ('dgs', 'dgs'),
+    ('dogoso', 'dgs'),
+    ('dgt', 'dgt'),
+    ('ndra''ngith', 'dgt'),
+    ('daungwurrung', 'dgw'),
+    ('dgw', 'dgw'),
+    ('dgx', 'dgx'),
+    ('doghoro', 'dgx'),
+    ('daga', 'dgz'),
+    ('dgz', 'dgz'),
+    ('dhd', 'dhd'),
+    ('dhundari', 'dhd'),
+    ('dhangu-djangu', 'dhg'),
+    ('dhg', 'dhg'),
+    ('dhi', 'dhi'),
+    ('dhimal', 'dhi'),
+    ('dhalandji', 'dhl'),
+    ('dhl', 'dhl'),
+    ('dhm', 'dhm'),
+    ('zemba', 'dhm'),
+    ('dhanki', 'dhn'),
+    ('dhn', 'dhn'),
+    ('dho', 'dho'),
+    ('dhodia', 'dho'),
+    ('dhargari', 'dhr'),
+    ('dhr', 'dhr'),
+    ('dhaiso', 'dhs'),
+    ('dhs', 'dhs'),
+    ('dhu', 'dhu'),
+    ('dhurga', 'dhu'),
+    ('dehu', 'dhv'),
+    ('dhv', 'dhv'),
+    ('dhanwar', 'dhw'),
+    ('dhanwar (nepal)', 'dhw'),
+    ('dhw', 'dhw'),
+    ('dhungaloo', 'dhx'),
+    ('dhx', 'dhx'),
+    ('dia', 'dia'),
+    ('dib', 'dib'),
+    ('south central dinka', 'dib'),
+    ('dic', 'dic'),
+    ('lakota dida', 'dic'),
+    ('did', 'did'),
+    ('didinga', 'did'),
+    ('dieri', 'dif'),
+    ('dif', 'dif'),
+    ('dig', 'dig'),
+    ('digo', 'dig'),
+    ('dih', 'dih'),
+    ('kumiai', 'dih'),
+    ('dii', 'dii'),
+    ('dimbong', 'dii'),
+    ('dij', 'dij'),
+    ('dik', 'dik'),
+    ('southwestern dinka', 'dik'),
+    ('dil', 'dil'),
+    ('dilling', 'dil'),
+    ('dim', 'dim'),
+    ('dime', 'dim'),
+    ('din', 'din'),
+    ('dinka', 'din'),
+    ('dibo', 'dio'),
+    ('dio', 'dio'),
+    ('dip', 'dip'),
+    ('northeastern dinka', 'dip'),
+    ('dimli', 'diq'),
+    ('dimli (individual language)', 'diq'),
+    ('diq', 'diq'),
+    ('dir', 'dir'),
+    ('dirim', 'dir'),
+    ('dimasa', 'dis'),
+    ('dis', 'dis'),
+    ('diriku', 'diu'),
+    ('diu', 'diu'),
+    ('diw', 'diw'),
+    ('northwestern dinka', 'diw'),
+    ('dix', 'dix'),
+    ('dixon reef', 'dix'),
+    ('diuwe', 'diy'),
+    ('diy', 'diy'),
+    ('ding', 'diz'),
+    ('diz', 'diz'),
+    ('dja', 'dja'),
+    ('djadjawurrung', 'dja'),
+    ('djb', 'djb'),
+    ('djinba', 'djb'),
+    ('dar daju daju', 'djc'),
+    ('djc', 'djc'),
+    ('djamindjung', 'djd'),
+    ('djd', 'djd'),
+    ('dje', 'dje'),
+    ('zarma', 'dje'),
+    ('djangun', 'djf'),
+    ('djf', 'djf'),
+    ('dji', 'dji'),
+    ('djinang', 'dji'),
+    ('djeebbana', 'djj'),
+    ('djj', 'djj'),
+    ('djk', 'djk'),
+    ('eastern maroon creole', 'djk'),
+    ('djm', 'djm'),
+    ('jamsay dogon', 'djm'),
+    ('djn', 'djn'),
+    ('jawoyn', 'djn'),
+    ('djo', 'djo'),
+    ('jangkang', 'djo'),
+    ('djambarrpuyngu', 'djr'),
+    ('djr', 'djr'),
+    ('dju', 'dju'),
+    ('kapriman', 'dju'),
+    ('djawi', 'djw'),
+    ('djw', 'djw'),
+    ('dakpakha', 'dka'),
+    ('dka', 'dka'),
+    ('dkg', 'dkg'),
+    ('kadung', 'dkg'),
+    ('dakka', 'dkk'),
+    ('dkk', 'dkk'),
+    ('dkr', 'dkr'),
+    ('kuijau', 'dkr'),
+    ('dks', 'dks'),
+    ('southeastern dinka', 'dks'),
+    ('dkx', 'dkx'),
+    ('mazagway', 'dkx'),
+    ('dlg', 'dlg'),
+    ('dolgan', 'dlg'),
+    ('dahalik', 'dlk'),
+    ('dlk', 'dlk'),
+    ('dalmatian', 'dlm'),
+    ('dlm', 'dlm'),
+    ('darlong', 'dln'),
+    ('dln', 'dln'),
+    ('dma', 'dma'),
+    ('duma', 'dma'),
+    ('dmb', 'dmb'),
+    ('mombo dogon', 'dmb'),
+    ('dmc', 'dmc'),
+    ('gavak', 'dmc'),
+    ('dmd', 'dmd'),
+    ('madhi madhi', 'dmd'),
+    ('dme', 'dme'),
+    ('dugwor', 'dme'),
+    ('dmf', 'dmf'),
+    ('medefaidrin', 'dmf'),
+    ('dmg', 'dmg'),
+    ('upper kinabatangan', 'dmg'),
+    ('dmk', 'dmk'),
+    ('domaaki', 'dmk'),
+    ('dameli', 'dml'),
+    ('dml', 'dml'),
+    ('dama', 'dmm'),
+    ('dmm', 'dmm'),
+    ('dmo', 'dmo'),
+    ('kemedzung', 'dmo'),
+    ('dmr', 'dmr'),
+    ('east damar', 'dmr'),
+    ('dampelas', 'dms'),
+    ('dms', 'dms'),
+    ('dmu', 'dmu'),
+    ('dubu', 'dmu'),
+    ('dmv', 'dmv'),
+    ('dumpas', 'dmv'),
+    ('dmw', 'dmw'),
+    ('mudburra', 'dmw'),
+    ('dema', 'dmx'),
+    ('dmx', 'dmx'),
+    ('demta', 'dmy'),
+    ('dmy', 'dmy'),
+    ('dna', 'dna'),
+    ('upper grand valley dani', 'dna'),
+    ('daonda', 'dnd'),
+    ('dnd', 'dnd'),
+    ('dne', 'dne'),
+    ('ndendeule', 'dne'),
+    ('dng', 'dng'),
+    ('dungan', 'dng'),
+    ('dni', 'dni'),
+    ('lower grand valley dani', 'dni'),
+    ('dnj', 'dnj'),
+    ('dengka', 'dnk'),
+    ('dnk', 'dnk'),
+    ('dnn', 'dnn'),
+    ('dzùùngoo', 'dnn'),
+    ('dno', 'dno'),
+    ('ndrulo', 'dno'),
+    ('danaru', 'dnr'),
+    ('dnr', 'dnr'),
+    ('dnt', 'dnt'),
+    ('mid grand valley dani', 'dnt'),
+    ('danau', 'dnu'),
+    ('dnu', 'dnu'),
+    ('danu', 'dnv'),
+    ('dnv', 'dnv'),
+    ('dnw', 'dnw'),
+    ('western dani', 'dnw'),
+    ('dení', 'dny'),
+    ('dny', 'dny'),
+    ('doa', 'doa'),
+    ('dom', 'doa'),
+    ('dob', 'dob'),
+    ('dobu', 'dob'),
+    ('doc', 'doc'),
+    ('northern dong', 'doc'),
+    ('doe', 'doe'),
+    ('dof', 'dof'),
+    ('domu', 'dof'),
+    ('doh', 'doh'),
+    ('dong', 'doh'),
+    ('dogri (macrolanguage)', 'doi'),
+    ('doi', 'doi'),
+    ('dok', 'dok'),
+    ('dondo', 'dok'),
+    ('dol', 'dol'),
+    ('doso', 'dol'),
+    ('don', 'don'),
+    ('toura', 'don'),
+    ('toura (papua new guinea)', 'don'),
+    ('dongo', 'doo'),
+    ('doo', 'doo'),
+    ('dop', 'dop'),
+    ('lukpa', 'dop'),
+    ('dominican sign language', 'doq'),
+    ('doq', 'doq'),
+    ('dor', 'dor'),
+    ('dori''o', 'dor'),
+    ('dogosé', 'dos'),
+    ('dos', 'dos'),
+    ('dass', 'dot'),
+    ('dot', 'dot'),
+    ('dombe', 'dov'),
+    ('dov', 'dov'),
+    ('dow', 'dow'),
+    ('doyayo', 'dow'),
+    ('bussa', 'dox'),
+    ('dox', 'dox'),
+    ('dompo', 'doy'),
+    ('doy', 'doy'),
+    ('dorze', 'doz'),
+    ('doz', 'doz'),
+    ('dpp', 'dpp'),
+    ('papar', 'dpp'),
+    ('dair', 'drb'),
+    ('drb', 'drb'),
+    ('drc', 'drc'),
+    ('minderico', 'drc'),
+    ('darmiya', 'drd'),
+    ('drd', 'drd'),
+    ('dolpo', 'dre'),
+    ('dre', 'dre'),
+    ('drg', 'drg'),
+    ('rungus', 'drg'),
+    ('c''lela', 'dri'),
+    ('dri', 'dri'),
+    ('drl', 'drl'),
+    ('paakantyi', 'drl'),
+    ('drn', 'drn'),
+    ('west damar', 'drn'),
+    ('daro-matu melanau', 'dro'),
+    ('dro', 'dro'),
+    ('drq', 'drq'),
+    ('dura', 'drq'),
+    ('drs', 'drs'),
+    ('gedeo', 'drs'),
+    ('drents', 'drt'),
+    ('drt', 'drt'),
+    ('dru', 'dru'),
+    ('rukai', 'dru'),
+    ('darai', 'dry'),
+    ('dry', 'dry'),
+    ('dsb', 'dsb'),
+    ('lower sorbian', 'dsb'),
+    ('dse', 'dse'),
+    ('dutch sign language', 'dse'),
+    ('daasanach', 'dsh'),
+    ('dsh', 'dsh'),
+    ('disa', 'dsi'),
+    ('dsi', 'dsi'),
+    ('danish sign language', 'dsl'),
+    ('dsl', 'dsl'),
+    ('dsn', 'dsn'),
+    ('dusner', 'dsn'),
+    ('desiya', 'dso'),
+    ('dso', 'dso'),
+    ('dsq', 'dsq'),
+    ('tadaksahak', 'dsq'),
+    ('dsz', 'dsz'),
+    ('mardin sign language', 'dsz'),
+    ('daur', 'dta'),
+    ('dta', 'dta'),
+    ('dtb', 'dtb'),
+    ('labuk-kinabatangan kadazan', 'dtb'),
+    ('ditidaht', 'dtd'),
+    ('dtd', 'dtd'),
+    ('adithinngithigh', 'dth'),
+    ('dth', 'dth'),
+    ('ana tinga dogon', 'dti'),
+    ('dti', 'dti'),
+    ('dtk', 'dtk'),
+    ('tene kan dogon', 'dtk'),
+    ('dtm', 'dtm'),
+    ('tomo kan dogon', 'dtm'),
+    ('daatsʼíin', 'dtn'),
+    ('dtn', 'dtn'),
+    ('dto', 'dto'),
+    ('tommo so dogon', 'dto'),
+    ('dtp', 'dtp'),
+    ('kadazan dusun', 'dtp'),
+    ('dtr', 'dtr'),
+    ('lotud', 'dtr'),
+    ('dts', 'dts'),
+    ('toro so dogon', 'dts'),
+    ('dtt', 'dtt'),
+    ('toro tegu dogon', 'dtt'),
+    ('dtu', 'dtu'),
+    ('tebul ure dogon', 'dtu'),
+    ('dotyali', 'dty'),
+    ('dty', 'dty'),
+    ('dua', 'dua'),
+    ('duala', 'dua'),
+    ('dub', 'dub'),
+    ('dubli', 'dub'),
+    ('duc', 'duc'),
+    ('duna', 'duc'),
+    ('due', 'due'),
+    ('umiray dumaget agta', 'due'),
+    ('duf', 'duf'),
+    ('dumbea', 'duf'),
+    ('dug', 'dug'),
+    ('duruma', 'dug'),
+    ('duh', 'duh'),
+    ('dungra bhil', 'duh'),
+    ('dui', 'dui'),
+    ('dumun', 'dui'),
+    ('duk', 'duk'),
+    ('uyajitaya', 'duk'),
+    ('alabat island agta', 'dul'),
+    ('dul', 'dul'),
+    ('dum', 'dum'),
+    ('dutch, middle', 'dum'),
+    ('dutch, middle (ca. 1050-1350)', 'dum'),
+    ('middle dutch', 'dum'),
+    ('middle dutch (ca. 1050-1350)', 'dum'),
+    ('dun', 'dun'),
+    ('dusun deyah', 'dun'),
+    ('duo', 'duo'),
+    ('dupaninan agta', 'duo'),
+    ('duano', 'dup'),
+    ('dup', 'dup'),
+    ('duq', 'duq'),
+    ('dusun malang', 'duq'),
+    ('dur', 'dur'),
+    ('dumi', 'dus'),
+    ('dus', 'dus'),
+    ('drung', 'duu'),
+    ('duu', 'duu'),
+    ('duv', 'duv'),
+    ('duvle', 'duv'),
+    ('dusun witu', 'duw'),
+    ('duw', 'duw'),
+    ('duungooma', 'dux'),
+    ('dux', 'dux'),
+    ('dicamay agta', 'duy'),
+    ('duy', 'duy'),
+    ('duli-gey', 'duz'),
+    ('duz', 'duz'),
+    ('dhivehi', 'dv'),
+    ('div', 'dv'),
+    ('divehi', 'dv'),
+    ('dv', 'dv'),
+    ('maldivian', 'dv'),
+    ('duau', 'dva'),
+    ('dva', 'dva'),
+    ('diri', 'dwa'),
+    ('dwa', 'dwa'),
+    ('dawik kui', 'dwk'),
+    ('dwk', 'dwk'),
+    ('dawro', 'dwr'),
+    ('dwr', 'dwr'),
+    ('dutton world speedwords', 'dws'),
+    ('dws', 'dws'),
+    ('dhuwal', 'dwu'),
+    ('dwu', 'dwu'),
+    ('dawawa', 'dww'),
+    ('dww', 'dww'),
+    ('dhuwaya', 'dwy'),
+    ('dwy', 'dwy'),
+    ('dewas rai', 'dwz'),
+    ('dwz', 'dwz'),
+    ('dya', 'dya'),
+    ('dyan', 'dya'),
+    ('dyaberdyaber', 'dyb'),
+    ('dyb', 'dyb'),
+    ('dyd', 'dyd'),
+    ('dyugun', 'dyd'),
+    ('dyg', 'dyg'),
+    ('villa viciosa agta', 'dyg'),
+    ('djimini senoufo', 'dyi'),
+    ('dyi', 'dyi'),
+    ('dym', 'dym'),
+    ('yanda dom dogon', 'dym'),
+    ('dyangadi', 'dyn'),
+    ('dyn', 'dyn'),
+    ('dyo', 'dyo'),
+    ('jola-fonyi', 'dyo'),
+    ('dyu', 'dyu'),
+    ('dyula', 'dyu'),
+    ('djabugay', 'dyy'),
+    ('dyy', 'dyy'),
+    ('dz', 'dz'),
+    ('dzo', 'dz'),
+    ('dzongkha', 'dz'),
+    ('dza', 'dza'),
+    ('tunzu', 'dza'),
+    ('djiwarli', 'dze'),
+    ('dze', 'dze'),
+    ('dazaga', 'dzg'),
+    ('dzg', 'dzg'),
+    ('dzalakha', 'dzl'),
+    ('dzl', 'dzl'),
+    ('dzando', 'dzn'),
+    ('dzn', 'dzn'),
+    ('eaa', 'eaa'),
+    ('karenggapa', 'eaa'),
+    ('beginci', 'ebc'),
+    ('ebc', 'ebc'),
+    ('ebg', 'ebg'),
+    ('ebughu', 'ebg'),
+    ('eastern bontok', 'ebk'),
+    ('ebk', 'ebk'),
+    ('ebo', 'ebo'),
+    ('teke-ebo', 'ebo'),
+    ('ebr', 'ebr'),
+    ('ebrié', 'ebr'),
+    ('ebu', 'ebu'),
+    ('embu', 'ebu'),
+    ('ecr', 'ecr'),
+    ('eteocretan', 'ecr'),
+    ('ecs', 'ecs'),
+    ('ecuadorian sign language', 'ecs'),
+    ('ecy', 'ecy'),
+    ('eteocypriot', 'ecy'),
+    ('ee', 'ee'),
+    ('ewe', 'ee'),
+    ('e', 'eee'),
+    ('eee', 'eee'),
+    ('efa', 'efa'),
+    ('efai', 'efa'),
+    ('efe', 'efe'),
+    ('efi', 'efi'),
+    ('efik', 'efi'),
+    ('ega', 'ega'),
+    ('egl', 'egl'),
+    ('emilian', 'egl'),
+    ('benamanga', 'egm'),
+    ('egm', 'egm'),
+    ('eggon', 'ego'),
+    ('ego', 'ego'),
+    ('egy', 'egy'),
+    ('egyptian', 'egy'),
+    ('egyptian (ancient)', 'egy'),
+    ('ehs', 'ehs'),
+    ('miyakubo sign language', 'ehs'),
+    ('ehu', 'ehu'),
+    ('ehueun', 'ehu'),
+    ('eip', 'eip'),
+    ('eipomek', 'eip'),
+    ('eit', 'eit'),
+    ('eitiep', 'eit'),
+    ('askopan', 'eiv'),
+    ('eiv', 'eiv'),
+    ('eja', 'eja'),
+    ('ejamat', 'eja'),
+    ('eka', 'eka'),
+    ('ekajuk', 'eka'),
+    ('eke', 'eke'),
+    ('ekit', 'eke'),
+    ('ekari', 'ekg'),
+    ('ekg', 'ekg'),
+    ('eki', 'eki'),
+    ('ekk', 'ekk'),
+    ('standard estonian', 'ekk'),
+    ('ekl', 'ekl'),
+    ('kol (bangladesh)', 'ekl'),
+    ('ekm', 'ekm'),
+    ('elip', 'ekm'),
+    ('eko', 'eko'),
+    ('koti', 'eko'),
+    ('ekp', 'ekp'),
+    ('ekpeye', 'ekp'),
+    ('ekr', 'ekr'),
+    ('yace', 'ekr'),
+    ('eastern kayah', 'eky'),
+    ('eky', 'eky'),
+    ('el', 'el'),
+    ('ell', 'el'),
+    ('gre', 'el'),
+    ('greek', 'el'),
+    ('greek, modern', 'el'),
+    ('greek, modern (1453-)', 'el'),
+    ('modern greek', 'el'),
+    ('modern greek (1453-)', 'el'),
+    ('ele', 'ele'),
+    ('elepi', 'ele'),
+    ('el hugeirat', 'elh'),
+    ('elh', 'elh'),
+    ('eli', 'eli'),
+    ('nding', 'eli'),
+    ('elk', 'elk'),
+    ('elkei', 'elk'),
+    ('eleme', 'elm'),
+    ('elm', 'elm'),
+    ('el molo', 'elo'),
+    ('elo', 'elo'),
+    ('elu', 'elu'),
+    ('elamite', 'elx'),
+    ('elx', 'elx'),
+    ('ema', 'ema'),
+    ('emai-iuleha-ora', 'ema'),
+    ('emb', 'emb'),
+    ('embaloh', 'emb'),
+    ('eme', 'eme'),
+    ('emerillon', 'eme'),
+    ('eastern meohang', 'emg'),
+    ('emg', 'emg'),
+    ('emi', 'emi'),
+    ('mussau-emira', 'emi'),
+    ('eastern maninkakan', 'emk'),
+    ('emk', 'emk'),
+    ('emm', 'emm'),
+    ('mamulique', 'emm'),
+    ('eman', 'emn'),
+    ('emn', 'emn'),
+    ('emp', 'emp'),
+    ('northern emberá', 'emp'),
+    ('eastern minyag', 'emq'),
+    ('emq', 'emq'),
+    ('ems', 'ems'),
+    ('pacific gulf yupik', 'ems'),
+    ('eastern muria', 'emu'),
+    ('emu', 'emu'),
+    ('emplawas', 'emw'),
+    ('emw', 'emw'),
+    ('emx', 'emx'),
+    ('erromintxela', 'emx'),
+    ('emy', 'emy'),
+    ('epigraphic mayan', 'emy'),
+    ('emz', 'emz'),
+    ('mbessa', 'emz'),
+    ('en', 'en'),
+    ('eng', 'en'),
+    ('english', 'en'),
+    ('apali', 'ena'),
+    ('ena', 'ena'),
+    ('enb', 'enb'),
+    ('markweeta', 'enb'),
+    ('enc', 'enc'),
+    ('end', 'end'),
+    ('ende', 'end'),
+    ('enf', 'enf'),
+    ('forest enets', 'enf'),
+    ('enh', 'enh'),
+    ('tundra enets', 'enh'),
+    ('enl', 'enl'),
+    ('enlhet', 'enl'),
+    ('english, middle', 'enm'),
+    ('english, middle (1100-1500)', 'enm'),
+    ('enm', 'enm'),
+    ('middle english', 'enm'),
+    ('middle english (1100-1500)', 'enm'),
+    ('engenni', 'enn'),
+    ('enn', 'enn'),
+    ('enggano', 'eno'),
+    ('eno', 'eno'),
+    ('enga', 'enq'),
+    ('enq', 'enq'),
+    ('emumu', 'enr'),
+    ('enr', 'enr'),
+    ('enu', 'enu'),
+    ('env', 'env'),
+    ('enwan', 'env'),
+    ('enwan (edo state)', 'env'),
+    ('enw', 'enw'),
+    ('enwan (akwa ibom state)', 'enw'),
+    ('enx', 'enx'),
+    ('enxet', 'enx'),
+    ('eo', 'eo'),
+    ('epo', 'eo'),
+    ('esperanto', 'eo'),
+    ('beti', 'eot'),
+    ('beti (côte d''ivoire)', 'eot'),
+    ('eot', 'eot'),
+    ('epi', 'epi'),
+    ('epie', 'epi'),
+    ('era', 'era'),
+    ('eravallan', 'era'),
+    ('erg', 'erg'),
+    ('sie', 'erg'),
+    ('erh', 'erh'),
+    ('eruwa', 'erh'),
+    ('eri', 'eri'),
+    ('ogea', 'eri'),
+    ('erk', 'erk'),
+    ('south efate', 'erk'),
+    ('ero', 'ero'),
+    ('horpa', 'ero'),
+    ('err', 'err'),
+    ('erre', 'err'),
+    ('ers', 'ers'),
+    ('ersu', 'ers'),
+    ('eritai', 'ert'),
+    ('ert', 'ert'),
+    ('erokwanas', 'erw'),
+    ('erw', 'erw'),
+    ('castilian', 'es'),
+    ('es', 'es'),
+    ('spa', 'es'),
+    ('spanish', 'es'),
+    ('ese', 'ese'),
+    ('ese ejja', 'ese'),
+    ('aheri gondi', 'esg'),
+    ('esg', 'esg'),
+    ('esh', 'esh'),
+    ('eshtehardi', 'esh'),
+    ('esi', 'esi'),
+    ('north alaskan inupiatun', 'esi'),
+    ('esk', 'esk'),
+    ('northwest alaska inupiatun', 'esk'),
+    ('egypt sign language', 'esl'),
+    ('esl', 'esl'),
+    ('esm', 'esm'),
+    ('esuma', 'esm'),
+    ('esn', 'esn'),
+    ('salvadoran sign language', 'esn'),
+    ('eso', 'eso'),
+    ('estonian sign language', 'eso'),
+    ('esq', 'esq'),
+    ('esselen', 'esq'),
+    ('central siberian yupik', 'ess'),
+    ('ess', 'ess'),
+    ('central yupik', 'esu'),
+    ('esu', 'esu'),
+    ('eskayan', 'esy'),
+    ('esy', 'esy'),
+    ('est', 'et'),
+    ('estonian', 'et'),
+    ('et', 'et'),
+    ('etb', 'etb'),
+    ('etebi', 'etb'),
+    ('etc', 'etc'),
+    ('etchemin', 'etc'),
+    ('eth', 'eth'),
+    ('ethiopian sign language', 'eth'),
+    ('etn', 'etn'),
+    ('eton', 'etn'),
+    ('eton (vanuatu)', 'etn'),
+    ('eto', 'eto'),
+    ('eton (cameroon)', 'eto'),
+    ('edolo', 'etr'),
+    ('etr', 'etr'),
+    ('ets', 'ets'),
+    ('yekhee', 'ets'),
+    ('etruscan', 'ett'),
+    ('ett', 'ett'),
+    ('ejagham', 'etu'),
+    ('etu', 'etu'),
+    ('eten', 'etx'),
+    ('etx', 'etx'),
+    ('etz', 'etz'),
+    ('semimi', 'etz'),
+    ('baq', 'eu'),
+    ('basque', 'eu'),
+    ('eu', 'eu'),
+    ('eus', 'eu'),
+    ('eve', 'eve'),
+    ('even', 'eve'),
+    ('evh', 'evh'),
+    ('uvbie', 'evh'),
+    ('evenki', 'evn'),
+    ('evn', 'evn'),
+    ('ewo', 'ewo'),
+    ('ewondo', 'ewo'),
+    ('ext', 'ext'),
+    ('extremaduran', 'ext'),
+    ('eya', 'eya'),
+    ('eyak', 'eya'),
+    ('eyo', 'eyo'),
+    ('keiyo', 'eyo'),
+    ('eza', 'eza'),
+    ('ezaa', 'eza'),
+    ('eze', 'eze'),
+    ('uzekwe', 'eze'),
+    ('fa', 'fa'),
+    ('fas', 'fa'),
+    ('per', 'fa'),
+    ('persian', 'fa'),
+    ('faa', 'faa'),
+    ('fasu', 'faa'),
+    ('fa d''ambu', 'fab'),
+    ('fab', 'fab'),
+    ('fad', 'fad'),
+    ('wagi', 'fad'),
+    ('faf', 'faf'),
+    ('fagani', 'faf'),
+    ('fag', 'fag'),
+    ('finongan', 'fag'),
+    ('baissa fali', 'fah'),
+    ('fah', 'fah'),
+    ('fai', 'fai'),
+    ('faiwol', 'fai'),
+    ('faita', 'faj'),
+    ('faj', 'faj'),
+    ('fak', 'fak'),
+    ('fang', 'fak'),
+    ('fang (cameroon)', 'fak'),
+    ('fal', 'fal'),
+    ('south fali', 'fal'),
+    ('fam', 'fam'),
+    ('fan', 'fan'),
+    ('fang (equatorial guinea)', 'fan'),
+    ('fap', 'fap'),
+    ('paloor', 'fap'),
+    ('far', 'far'),
+    ('fataleka', 'far'),
+    ('fanti', 'fat'),
+    ('fat', 'fat'),
+    ('fau', 'fau'),
+    ('fayu', 'fau'),
+    ('fala', 'fax'),
+    ('fax', 'fax'),
+    ('fay', 'fay'),
+    ('southwestern fars', 'fay'),
+    ('faz', 'faz'),
+    ('northwestern fars', 'faz'),
+    ('fbl', 'fbl'),
+    ('west albay bikol', 'fbl'),
+    ('fcs', 'fcs'),
+    ('quebec sign language', 'fcs'),
+    ('fer', 'fer'),
+    ('feroge', 'fer'),
+    ('ff', 'ff'),
+    ('ful', 'ff'),
+    ('fulah', 'ff'),
+    ('ffi', 'ffi'),
+    ('foia foia', 'ffi'),
+    ('ffm', 'ffm'),
+    ('maasina fulfulde', 'ffm'),
+    ('fgr', 'fgr'),
+    ('fongoro', 'fgr'),
+    ('fi', 'fi'),
+    ('fin', 'fi'),
+    ('finnish', 'fi'),
+    ('fia', 'fia'),
+    ('nobiin', 'fia'),
+    ('fie', 'fie'),
+    ('fyer', 'fie'),
+    ('faifi', 'fif'),
+    ('fif', 'fif'),
+    ('fil', 'fil'),
+    ('filipino', 'fil'),
+    ('pilipino', 'fil'),
+    ('fip', 'fip'),
+    ('fipa', 'fip'),
+    ('fir', 'fir'),
+    ('firan', 'fir'),
+    ('fit', 'fit'),
+    ('tornedalen finnish', 'fit'),
+    ('fiw', 'fiw'),
+    ('fiwaga', 'fiw'),
+    ('fij', 'fj'),
+    ('fijian', 'fj'),
+    ('fj', 'fj'),
+    ('fkk', 'fkk'),
+    ('kirya-konzəl', 'fkk'),
+    ('fkv', 'fkv'),
+    ('kven finnish', 'fkv'),
+    ('fla', 'fla'),
+    ('kalispel-pend d''oreille', 'fla'),
+    ('flh', 'flh'),
+    ('foau', 'flh'),
+    ('fali', 'fli'),
+    ('fli', 'fli'),
+    ('fll', 'fll'),
+    ('north fali', 'fll'),
+    ('flinders island', 'fln'),
+    ('fln', 'fln'),
+    ('flr', 'flr'),
+    ('fuliiru', 'flr'),
+    ('flaaitaal', 'fly'),
+    ('fly', 'fly'),
+    ('fe''fe''', 'fmp'),
+    ('fmp', 'fmp'),
+    ('far western muria', 'fmu'),
+    ('fmu', 'fmu'),
+    ('fanbak', 'fnb'),
+    ('fnb', 'fnb'),
+    ('fanagalo', 'fng'),
+    ('fng', 'fng'),
+    ('fania', 'fni'),
+    ('fni', 'fni'),
+    ('fao', 'fo'),
+    ('faroese', 'fo'),
+    ('fo', 'fo'),
+    ('fod', 'fod'),
+    ('foodo', 'fod'),
+    ('foi', 'foi'),
+    ('fom', 'fom'),
+    ('foma', 'fom'),
+    ('fon', 'fon'),
+    ('for', 'for'),
+    ('fore', 'for'),
+    ('fos', 'fos'),
+    ('siraya', 'fos'),
+    ('fernando po creole english', 'fpe'),
+    ('fpe', 'fpe'),
+    ('fqs', 'fqs'),
+    ('fr', 'fr'),
+    ('fra', 'fr'),
+    ('fre', 'fr'),
+    ('french', 'fr'),
+    ('cajun french', 'frc'),
+    ('frc', 'frc'),
+    ('fordata', 'frd'),
+    ('frd', 'frd'),
+    ('frankish', 'frk'),
+    ('frk', 'frk'),
+    ('french, middle', 'frm'),
+    ('french, middle (ca. 1400-1600)', 'frm'),
+    ('frm', 'frm'),
+    ('middle french', 'frm'),
+    ('middle french (ca. 1400-1600)', 'frm'),
+    ('french, old', 'fro'),
+    ('french, old (842-ca. 1400)', 'fro'),
+    ('fro', 'fro'),
+    ('old french', 'fro'),
+    ('old french (842-ca. 1400)', 'fro'),
+    ('arpitan', 'frp'),
+    ('frp', 'frp'),
+    ('forak', 'frq'),
+    ('frq', 'frq'),
+    ('frr', 'frr'),
+    ('northern frisian', 'frr'),
+    ('eastern frisian', 'frs'),
+    ('frs', 'frs'),
+    ('fortsenal', 'frt'),
+    ('frt', 'frt'),
+    ('finnish sign language', 'fse'),
+    ('fse', 'fse'),
+    ('french sign language', 'fsl'),
+    ('fsl', 'fsl'),
+    ('finland-swedish sign language', 'fss'),
+    ('fss', 'fss'),
+    ('adamawa fulfulde', 'fub'),
+    ('fub', 'fub'),
+    ('fuc', 'fuc'),
+    ('pulaar', 'fuc'),
+    ('east futuna', 'fud'),
+    ('fud', 'fud'),
+    ('borgu fulfulde', 'fue'),
+    ('fue', 'fue'),
+    ('fuf', 'fuf'),
+    ('pular', 'fuf'),
+    ('fuh', 'fuh'),
+    ('western niger fulfulde', 'fuh'),
+    ('bagirmi fulfulde', 'fui'),
+    ('fui', 'fui'),
+    ('fuj', 'fuj'),
+    ('fum', 'fum'),
+    ('fulniô', 'fun'),
+    ('fun', 'fun'),
+    ('central-eastern niger fulfulde', 'fuq'),
+    ('fuq', 'fuq'),
+    ('friulian', 'fur'),
+    ('fur', 'fur'),
+    ('fut', 'fut'),
+    ('futuna-aniwa', 'fut'),
+    ('furu', 'fuu'),
+    ('fuu', 'fuu'),
+    ('fuv', 'fuv'),
+    ('nigerian fulfulde', 'fuv'),
+    ('fuy', 'fuy'),
+    ('fuyug', 'fuy'),
+    ('fvr', 'fvr'),
+    ('fwa', 'fwa'),
+    ('fwâi', 'fwa'),
+    ('fwe', 'fwe'),
+    ('fry', 'fy'),
+    ('fy', 'fy'),
+    ('western frisian', 'fy'),
+    ('ga', 'ga'),
+    ('gle', 'ga'),
+    ('irish', 'ga'),
+    ('gaa', 'gaa'),
+    ('gab', 'gab'),
+    ('gabri', 'gab'),
+    ('gac', 'gac'),
+    ('mixed great andamanese', 'gac'),
+    ('gad', 'gad'),
+    ('gaddang', 'gad'),
+    ('gae', 'gae'),
+    ('guarequena', 'gae'),
+    ('gaf', 'gaf'),
+    ('gende', 'gaf'),
+    ('gag', 'gag'),
+    ('gagauz', 'gag'),
+    ('alekano', 'gah'),
+    ('gah', 'gah'),
+    ('borei', 'gai'),
+    ('gai', 'gai'),
+    ('gadsup', 'gaj'),
+    ('gaj', 'gaj'),
+    ('gak', 'gak'),
+    ('gamkonora', 'gak'),
+    ('gal', 'gal'),
+    ('galolen', 'gal'),
+    ('gam', 'gam'),
+    ('kandawo', 'gam'),
+    ('gan', 'gan'),
+    ('gan chinese', 'gan'),
+    ('gants', 'gao'),
+    ('gao', 'gao'),
+    ('gap', 'gap'),
+    ('gaq', 'gaq'),
+    ('gata''', 'gaq'),
+    ('galeya', 'gar'),
+    ('gar', 'gar'),
+    ('adiwasi garasia', 'gas'),
+    ('gas', 'gas'),
+    ('gat', 'gat'),
+    ('kenati', 'gat'),
+    ('gau', 'gau'),
+    ('mudhili gadaba', 'gau'),
+    ('gaw', 'gaw'),
+    ('nobonob', 'gaw'),
+    ('borana-arsi-guji oromo', 'gax'),
+    ('gax', 'gax'),
+    ('gay', 'gay'),
+    ('gayo', 'gay'),
+    ('gaz', 'gaz'),
+    ('west central oromo', 'gaz'),
+    ('gba', 'gba'),
+    ('gbaya', 'gba'),
+    ('gbaya (central african republic)', 'gba'),
+    ('gbb', 'gbb'),
+    ('kaytetye', 'gbb'),
+    ('gbd', 'gbd'),
+    ('karajarri', 'gbd'),
+    ('gbe', 'gbe'),
+    ('niksek', 'gbe'),
+    ('gaikundi', 'gbf'),
+    ('gbf', 'gbf'),
+    ('gbanziri', 'gbg'),
+    ('gbg', 'gbg'),
+    ('defi gbe', 'gbh'),
+    ('gbh', 'gbh'),
+    ('galela', 'gbi'),
+    ('gbi', 'gbi'),
+    ('bodo gadaba', 'gbj'),
+    ('gbj', 'gbj'),
+    ('gaddi', 'gbk'),
+    ('gbk', 'gbk'),
+    ('gamit', 'gbl'),
+    ('gbl', 'gbl'),
+    ('garhwali', 'gbm'),
+    ('gbm', 'gbm'),
+    ('gbn', 'gbn'),
+    ('mo''da', 'gbn'),
+    ('gbo', 'gbo'),
+    ('northern grebo', 'gbo'),
+    ('gbaya-bossangoa', 'gbp'),
+    ('gbp', 'gbp'),
+    ('gbaya-bozoum', 'gbq'),
+    ('gbq', 'gbq'),
+    ('gbagyi', 'gbr'),
+    ('gbr', 'gbr'),
+    ('gbesi gbe', 'gbs'),
+    ('gbs', 'gbs'),
+    ('gagadu', 'gbu'),
+    ('gbu', 'gbu'),
+    ('gbanu', 'gbv'),
+    ('gbv', 'gbv'),
+    ('gabi-gabi', 'gbw'),
+    ('gbw', 'gbw'),
+    ('eastern xwla gbe', 'gbx'),
+    ('gbx', 'gbx'),
+    ('gbari', 'gby'),
+    ('gby', 'gby'),
+    ('gbz', 'gbz'),
+    ('zoroastrian dari', 'gbz'),
+    ('gcc', 'gcc'),
+    ('mali', 'gcc'),
+    ('ganggalida', 'gcd'),
+    ('gcd', 'gcd'),
+    ('galice', 'gce'),
+    ('gce', 'gce'),
+    ('gcf', 'gcf'),
+    ('guadeloupean creole french', 'gcf'),
+    ('gcl', 'gcl'),
+    ('grenadian creole english', 'gcl'),
+    ('gaina', 'gcn'),
+    ('gcn', 'gcn'),
+    ('gcr', 'gcr'),
+    ('guianese creole french', 'gcr'),
+    ('colonia tovar german', 'gct'),
+    ('gct', 'gct'),
+    ('gaelic', 'gd'),
+    ('gd', 'gd'),
+    ('gla', 'gd'),
+    ('scottish gaelic', 'gd'),
+    ('gade lohar', 'gda'),
+    ('gda', 'gda'),
+    ('gdb', 'gdb'),
+    ('pottangi ollar gadaba', 'gdb'),
+    ('gdc', 'gdc'),
+    ('gugu badhun', 'gdc'),
+    ('gdd', 'gdd'),
+    ('gedaged', 'gdd'),
+    ('gde', 'gde'),
+    ('gude', 'gde'),
+    ('gdf', 'gdf'),
+    ('guduf-gava', 'gdf'),
+    ('ga''dang', 'gdg'),
+    ('gdg', 'gdg'),
+    ('gadjerawang', 'gdh'),
+    ('gdh', 'gdh'),
+    ('gdi', 'gdi'),
+    ('gundi', 'gdi'),
+    ('gdj', 'gdj'),
+    ('gurdjar', 'gdj'),
+    ('gadang', 'gdk'),
+    ('gdk', 'gdk'),
+    ('dirasha', 'gdl'),
+    ('gdl', 'gdl'),
+    ('gdm', 'gdm'),
+    ('laal', 'gdm'),
+    ('gdn', 'gdn'),
+    ('umanakaina', 'gdn'),
+    ('gdo', 'gdo'),
+    ('ghodoberi', 'gdo'),
+    ('gdq', 'gdq'),
+    ('mehri', 'gdq'),
+    ('gdr', 'gdr'),
+    ('wipi', 'gdr'),
+    ('gds', 'gds'),
+    ('ghandruk sign language', 'gds'),
+    ('gdt', 'gdt'),
+    ('kungardutyi', 'gdt'),
+    ('gdu', 'gdu'),
+    ('gudu', 'gdu'),
+    ('gdx', 'gdx'),
+    ('godwari', 'gdx'),
+    ('gea', 'gea'),
+    ('geruma', 'gea'),
+    ('geb', 'geb'),
+    ('kire', 'geb'),
+    ('gboloo grebo', 'gec'),
+    ('gec', 'gec'),
+    ('gade', 'ged'),
+    ('ged', 'ged'),
+    ('gef', 'gef'),
+    ('gerai', 'gef'),
+    ('geg', 'geg'),
+    ('gengle', 'geg'),
+    ('geh', 'geh'),
+    ('hutterite german', 'geh'),
+    ('gebe', 'gei'),
+    ('gei', 'gei'),
+    ('gej', 'gej'),
+    ('gen', 'gej'),
+    ('gek', 'gek'),
+    ('ywom', 'gek'),
+    ('gel', 'gel'),
+    ('ut-ma''in', 'gel'),
+    ('geme', 'geq'),
+    ('geq', 'geq'),
+    ('ges', 'ges'),
+    ('geser-gorom', 'ges'),
+    ('eviya', 'gev'),
+    ('gev', 'gev'),
+    ('gera', 'gew'),
+    ('gew', 'gew'),
+    ('garre', 'gex'),
+    ('gex', 'gex'),
+    ('enya', 'gey'),
+    ('gey', 'gey'),
+    ('geez', 'gez'),
+    ('gez', 'gez'),
+    ('gfk', 'gfk'),
+    ('patpatar', 'gfk'),
+    ('gafat', 'gft'),
+    ('gft', 'gft'),
+    ('gga', 'gga'),
+    ('gbii', 'ggb'),
+    ('ggb', 'ggb'),
+    ('ggd', 'ggd'),
+    ('gugadj', 'ggd'),
+    ('gge', 'gge'),
+    ('gurr-goni', 'gge'),
+    ('ggg', 'ggg'),
+    ('gurgula', 'ggg'),
+    ('ggk', 'ggk'),
+    ('kungarakany', 'ggk'),
+    ('ganglau', 'ggl'),
+    ('ggl', 'ggl'),
+    ('ggt', 'ggt'),
+    ('gitua', 'ggt'),
+    ('gagu', 'ggu'),
+    ('ggu', 'ggu'),
+    ('ggw', 'ggw'),
+    ('gogodala', 'ggw'),
+    ('gha', 'gha'),
+    ('ghadamès', 'gha'),
+    ('ghc', 'ghc'),
+    ('hiberno-scottish gaelic', 'ghc'),
+    ('ghe', 'ghe'),
+    ('southern ghale', 'ghe'),
+    ('ghh', 'ghh'),
+    ('northern ghale', 'ghh'),
+    ('geko karen', 'ghk'),
+    ('ghk', 'ghk'),
+    ('ghl', 'ghl'),
+    ('ghulfan', 'ghl'),
+    ('ghanongga', 'ghn'),
+    ('ghn', 'ghn'),
+    ('gho', 'gho'),
+    ('ghomara', 'gho'),
+    ('ghera', 'ghr'),
+    ('ghr', 'ghr'),
+    ('ghs', 'ghs'),
+    ('guhu-samane', 'ghs'),
+    ('ght', 'ght'),
+    ('kuke', 'ght'),
+    ('gia', 'gia'),
+    ('kija', 'gia'),
+    ('gib', 'gib'),
+    ('gibanawa', 'gib'),
+    ('gail', 'gic'),
+    ('gic', 'gic'),
+    ('gid', 'gid'),
+    ('gidar', 'gid'),
+    ('gaɓogbo', 'gie'),
+    ('gie', 'gie'),
+    ('gig', 'gig'),
+    ('goaria', 'gig'),
+    ('gih', 'gih'),
+    ('githabul', 'gih'),
+    ('gii', 'gii'),
+    ('girirra', 'gii'),
+    ('gil', 'gil'),
+    ('gilbertese', 'gil'),
+    ('gim', 'gim'),
+    ('gimi', 'gim'),
+    ('gimi (eastern highlands)', 'gim'),
+    ('gin', 'gin'),
+    ('hinukh', 'gin'),
+    ('gimi (west new britain)', 'gip'),
+    ('gip', 'gip'),
+    ('giq', 'giq'),
+    ('green gelao', 'giq'),
+    ('gir', 'gir'),
+    ('red gelao', 'gir'),
+    ('gis', 'gis'),
+    ('north giziga', 'gis'),
+    ('git', 'git'),
+    ('gitxsan', 'git'),
+    ('giu', 'giu'),
+    ('mulao', 'giu'),
+    ('giw', 'giw'),
+    ('white gelao', 'giw'),
+    ('gilima', 'gix'),
+    ('gix', 'gix'),
+    ('giy', 'giy'),
+    ('giyug', 'giy'),
+    ('giz', 'giz'),
+    ('south giziga', 'giz'),
+    ('gjk', 'gjk'),
+    ('kachi koli', 'gjk'),
+    ('gjm', 'gjm'),
+    ('gunditjmara', 'gjm'),
+    ('gjn', 'gjn'),
+    ('gonja', 'gjn'),
+    ('gjr', 'gjr'),
+    ('gurindji kriol', 'gjr'),
+    ('gju', 'gju'),
+    ('gujari', 'gju'),
+    ('gka', 'gka'),
+    ('guya', 'gka'),
+    ('gkd', 'gkd'),
+    ('magɨ', 'gkd'),
+    ('magɨ (madang province)', 'gkd'),
+    ('gke', 'gke'),
+    ('ndai', 'gke'),
+    ('gkn', 'gkn'),
+    ('gokana', 'gkn'),
+    ('gko', 'gko'),
+    ('kok-nar', 'gko'),
+    ('gkp', 'gkp'),
+    ('guinea kpelle', 'gkp'),
+    ('gku', 'gku'),
+    ('ǂungkue', 'gku'),
+    ('galician', 'gl'),
+    ('gl', 'gl'),
+    ('glg', 'gl'),
+    ('belning', 'glb'),
+    ('glb', 'glb'),
+    ('bon gula', 'glc'),
+    ('glc', 'glc'),
+    ('gld', 'gld'),
+    ('nanai', 'gld'),
+    ('glh', 'glh'),
+    ('northwest pashai', 'glh'),
+    ('glj', 'glj'),
+    ('gula iro', 'glj'),
+    ('gilaki', 'glk'),
+    ('glk', 'glk'),
+    ('garlali', 'gll'),
+    ('gll', 'gll'),
+    ('galambu', 'glo'),
+    ('glo', 'glo'),
+    ('glaro-twabo', 'glr'),
+    ('glr', 'glr'),
+    ('glu', 'glu'),
+    ('gula', 'glu'),
+    ('gula (chad)', 'glu'),
+    ('glavda', 'glw'),
+    ('glw', 'glw'),
+    ('gly', 'gly'),
+    ('gule', 'gly'),
+    ('gambera', 'gma'),
+    ('gma', 'gma'),
+    ('gmb', 'gmb'),
+    ('gula''alaa', 'gmb'),
+    ('gmd', 'gmd'),
+    ('mághdì', 'gmd'),
+    ('gmg', 'gmg'),
+    ('magɨyi', 'gmg'),
+    ('german, middle high', 'gmh'),
+    ('german, middle high (ca. 1050-1500)', 'gmh'),
+    ('gmh', 'gmh'),
+    ('middle high german', 'gmh'),
+    ('middle high german (ca. 1050-1500)', 'gmh'),
+    ('gml', 'gml'),
+    ('middle low german', 'gml'),
+    ('gbaya-mbodomo', 'gmm'),
+    ('gmm', 'gmm'),
+    ('gimnime', 'gmn'),
+    ('gmn', 'gmn'),
+    ('gmr', 'gmr'),
+    ('mirning', 'gmr'),
+    ('gmu', 'gmu'),
+    ('gumalu', 'gmu'),
+    ('gamo', 'gmv'),
+    ('gmv', 'gmv'),
+    ('gmx', 'gmx'),
+    ('magoma', 'gmx'),
+    ('gmy', 'gmy'),
+    ('mycenaean greek', 'gmy'),
+    ('gmz', 'gmz'),
+    ('mgbolizhia', 'gmz'),
+    ('gn', 'gn'),
+    ('grn', 'gn'),
+    ('guarani', 'gn'),
+    ('gna', 'gna'),
+    ('kaansa', 'gna'),
+    ('gangte', 'gnb'),
+    ('gnb', 'gnb'),
+    ('gnc', 'gnc'),
+    ('guanche', 'gnc'),
+    ('gnd', 'gnd'),
+    ('zulgo-gemzek', 'gnd'),
+    ('ganang', 'gne'),
+    ('gne', 'gne'),
+    ('gng', 'gng'),
+    ('ngangam', 'gng'),
+    ('gnh', 'gnh'),
+    ('lere', 'gnh'),
+    ('gni', 'gni'),
+    ('gooniyandi', 'gni'),
+    ('gnj', 'gnj'),
+    ('ngen', 'gnj'),
+    ('gnk', 'gnk'),
+    ('ǁgana', 'gnk'),
+    ('gangulu', 'gnl'),
+    ('gnl', 'gnl'),
+    ('ginuman', 'gnm'),
+    ('gnm', 'gnm'),
+    ('gnn', 'gnn'),
+    ('gumatj', 'gnn'),
+    ('gno', 'gno'),
+    ('northern gondi', 'gno'),
+    ('gana', 'gnq'),
+    ('gnq', 'gnq'),
+    ('gnr', 'gnr'),
+    ('gureng gureng', 'gnr'),
+    ('gnt', 'gnt'),
+    ('guntai', 'gnt'),
+    ('gnau', 'gnu'),
+    ('gnu', 'gnu'),
+    ('gnw', 'gnw'),
+    ('western bolivian guaraní', 'gnw'),
+    ('ganzi', 'gnz'),
+    ('gnz', 'gnz'),
+    ('goa', 'goa'),
+    ('guro', 'goa'),
+    ('gob', 'gob'),
+    ('playero', 'gob'),
+    ('goc', 'goc'),
+    ('gorakor', 'goc'),
+    ('god', 'god'),
+    ('godié', 'god'),
+    ('goe', 'goe'),
+    ('gongduk', 'goe'),
+    ('gof', 'gof'),
+    ('gofa', 'gof'),
+    ('gog', 'gog'),
+    ('gogo', 'gog'),
+    ('german, old high', 'goh'),
+    ('german, old high (ca. 750-1050)', 'goh'),
+    ('goh', 'goh'),
+    ('old high german', 'goh'),
+    ('old high german (ca. 750-1050)', 'goh'),
+    ('gobasi', 'goi'),
+    ('goi', 'goi'),
+    ('goj', 'goj'),
+    ('gowlan', 'goj'),
+    ('gok', 'gok'),
+    ('gowli', 'gok'),
+    ('gol', 'gol'),
+    ('gola', 'gol'),
+    ('goan konkani', 'gom'),
+    ('gom', 'gom'),
+    ('gon', 'gon'),
+    ('gondi', 'gon'),
+    ('gone dau', 'goo'),
+    ('goo', 'goo'),
+    ('gop', 'gop'),
+    ('yeretuar', 'gop'),
+    ('goq', 'goq'),
+    ('gorap', 'goq'),
+    ('gor', 'gor'),
+    ('gorontalo', 'gor'),
+    ('gos', 'gos'),
+    ('gronings', 'gos'),
+    ('got', 'got'),
+    ('gothic', 'got'),
+    ('gavar', 'gou'),
+    ('gou', 'gou'),
+    ('gov', 'gov'),
+    ('gorowa', 'gow'),
+    ('gow', 'gow'),
+    ('gobu', 'gox'),
+    ('gox', 'gox'),
+    ('goundo', 'goy'),
+    ('goy', 'goy'),
+    ('goz', 'goz'),
+    ('gozarkhani', 'goz'),
+    ('gpa', 'gpa'),
+    ('gupa-abawa', 'gpa'),
+    ('ghanaian pidgin english', 'gpe'),
+    ('gpe', 'gpe'),
+    ('gpn', 'gpn'),
+    ('taiap', 'gpn'),
+    ('ga''anda', 'gqa'),
+    ('gqa', 'gqa'),
+    ('gqi', 'gqi'),
+    ('guiqiong', 'gqi'),
+    ('gqn', 'gqn'),
+    ('guana', 'gqn'),
+    ('guana (brazil)', 'gqn'),
+    ('gqr', 'gqr'),
+    ('gqu', 'gqu'),
+    ('qau', 'gqu'),
+    ('gra', 'gra'),
+    ('rajput garasia', 'gra'),
+    ('grb', 'grb'),
+    ('grebo', 'grb'),
+    ('ancient greek', 'grc'),
+    ('ancient greek (to 1453)', 'grc'),
+    ('grc', 'grc'),
+    ('greek, ancient', 'grc'),
+    ('greek, ancient (to 1453)', 'grc'),
+    ('grd', 'grd'),
+    ('guruntum-mbaaru', 'grd'),
+    ('grg', 'grg'),
+    ('madi', 'grg'),
+    ('gbiri-niragu', 'grh'),
+    ('grh', 'grh'),
+    ('ghari', 'gri'),
+    ('gri', 'gri'),
+    ('grj', 'grj'),
+    ('southern grebo', 'grj'),
+    ('grm', 'grm'),
+    ('kota marudu talantang', 'grm'),
+    ('gro', 'gro'),
+    ('groma', 'gro'),
+    ('gorovu', 'grq'),
+    ('grq', 'grq'),
+    ('grr', 'grr'),
+    ('taznatit', 'grr'),
+    ('gresi', 'grs'),
+    ('grs', 'grs'),
+    ('garo', 'grt'),
+    ('grt', 'grt'),
+    ('gru', 'gru'),
+    ('kistane', 'gru'),
+    ('central grebo', 'grv'),
+    ('grv', 'grv'),
+    ('grw', 'grw'),
+    ('gweda', 'grw'),
+    ('grx', 'grx'),
+    ('guriaso', 'grx'),
+    ('barclayville grebo', 'gry'),
+    ('gry', 'gry'),
+    ('grz', 'grz'),
+    ('guramalum', 'grz'),
+    ('ghanaian sign language', 'gse'),
+    ('gse', 'gse'),
+    ('german sign language', 'gsg'),
+    ('gsg', 'gsg'),
+    ('gsl', 'gsl'),
+    ('gusilay', 'gsl'),
+    ('gsm', 'gsm'),
+    ('guatemalan sign language', 'gsm'),
+    ('gsn', 'gsn'),
+    ('nema', 'gsn'),
+    ('gso', 'gso'),
+    ('southwest gbaya', 'gso'),
+    ('gsp', 'gsp'),
+    ('wasembo', 'gsp'),
+    ('greek sign language', 'gss'),
+    ('gss', 'gss'),
+    ('alemannic', 'gsw'),
+    ('alsatian', 'gsw'),
+    ('gsw', 'gsw'),
+    ('swiss german', 'gsw'),
+    ('gta', 'gta'),
+    ('guató', 'gta'),
+    ('aghu-tharnggala', 'gtu'),
+    ('gtu', 'gtu'),
+    ('gu', 'gu'),
+    ('guj', 'gu'),
+    ('gujarati', 'gu'),
+    ('gua', 'gua'),
+    ('shiki', 'gua'),
+    ('guajajára', 'gub'),
+    ('gub', 'gub'),
+    ('guc', 'guc'),
+    ('wayuu', 'guc'),
+    ('gud', 'gud'),
+    ('yocoboué dida', 'gud'),
+    ('gue', 'gue'),
+    ('gurindji', 'gue'),
+    ('guf', 'guf'),
+    ('gupapuyngu', 'guf'),
+    ('gug', 'gug'),
+    ('paraguayan guaraní', 'gug'),
+    ('guahibo', 'guh'),
+    ('guh', 'guh'),
+    ('eastern bolivian guaraní', 'gui'),
+    ('gui', 'gui'),
+    ('guk', 'guk'),
+    ('gumuz', 'guk'),
+    ('gul', 'gul'),
+    ('sea island creole english', 'gul'),
+    ('guambiano', 'gum'),
+    ('gum', 'gum'),
+    ('gun', 'gun'),
+    ('mbyá guaraní', 'gun'),
+    ('guayabero', 'guo'),
+    ('guo', 'guo'),
+    ('gunwinggu', 'gup'),
+    ('gup', 'gup'),
+    ('aché', 'guq'),
+    ('guq', 'guq'),
+    ('farefare', 'gur'),
+    ('gur', 'gur'),
+    ('guinean sign language', 'gus'),
+    ('gus', 'gus'),
+    ('gut', 'gut'),
+    ('maléku jaíka', 'gut'),
+    ('guu', 'guu'),
+    ('yanomamö', 'guu'),
+    ('guw', 'guw'),
+    ('gourmanchéma', 'gux'),
+    ('gux', 'gux'),
+    ('gusii', 'guz'),
+    ('guz', 'guz'),
+    ('glv', 'gv'),
+    ('gv', 'gv'),
+    ('manx', 'gv'),
+    ('guana (paraguay)', 'gva'),
+    ('gva', 'gva'),
+    ('guanano', 'gvc'),
+    ('gvc', 'gvc'),
+    ('duwet', 'gve'),
+    ('gve', 'gve'),
+    ('golin', 'gvf'),
+    ('gvf', 'gvf'),
+    ('guajá', 'gvj'),
+    ('gvj', 'gvj'),
+    ('gulay', 'gvl'),
+    ('gvl', 'gvl'),
+    ('gurmana', 'gvm'),
+    ('gvm', 'gvm'),
+    ('gvn', 'gvn'),
+    ('kuku-yalanji', 'gvn'),
+    ('gavião do jiparaná', 'gvo'),
+    ('gvo', 'gvo'),
+    ('gvp', 'gvp'),
+    ('pará gavião', 'gvp'),
+    ('gurung', 'gvr'),
+    ('gvr', 'gvr'),
+    ('gumawana', 'gvs'),
+    ('gvs', 'gvs'),
+    ('guyani', 'gvy'),
+    ('gvy', 'gvy'),
+    ('gwa', 'gwa'),
+    ('mbato', 'gwa'),
+    ('gwb', 'gwb'),
+    ('gawri', 'gwc'),
+    ('gwc', 'gwc'),
+    ('gawwada', 'gwd'),
+    ('gwd', 'gwd'),
+    ('gwe', 'gwe'),
+    ('gweno', 'gwe'),
+    ('gowro', 'gwf'),
+    ('gwf', 'gwf'),
+    ('gwg', 'gwg'),
+    ('moo', 'gwg'),
+    ('gwi', 'gwi'),
+    ('gwich''in', 'gwi'),
+    ('gwichʼin', 'gwi'),
+    ('gwj', 'gwj'),
+    ('ǀgwi', 'gwj'),
+    ('awngthim', 'gwm'),
+    ('gwm', 'gwm'),
+    ('gwandara', 'gwn'),
+    ('gwn', 'gwn'),
+    ('gwere', 'gwr'),
+    ('gwr', 'gwr'),
+    ('gawar-bati', 'gwt'),
+    ('gwt', 'gwt'),
+    ('guwamu', 'gwu'),
+    ('gwu', 'gwu'),
+    ('gww', 'gww'),
+    ('kwini', 'gww'),
+    ('gwx', 'gwx'),
+    ('gxx', 'gxx'),
+    ('wè southern', 'gxx'),
+    ('gya', 'gya'),
+    ('northwest gbaya', 'gya'),
+    ('garus', 'gyb'),
+    ('gyb', 'gyb'),
+    ('gyd', 'gyd'),
+    ('kayardild', 'gyd'),
+    ('gye', 'gye'),
+    ('gyem', 'gye'),
+    ('gungabula', 'gyf'),
+    ('gyf', 'gyf'),
+    ('gbayi', 'gyg'),
+    ('gyg', 'gyg'),
+    ('gyele', 'gyi'),
+    ('gyi', 'gyi'),
+    ('gayil', 'gyl'),
+    ('gyl', 'gyl'),
+    ('gym', 'gym'),
+    ('ngäbere', 'gym'),
+    ('guyanese creole english', 'gyn'),
+    ('gyn', 'gyn'),
+    ('gyalsumdo', 'gyo'),
+    ('gyo', 'gyo'),
+    ('guarayu', 'gyr'),
+    ('gyr', 'gyr'),
+    ('gunya', 'gyy'),
+    ('gyy', 'gyy'),
+    ('geji', 'gyz'),
+    ('gyz', 'gyz'),
+    ('ganza', 'gza'),
+    ('gza', 'gza'),
+    ('gazi', 'gzi'),
+    ('gzi', 'gzi'),
+    ('gane', 'gzn'),
+    ('gzn', 'gzn'),
+    ('ha', 'ha'),
+    ('hau', 'ha'),
+    ('hausa', 'ha'),
+    ('haa', 'haa'),
+    ('han', 'haa'),
+    ('hab', 'hab'),
+    ('hanoi sign language', 'hab'),
+    ('gurani', 'hac'),
+    ('hac', 'hac'),
+    ('had', 'had'),
+    ('hatam', 'had'),
+    ('eastern oromo', 'hae'),
+    ('hae', 'hae'),
+    ('haf', 'haf'),
+    ('haiphong sign language', 'haf'),
+    ('hag', 'hag'),
+    ('hanga', 'hag'),
+    ('hah', 'hah'),
+    ('hahon', 'hah'),
+    ('hai', 'hai'),
+    ('haida', 'hai'),
+    ('haj', 'haj'),
+    ('hajong', 'haj'),
+    ('hak', 'hak'),
+    ('hakka chinese', 'hak'),
+    ('hal', 'hal'),
+    ('halang', 'hal'),
+    ('ham', 'ham'),
+    ('hewa', 'ham'),
+    ('hangaza', 'han'),
+    ('hakö', 'hao'),
+    ('hao', 'hao'),
+    ('hap', 'hap'),
+    ('hupla', 'hap'),
+    ('haq', 'haq'),
+    ('har', 'har'),
+    ('harari', 'har'),
+    ('haisla', 'has'),
+    ('has', 'has'),
+    ('hav', 'hav'),
+    ('havu', 'hav'),
+    ('haw', 'haw'),
+    ('hawaiian', 'haw'),
+    ('hax', 'hax'),
+    ('southern haida', 'hax'),
+    ('hay', 'hay'),
+    ('haya', 'hay'),
+    ('haz', 'haz'),
+    ('hazaragi', 'haz'),
+    ('hamba', 'hba'),
+    ('hba', 'hba'),
+    ('hbb', 'hbb'),
+    ('huba', 'hbb'),
+    ('hbn', 'hbn'),
+    ('heiban', 'hbn'),
+    ('ancient hebrew', 'hbo'),
+    ('hbo', 'hbo'),
+    ('habu', 'hbu'),
+    ('hbu', 'hbu'),
+    ('andaman creole hindi', 'hca'),
+    ('hca', 'hca'),
+    ('hch', 'hch'),
+    ('huichol', 'hch'),
+    ('hdn', 'hdn'),
+    ('northern haida', 'hdn'),
+    ('hds', 'hds'),
+    ('honduras sign language', 'hds'),
+    ('hadiyya', 'hdy'),
+    ('hdy', 'hdy'),
+    ('he', 'he'),
+    ('heb', 'he'),
+    ('hebrew', 'he'),
+    ('hea', 'hea'),
+    ('northern qiandong miao', 'hea'),
+    ('hed', 'hed'),
+    ('herdé', 'hed'),
+    ('heg', 'heg'),
+    ('helong', 'heg'),
+    ('heh', 'heh'),
+    ('hehe', 'heh'),
+    ('hei', 'hei'),
+    ('heiltsuk', 'hei'),
+    ('hem', 'hem'),
+    ('hemba', 'hem'),
+    ('haiǁom', 'hgm'),
+    ('hgm', 'hgm'),
+    ('haigwai', 'hgw'),
+    ('hgw', 'hgw'),
+    ('hhi', 'hhi'),
+    ('hoia hoia', 'hhi'),
+    ('hhr', 'hhr'),
+    ('kerak', 'hhr'),
+    ('hhy', 'hhy'),
+    ('hoyahoya', 'hhy'),
+    ('hi', 'hi'),
+    ('hin', 'hi'),
+    ('hindi', 'hi'),
+    ('hia', 'hia'),
+    ('lamang', 'hia'),
+    ('hib', 'hib'),
+    ('hibito', 'hib'),
+    ('hid', 'hid'),
+    ('hidatsa', 'hid'),
+    ('fiji hindi', 'hif'),
+    ('hif', 'hif'),
+    ('hig', 'hig'),
+    ('kamwe', 'hig'),
+    ('hih', 'hih'),
+    ('pamosu', 'hih'),
+    ('hii', 'hii'),
+    ('hinduri', 'hii'),
+    ('hij', 'hij'),
+    ('hijuk', 'hij'),
+    ('hik', 'hik'),
+    ('seit-kaitetu', 'hik'),
+    ('hil', 'hil'),
+    ('hiligaynon', 'hil'),
+    ('hio', 'hio'),
+    ('tsoa', 'hio'),
+    ('himarimã', 'hir'),
+    ('hir', 'hir'),
+    ('hit', 'hit'),
+    ('hittite', 'hit'),
+    ('hiw', 'hiw'),
+    ('hix', 'hix'),
+    ('hixkaryána', 'hix'),
+    ('haji', 'hji'),
+    ('hji', 'hji'),
+    ('hka', 'hka'),
+    ('kahe', 'hka'),
+    ('hke', 'hke'),
+    ('hunde', 'hke'),
+    ('hkh', 'hkh'),
+    ('khah', 'hkh'),
+    ('hkk', 'hkk'),
+    ('hunjara-kaina ke', 'hkk'),
+    ('hkn', 'hkn'),
+    ('mel-khaonh', 'hkn'),
+    ('hks', 'hks'),
+    ('hong kong sign language', 'hks'),
+    ('halia', 'hla'),
+    ('hla', 'hla'),
+    ('halbi', 'hlb'),
+    ('hlb', 'hlb'),
+    ('halang doan', 'hld'),
+    ('hld', 'hld'),
+    ('hle', 'hle'),
+    ('hlersu', 'hle'),
+    ('hlt', 'hlt'),
+    ('matu chin', 'hlt'),
+    ('hieroglyphic luwian', 'hlu'),
+    ('hlu', 'hlu'),
+    ('hma', 'hma'),
+    ('southern mashan hmong', 'hma'),
+    ('hmb', 'hmb'),
+    ('humburi senni songhay', 'hmb'),
+    ('central huishui hmong', 'hmc'),
+    ('hmc', 'hmc'),
+    ('hmd', 'hmd'),
+    ('large flowery miao', 'hmd'),
+    ('eastern huishui hmong', 'hme'),
+    ('hme', 'hme'),
+    ('hmf', 'hmf'),
+    ('hmong don', 'hmf'),
+    ('hmg', 'hmg'),
+    ('southwestern guiyang hmong', 'hmg'),
+    ('hmh', 'hmh'),
+    ('southwestern huishui hmong', 'hmh'),
+    ('hmi', 'hmi'),
+    ('northern huishui hmong', 'hmi'),
+    ('ge', 'hmj'),
+    ('hmj', 'hmj'),
+    ('hmk', 'hmk'),
+    ('maek', 'hmk'),
+    ('hml', 'hml'),
+    ('luopohe hmong', 'hml'),
+    ('central mashan hmong', 'hmm'),
+    ('hmm', 'hmm'),
+    ('hmn', 'hmn'),
+    ('hmong', 'hmn'),
+    ('mong', 'hmn'),
+    ('hmp', 'hmp'),
+    ('northern mashan hmong', 'hmp'),
+    ('eastern qiandong miao', 'hmq'),
+    ('hmq', 'hmq'),
+    ('hmar', 'hmr'),
+    ('hmr', 'hmr'),
+    ('hms', 'hms'),
+    ('southern qiandong miao', 'hms'),
+    ('hamtai', 'hmt'),
+    ('hmt', 'hmt'),
+    ('hamap', 'hmu'),
+    ('hmu', 'hmu'),
+    ('hmong dô', 'hmv'),
+    ('hmv', 'hmv'),
+    ('hmw', 'hmw'),
+    ('western mashan hmong', 'hmw'),
+    ('hmy', 'hmy'),
+    ('southern guiyang hmong', 'hmy'),
+    ('hmong shua', 'hmz'),
+    ('hmz', 'hmz'),
+    ('hna', 'hna'),
+    ('mina', 'hna'),
+    ('mina (cameroon)', 'hna'),
+    ('hnd', 'hnd'),
+    ('southern hindko', 'hnd'),
+    ('chhattisgarhi', 'hne'),
+    ('hne', 'hne'),
+    ('hng', 'hng'),
+    ('hungu', 'hng'),
+    ('hnh', 'hnh'),
+    ('ǁani', 'hnh'),
+    ('hani', 'hni'),
+    ('hni', 'hni'),
+    ('hmong njua', 'hnj'),
+    ('hnj', 'hnj'),
+    ('hanunoo', 'hnn'),
+    ('hnn', 'hnn'),
+    ('hno', 'hno'),
+    ('northern hindko', 'hno'),
+    ('caribbean hindustani', 'hns'),
+    ('hns', 'hns'),
+    ('hnu', 'hnu'),
+    ('hung', 'hnu'),
+    ('hiri motu', 'ho'),
+    ('hmo', 'ho'),
+    ('ho', 'ho'),
+    ('hoa', 'hoa'),
+    ('hoava', 'hoa'),
+    ('hob', 'hob'),
+    ('mari (madang province)', 'hob'),
+    ('hoc', 'hoc'),
+    ('hod', 'hod'),
+    ('holma', 'hod'),
+    ('hoe', 'hoe'),
+    ('horom', 'hoe'),
+    ('hobyót', 'hoh'),
+    ('hoh', 'hoh'),
+    ('hoi', 'hoi'),
+    ('holikachuk', 'hoi'),
+    ('hadothi', 'hoj'),
+    ('hoj', 'hoj'),
+    ('hol', 'hol'),
+    ('holu', 'hol'),
+    ('hom', 'hom'),
+    ('homa', 'hom'),
+    ('holoholo', 'hoo'),
+    ('hoo', 'hoo'),
+    ('hop', 'hop'),
+    ('hopi', 'hop'),
+    ('hor', 'hor'),
+    ('horo', 'hor'),
+    ('ho chi minh city sign language', 'hos'),
+    ('hos', 'hos'),
+    ('hot', 'hot'),
+    ('hote', 'hot'),
+    ('hov', 'hov'),
+    ('hovongan', 'hov'),
+    ('honi', 'how'),
+    ('how', 'how'),
+    ('holiya', 'hoy'),
+    ('hoy', 'hoy'),
+    ('hoz', 'hoz'),
+    ('hozo', 'hoz'),
+    ('hpo', 'hpo'),
+    ('hpon', 'hpo'),
+    ('hawai''i sign language', 'hps'),
+    ('hawai''i sign language (hsl)', 'hps'),
+    ('hps', 'hps'),
+    ('croatian', 'hr'),
+    ('hr', 'hr'),
+    ('hrv', 'hr'),
+    ('hra', 'hra'),
+    ('hrangkhol', 'hra'),
+    ('hrc', 'hrc'),
+    ('niwer mil', 'hrc'),
+    ('hre', 'hre'),
+    ('haruku', 'hrk'),
+    ('hrk', 'hrk'),
+    ('horned miao', 'hrm'),
+    ('hrm', 'hrm'),
+    ('haroi', 'hro'),
+    ('hro', 'hro'),
+    ('hrp', 'hrp'),
+    ('nhirrpi', 'hrp'),
+    ('hrt', 'hrt'),
+    ('hértevin', 'hrt'),
+    ('hru', 'hru'),
+    ('hruso', 'hru'),
+    ('hrw', 'hrw'),
+    ('warwar feni', 'hrw'),
+    ('hrx', 'hrx'),
+    ('hunsrik', 'hrx'),
+    ('harzani', 'hrz'),
+    ('hrz', 'hrz'),
+    ('hsb', 'hsb'),
+    ('upper sorbian', 'hsb'),
+    ('hsh', 'hsh'),
+    ('hungarian sign language', 'hsh'),
+    ('hausa sign language', 'hsl'),
+    ('hsl', 'hsl'),
+    ('hsn', 'hsn'),
+    ('xiang chinese', 'hsn'),
+    ('harsusi', 'hss'),
+    ('hss', 'hss'),
+    ('haitian', 'ht'),
+    ('haitian creole', 'ht'),
+    ('hat', 'ht'),
+    ('ht', 'ht'),
+    ('hoti', 'hti'),
+    ('hti', 'hti'),
+    ('hto', 'hto'),
+    ('minica huitoto', 'hto'),
+    ('hadza', 'hts'),
+    ('hts', 'hts'),
+    ('hitu', 'htu'),
+    ('htu', 'htu'),
+    ('htx', 'htx'),
+    ('middle hittite', 'htx'),
+    ('hu', 'hu'),
+    ('hun', 'hu'),
+    ('hungarian', 'hu'),
+    ('huambisa', 'hub'),
+    ('hub', 'hub'),
+    ('huc', 'huc'),
+    ('ǂhua', 'huc'),
+    ('huaulu', 'hud'),
+    ('hud', 'hud'),
+    ('hue', 'hue'),
+    ('san francisco del mar huave', 'hue'),
+    ('huf', 'huf'),
+    ('humene', 'huf'),
+    ('huachipaeri', 'hug'),
+    ('hug', 'hug'),
+    ('huh', 'huh'),
+    ('huilliche', 'huh'),
+    ('hui', 'hui'),
+    ('huli', 'hui'),
+    ('huj', 'huj'),
+    ('northern guiyang hmong', 'huj'),
+    ('huk', 'huk'),
+    ('hulung', 'huk'),
+    ('hul', 'hul'),
+    ('hula', 'hul'),
+    ('hum', 'hum'),
+    ('hungana', 'hum'),
+    ('huo', 'huo'),
+    ('hup', 'hup'),
+    ('hupa', 'hup'),
+    ('huq', 'huq'),
+    ('tsat', 'huq'),
+    ('halkomelem', 'hur'),
+    ('hur', 'hur'),
+    ('huastec', 'hus'),
+    ('hus', 'hus'),
+    ('humla', 'hut'),
+    ('hut', 'hut'),
+    ('huu', 'huu'),
+    ('murui huitoto', 'huu'),
+    ('huv', 'huv'),
+    ('san mateo del mar huave', 'huv'),
+    ('hukumina', 'huw'),
+    ('huw', 'huw'),
+    ('hux', 'hux'),
+    ('nüpode huitoto', 'hux'),
+    ('hulaulá', 'huy'),
+    ('huy', 'huy'),
+    ('hunzib', 'huz'),
+    ('huz', 'huz'),
+    ('haitian vodoun culture language', 'hvc'),
+    ('hvc', 'hvc'),
+    ('hve', 'hve'),
+    ('san dionisio del mar huave', 'hve'),
+    ('haveke', 'hvk'),
+    ('hvk', 'hvk'),
+    ('hvn', 'hvn'),
+    ('sabu', 'hvn'),
+    ('hvv', 'hvv'),
+    ('santa maría del mar huave', 'hvv'),
+    ('hwa', 'hwa'),
+    ('wané', 'hwa'),
+    ('hawai''i creole english', 'hwc'),
+    ('hwc', 'hwc'),
+    ('hwana', 'hwo'),
+    ('hwo', 'hwo'),
+    ('arm', 'hy'),
+    ('armenian', 'hy'),
+    ('hy', 'hy'),
+    ('hye', 'hy'),
+    ('hya', 'hya'),
+    ('hyw', 'hyw'),
+    ('western armenian', 'hyw'),
+    ('her', 'hz'),
+    ('herero', 'hz'),
+    ('hz', 'hz'),
+    ('ia', 'ia'),
+    ('ina', 'ia'),
+    ('interlingua', 'ia'),
+    ('interlingua (international auxiliary language association)', 'ia'),
+    ('iaai', 'iai'),
+    ('iai', 'iai'),
+    ('ian', 'ian'),
+    ('iatmul', 'ian'),
+    ('iar', 'iar'),
+    ('purari', 'iar'),
+    ('iba', 'iba'),
+    ('iban', 'iba'),
+    ('ibb', 'ibb'),
+    ('ibibio', 'ibb'),
+    ('ibd', 'ibd'),
+    ('iwaidja', 'ibd'),
+    ('akpes', 'ibe'),
+    ('ibe', 'ibe'),
+    ('ibanag', 'ibg'),
+    ('ibg', 'ibg'),
+    ('bih', 'ibh'),
+    ('ibh', 'ibh'),
+    ('ibaloi', 'ibl'),
+    ('ibl', 'ibl'),
+    ('agoi', 'ibm'),
+    ('ibm', 'ibm'),
+    ('ibino', 'ibn'),
+    ('ibn', 'ibn'),
+    ('ibr', 'ibr'),
+    ('ibuoro', 'ibr'),
+    ('ibu', 'ibu'),
+    ('ibani', 'iby'),
+    ('iby', 'iby'),
+    ('ede ica', 'ica'),
+    ('ica', 'ica'),
+    ('etkywan', 'ich'),
+    ('ich', 'ich'),
+    ('icelandic sign language', 'icl'),
+    ('icl', 'icl'),
+    ('icr', 'icr'),
+    ('islander creole english', 'icr'),
+    ('id', 'id'),
+    ('ind', 'id'),
+    ('indonesian', 'id'),
+    ('ida', 'ida'),
+    ('idakho-isukha-tiriki', 'ida'),
+    ('idb', 'idb'),
+    ('indo-portuguese', 'idb'),
+    ('idc', 'idc'),
+    ('idon', 'idc'),
+    ('ede idaca', 'idd'),
+    ('idd', 'idd'),
+    ('ide', 'ide'),
+    ('idere', 'ide'),
+    ('idi', 'idi'),
+    ('idr', 'idr'),
+    ('indri', 'idr'),
+    ('idesa', 'ids'),
+    ('ids', 'ids'),
+    ('idaté', 'idt'),
+    ('idt', 'idt'),
+    ('idoma', 'idu'),
+    ('idu', 'idu'),
+    ('ie', 'ie'),
+    ('ile', 'ie'),
+    ('interlingue', 'ie'),
+    ('occidental', 'ie'),
+    ('amganad ifugao', 'ifa'),
+    ('ifa', 'ifa'),
+    ('batad ifugao', 'ifb'),
+    ('ifb', 'ifb'),
+    ('ife', 'ife'),
+    ('ifè', 'ife'),
+    ('iff', 'iff'),
+    ('ifo', 'iff'),
+    ('ifk', 'ifk'),
+    ('tuwali ifugao', 'ifk'),
+    ('ifm', 'ifm'),
+    ('teke-fuumu', 'ifm'),
+    ('ifu', 'ifu'),
+    ('mayoyao ifugao', 'ifu'),
+    ('ify', 'ify'),
+    ('keley-i kallahan', 'ify'),
+    ('ibo', 'ig'),
+    ('ig', 'ig'),
+    ('igbo', 'ig'),
+    ('ebira', 'igb'),
+    ('igb', 'igb'),
+    ('ige', 'ige'),
+    ('igede', 'ige'),
+    ('igana', 'igg'),
+    ('igg', 'igg'),
+    ('igala', 'igl'),
+    ('igl', 'igl'),
+    ('igm', 'igm'),
+    ('kanggape', 'igm'),
+    ('ign', 'ign'),
+    ('ignaciano', 'ign'),
+    ('isebe', 'igo'),
+    ('igs', 'igs'),
+    ('interglossa', 'igs'),
+    ('igw', 'igw'),
+    ('igwe', 'igw'),
+    ('iha based pidgin', 'ihb'),
+    ('ihb', 'ihb'),
+    ('ihi', 'ihi'),
+    ('ihievbe', 'ihi'),
+    ('iha', 'ihp'),
+    ('ihp', 'ihp'),
+    ('bidhawal', 'ihw'),
+    ('ihw', 'ihw'),
+    ('ii', 'ii'),
+    ('iii', 'ii'),
+    ('nuosu', 'ii'),
+    ('sichuan yi', 'ii'),
+    ('iin', 'iin'),
+    ('thiin', 'iin'),
+    ('ijc', 'ijc'),
+    ('izon', 'ijc'),
+    ('biseni', 'ije'),
+    ('ije', 'ije'),
+    ('ede ije', 'ijj'),
+    ('ijj', 'ijj'),
+    ('ijn', 'ijn'),
+    ('kalabari', 'ijn'),
+    ('ijs', 'ijs'),
+    ('southeast ijo', 'ijs'),
+    ('ik', 'ik'),
+    ('inupiaq', 'ik'),
+    ('ipk', 'ik'),
+    ('eastern canadian inuktitut', 'ike'),
+    ('ike', 'ike'),
+    ('iki', 'iki'),
+    ('iko', 'iki'),
+    ('ika', 'ikk'),
+    ('ikk', 'ikk'),
+    ('ikl', 'ikl'),
+    ('ikulu', 'ikl'),
+    ('olulumo-ikom', 'iko'),
+    ('ikp', 'ikp'),
+    ('ikpeshi', 'ikp'),
+    ('ikaranggal', 'ikr'),
+    ('ikr', 'ikr'),
+    ('iks', 'iks'),
+    ('inuit sign language', 'iks'),
+    ('ikt', 'ikt'),
+    ('inuinnaqtun', 'ikt'),
+    ('iku-gora-ankwa', 'ikv'),
+    ('ikv', 'ikv'),
+    ('ikw', 'ikw'),
+    ('ikwere', 'ikw'),
+    ('ikx', 'ikx'),
+    ('ikizu', 'ikz'),
+    ('ikz', 'ikz'),
+    ('ila', 'ila'),
+    ('ile ape', 'ila'),
+    ('ilb', 'ilb'),
+    ('garig-ilgar', 'ilg'),
+    ('ilg', 'ilg'),
+    ('ili', 'ili'),
+    ('ili turki', 'ili'),
+    ('ilk', 'ilk'),
+    ('ilongot', 'ilk'),
+    ('ilm', 'ilm'),
+    ('iranun', 'ilm'),
+    ('iranun (malaysia)', 'ilm'),
+    ('ilo', 'ilo'),
+    ('iloko', 'ilo'),
+    ('ilp', 'ilp'),
+    ('iranun (philippines)', 'ilp'),
+    ('ils', 'ils'),
+    ('international sign', 'ils'),
+    ('ili''uun', 'ilu'),
+    ('ilu', 'ilu'),
+    ('ilue', 'ilv'),
+    ('ilv', 'ilv'),
+    ('ima', 'ima'),
+    ('mala malasar', 'ima'),
+    ('anamgura', 'imi'),
+    ('imi', 'imi'),
+    ('iml', 'iml'),
+    ('miluk', 'iml'),
+    ('imn', 'imn'),
+    ('imonda', 'imn'),
+    ('imbongu', 'imo'),
+    ('imo', 'imo'),
+    ('imr', 'imr'),
+    ('imroing', 'imr'),
+    ('ims', 'ims'),
+    ('marsian', 'ims'),
+    ('imotong', 'imt'),
+    ('imt', 'imt'),
+    ('imy', 'imy'),
+    ('milyan', 'imy'),
+    ('inb', 'inb'),
+    ('inga', 'inb'),
+    ('degexit''an', 'ing'),
+    ('ing', 'ing'),
+    ('ingush', 'inh'),
+    ('inh', 'inh'),
+    ('inj', 'inj'),
+    ('jungle inga', 'inj'),
+    ('indonesian sign language', 'inl'),
+    ('inl', 'inl'),
+    ('inm', 'inm'),
+    ('minaean', 'inm'),
+    ('inn', 'inn'),
+    ('isinai', 'inn'),
+    ('ino', 'ino'),
+    ('inoke-yate', 'ino'),
+    ('inp', 'inp'),
+    ('iñapari', 'inp'),
+    ('indian sign language', 'ins'),
+    ('ins', 'ins'),
+    ('int', 'int'),
+    ('intha', 'int'),
+    ('ineseño', 'inz'),
+    ('inz', 'inz'),
+    ('ido', 'io'),
+    ('io', 'io'),
+    ('inor', 'ior'),
+    ('ior', 'ior'),
+    ('iou', 'iou'),
+    ('tuma-irumu', 'iou'),
+    ('iow', 'iow'),
+    ('iowa-oto', 'iow'),
+    ('ipi', 'ipi'),
+    ('ipili', 'ipi'),
+    ('ipiko', 'ipo'),
+    ('ipo', 'ipo'),
+    ('iqu', 'iqu'),
+    ('iquito', 'iqu'),
+    ('ikwo', 'iqw'),
+    ('iqw', 'iqw'),
+    ('ire', 'ire'),
+    ('iresim', 'ire'),
+    ('irarutu', 'irh'),
+    ('irh', 'irh'),
+    ('iri', 'iri'),
+    ('rigwe', 'iri'),
+    ('iraqw', 'irk'),
+    ('irk', 'irk'),
+    ('irn', 'irn'),
+    ('irántxe', 'irn'),
+    ('ir', 'irr'),
+    ('irr', 'irr'),
+    ('iru', 'iru'),
+    ('irula', 'iru'),
+    ('irx', 'irx'),
+    ('kamberau', 'irx'),
+    ('iraya', 'iry'),
+    ('iry', 'iry'),
+    ('ice', 'is'),
+    ('icelandic', 'is'),
+    ('is', 'is'),
+    ('isl', 'is'),
+    ('isa', 'isa'),
+    ('isabi', 'isa'),
+    ('isc', 'isc'),
+    ('isconahua', 'isc'),
+    ('isd', 'isd'),
+    ('isnag', 'isd'),
+    ('ise', 'ise'),
+    ('italian sign language', 'ise'),
+    ('irish sign language', 'isg'),
+    ('isg', 'isg'),
+    ('esan', 'ish'),
+    ('ish', 'ish'),
+    ('isi', 'isi'),
+    ('nkem-nkum', 'isi'),
+    ('ishkashimi', 'isk'),
+    ('isk', 'isk'),
+    ('ism', 'ism'),
+    ('masimasi', 'ism'),
+    ('isanzu', 'isn'),
+    ('isn', 'isn'),
+    ('iso', 'iso'),
+    ('isoko', 'iso'),
+    ('isr', 'isr'),
+    ('israeli sign language', 'isr'),
+    ('ist', 'ist'),
+    ('istriot', 'ist'),
+    ('isu', 'isu'),
+    ('isu (menchum division)', 'isu'),
+    ('it', 'it'),
+    ('ita', 'it'),
+    ('italian', 'it'),
+    ('binongan itneg', 'itb'),
+    ('itb', 'itb'),
+    ('itd', 'itd'),
+    ('southern tidung', 'itd'),
+    ('ite', 'ite'),
+    ('itene', 'ite'),
+    ('inlaod itneg', 'iti'),
+    ('iti', 'iti'),
+    ('itk', 'itk'),
+    ('judeo-italian', 'itk'),
+    ('itelmen', 'itl'),
+    ('itl', 'itl'),
+    ('itm', 'itm'),
+    ('itu mbon uzo', 'itm'),
+    ('ito', 'ito'),
+    ('itonama', 'ito'),
+    ('iteri', 'itr'),
+    ('itr', 'itr'),
+    ('isekiri', 'its'),
+    ('its', 'its'),
+    ('itt', 'itt'),
+    ('maeng itneg', 'itt'),
+    ('itawit', 'itv'),
+    ('itv', 'itv'),
+    ('itw', 'itw'),
+    ('itik', 'itx'),
+    ('itx', 'itx'),
+    ('ity', 'ity'),
+    ('moyadan itneg', 'ity'),
+    ('itz', 'itz'),
+    ('itzá', 'itz'),
+    ('iku', 'iu'),
+    ('inuktitut', 'iu'),
+    ('iu', 'iu'),
+    ('iu mien', 'ium'),
+    ('ium', 'ium'),
+    ('ibatan', 'ivb'),
+    ('ivb', 'ivb'),
+    ('ivatan', 'ivv'),
+    ('ivv', 'ivv'),
+    ('i-wak', 'iwk'),
+    ('iwk', 'iwk'),
+    ('iwam', 'iwm'),
+    ('iwm', 'iwm'),
+    ('iwo', 'iwo'),
+    ('iwur', 'iwo'),
+    ('iws', 'iws'),
+    ('sepik iwam', 'iws'),
+    ('ixc', 'ixc'),
+    ('ixcatec', 'ixc'),
+    ('ixil', 'ixl'),
+    ('ixl', 'ixl'),
+    ('iya', 'iya'),
+    ('iyayu', 'iya'),
+    ('iyo', 'iyo'),
+    ('mesaka', 'iyo'),
+    ('iyx', 'iyx'),
+    ('yaka (congo)', 'iyx'),
+    ('ingrian', 'izh'),
+    ('izh', 'izh'),
+    ('izere', 'izr'),
+    ('izr', 'izr'),
+    ('izii', 'izz'),
+    ('izz', 'izz'),
+    ('ja', 'ja'),
+    ('japanese', 'ja'),
+    ('jpn', 'ja'),
+    ('jaa', 'jaa'),
+    ('jamamadí', 'jaa'),
+    ('hyam', 'jab'),
+    ('jab', 'jab'),
+    ('jac', 'jac'),
+    ('popti''', 'jac'),
+    ('jad', 'jad'),
+    ('jahanka', 'jad'),
+    ('jae', 'jae'),
+    ('yabem', 'jae'),
+    ('jaf', 'jaf'),
+    ('jara', 'jaf'),
+    ('jah', 'jah'),
+    ('jah hut', 'jah'),
+    ('jaj', 'jaj'),
+    ('zazao', 'jaj'),
+    ('jak', 'jak'),
+    ('jakun', 'jak'),
+    ('jal', 'jal'),
+    ('yalahatan', 'jal'),
+    ('jam', 'jam'),
+    ('jamaican creole english', 'jam'),
+    ('jan', 'jan'),
+    ('jandai', 'jan'),
+    ('jao', 'jao'),
+    ('yanyuwa', 'jao'),
+    ('jaq', 'jaq'),
+    ('yaqay', 'jaq'),
+    ('jas', 'jas'),
+    ('new caledonian javanese', 'jas'),
+    ('jakati', 'jat'),
+    ('jat', 'jat'),
+    ('jau', 'jau'),
+    ('yaur', 'jau'),
+    ('jambi malay', 'jax'),
+    ('jax', 'jax'),
+    ('jay', 'jay'),
+    ('yan-nhangu', 'jay'),
+    ('jawe', 'jaz'),
+    ('jaz', 'jaz'),
+    ('jbe', 'jbe'),
+    ('judeo-berber', 'jbe'),
+    ('badjiri', 'jbi'),
+    ('jbi', 'jbi'),
+    ('arandai', 'jbj'),
+    ('jbj', 'jbj'),
+    ('barikewa', 'jbk'),
+    ('jbk', 'jbk'),
+    ('bijim', 'jbm'),
+    ('jbm', 'jbm'),
+    ('jbn', 'jbn'),
+    ('nafusi', 'jbn'),
+    ('jbo', 'jbo'),
+    ('lojban', 'jbo'),
+    ('jbr', 'jbr'),
+    ('jofotek-bromnya', 'jbr'),
+    ('jabutí', 'jbt'),
+    ('jbt', 'jbt'),
+    ('jbu', 'jbu'),
+    ('jukun takum', 'jbu'),
+    ('jbw', 'jbw'),
+    ('yawijibaya', 'jbw'),
+    ('jamaican country sign language', 'jcs'),
+    ('jcs', 'jcs'),
+    ('jct', 'jct'),
+    ('krymchak', 'jct'),
+    ('jda', 'jda'),
+    ('jadgali', 'jdg'),
+    ('jdg', 'jdg'),
+    ('jdt', 'jdt'),
+    ('judeo-tat', 'jdt'),
+    ('jeb', 'jeb'),
+    ('jebero', 'jeb'),
+    ('jee', 'jee'),
+    ('jerung', 'jee'),
+    ('jeh', 'jeh'),
+    ('jei', 'jei'),
+    ('yei', 'jei'),
+    ('jek', 'jek'),
+    ('jeri kuo', 'jek'),
+    ('jel', 'jel'),
+    ('yelmek', 'jel'),
+    ('jen', 'jen'),
+    ('jer', 'jer'),
+    ('jere', 'jer'),
+    ('jet', 'jet'),
+    ('manem', 'jet'),
+    ('jeu', 'jeu'),
+    ('jonkor bourmataguil', 'jeu'),
+    ('jgb', 'jgb'),
+    ('ngbee', 'jgb'),
+    ('jge', 'jge'),
+    ('judeo-georgian', 'jge'),
+    ('gwak', 'jgk'),
+    ('jgk', 'jgk'),
+    ('jgo', 'jgo'),
+    ('ngomba', 'jgo'),
+    ('jehai', 'jhi'),
+    ('jhi', 'jhi'),
+    ('jhankot sign language', 'jhs'),
+    ('jhs', 'jhs'),
+    ('jia', 'jia'),
+    ('jina', 'jia'),
+    ('jib', 'jib'),
+    ('jibu', 'jib'),
+    ('jic', 'jic'),
+    ('tol', 'jic'),
+    ('bu', 'jid'),
+    ('bu (kaduna state)', 'jid'),
+    ('jid', 'jid'),
+    ('jie', 'jie'),
+    ('jilbe', 'jie'),
+    ('jig', 'jig'),
+    ('jingulu', 'jig'),
+    ('jih', 'jih'),
+    ('stodsde', 'jih'),
+    ('jii', 'jii'),
+    ('jiiddu', 'jii'),
+    ('jil', 'jil'),
+    ('jilim', 'jil'),
+    ('jim', 'jim'),
+    ('jimi', 'jim'),
+    ('jimi (cameroon)', 'jim'),
+    ('jiamao', 'jio'),
+    ('jio', 'jio'),
+    ('guanyinqiao', 'jiq'),
+    ('jiq', 'jiq'),
+    ('jit', 'jit'),
+    ('jita', 'jit'),
+    ('jiu', 'jiu'),
+    ('youle jinuo', 'jiu'),
+    ('jiv', 'jiv'),
+    ('shuar', 'jiv'),
+    ('buyuan jinuo', 'jiy'),
+    ('jiy', 'jiy'),
+    ('jejueo', 'jje'),
+    ('jje', 'jje'),
+    ('bankal', 'jjr'),
+    ('jjr', 'jjr'),
+    ('jka', 'jka'),
+    ('kaera', 'jka'),
+    ('jkm', 'jkm'),
+    ('mobwa karen', 'jkm'),
+    ('jko', 'jko'),
+    ('kubo', 'jko'),
+    ('jkp', 'jkp'),
+    ('paku karen', 'jkp'),
+    ('jkr', 'jkr'),
+    ('koro', 'jkr'),
+    ('koro (india)', 'jkr'),
+    ('amami koniya sign language', 'jks'),
+    ('jks', 'jks'),
+    ('jku', 'jku'),
+    ('labir', 'jku'),
+    ('jle', 'jle'),
+    ('ngile', 'jle'),
+    ('jamaican sign language', 'jls'),
+    ('jls', 'jls'),
+    ('dima', 'jma'),
+    ('jma', 'jma'),
+    ('jmb', 'jmb'),
+    ('zumbun', 'jmb'),
+    ('jmc', 'jmc'),
+    ('machame', 'jmc'),
+    ('jmd', 'jmd'),
+    ('yamdena', 'jmd'),
+    ('jimi (nigeria)', 'jmi'),
+    ('jmi', 'jmi'),
+    ('jml', 'jml'),
+    ('jumli', 'jml'),
+    ('jmn', 'jmn'),
+    ('makuri naga', 'jmn'),
+    ('jmr', 'jmr'),
+    ('kamara', 'jmr'),
+    ('jms', 'jms'),
+    ('mashi', 'jms'),
+    ('mashi (nigeria)', 'jms'),
+    ('jmw', 'jmw'),
+    ('mouwase', 'jmw'),
+    ('jmx', 'jmx'),
+    ('western juxtlahuaca mixtec', 'jmx'),
+    ('jangshung', 'jna'),
+    ('jna', 'jna'),
+    ('jandavra', 'jnd'),
+    ('jnd', 'jnd'),
+    ('jng', 'jng'),
+    ('yangman', 'jng'),
+    ('janji', 'jni'),
+    ('jni', 'jni'),
+    ('jnj', 'jnj'),
+    ('yemsa', 'jnj'),
+    ('jnl', 'jnl'),
+    ('rawat', 'jnl'),
+    ('jaunsari', 'jns'),
+    ('jns', 'jns'),
+    ('job', 'job'),
+    ('joba', 'job'),
+    ('jod', 'jod'),
+    ('wojenaka', 'jod'),
+    ('jog', 'jog'),
+    ('jogi', 'jog'),
+    ('jor', 'jor'),
+    ('jorá', 'jor'),
+    ('jordanian sign language', 'jos'),
+    ('jos', 'jos'),
+    ('jow', 'jow'),
+    ('jowulu', 'jow'),
+    ('jewish palestinian aramaic', 'jpa'),
+    ('jpa', 'jpa'),
+    ('jpr', 'jpr'),
+    ('judeo-persian', 'jpr'),
+    ('jaqaru', 'jqr'),
+    ('jqr', 'jqr'),
+    ('jarai', 'jra'),
+    ('jra', 'jra'),
+    ('jrb', 'jrb'),
+    ('judeo-arabic', 'jrb'),
+    ('jiru', 'jrr'),
+    ('jrr', 'jrr'),
+    ('jakattoe', 'jrt'),
+    ('jrt', 'jrt'),
+    ('japrería', 'jru'),
+    ('jru', 'jru'),
+    ('japanese sign language', 'jsl'),
+    ('jsl', 'jsl'),
+    ('jua', 'jua'),
+    ('júma', 'jua'),
+    ('jub', 'jub'),
+    ('wannu', 'jub'),
+    ('juc', 'juc'),
+    ('jurchen', 'juc'),
+    ('jud', 'jud'),
+    ('worodougou', 'jud'),
+    ('hõne', 'juh'),
+    ('juh', 'juh'),
+    ('jui', 'jui'),
+    ('ngadjuri', 'jui'),
+    ('juk', 'juk'),
+    ('wapan', 'juk'),
+    ('jirel', 'jul'),
+    ('jul', 'jul'),
+    ('jum', 'jum'),
+    ('jumjum', 'jum'),
+    ('juang', 'jun'),
+    ('jun', 'jun'),
+    ('jiba', 'juo'),
+    ('juo', 'juo'),
+    ('hupdë', 'jup'),
+    ('jup', 'jup'),
+    ('jur', 'jur'),
+    ('jurúna', 'jur'),
+    ('jumla sign language', 'jus'),
+    ('jus', 'jus'),
+    ('jut', 'jut'),
+    ('jutish', 'jut'),
+    ('ju', 'juu'),
+    ('juu', 'juu'),
+    ('juw', 'juw'),
+    ('wãpha', 'juw'),
+    ('juray', 'juy'),
+    ('juy', 'juy'),
+    ('jav', 'jv'),
+    ('javanese', 'jv'),
+    ('jv', 'jv'),
+    ('javindo', 'jvd'),
+    ('jvd', 'jvd'),
+    ('caribbean javanese', 'jvn'),
+    ('jvn', 'jvn'),
+    ('jwi', 'jwi'),
+    ('jwira-pepesa', 'jwi'),
+    ('jiarong', 'jya'),
+    ('jya', 'jya'),
+    ('judeo-yemeni arabic', 'jye'),
+    ('jye', 'jye'),
+    ('jaya', 'jyy'),
+    ('jyy', 'jyy'),
+    ('geo', 'ka'),
+    ('georgian', 'ka'),
+    ('ka', 'ka'),
+    ('kat', 'ka'),
+    ('kaa', 'kaa'),
+    ('kara-kalpak', 'kaa'),
+    ('kab', 'kab'),
+    ('kabyle', 'kab'),
+    ('jingpho', 'kac'),
+    ('kac', 'kac'),
+    ('kachin', 'kac'),
+    ('adara', 'kad'),
+    ('kad', 'kad'),
+    ('kae', 'kae'),
+    ('ketangalan', 'kae'),
+    ('kaf', 'kaf'),
+    ('katso', 'kaf'),
+    ('kag', 'kag'),
+    ('kajaman', 'kag'),
+    ('kah', 'kah'),
+    ('kara', 'kah'),
+    ('kara (central african republic)', 'kah'),
+    ('kai', 'kai'),
+    ('karekare', 'kai'),
+    ('jju', 'kaj'),
+    ('kaj', 'kaj'),
+    ('kak', 'kak'),
+    ('kalanguya', 'kak'),
+    ('kam', 'kam'),
+    ('kamba', 'kam'),
+    ('kamba (kenya)', 'kam'),
+    ('kao', 'kao'),
+    ('xaasongaxango', 'kao'),
+    ('bezhta', 'kap'),
+    ('kap', 'kap'),
+    ('capanahua', 'kaq'),
+    ('kaq', 'kaq'),
+    ('katukína', 'kav'),
+    ('kav', 'kav'),
+    ('kaw', 'kaw'),
+    ('kawi', 'kaw'),
+    ('kax', 'kax'),
+    ('kamayurá', 'kay'),
+    ('kay', 'kay'),
+    ('kalarko', 'kba'),
+    ('kba', 'kba'),
+    ('kaxuiâna', 'kbb'),
+    ('kbb', 'kbb'),
+    ('kadiwéu', 'kbc'),
+    ('kbc', 'kbc'),
+    ('kabardian', 'kbd'),
+    ('kbd', 'kbd'),
+    ('kanju', 'kbe'),
+    ('kbe', 'kbe'),
+    ('kbg', 'kbg'),
+    ('khamba', 'kbg'),
+    ('camsá', 'kbh'),
+    ('kbh', 'kbh'),
+    ('kaptiau', 'kbi'),
+    ('kbi', 'kbi'),
+    ('kari', 'kbj'),
+    ('kbj', 'kbj'),
+    ('grass koiari', 'kbk'),
+    ('kbk', 'kbk'),
+    ('kanembu', 'kbl'),
+    ('kbl', 'kbl'),
+    ('iwal', 'kbm'),
+    ('kbm', 'kbm'),
+    ('kare', 'kbn'),
+    ('kare (central african republic)', 'kbn'),
+    ('kbn', 'kbn'),
+    ('kbo', 'kbo'),
+    ('keliko', 'kbo'),
+    ('kabiyè', 'kbp'),
+    ('kbp', 'kbp'),
+    ('kamano', 'kbq'),
+    ('kbq', 'kbq'),
+    ('kafa', 'kbr'),
+    ('kbr', 'kbr'),
+    ('kande', 'kbs'),
+    ('kbs', 'kbs'),
+    ('abadi', 'kbt'),
+    ('kbt', 'kbt'),
+    ('kabutra', 'kbu'),
+    ('kbu', 'kbu'),
+    ('dera', 'kbv'),
+    ('dera (indonesia)', 'kbv'),
+    ('kbv', 'kbv'),
+    ('kaiep', 'kbw'),
+    ('kbw', 'kbw'),
+    ('ap ma', 'kbx'),
+    ('kbx', 'kbx'),
+    ('kby', 'kby'),
+    ('manga kanuri', 'kby'),
+    ('duhwa', 'kbz'),
+    ('kbz', 'kbz'),
+    ('kca', 'kca'),
+    ('khanty', 'kca'),
+    ('kawacha', 'kcb'),
+    ('kcb', 'kcb'),
+    ('kcc', 'kcc'),
+    ('lubila', 'kcc'),
+    ('kcd', 'kcd'),
+    ('ngkâlmpw kanum', 'kcd'),
+    ('kaivi', 'kce'),
+    ('kce', 'kce'),
+    ('kcf', 'kcf'),
+    ('ukaan', 'kcf'),
+    ('kcg', 'kcg'),
+    ('tyap', 'kcg'),
+    ('kch', 'kch'),
+    ('vono', 'kch'),
+    ('kamantan', 'kci'),
+    ('kci', 'kci'),
+    ('kcj', 'kcj'),
+    ('kobiana', 'kcj'),
+    ('kalanga', 'kck'),
+    ('kck', 'kck'),
+    ('kcl', 'kcl'),
+    ('kela', 'kcl'),
+    ('kela (papua new guinea)', 'kcl'),
+    ('gula (central african republic)', 'kcm'),
+    ('kcm', 'kcm'),
+    ('kcn', 'kcn'),
+    ('nubi', 'kcn'),
+    ('kco', 'kco'),
+    ('kinalakna', 'kco'),
+    ('kanga', 'kcp'),
+    ('kcp', 'kcp'),
+    ('kamo', 'kcq'),
+    ('kcq', 'kcq'),
+    ('katla', 'kcr'),
+    ('kcr', 'kcr'),
+    ('kcs', 'kcs'),
+    ('koenoem', 'kcs'),
+    ('kaian', 'kct'),
+    ('kct', 'kct'),
+    ('kami', 'kcu'),
+    ('kami (tanzania)', 'kcu'),
+    ('kcu', 'kcu'),
+    ('kcv', 'kcv'),
+    ('kete', 'kcv'),
+    ('kabwari', 'kcw'),
+    ('kcw', 'kcw'),
+    ('kachama-ganjule', 'kcx'),
+    ('kcx', 'kcx'),
+    ('kcy', 'kcy'),
+    ('korandje', 'kcy'),
+    ('kcz', 'kcz'),
+    ('konongo', 'kcz'),
+    ('kda', 'kda'),
+    ('worimi', 'kda'),
+    ('kdc', 'kdc'),
+    ('kutu', 'kdc'),
+    ('kdd', 'kdd'),
+    ('yankunytjatjara', 'kdd'),
+    ('kde', 'kde'),
+    ('makonde', 'kde'),
+    ('kdf', 'kdf'),
+    ('mamusi', 'kdf'),
+    ('kdg', 'kdg'),
+    ('seba', 'kdg'),
+    ('kdh', 'kdh'),
+    ('tem', 'kdh'),
+    ('kdi', 'kdi'),
+    ('kumam', 'kdi'),
+    ('karamojong', 'kdj'),
+    ('kdj', 'kdj'),
+    ('kdk', 'kdk'),
+    ('numèè', 'kdk'),
+    ('kdl', 'kdl'),
+    ('tsikimba', 'kdl'),
+    ('kagoma', 'kdm'),
+    ('kdm', 'kdm'),
+    ('kdn', 'kdn'),
+    ('kunda', 'kdn'),
+    ('kaningdon-nindem', 'kdp'),
+    ('kdp', 'kdp'),
+    ('kdq', 'kdq'),
+    ('koch', 'kdq'),
+    ('karaim', 'kdr'),
+    ('kdr', 'kdr'),
+    ('kdt', 'kdt'),
+    ('kuy', 'kdt'),
+    ('kadaru', 'kdu'),
+    ('kdu', 'kdu'),
+    ('kdw', 'kdw'),
+    ('koneraw', 'kdw'),
+    ('kdx', 'kdx'),
+    ('kdy', 'kdy'),
+    ('keder', 'kdy'),
+    ('kdz', 'kdz'),
+    ('kwaja', 'kdz'),
+    ('kabuverdianu', 'kea'),
+    ('kea', 'kea'),
+    ('keb', 'keb'),
+    ('kélé', 'keb'),
+    ('kec', 'kec'),
+    ('keiga', 'kec'),
+    ('ked', 'ked'),
+    ('kerewe', 'ked'),
+    ('eastern keres', 'kee'),
+    ('kee', 'kee'),
+    ('kef', 'kef'),
+    ('kpessi', 'kef'),
+    ('keg', 'keg'),
+    ('tese', 'keg'),
+    ('keak', 'keh'),
+    ('keh', 'keh'),
+    ('kei', 'kei'),
+    ('kadar', 'kej'),
+    ('kej', 'kej'),
+    ('kek', 'kek'),
+    ('kekchí', 'kek'),
+    ('kel', 'kel'),
+    ('kela (democratic republic of congo)', 'kel'),
+    ('kem', 'kem'),
+    ('kemak', 'kem'),
+    ('ken', 'ken'),
+    ('kenyang', 'ken'),
+    ('kakwa', 'keo'),
+    ('keo', 'keo'),
+    ('kaikadi', 'kep'),
+    ('kep', 'kep'),
+    ('kamar', 'keq'),
+    ('keq', 'keq'),
+    ('ker', 'ker'),
+    ('kera', 'ker'),
+    ('kes', 'kes'),
+    ('kugbo', 'kes'),
+    ('ket', 'ket'),
+    ('akebu', 'keu'),
+    ('keu', 'keu'),
+    ('kanikkaran', 'kev'),
+    ('kev', 'kev'),
+    ('kew', 'kew'),
+    ('west kewa', 'kew'),
+    ('kex', 'kex'),
+    ('kukna', 'kex'),
+    ('key', 'key'),
+    ('kupia', 'key'),
+    ('kez', 'kez'),
+    ('kukele', 'kez'),
+    ('kfa', 'kfa'),
+    ('kodava', 'kfa'),
+    ('kfb', 'kfb'),
+    ('northwestern kolami', 'kfb'),
+    ('kfc', 'kfc'),
+    ('konda-dora', 'kfc'),
+    ('kfd', 'kfd'),
+    ('korra koraga', 'kfd'),
+    ('kfe', 'kfe'),
+    ('kota', 'kfe'),
+    ('kota (india)', 'kfe'),
+    ('kff', 'kff'),
+    ('koya', 'kff'),
+    ('kfg', 'kfg'),
+    ('kudiya', 'kfg'),
+    ('kfh', 'kfh'),
+    ('kurichiya', 'kfh'),
+    ('kannada kurumba', 'kfi'),
+    ('kfi', 'kfi'),
+    ('kemiehua', 'kfj'),
+    ('kfj', 'kfj'),
+    ('kfk', 'kfk'),
+    ('kinnauri', 'kfk'),
+    ('kfl', 'kfl'),
+    ('kung', 'kfl'),
+    ('kfm', 'kfm'),
+    ('khunsari', 'kfm'),
+    ('kfn', 'kfn'),
+    ('kuk', 'kfn'),
+    ('kfo', 'kfo'),
+    ('koro (côte d''ivoire)', 'kfo'),
+    ('kfp', 'kfp'),
+    ('korwa', 'kfp'),
+    ('kfq', 'kfq'),
+    ('korku', 'kfq'),
+    ('kachhi', 'kfr'),
+    ('kfr', 'kfr'),
+    ('bilaspuri', 'kfs'),
+    ('kfs', 'kfs'),
+    ('kanjari', 'kft'),
+    ('kft', 'kft'),
+    ('katkari', 'kfu'),
+    ('kfu', 'kfu'),
+    ('kfv', 'kfv'),
+    ('kurmukar', 'kfv'),
+    ('kfw', 'kfw'),
+    ('kharam naga', 'kfw'),
+    ('kfx', 'kfx'),
+    ('kullu pahari', 'kfx'),
+    ('kfy', 'kfy'),
+    ('kumaoni', 'kfy'),
+    ('kfz', 'kfz'),
+    ('koromfé', 'kfz'),
+    ('kg', 'kg'),
+    ('kon', 'kg'),
+    ('kongo', 'kg'),
+    ('kga', 'kga'),
+    ('koyaga', 'kga'),
+    ('kawe', 'kgb'),
+    ('kgb', 'kgb'),
+    ('kge', 'kge'),
+    ('komering', 'kge'),
+    ('kgf', 'kgf'),
+    ('kube', 'kgf'),
+    ('kgg', 'kgg'),
+    ('kusunda', 'kgg'),
+    ('kgi', 'kgi'),
+    ('selangor sign language', 'kgi'),
+    ('gamale kham', 'kgj'),
+    ('kgj', 'kgj'),
+    ('kaiwá', 'kgk'),
+    ('kgk', 'kgk'),
+    ('kgl', 'kgl'),
+    ('kunggari', 'kgl'),
+    ('karipúna', 'kgm'),
+    ('kgm', 'kgm'),
+    ('karingani', 'kgn'),
+    ('kgn', 'kgn'),
+    ('kgo', 'kgo'),
+    ('krongo', 'kgo'),
+    ('kaingang', 'kgp'),
+    ('kgp', 'kgp'),
+    ('kamoro', 'kgq'),
+    ('kgq', 'kgq'),
+    ('abun', 'kgr'),
+    ('kgr', 'kgr'),
+    ('kgs', 'kgs'),
+    ('kumbainggar', 'kgs'),
+    ('kgt', 'kgt'),
+    ('somyev', 'kgt'),
+    ('kgu', 'kgu'),
+    ('kobol', 'kgu'),
+    ('karas', 'kgv'),
+    ('kgv', 'kgv'),
+    ('karon dori', 'kgw'),
+    ('kgw', 'kgw'),
+    ('kamaru', 'kgx'),
+    ('kgx', 'kgx'),
+    ('kgy', 'kgy'),
+    ('kyerung', 'kgy'),
+    ('kha', 'kha'),
+    ('khasi', 'kha'),
+    ('khb', 'khb'),
+    ('lü', 'khb'),
+    ('khc', 'khc'),
+    ('tukang besi north', 'khc'),
+    ('bädi kanum', 'khd'),
+    ('khd', 'khd'),
+    ('khe', 'khe'),
+    ('korowai', 'khe'),
+    ('khf', 'khf'),
+    ('khuen', 'khf'),
+    ('khams tibetan', 'khg'),
+    ('khg', 'khg'),
+    ('kehu', 'khh'),
+    ('khh', 'khh'),
+    ('khj', 'khj'),
+    ('kuturmi', 'khj'),
+    ('halh mongolian', 'khk'),
+    ('khk', 'khk'),
+    ('khl', 'khl'),
+    ('lusi', 'khl'),
+    ('khandesi', 'khn'),
+    ('khn', 'khn'),
+    ('kho', 'kho'),
+    ('khotanese', 'kho'),
+    ('sakan', 'kho'),
+    ('kapori', 'khp'),
+    ('khp', 'khp'),
+    ('khq', 'khq'),
+    ('koyra chiini songhay', 'khq'),
+    ('kharia', 'khr'),
+    ('khr', 'khr'),
+    ('kasua', 'khs'),
+    ('khs', 'khs'),
+    ('khamti', 'kht'),
+    ('kht', 'kht'),
+    ('khu', 'khu'),
+    ('nkhumbi', 'khu'),
+    ('khv', 'khv'),
+    ('khvarshi', 'khv'),
+    ('khowar', 'khw'),
+    ('khw', 'khw'),
+    ('kanu', 'khx'),
+    ('khx', 'khx'),
+    ('kele', 'khy'),
+    ('kele (democratic republic of congo)', 'khy'),
+    ('khy', 'khy'),
+    ('keapara', 'khz'),
+    ('khz', 'khz'),
+    ('gikuyu', 'ki'),
+    ('ki', 'ki'),
+    ('kik', 'ki'),
+    ('kikuyu', 'ki'),
+    ('kia', 'kia'),
+    ('kim', 'kia'),
+    ('kib', 'kib'),
+    ('koalib', 'kib'),
+    ('kic', 'kic'),
+    ('kickapoo', 'kic'),
+    ('kid', 'kid'),
+    ('koshin', 'kid'),
+    ('kibet', 'kie'),
+    ('kie', 'kie'),
+    ('eastern parbate kham', 'kif'),
+    ('kif', 'kif'),
+    ('kig', 'kig'),
+    ('kimaama', 'kig'),
+    ('kih', 'kih'),
+    ('kilmeri', 'kih'),
+    ('kii', 'kii'),
+    ('kitsai', 'kii'),
+    ('kij', 'kij'),
+    ('kilivila', 'kij'),
+    ('kariya', 'kil'),
+    ('kil', 'kil'),
+    ('karagas', 'kim'),
+    ('kio', 'kio'),
+    ('kiowa', 'kio'),
+    ('kip', 'kip'),
+    ('sheshi kham', 'kip'),
+    ('kiq', 'kiq'),
+    ('kosadle', 'kiq'),
+    ('kis', 'kis'),
+    ('agob', 'kit'),
+    ('kit', 'kit'),
+    ('kirmanjki', 'kiu'),
+    ('kirmanjki (individual language)', 'kiu'),
+    ('kiu', 'kiu'),
+    ('kimbu', 'kiv'),
+    ('kiv', 'kiv'),
+    ('kiw', 'kiw'),
+    ('northeast kiwai', 'kiw'),
+    ('khiamniungan naga', 'kix'),
+    ('kix', 'kix'),
+    ('kirikiri', 'kiy'),
+    ('kiy', 'kiy'),
+    ('kisi', 'kiz'),
+    ('kiz', 'kiz'),
+    ('kj', 'kj'),
+    ('kua', 'kj'),
+    ('kuanyama', 'kj'),
+    ('kwanyama', 'kj'),
+    ('kja', 'kja'),
+    ('mlap', 'kja'),
+    ('kjb', 'kjb'),
+    ('q''anjob''al', 'kjb'),
+    ('coastal konjo', 'kjc'),
+    ('kjc', 'kjc'),
+    ('kjd', 'kjd'),
+    ('southern kiwai', 'kjd'),
+    ('kisar', 'kje'),
+    ('kje', 'kje'),
+    ('khmu', 'kjg'),
+    ('kjg', 'kjg'),
+    ('khakas', 'kjh'),
+    ('kjh', 'kjh'),
+    ('kji', 'kji'),
+    ('zabana', 'kji'),
+    ('khinalugh', 'kjj'),
+    ('kjj', 'kjj'),
+    ('highland konjo', 'kjk'),
+    ('kjk', 'kjk'),
+    ('kjl', 'kjl'),
+    ('western parbate kham', 'kjl'),
+    ('kháng', 'kjm'),
+    ('kjm', 'kjm'),
+    ('kjn', 'kjn'),
+    ('kunjen', 'kjn'),
+    ('harijan kinnauri', 'kjo'),
+    ('kjo', 'kjo'),
+    ('kjp', 'kjp'),
+    ('pwo eastern karen', 'kjp'),
+    ('kjq', 'kjq'),
+    ('western keres', 'kjq'),
+    ('kjr', 'kjr'),
+    ('kurudu', 'kjr'),
+    ('east kewa', 'kjs'),
+    ('kjs', 'kjs'),
+    ('kjt', 'kjt'),
+    ('phrae pwo karen', 'kjt'),
+    ('kashaya', 'kju'),
+    ('kju', 'kju'),
+    ('kaikavian literary language', 'kjv'),
+    ('kjv', 'kjv'),
+    ('kjx', 'kjx'),
+    ('ramopa', 'kjx'),
+    ('erave', 'kjy'),
+    ('kjy', 'kjy'),
+    ('bumthangkha', 'kjz'),
+    ('kjz', 'kjz'),
+    ('kaz', 'kk'),
+    ('kazakh', 'kk'),
+    ('kk', 'kk'),
+    ('kakanda', 'kka'),
+    ('kka', 'kka'),
+    ('kkb', 'kkb'),
+    ('kwerisa', 'kkb'),
+    ('kkc', 'kkc'),
+    ('odoodee', 'kkc'),
+    ('kinuku', 'kkd'),
+    ('kkd', 'kkd'),
+    ('kakabe', 'kke'),
+    ('kke', 'kke'),
+    ('kalaktang monpa', 'kkf'),
+    ('kkf', 'kkf'),
+    ('kkg', 'kkg'),
+    ('mabaka valley kalinga', 'kkg'),
+    ('khün', 'kkh'),
+    ('kkh', 'kkh'),
+    ('kagulu', 'kki'),
+    ('kki', 'kki'),
+    ('kako', 'kkj'),
+    ('kkj', 'kkj'),
+    ('kkk', 'kkk'),
+    ('kokota', 'kkk'),
+    ('kkl', 'kkl'),
+    ('kosarek yale', 'kkl'),
+    ('kiong', 'kkm'),
+    ('kkm', 'kkm'),
+    ('kkn', 'kkn'),
+    ('kon keu', 'kkn'),
+    ('karko', 'kko'),
+    ('kko', 'kko'),
+    ('gugubera', 'kkp'),
+    ('kkp', 'kkp'),
+    ('kaeku', 'kkq'),
+    ('kkq', 'kkq'),
+    ('kir-balar', 'kkr'),
+    ('kkr', 'kkr'),
+    ('giiwo', 'kks'),
+    ('kks', 'kks'),
+    ('kkt', 'kkt'),
+    ('koi', 'kkt'),
+    ('kku', 'kku'),
+    ('tumi', 'kku'),
+    ('kangean', 'kkv'),
+    ('kkv', 'kkv'),
+    ('kkw', 'kkw'),
+    ('teke-kukuya', 'kkw'),
+    ('kkx', 'kkx'),
+    ('kohin', 'kkx'),
+    ('guugu yimidhirr', 'kky'),
+    ('kky', 'kky'),
+    ('kaska', 'kkz'),
+    ('kkz', 'kkz'),
+    ('greenlandic', 'kl'),
+    ('kal', 'kl'),
+    ('kalaallisut', 'kl'),
+    ('kl', 'kl'),
+    ('kla', 'kla'),
+    ('klamath-modoc', 'kla'),
+    ('kiliwa', 'klb'),
+    ('klb', 'klb'),
+    ('klc', 'klc'),
+    ('kolbila', 'klc'),
+    ('gamilaraay', 'kld'),
+    ('kld', 'kld'),
+    ('kle', 'kle'),
+    ('kulung (nepal)', 'kle'),
+    ('kendeje', 'klf'),
+    ('klf', 'klf'),
+    ('klg', 'klg'),
+    ('tagakaulo', 'klg'),
+    ('klh', 'klh'),
+    ('weliki', 'klh'),
+    ('kalumpang', 'kli'),
+    ('kli', 'kli'),
+    ('khalaj', 'klj'),
+    ('klj', 'klj'),
+    ('klk', 'klk'),
+    ('kono', 'klk'),
+    ('kono (nigeria)', 'klk'),
+    ('kagan kalagan', 'kll'),
+    ('kll', 'kll'),
+    ('klm', 'klm'),
+    ('migum', 'klm'),
+    ('kalenjin', 'kln'),
+    ('kln', 'kln'),
+    ('kapya', 'klo'),
+    ('klo', 'klo'),
+    ('kamasa', 'klp'),
+    ('klp', 'klp'),
+    ('klq', 'klq'),
+    ('rumu', 'klq'),
+    ('khaling', 'klr'),
+    ('klr', 'klr'),
+    ('kalasha', 'kls'),
+    ('kls', 'kls'),
+    ('klt', 'klt'),
+    ('nukna', 'klt'),
+    ('klao', 'klu'),
+    ('klu', 'klu'),
+    ('klv', 'klv'),
+    ('maskelynes', 'klv'),
+    ('klw', 'klw'),
+    ('tado', 'klw'),
+    ('klx', 'klx'),
+    ('koluwawa', 'klx'),
+    ('kalao', 'kly'),
+    ('kly', 'kly'),
+    ('kabola', 'klz'),
+    ('klz', 'klz'),
+    ('central khmer', 'km'),
+    ('khm', 'km'),
+    ('khmer', 'km'),
+    ('km', 'km'),
+    ('kma', 'kma'),
+    ('konni', 'kma'),
+    ('kimbundu', 'kmb'),
+    ('kmb', 'kmb'),
+    ('kmc', 'kmc'),
+    ('southern dong', 'kmc'),
+    ('kmd', 'kmd'),
+    ('majukayang kalinga', 'kmd'),
+    ('bakole', 'kme'),
+    ('kme', 'kme'),
+    ('kare (papua new guinea)', 'kmf'),
+    ('kmf', 'kmf'),
+    ('kmg', 'kmg'),
+    ('kâte', 'kmg'),
+    ('kalam', 'kmh'),
+    ('kmh', 'kmh'),
+    ('kami (nigeria)', 'kmi'),
+    ('kmi', 'kmi'),
+    ('kmj', 'kmj'),
+    ('kumarbhag paharia', 'kmj'),
+    ('kmk', 'kmk'),
+    ('limos kalinga', 'kmk'),
+    ('kml', 'kml'),
+    ('tanudan kalinga', 'kml'),
+    ('kmm', 'kmm'),
+    ('kom (india)', 'kmm'),
+    ('awtuw', 'kmn'),
+    ('kmn', 'kmn'),
+    ('kmo', 'kmo'),
+    ('kwoma', 'kmo'),
+    ('gimme', 'kmp'),
+    ('kmp', 'kmp'),
+    ('kmq', 'kmq'),
+    ('kwama', 'kmq'),
+    ('kmr', 'kmr'),
+    ('northern kurdish', 'kmr'),
+    ('kamasau', 'kms'),
+    ('kms', 'kms'),
+    ('kemtuik', 'kmt'),
+    ('kmt', 'kmt'),
+    ('kanite', 'kmu'),
+    ('kmu', 'kmu'),
+    ('karipúna creole french', 'kmv'),
+    ('kmv', 'kmv'),
+    ('kmw', 'kmw'),
+    ('komo', 'kmw'),
+    ('komo (democratic republic of congo)', 'kmw'),
+    ('kmx', 'kmx'),
+    ('waboda', 'kmx'),
+    ('kmy', 'kmy'),
+    ('koma', 'kmy'),
+    ('khorasani turkish', 'kmz'),
+    ('kmz', 'kmz'),
+    ('kan', 'kn'),
+    ('kannada', 'kn'),
+    ('kn', 'kn'),
+    ('dera (nigeria)', 'kna'),
+    ('kna', 'kna'),
+    ('knb', 'knb'),
+    ('lubuagan kalinga', 'knb'),
+    ('central kanuri', 'knc'),
+    ('knc', 'knc'),
+    ('knd', 'knd'),
+    ('konda', 'knd'),
+    ('kankanaey', 'kne'),
+    ('kne', 'kne'),
+    ('knf', 'knf'),
+    ('mankanya', 'knf'),
+    ('kng', 'kng'),
+    ('koongo', 'kng'),
+    ('kanufi', 'kni'),
+    ('kni', 'kni'),
+    ('knj', 'knj'),
+    ('western kanjobal', 'knj'),
+    ('knk', 'knk'),
+    ('kuranko', 'knk'),
+    ('keninjal', 'knl'),
+    ('knl', 'knl'),
+    ('kanamarí', 'knm'),
+    ('knm', 'knm'),
+    ('knn', 'knn'),
+    ('konkani', 'knn'),
+    ('konkani (individual language)', 'knn'),
+    ('kno', 'kno'),
+    ('kono (sierra leone)', 'kno'),
+    ('knp', 'knp'),
+    ('kwanja', 'knp'),
+    ('kintaq', 'knq'),
+    ('knq', 'knq'),
+    ('kaningra', 'knr'),
+    ('knr', 'knr'),
+    ('kensiu', 'kns'),
+    ('kns', 'kns'),
+    ('knt', 'knt'),
+    ('panoan katukína', 'knt'),
+    ('knu', 'knu'),
+    ('kono (guinea)', 'knu'),
+    ('knv', 'knv'),
+    ('tabo', 'knv'),
+    ('knw', 'knw'),
+    ('kung-ekoka', 'knw'),
+    ('kendayan', 'knx'),
+    ('knx', 'knx'),
+    ('kanyok', 'kny'),
+    ('kny', 'kny'),
+    ('kalamsé', 'knz'),
+    ('knz', 'knz'),
+    ('ko', 'ko'),
+    ('kor', 'ko'),
+    ('korean', 'ko'),
+    ('koa', 'koa'),
+    ('konomala', 'koa'),
+    ('koc', 'koc'),
+    ('kpati', 'koc'),
+    ('kod', 'kod'),
+    ('kodi', 'kod'),
+    ('kacipo-bale suri', 'koe'),
+    ('koe', 'koe'),
+    ('kof', 'kof'),
+    ('kubi', 'kof'),
+    ('cogui', 'kog'),
+    ('kog', 'kog'),
+    ('koh', 'koh'),
+    ('koyo', 'koh'),
+    ('komi-permyak', 'koi'),
+    ('kok', 'kok'),
+    ('konkani (macrolanguage)', 'kok'),
+    ('kol (papua new guinea)', 'kol'),
+    ('konzo', 'koo'),
+    ('koo', 'koo'),
+    ('kop', 'kop'),
+    ('waube', 'kop'),
+    ('koq', 'koq'),
+    ('kota (gabon)', 'koq'),
+    ('kos', 'kos'),
+    ('kosraean', 'kos'),
+    ('kot', 'kot'),
+    ('lagwan', 'kot'),
+    ('koke', 'kou'),
+    ('kou', 'kou'),
+    ('kov', 'kov'),
+    ('kudu-camo', 'kov'),
+    ('kow', 'kow'),
+    ('kugama', 'kow'),
+    ('koy', 'koy'),
+    ('koyukon', 'koy'),
+    ('korak', 'koz'),
+    ('koz', 'koz'),
+    ('kpa', 'kpa'),
+    ('kutto', 'kpa'),
+    ('kpb', 'kpb'),
+    ('mullu kurumba', 'kpb'),
+    ('curripaco', 'kpc'),
+    ('kpc', 'kpc'),
+    ('koba', 'kpd'),
+    ('kpd', 'kpd'),
+    ('kpe', 'kpe'),
+    ('kpelle', 'kpe'),
+    ('komba', 'kpf'),
+    ('kpf', 'kpf'),
+    ('kapingamarangi', 'kpg'),
+    ('kpg', 'kpg'),
+    ('kph', 'kph'),
+    ('kplang', 'kph'),
+    ('kofei', 'kpi'),
+    ('kpi', 'kpi'),
+    ('karajá', 'kpj'),
+    ('kpj', 'kpj'),
+    ('kpan', 'kpk'),
+    ('kpk', 'kpk'),
+    ('kpala', 'kpl'),
+    ('kpl', 'kpl'),
+    ('koho', 'kpm'),
+    ('kpm', 'kpm'),
+    ('kepkiriwát', 'kpn'),
+    ('kpn', 'kpn'),
+    ('ikposo', 'kpo'),
+    ('kpo', 'kpo'),
+    ('korupun-sela', 'kpq'),
+    ('kpq', 'kpq'),
+    ('korafe-yegha', 'kpr'),
+    ('kpr', 'kpr'),
+    ('kps', 'kps'),
+    ('tehit', 'kps'),
+    ('karata', 'kpt'),
+    ('kpt', 'kpt'),
+    ('kafoa', 'kpu'),
+    ('kpu', 'kpu'),
+    ('komi-zyrian', 'kpv'),
+    ('kpv', 'kpv'),
+    ('kobon', 'kpw'),
+    ('kpw', 'kpw'),
+    ('kpx', 'kpx'),
+    ('mountain koiali', 'kpx'),
+    ('koryak', 'kpy'),
+    ('kpy', 'kpy'),
+    ('kpz', 'kpz'),
+    ('kupsabiny', 'kpz'),
+    ('kqa', 'kqa'),
+    ('mum', 'kqa'),
+    ('kovai', 'kqb'),
+    ('kqb', 'kqb'),
+    ('doromu-koki', 'kqc'),
+    ('kqc', 'kqc'),
+    ('koy sanjaq surat', 'kqd'),
+    ('kqd', 'kqd'),
+    ('kalagan', 'kqe'),
+    ('kqe', 'kqe'),
+    ('kakabai', 'kqf'),
+    ('kqf', 'kqf'),
+    ('kqg', 'kqg'),
+    ('kisankasa', 'kqh'),
+    ('kqh', 'kqh'),
+    ('koitabu', 'kqi'),
+    ('kqi', 'kqi'),
+    ('koromira', 'kqj'),
+    ('kqj', 'kqj'),
+    ('kotafon gbe', 'kqk'),
+    ('kqk', 'kqk'),
+    ('kql', 'kql'),
+    ('kyenele', 'kql'),
+    ('khisa', 'kqm'),
+    ('kqm', 'kqm'),
+    ('kaonde', 'kqn'),
+    ('kqn', 'kqn'),
+    ('eastern krahn', 'kqo'),
+    ('kqo', 'kqo'),
+    ('kimré', 'kqp'),
+    ('kqp', 'kqp'),
+    ('kqq', 'kqq'),
+    ('krenak', 'kqq'),
+    ('kimaragang', 'kqr'),
+    ('kqr', 'kqr'),
+    ('kqs', 'kqs'),
+    ('northern kissi', 'kqs'),
+    ('klias river kadazan', 'kqt'),
+    ('kqt', 'kqt'),
+    ('kqu', 'kqu'),
+    ('seroa', 'kqu'),
+    ('kqv', 'kqv'),
+    ('okolod', 'kqv'),
+    ('kandas', 'kqw'),
+    ('kqw', 'kqw'),
+    ('kqx', 'kqx'),
+    ('mser', 'kqx'),
+    ('koorete', 'kqy'),
+    ('kqy', 'kqy'),
+    ('korana', 'kqz'),
+    ('kqz', 'kqz'),
+    ('kanuri', 'kr'),
+    ('kau', 'kr'),
+    ('kr', 'kr'),
+    ('kra', 'kra'),
+    ('kumhali', 'kra'),
+    ('karkin', 'krb'),
+    ('krb', 'krb'),
+    ('karachay-balkar', 'krc'),
+    ('krc', 'krc'),
+    ('kairui-midiki', 'krd'),
+    ('krd', 'krd'),
+    ('kre', 'kre'),
+    ('panará', 'kre'),
+    ('koro (vanuatu)', 'krf'),
+    ('krf', 'krf'),
+    ('krh', 'krh'),
+    ('kurama', 'krh'),
+    ('kri', 'kri'),
+    ('krio', 'kri'),
+    ('kinaray-a', 'krj'),
+    ('krj', 'krj'),
+    ('kerek', 'krk'),
+    ('krk', 'krk'),
+    ('karelian', 'krl'),
+    ('krl', 'krl'),
+    ('krn', 'krn'),
+    ('sapo', 'krn'),
+    ('korop', 'krp'),
+    ('krp', 'krp'),
+    ('krr', 'krr'),
+    ('krung', 'krr'),
+    ('gbaya (sudan)', 'krs'),
+    ('krs', 'krs'),
+    ('krt', 'krt'),
+    ('tumari kanuri', 'krt'),
+    ('kru', 'kru'),
+    ('kurukh', 'kru'),
+    ('kavet', 'krv'),
+    ('krv', 'krv'),
+    ('krw', 'krw'),
+    ('western krahn', 'krw'),
+    ('karon', 'krx'),
+    ('krx', 'krx'),
+    ('kry', 'kry'),
+    ('kryts', 'kry'),
+    ('krz', 'krz'),
+    ('sota kanum', 'krz'),
+    ('kas', 'ks'),
+    ('kashmiri', 'ks'),
+    ('ks', 'ks'),
+    ('ksa', 'ksa'),
+    ('shuwa-zamani', 'ksa'),
+    ('ksb', 'ksb'),
+    ('shambala', 'ksb'),
+    ('ksc', 'ksc'),
+    ('southern kalinga', 'ksc'),
+    ('ksd', 'ksd'),
+    ('kuanua', 'ksd'),
+    ('kse', 'kse'),
+    ('kuni', 'kse'),
+    ('bafia', 'ksf'),
+    ('ksf', 'ksf'),
+    ('ksg', 'ksg'),
+    ('kusaghe', 'ksg'),
+    ('ksh', 'ksh'),
+    ('kölsch', 'ksh'),
+    ('krisa', 'ksi'),
+    ('ksi', 'ksi'),
+    ('ksj', 'ksj'),
+    ('uare', 'ksj'),
+    ('kansa', 'ksk'),
+    ('ksk', 'ksk'),
+    ('ksl', 'ksl'),
+    ('kumalu', 'ksl'),
+    ('ksm', 'ksm'),
+    ('kumba', 'ksm'),
+    ('kasiguranin', 'ksn'),
+    ('ksn', 'ksn'),
+    ('kofa', 'kso'),
+    ('kso', 'kso'),
+    ('kaba', 'ksp'),
+    ('ksp', 'ksp'),
+    ('ksq', 'ksq'),
+    ('kwaami', 'ksq'),
+    ('borong', 'ksr'),
+    ('ksr', 'ksr'),
+    ('kss', 'kss'),
+    ('southern kisi', 'kss'),
+    ('kst', 'kst'),
+    ('winyé', 'kst'),
+    ('khamyang', 'ksu'),
+    ('ksu', 'ksu'),
+    ('ksv', 'ksv'),
+    ('kusu', 'ksv'),
+    ('ksw', 'ksw'),
+    ('s''gaw karen', 'ksw'),
+    ('kedang', 'ksx'),
+    ('ksx', 'ksx'),
+    ('kharia thar', 'ksy'),
+    ('ksy', 'ksy'),
+    ('kodaku', 'ksz'),
+    ('ksz', 'ksz'),
+    ('katua', 'kta'),
+    ('kta', 'kta'),
+    ('kambaata', 'ktb'),
+    ('ktb', 'ktb'),
+    ('kholok', 'ktc'),
+    ('ktc', 'ktc'),
+    ('kokata', 'ktd'),
+    ('ktd', 'ktd'),
+    ('kte', 'kte'),
+    ('nubri', 'kte'),
+    ('ktf', 'ktf'),
+    ('kwami', 'ktf'),
+    ('kalkutung', 'ktg'),
+    ('ktg', 'ktg'),
+    ('karanga', 'kth'),
+    ('kth', 'kth'),
+    ('kti', 'kti'),
+    ('north muyu', 'kti'),
+    ('ktj', 'ktj'),
+    ('plapo krumen', 'ktj'),
+    ('kaniet', 'ktk'),
+    ('ktk', 'ktk'),
+    ('koroshi', 'ktl'),
+    ('ktl', 'ktl'),
+    ('ktm', 'ktm'),
+    ('kurti', 'ktm'),
+    ('karitiâna', 'ktn'),
+    ('ktn', 'ktn'),
+    ('kto', 'kto'),
+    ('kuot', 'kto'),
+    ('kaduo', 'ktp'),
+    ('ktp', 'ktp'),
+    ('katabaga', 'ktq'),
+    ('ktq', 'ktq'),
+    ('kts', 'kts'),
+    ('south muyu', 'kts'),
+    ('ketum', 'ktt'),
+    ('ktt', 'ktt'),
+    ('kituba', 'ktu'),
+    ('kituba (democratic republic of congo)', 'ktu'),
+    ('ktu', 'ktu'),
+    ('eastern katu', 'ktv'),
+    ('ktv', 'ktv'),
+    ('kato', 'ktw'),
+    ('ktw', 'ktw'),
+    ('kaxararí', 'ktx'),
+    ('ktx', 'ktx'),
+    ('kango', 'kty'),
+    ('kango (bas-uélé district)', 'kty'),
+    ('kty', 'kty'),
+    ('juǀʼhoan', 'ktz'),
+    ('ktz', 'ktz'),
+    ('ku', 'ku'),
+    ('kur', 'ku'),
+    ('kurdish', 'ku'),
+    ('kub', 'kub'),
+    ('kutep', 'kub'),
+    ('kuc', 'kuc'),
+    ('kwinsu', 'kuc'),
+    ('''auhelawa', 'kud'),
+    ('kud', 'kud'),
+    ('kue', 'kue'),
+    ('kuman', 'kue'),
+    ('kuman (papua new guinea)', 'kue'),
+    ('kuf', 'kuf'),
+    ('western katu', 'kuf'),
+    ('kug', 'kug'),
+    ('kupa', 'kug'),
+    ('kuh', 'kuh'),
+    ('kushi', 'kuh'),
+    ('kui', 'kui'),
+    ('kuikúro-kalapálo', 'kui'),
+    ('kuj', 'kuj'),
+    ('kuria', 'kuj'),
+    ('kepo''', 'kuk'),
+    ('kul', 'kul'),
+    ('kulere', 'kul'),
+    ('kum', 'kum'),
+    ('kumyk', 'kum'),
+    ('kun', 'kun'),
+    ('kunama', 'kun'),
+    ('kumukio', 'kuo'),
+    ('kuo', 'kuo'),
+    ('kunimaipa', 'kup'),
+    ('kup', 'kup'),
+    ('karipuna', 'kuq'),
+    ('kuq', 'kuq'),
+    ('kus', 'kus'),
+    ('kusaal', 'kus'),
+    ('kut', 'kut'),
+    ('kutenai', 'kut'),
+    ('kuu', 'kuu'),
+    ('upper kuskokwim', 'kuu'),
+    ('kuv', 'kuv'),
+    ('kpagua', 'kuw'),
+    ('kuw', 'kuw'),
+    ('kukatja', 'kux'),
+    ('kux', 'kux'),
+    ('kuuku-ya''u', 'kuy'),
+    ('kunza', 'kuz'),
+    ('kuz', 'kuz'),
+    ('kom', 'kv'),
+    ('komi', 'kv'),
+    ('kv', 'kv'),
+    ('bagvalal', 'kva'),
+    ('kva', 'kva'),
+    ('kubu', 'kvb'),
+    ('kvb', 'kvb'),
+    ('kove', 'kvc'),
+    ('kvc', 'kvc'),
+    ('kui (indonesia)', 'kvd'),
+    ('kvd', 'kvd'),
+    ('kalabakan', 'kve'),
+    ('kve', 'kve'),
+    ('kabalai', 'kvf'),
+    ('kvf', 'kvf'),
+    ('kuni-boazi', 'kvg'),
+    ('kvg', 'kvg'),
+    ('komodo', 'kvh'),
+    ('kvh', 'kvh'),
+    ('kvi', 'kvi'),
+    ('kwang', 'kvi'),
+    ('kvj', 'kvj'),
+    ('psikye', 'kvj'),
+    ('korean sign language', 'kvk'),
+    ('kvk', 'kvk'),
+    ('kayaw', 'kvl'),
+    ('kvl', 'kvl'),
+    ('kendem', 'kvm'),
+    ('kvm', 'kvm'),
+    ('border kuna', 'kvn'),
+    ('kvn', 'kvn'),
+    ('dobel', 'kvo'),
+    ('kvo', 'kvo'),
+    ('kompane', 'kvp'),
+    ('kvp', 'kvp'),
+    ('geba karen', 'kvq'),
+    ('kvq', 'kvq'),
+    ('kerinci', 'kvr'),
+    ('kvr', 'kvr'),
+    ('kvt', 'kvt'),
+    ('lahta karen', 'kvt'),
+    ('kvu', 'kvu'),
+    ('yinbaw karen', 'kvu'),
+    ('kola', 'kvv'),
+    ('kvv', 'kvv'),
+    ('kvw', 'kvw'),
+    ('wersing', 'kvw'),
+    ('kvx', 'kvx'),
+    ('parkari koli', 'kvx'),
+    ('kvy', 'kvy'),
+    ('yintale karen', 'kvy'),
+    ('kvz', 'kvz'),
+    ('tsakwambo', 'kvz'),
+    ('cor', 'kw'),
+    ('cornish', 'kw'),
+    ('kw', 'kw'),
+    ('dâw', 'kwa'),
+    ('kwa', 'kwa'),
+    ('kwb', 'kwb'),
+    ('kwc', 'kwc'),
+    ('likwala', 'kwc'),
+    ('kwaio', 'kwd'),
+    ('kwd', 'kwd'),
+    ('kwe', 'kwe'),
+    ('kwerba', 'kwe'),
+    ('kwara''ae', 'kwf'),
+    ('kwf', 'kwf'),
+    ('kwg', 'kwg'),
+    ('sara kaba deme', 'kwg'),
+    ('kowiai', 'kwh'),
+    ('kwh', 'kwh'),
+    ('awa-cuaiquer', 'kwi'),
+    ('kwi', 'kwi'),
+    ('kwanga', 'kwj'),
+    ('kwj', 'kwj'),
+    ('kwakiutl', 'kwk'),
+    ('kwk', 'kwk'),
+    ('kofyar', 'kwl'),
+    ('kwl', 'kwl'),
+    ('kwambi', 'kwm'),
+    ('kwm', 'kwm'),
+    ('kwangali', 'kwn'),
+    ('kwn', 'kwn'),
+    ('kwo', 'kwo'),
+    ('kwomtari', 'kwo'),
+    ('kodia', 'kwp'),
+    ('kwp', 'kwp'),
+    ('kwer', 'kwr'),
+    ('kwr', 'kwr'),
+    ('kwese', 'kws'),
+    ('kws', 'kws'),
+    ('kwesten', 'kwt'),
+    ('kwt', 'kwt'),
+    ('kwakum', 'kwu'),
+    ('kwu', 'kwu'),
+    ('kwv', 'kwv'),
+    ('sara kaba náà', 'kwv'),
+    ('kwinti', 'kww'),
+    ('kww', 'kww'),
+    ('khirwar', 'kwx'),
+    ('kwx', 'kwx'),
+    ('kwy', 'kwy'),
+    ('san salvador kongo', 'kwy'),
+    ('kwadi', 'kwz'),
+    ('kwz', 'kwz'),
+    ('kairiru', 'kxa'),
+    ('kxa', 'kxa'),
+    ('krobu', 'kxb'),
+    ('kxb', 'kxb'),
+    ('konso', 'kxc'),
+    ('kxc', 'kxc'),
+    ('brunei', 'kxd'),
+    ('kxd', 'kxd'),
+    ('kxf', 'kxf'),
+    ('manumanaw karen', 'kxf'),
+    ('karo (ethiopia)', 'kxh'),
+    ('kxh', 'kxh'),
+    ('keningau murut', 'kxi'),
+    ('kxi', 'kxi'),
+    ('kulfa', 'kxj'),
+    ('kxj', 'kxj'),
+    ('kxk', 'kxk'),
+    ('zayein karen', 'kxk'),
+    ('kxm', 'kxm'),
+    ('northern khmer', 'kxm'),
+    ('kanowit-tanjong melanau', 'kxn'),
+    ('kxn', 'kxn'),
+    ('kanoé', 'kxo'),
+    ('kxo', 'kxo'),
+    ('kxp', 'kxp'),
+    ('wadiyara koli', 'kxp'),
+    ('kxq', 'kxq'),
+    ('smärky kanum', 'kxq'),
+    ('koro (papua new guinea)', 'kxr'),
+    ('kxr', 'kxr'),
+    ('kangjia', 'kxs'),
+    ('kxs', 'kxs'),
+    ('koiwat', 'kxt'),
+    ('kxt', 'kxt'),
+    ('kuvi', 'kxv'),
+    ('kxv', 'kxv'),
+    ('konai', 'kxw'),
+    ('kxw', 'kxw'),
+    ('kxx', 'kxx'),
+    ('likuba', 'kxx'),
+    ('kayong', 'kxy'),
+    ('kxy', 'kxy'),
+    ('kerewo', 'kxz'),
+    ('kxz', 'kxz'),
+    ('kir', 'ky'),
+    ('kirghiz', 'ky'),
+    ('ky', 'ky'),
+    ('kyrgyz', 'ky'),
+    ('kwaya', 'kya'),
+    ('kya', 'kya'),
+    ('butbut kalinga', 'kyb'),
+    ('kyb', 'kyb'),
+    ('kyaka', 'kyc'),
+    ('kyc', 'kyc'),
+    ('karey', 'kyd'),
+    ('kyd', 'kyd'),
+    ('krache', 'kye'),
+    ('kye', 'kye'),
+    ('kouya', 'kyf'),
+    ('kyf', 'kyf'),
+    ('keyagana', 'kyg'),
+    ('kyg', 'kyg'),
+    ('karok', 'kyh'),
+    ('kyh', 'kyh'),
+    ('kiput', 'kyi'),
+    ('kyi', 'kyi'),
+    ('karao', 'kyj'),
+    ('kyj', 'kyj'),
+    ('kamayo', 'kyk'),
+    ('kyk', 'kyk'),
+    ('kalapuya', 'kyl'),
+    ('kyl', 'kyl'),
+    ('kpatili', 'kym'),
+    ('kym', 'kym'),
+    ('kyn', 'kyn'),
+    ('northern binukidnon', 'kyn'),
+    ('kelon', 'kyo'),
+    ('kyo', 'kyo'),
+    ('kang', 'kyp'),
+    ('kyp', 'kyp'),
+    ('kenga', 'kyq'),
+    ('kyq', 'kyq'),
+    ('kuruáya', 'kyr'),
+    ('kyr', 'kyr'),
+    ('baram kayan', 'kys'),
+    ('kys', 'kys'),
+    ('kayagar', 'kyt'),
+    ('kyt', 'kyt'),
+    ('kyu', 'kyu'),
+    ('western kayah', 'kyu'),
+    ('kayort', 'kyv'),
+    ('kyv', 'kyv'),
+    ('kudmali', 'kyw'),
+    ('kyw', 'kyw'),
+    ('kyx', 'kyx'),
+    ('rapoisi', 'kyx'),
+    ('kambaira', 'kyy'),
+    ('kyy', 'kyy'),
+    ('kayabí', 'kyz'),
+    ('kyz', 'kyz'),
+    ('kza', 'kza'),
+    ('western karaboro', 'kza'),
+    ('kaibobo', 'kzb'),
+    ('kzb', 'kzb'),
+    ('bondoukou kulango', 'kzc'),
+    ('kzc', 'kzc'),
+    ('kadai', 'kzd'),
+    ('kzd', 'kzd'),
+    ('kosena', 'kze'),
+    ('kze', 'kze'),
+    ('da''a kaili', 'kzf'),
+    ('kzf', 'kzf'),
+    ('kikai', 'kzg'),
+    ('kzg', 'kzg'),
+    ('kelabit', 'kzi'),
+    ('kzi', 'kzi'),
+    ('kazukuru', 'kzk'),
+    ('kzk', 'kzk'),
+    ('kayeli', 'kzl'),
+    ('kzl', 'kzl'),
+    ('kais', 'kzm'),
+    ('kzm', 'kzm'),
+    ('kokola', 'kzn'),
+    ('kzn', 'kzn'),
+    ('kaningi', 'kzo'),
+    ('kzo', 'kzo'),
+    ('kaidipang', 'kzp'),
+    ('kzp', 'kzp'),
+    ('kaike', 'kzq'),
+    ('kzq', 'kzq'),
+    ('karang', 'kzr'),
+    ('kzr', 'kzr'),
+    ('kzs', 'kzs'),
+    ('sugut dusun', 'kzs'),
+    ('kayupulau', 'kzu'),
+    ('kzu', 'kzu'),
+    ('komyandaret', 'kzv'),
+    ('kzv', 'kzv'),
+    ('karirí-xocó', 'kzw'),
+    ('kzw', 'kzw'),
+    ('kamarian', 'kzx'),
+    ('kzx', 'kzx'),
+    ('kango (tshopo district)', 'kzy'),
+    ('kzy', 'kzy'),
+    ('kalabra', 'kzz'),
+    ('kzz', 'kzz'),
+    ('la', 'la'),
+    ('lat', 'la'),
+    ('latin', 'la'),
+    ('laa', 'laa'),
+    ('southern subanen', 'laa'),
+    ('lab', 'lab'),
+    ('linear a', 'lab'),
+    ('lac', 'lac'),
+    ('lacandon', 'lac'),
+    ('lad', 'lad'),
+    ('ladino', 'lad'),
+    ('lae', 'lae'),
+    ('pattani', 'lae'),
+    ('laf', 'laf'),
+    ('lafofa', 'laf'),
+    ('lag', 'lag'),
+    ('langi', 'lag'),
+    ('lah', 'lah'),
+    ('lahnda', 'lah'),
+    ('lai', 'lai'),
+    ('lambya', 'lai'),
+    ('laj', 'laj'),
+    ('lango', 'laj'),
+    ('lango (uganda)', 'laj'),
+    ('lal', 'lal'),
+    ('lalia', 'lal'),
+    ('lam', 'lam'),
+    ('lamba', 'lam'),
+    ('lan', 'lan'),
+    ('laru', 'lan'),
+    ('laka', 'lap'),
+    ('laka (chad)', 'lap'),
+    ('lap', 'lap'),
+    ('laq', 'laq'),
+    ('qabiao', 'laq'),
+    ('lar', 'lar'),
+    ('larteh', 'lar'),
+    ('lama', 'las'),
+    ('lama (togo)', 'las'),
+    ('las', 'las'),
+    ('laba', 'lau'),
+    ('lau', 'lau'),
+    ('lauje', 'law'),
+    ('law', 'law'),
+    ('lax', 'lax'),
+    ('tiwa', 'lax'),
+    ('lama bai', 'lay'),
+    ('lay', 'lay'),
+    ('aribwatsa', 'laz'),
+    ('laz', 'laz'),
+    ('lb', 'lb'),
+    ('letzeburgesch', 'lb'),
+    ('ltz', 'lb'),
+    ('luxembourgish', 'lb'),
+    ('label', 'lbb'),
+    ('lbb', 'lbb'),
+    ('lakkia', 'lbc'),
+    ('lbc', 'lbc'),
+    ('lak', 'lbe'),
+    ('lbe', 'lbe'),
+    ('lbf', 'lbf'),
+    ('tinani', 'lbf'),
+    ('laopang', 'lbg'),
+    ('lbg', 'lbg'),
+    ('la''bi', 'lbi'),
+    ('lbi', 'lbi'),
+    ('ladakhi', 'lbj'),
+    ('lbj', 'lbj'),
+    ('central bontok', 'lbk'),
+    ('lbk', 'lbk'),
+    ('lbl', 'lbl'),
+    ('libon bikol', 'lbl'),
+    ('lbm', 'lbm'),
+    ('lodhi', 'lbm'),
+    ('lbn', 'lbn'),
+    ('rmeet', 'lbn'),
+    ('laven', 'lbo'),
+    ('lbo', 'lbo'),
+    ('lbq', 'lbq'),
+    ('wampar', 'lbq'),
+    ('lbr', 'lbr'),
+    ('lohorung', 'lbr'),
+    ('lbs', 'lbs'),
+    ('libyan sign language', 'lbs'),
+    ('lachi', 'lbt'),
+    ('lbt', 'lbt'),
+    ('labu', 'lbu'),
+    ('lbu', 'lbu'),
+    ('lavatbura-lamusong', 'lbv'),
+    ('lbv', 'lbv'),
+    ('lbw', 'lbw'),
+    ('tolaki', 'lbw'),
+    ('lawangan', 'lbx'),
+    ('lbx', 'lbx'),
+    ('lamalama', 'lby'),
+    ('lby', 'lby'),
+    ('lardil', 'lbz'),
+    ('lbz', 'lbz'),
+    ('lcc', 'lcc'),
+    ('legenyem', 'lcc'),
+    ('lcd', 'lcd'),
+    ('lola', 'lcd'),
+    ('lce', 'lce'),
+    ('loncong', 'lce'),
+    ('lcf', 'lcf'),
+    ('lubu', 'lcf'),
+    ('lch', 'lch'),
+    ('luchazi', 'lch'),
+    ('lcl', 'lcl'),
+    ('lisela', 'lcl'),
+    ('lcm', 'lcm'),
+    ('tungag', 'lcm'),
+    ('lcp', 'lcp'),
+    ('western lawa', 'lcp'),
+    ('lcq', 'lcq'),
+    ('luhu', 'lcq'),
+    ('lcs', 'lcs'),
+    ('lisabata-nuniali', 'lcs'),
+    ('kla-dan', 'lda'),
+    ('lda', 'lda'),
+    ('dũya', 'ldb'),
+    ('ldb', 'ldb'),
+    ('ldd', 'ldd'),
+    ('luri', 'ldd'),
+    ('ldg', 'ldg'),
+    ('lenyima', 'ldg'),
+    ('lamja-dengsa-tola', 'ldh'),
+    ('ldh', 'ldh'),
+    ('laari', 'ldi'),
+    ('ldi', 'ldi'),
+    ('ldj', 'ldj'),
+    ('lemoro', 'ldj'),
+    ('ldk', 'ldk'),
+    ('leelau', 'ldk'),
+    ('kaan', 'ldl'),
+    ('ldl', 'ldl'),
+    ('landoma', 'ldm'),
+    ('ldm', 'ldm'),
+    ('ldn', 'ldn'),
+    ('láadan', 'ldn'),
+    ('ldo', 'ldo'),
+    ('loo', 'ldo'),
+    ('ldp', 'ldp'),
+    ('ldq', 'ldq'),
+    ('lufu', 'ldq'),
+    ('lea', 'lea'),
+    ('lega-shabunda', 'lea'),
+    ('lala-bisa', 'leb'),
+    ('leb', 'leb'),
+    ('lec', 'lec'),
+    ('leco', 'lec'),
+    ('led', 'led'),
+    ('lendu', 'led'),
+    ('lee', 'lee'),
+    ('lyélé', 'lee'),
+    ('lef', 'lef'),
+    ('lelemi', 'lef'),
+    ('leh', 'leh'),
+    ('lenje', 'leh'),
+    ('lei', 'lei'),
+    ('lemio', 'lei'),
+    ('lej', 'lej'),
+    ('lengola', 'lej'),
+    ('leipon', 'lek'),
+    ('lek', 'lek'),
+    ('lel', 'lel'),
+    ('lele', 'lel'),
+    ('lele (democratic republic of congo)', 'lel'),
+    ('lem', 'lem'),
+    ('nomaande', 'lem'),
+    ('len', 'len'),
+    ('lenca', 'len'),
+    ('leo', 'leo'),
+    ('leti', 'leo'),
+    ('leti (cameroon)', 'leo'),
+    ('lep', 'lep'),
+    ('lepcha', 'lep'),
+    ('lembena', 'leq'),
+    ('leq', 'leq'),
+    ('lenkau', 'ler'),
+    ('ler', 'ler'),
+    ('les', 'les'),
+    ('lese', 'les'),
+    ('lesing-gelimi', 'let'),
+    ('let', 'let'),
+    ('kara (papua new guinea)', 'leu'),
+    ('leu', 'leu'),
+    ('lamma', 'lev'),
+    ('lev', 'lev'),
+    ('ledo kaili', 'lew'),
+    ('lew', 'lew'),
+    ('lex', 'lex'),
+    ('luang', 'lex'),
+    ('lemolang', 'ley'),
+    ('ley', 'ley'),
+    ('lez', 'lez'),
+    ('lezghian', 'lez'),
+    ('lefa', 'lfa'),
+    ('lfa', 'lfa'),
+    ('lfn', 'lfn'),
+    ('lingua franca nova', 'lfn'),
+    ('ganda', 'lg'),
+    ('lg', 'lg'),
+    ('lug', 'lg'),
+    ('lga', 'lga'),
+    ('lungga', 'lga'),
+    ('laghu', 'lgb'),
+    ('lgb', 'lgb'),
+    ('lgg', 'lgg'),
+    ('lugbara', 'lgg'),
+    ('laghuu', 'lgh'),
+    ('lgh', 'lgh'),
+    ('lengilu', 'lgi'),
+    ('lgi', 'lgi'),
+    ('lgk', 'lgk'),
+    ('lingarak', 'lgk'),
+    ('lgl', 'lgl'),
+    ('wala', 'lgl'),
+    ('lega-mwenga', 'lgm'),
+    ('lgm', 'lgm'),
+    ('lgn', 'lgn'),
+    ('t''apo', 'lgn'),
+    ('lango (south sudan)', 'lgo'),
+    ('lgo', 'lgo'),
+    ('lgq', 'lgq'),
+    ('logba', 'lgq'),
+    ('lengo', 'lgr'),
+    ('lgr', 'lgr'),
+    ('lgt', 'lgt'),
+    ('pahi', 'lgt'),
+    ('lgu', 'lgu'),
+    ('longgu', 'lgu'),
+    ('lgz', 'lgz'),
+    ('ligenza', 'lgz'),
+    ('laha', 'lha'),
+    ('laha (viet nam)', 'lha'),
+    ('lha', 'lha'),
+    ('laha (indonesia)', 'lhh'),
+    ('lhh', 'lhh'),
+    ('lahu shi', 'lhi'),
+    ('lhi', 'lhi'),
+    ('lahul lohar', 'lhl'),
+    ('lhl', 'lhl'),
+    ('lhm', 'lhm'),
+    ('lhomi', 'lhm'),
+    ('lahanan', 'lhn'),
+    ('lhn', 'lhn'),
+    ('lhokpu', 'lhp'),
+    ('lhp', 'lhp'),
+    ('lhs', 'lhs'),
+    ('mlahsö', 'lhs'),
+    ('lht', 'lht'),
+    ('lo-toga', 'lht'),
+    ('lahu', 'lhu'),
+    ('lhu', 'lhu'),
+    ('li', 'li'),
+    ('lim', 'li'),
+    ('limburgan', 'li'),
+    ('limburger', 'li'),
+    ('limburgish', 'li'),
+    ('lia', 'lia'),
+    ('west-central limba', 'lia'),
+    ('lib', 'lib'),
+    ('likum', 'lib'),
+    ('hlai', 'lic'),
+    ('lic', 'lic'),
+    ('lid', 'lid'),
+    ('nyindrou', 'lid'),
+    ('lie', 'lie'),
+    ('likila', 'lie'),
+    ('lif', 'lif'),
+    ('limbu', 'lif'),
+    ('lig', 'lig'),
+    ('ligbi', 'lig'),
+    ('lih', 'lih'),
+    ('lihir', 'lih'),
+    ('ligurian', 'lij'),
+    ('lij', 'lij'),
+    ('lik', 'lik'),
+    ('lika', 'lik'),
+    ('lil', 'lil'),
+    ('lillooet', 'lil'),
+    ('liki', 'lio'),
+    ('lio', 'lio'),
+    ('lip', 'lip'),
+    ('sekpele', 'lip'),
+    ('libido', 'liq'),
+    ('liq', 'liq'),
+    ('liberian english', 'lir'),
+    ('lir', 'lir'),
+    ('lis', 'lis'),
+    ('lisu', 'lis'),
+    ('liu', 'liu'),
+    ('logorik', 'liu'),
+    ('liv', 'liv'),
+    ('liw', 'liw'),
+    ('liabuku', 'lix'),
+    ('lix', 'lix'),
+    ('banda-bambari', 'liy'),
+    ('liy', 'liy'),
+    ('libinza', 'liz'),
+    ('liz', 'liz'),
+    ('golpa', 'lja'),
+    ('lja', 'lja'),
+    ('lje', 'lje'),
+    ('rampi', 'lje'),
+    ('laiyolo', 'lji'),
+    ('lji', 'lji'),
+    ('li''o', 'ljl'),
+    ('ljl', 'ljl'),
+    ('lampung api', 'ljp'),
+    ('ljp', 'ljp'),
+    ('ljw', 'ljw'),
+    ('yirandali', 'ljw'),
+    ('ljx', 'ljx'),
+    ('yuru', 'ljx'),
+    ('lakalei', 'lka'),
+    ('lka', 'lka'),
+    ('kabras', 'lkb'),
+    ('lkb', 'lkb'),
+    ('kucong', 'lkc'),
+    ('lkc', 'lkc'),
+    ('lakondê', 'lkd'),
+    ('lkd', 'lkd'),
+    ('kenyi', 'lke'),
+    ('lke', 'lke'),
+    ('lakha', 'lkh'),
+    ('lkh', 'lkh'),
+    ('laki', 'lki'),
+    ('lki', 'lki'),
+    ('lkj', 'lkj'),
+    ('remun', 'lkj'),
+    ('laeko-libuat', 'lkl'),
+    ('lkl', 'lkl'),
+    ('kalaamaya', 'lkm'),
+    ('lkm', 'lkm'),
+    ('lakon', 'lkn'),
+    ('lkn', 'lkn'),
+    ('khayo', 'lko'),
+    ('lko', 'lko'),
+    ('lkr', 'lkr'),
+    ('päri', 'lkr'),
+    ('kisa', 'lks'),
+    ('lks', 'lks'),
+    ('lakota', 'lkt'),
+    ('lkt', 'lkt'),
+    ('kungkari', 'lku'),
+    ('lku', 'lku'),
+    ('lky', 'lky'),
+    ('lokoya', 'lky'),
+    ('lala-roba', 'lla'),
+    ('lla', 'lla'),
+    ('llb', 'llb'),
+    ('lolo', 'llb'),
+    ('lele (guinea)', 'llc'),
+    ('llc', 'llc'),
+    ('ladin', 'lld'),
+    ('lld', 'lld'),
+    ('lele (papua new guinea)', 'lle'),
+    ('lle', 'lle'),
+    ('hermit', 'llf'),
+    ('llf', 'llf'),
+    ('llg', 'llg'),
+    ('lole', 'llg'),
+    ('lamu', 'llh'),
+    ('llh', 'llh'),
+    ('lli', 'lli'),
+    ('teke-laali', 'lli'),
+    ('ladji ladji', 'llj'),
+    ('llj', 'llj'),
+    ('lelak', 'llk'),
+    ('llk', 'llk'),
+    ('lilau', 'lll'),
+    ('lll', 'lll'),
+    ('lasalimu', 'llm'),
+    ('llm', 'llm'),
+    ('lele (chad)', 'lln'),
+    ('lln', 'lln'),
+    ('llp', 'llp'),
+    ('north efate', 'llp'),
+    ('llq', 'llq'),
+    ('lolak', 'llq'),
+    ('lithuanian sign language', 'lls'),
+    ('lls', 'lls'),
+    ('llu', 'llu'),
+    ('lauan', 'llx'),
+    ('llx', 'llx'),
+    ('east limba', 'lma'),
+    ('lma', 'lma'),
+    ('lmb', 'lmb'),
+    ('merei', 'lmb'),
+    ('limilngan', 'lmc'),
+    ('lmc', 'lmc'),
+    ('lmd', 'lmd'),
+    ('lumun', 'lmd'),
+    ('lme', 'lme'),
+    ('pévé', 'lme'),
+    ('lmf', 'lmf'),
+    ('south lembata', 'lmf'),
+    ('lamogai', 'lmg'),
+    ('lmg', 'lmg'),
+    ('lambichhong', 'lmh'),
+    ('lmh', 'lmh'),
+    ('lmi', 'lmi'),
+    ('lombi', 'lmi'),
+    ('lmj', 'lmj'),
+    ('west lembata', 'lmj'),
+    ('lamkang', 'lmk'),
+    ('lmk', 'lmk'),
+    ('hano', 'lml'),
+    ('lml', 'lml'),
+    ('lambadi', 'lmn'),
+    ('lmn', 'lmn'),
+    ('lmo', 'lmo'),
+    ('lombard', 'lmo'),
+    ('limbum', 'lmp'),
+    ('lmp', 'lmp'),
+    ('lamatuka', 'lmq'),
+    ('lmq', 'lmq'),
+    ('lamalera', 'lmr'),
+    ('lmr', 'lmr'),
+    ('lamenu', 'lmu'),
+    ('lmu', 'lmu'),
+    ('lmv', 'lmv'),
+    ('lomaiviti', 'lmv'),
+    ('lake miwok', 'lmw'),
+    ('lmw', 'lmw'),
+    ('laimbue', 'lmx'),
+    ('lmx', 'lmx'),
+    ('lamboya', 'lmy'),
+    ('lmy', 'lmy'),
+    ('lin', 'ln'),
+    ('lingala', 'ln'),
+    ('ln', 'ln'),
+    ('langbashe', 'lna'),
+    ('lna', 'lna'),
+    ('lnb', 'lnb'),
+    ('mbalanhu', 'lnb'),
+    ('lnd', 'lnd'),
+    ('lundayeh', 'lnd'),
+    ('langobardic', 'lng'),
+    ('lng', 'lng'),
+    ('lanoh', 'lnh'),
+    ('lnh', 'lnh'),
+    ('daantanai''', 'lni'),
+    ('lni', 'lni'),
+    ('leningitij', 'lnj'),
+    ('lnj', 'lnj'),
+    ('lnl', 'lnl'),
+    ('south central banda', 'lnl'),
+    ('langam', 'lnm'),
+    ('lnm', 'lnm'),
+    ('lnn', 'lnn'),
+    ('lorediakarkar', 'lnn'),
+    ('lamnso''', 'lns'),
+    ('lns', 'lns'),
+    ('lnu', 'lnu'),
+    ('longuda', 'lnu'),
+    ('lanima', 'lnw'),
+    ('lnw', 'lnw'),
+    ('lnz', 'lnz'),
+    ('lonzo', 'lnz'),
+    ('lao', 'lo'),
+    ('lo', 'lo'),
+    ('loa', 'loa'),
+    ('loloda', 'loa'),
+    ('lob', 'lob'),
+    ('lobi', 'lob'),
+    ('inonhan', 'loc'),
+    ('loc', 'loc'),
+    ('loe', 'loe'),
+    ('saluan', 'loe'),
+    ('lof', 'lof'),
+    ('logol', 'lof'),
+    ('log', 'log'),
+    ('logo', 'log'),
+    ('loh', 'loh'),
+    ('narim', 'loh'),
+    ('loi', 'loi'),
+    ('loma', 'loi'),
+    ('loma (côte d''ivoire)', 'loi'),
+    ('loj', 'loj'),
+    ('lou', 'loj'),
+    ('lok', 'lok'),
+    ('loko', 'lok'),
+    ('lol', 'lol'),
+    ('mongo', 'lol'),
+    ('lom', 'lom'),
+    ('loma (liberia)', 'lom'),
+    ('lon', 'lon'),
+    ('malawi lomwe', 'lon'),
+    ('lombo', 'loo'),
+    ('lop', 'lop'),
+    ('lopa', 'lop'),
+    ('lobala', 'loq'),
+    ('loq', 'loq'),
+    ('lor', 'lor'),
+    ('téén', 'lor'),
+    ('loniu', 'los'),
+    ('los', 'los'),
+    ('lot', 'lot'),
+    ('otuho', 'lot'),
+    ('louisiana creole', 'lou'),
+    ('lopi', 'lov'),
+    ('lov', 'lov'),
+    ('low', 'low'),
+    ('tampias lobu', 'low'),
+    ('loun', 'lox'),
+    ('lox', 'lox'),
+    ('loke', 'loy'),
+    ('loy', 'loy'),
+    ('loz', 'loz'),
+    ('lozi', 'loz'),
+    ('lelepa', 'lpa'),
+    ('lpa', 'lpa'),
+    ('lepki', 'lpe'),
+    ('lpe', 'lpe'),
+    ('long phuri naga', 'lpn'),
+    ('lpn', 'lpn'),
+    ('lipo', 'lpo'),
+    ('lpo', 'lpo'),
+    ('lopit', 'lpx'),
+    ('lpx', 'lpx'),
+    ('logir', 'lqr'),
+    ('lqr', 'lqr'),
+    ('lra', 'lra'),
+    ('rara bakati''', 'lra'),
+    ('lrc', 'lrc'),
+    ('northern luri', 'lrc'),
+    ('laurentian', 'lre'),
+    ('lre', 'lre'),
+    ('laragia', 'lrg'),
+    ('lrg', 'lrg'),
+    ('lri', 'lri'),
+    ('marachi', 'lri'),
+    ('loarki', 'lrk'),
+    ('lrk', 'lrk'),
+    ('lari', 'lrl'),
+    ('lrl', 'lrl'),
+    ('lrm', 'lrm'),
+    ('marama', 'lrm'),
+    ('lorang', 'lrn'),
+    ('lrn', 'lrn'),
+    ('laro', 'lro'),
+    ('lro', 'lro'),
+    ('lrr', 'lrr'),
+    ('southern yamphu', 'lrr'),
+    ('larantuka malay', 'lrt'),
+    ('lrt', 'lrt'),
+    ('larevat', 'lrv'),
+    ('lrv', 'lrv'),
+    ('lemerig', 'lrz'),
+    ('lrz', 'lrz'),
+    ('lasgerdi', 'lsa'),
+    ('lsa', 'lsa'),
+    ('burundian sign language', 'lsb'),
+    ('lsb', 'lsb'),
+    ('albarradas sign language', 'lsc'),
+    ('lsc', 'lsc'),
+    ('lishana deni', 'lsd'),
+    ('lsd', 'lsd'),
+    ('lse', 'lse'),
+    ('lusengo', 'lse'),
+    ('lish', 'lsh'),
+    ('lsh', 'lsh'),
+    ('lashi', 'lsi'),
+    ('lsi', 'lsi'),
+    ('latvian sign language', 'lsl'),
+    ('lsl', 'lsl'),
+    ('lsm', 'lsm'),
+    ('saamia', 'lsm'),
+    ('lsn', 'lsn'),
+    ('tibetan sign language', 'lsn'),
+    ('laos sign language', 'lso'),
+    ('lso', 'lso'),
+    ('lsp', 'lsp'),
+    ('panamanian sign language', 'lsp'),
+    ('aruop', 'lsr'),
+    ('lsr', 'lsr'),
+    ('lasi', 'lss'),
+    ('lss', 'lss'),
+    ('lst', 'lst'),
+    ('trinidad and tobago sign language', 'lst'),
+    ('lsv', 'lsv'),
+    ('sivia sign language', 'lsv'),
+    ('lsw', 'lsw'),
+    ('seychelles sign language', 'lsw'),
+    ('lsy', 'lsy'),
+    ('mauritian sign language', 'lsy'),
+    ('lit', 'lt'),
+    ('lithuanian', 'lt'),
+    ('lt', 'lt'),
+    ('late middle chinese', 'ltc'),
+    ('ltc', 'ltc'),
+    ('latgalian', 'ltg'),
+    ('ltg', 'ltg'),
+    ('lth', 'lth'),
+    ('thur', 'lth'),
+    ('leti (indonesia)', 'lti'),
+    ('lti', 'lti'),
+    ('latundê', 'ltn'),
+    ('ltn', 'ltn'),
+    ('lto', 'lto'),
+    ('tsotso', 'lto'),
+    ('lts', 'lts'),
+    ('tachoni', 'lts'),
+    ('latu', 'ltu'),
+    ('ltu', 'ltu'),
+    ('lu', 'lu'),
+    ('lub', 'lu'),
+    ('luba-katanga', 'lu'),
+    ('lua', 'lua'),
+    ('luba-lulua', 'lua'),
+    ('aringa', 'luc'),
+    ('luc', 'luc'),
+    ('lud', 'lud'),
+    ('ludian', 'lud'),
+    ('lue', 'lue'),
+    ('luvale', 'lue'),
+    ('laua', 'luf'),
+    ('luf', 'luf'),
+    ('lui', 'lui'),
+    ('luiseno', 'lui'),
+    ('luj', 'luj'),
+    ('luna', 'luj'),
+    ('luk', 'luk'),
+    ('lunanakha', 'luk'),
+    ('lul', 'lul'),
+    ('olu''bo', 'lul'),
+    ('luimbi', 'lum'),
+    ('lum', 'lum'),
+    ('lun', 'lun'),
+    ('lunda', 'lun'),
+    ('luo', 'luo'),
+    ('luo (kenya and tanzania)', 'luo'),
+    ('lumbu', 'lup'),
+    ('lup', 'lup'),
+    ('lucumi', 'luq'),
+    ('luq', 'luq'),
+    ('laura', 'lur'),
+    ('lur', 'lur'),
+    ('lus', 'lus'),
+    ('lushai', 'lus'),
+    ('lushootseed', 'lut'),
+    ('lut', 'lut'),
+    ('lumba-yakkha', 'luu'),
+    ('luu', 'luu'),
+    ('luv', 'luv'),
+    ('luwati', 'luv'),
+    ('luo (cameroon)', 'luw'),
+    ('luw', 'luw'),
+    ('luy', 'luy'),
+    ('luyia', 'luy'),
+    ('luz', 'luz'),
+    ('southern luri', 'luz'),
+    ('latvian', 'lv'),
+    ('lav', 'lv'),
+    ('lv', 'lv'),
+    ('lva', 'lva'),
+    ('maku''a', 'lva'),
+    ('lavi', 'lvi'),
+    ('lvi', 'lvi'),
+    ('lavukaleve', 'lvk'),
+    ('lvk', 'lvk'),
+    ('lvs', 'lvs'),
+    ('standard latvian', 'lvs'),
+    ('levuka', 'lvu'),
+    ('lvu', 'lvu'),
+    ('lwa', 'lwa'),
+    ('lwalu', 'lwa'),
+    ('lewo eleng', 'lwe'),
+    ('lwe', 'lwe'),
+    ('lwg', 'lwg'),
+    ('wanga', 'lwg'),
+    ('lwh', 'lwh'),
+    ('white lachi', 'lwh'),
+    ('eastern lawa', 'lwl'),
+    ('lwl', 'lwl'),
+    ('laomian', 'lwm'),
+    ('lwm', 'lwm'),
+    ('luwo', 'lwo'),
+    ('lwo', 'lwo'),
+    ('lws', 'lws'),
+    ('malawian sign language', 'lws'),
+    ('lewotobi', 'lwt'),
+    ('lwt', 'lwt'),
+    ('lawu', 'lwu'),
+    ('lwu', 'lwu'),
+    ('lewo', 'lww'),
+    ('lww', 'lww'),
+    ('lakurumau', 'lxm'),
+    ('lxm', 'lxm'),
+    ('layakha', 'lya'),
+    ('lya', 'lya'),
+    ('lyg', 'lyg'),
+    ('lyngngam', 'lyg'),
+    ('luyana', 'lyn'),
+    ('lyn', 'lyn'),
+    ('literary chinese', 'lzh'),
+    ('lzh', 'lzh'),
+    ('litzlitz', 'lzl'),
+    ('lzl', 'lzl'),
+    ('leinong naga', 'lzn'),
+    ('lzn', 'lzn'),
+    ('lzz', 'lzz'),
+    ('san jerónimo tecóatl mazatec', 'maa'),
+    ('mab', 'mab'),
+    ('yutanduchi mixtec', 'mab'),
+    ('mad', 'mad'),
+    ('madurese', 'mad'),
+    ('bo-rukul', 'mae'),
+    ('mae', 'mae'),
+    ('maf', 'maf'),
+    ('mafa', 'maf'),
+    ('mag', 'mag'),
+    ('magahi', 'mag'),
+    ('mai', 'mai'),
+    ('maithili', 'mai'),
+    ('jalapa de díaz mazatec', 'maj'),
+    ('maj', 'maj'),
+    ('mak', 'mak'),
+    ('makasar', 'mak'),
+    ('mam', 'mam'),
+    ('man', 'man'),
+    ('mandingo', 'man'),
+    ('chiquihuitlán mazatec', 'maq'),
+    ('maq', 'maq'),
+    ('mas', 'mas'),
+    ('masai', 'mas'),
+    ('mat', 'mat'),
+    ('san francisco matlatzinca', 'mat'),
+    ('huautla mazatec', 'mau'),
+    ('mau', 'mau'),
+    ('mav', 'mav'),
+    ('sateré-mawé', 'mav'),
+    ('mampruli', 'maw'),
+    ('maw', 'maw'),
+    ('max', 'max'),
+    ('north moluccan malay', 'max'),
+    ('central mazahua', 'maz'),
+    ('maz', 'maz'),
+    ('higaonon', 'mba'),
+    ('mba', 'mba'),
+    ('mbb', 'mbb'),
+    ('western bukidnon manobo', 'mbb'),
+    ('macushi', 'mbc'),
+    ('mbc', 'mbc'),
+    ('dibabawon manobo', 'mbd'),
+    ('mbd', 'mbd'),
+    ('mbe', 'mbe'),
+    ('molale', 'mbe'),
+    ('baba malay', 'mbf'),
+    ('mbf', 'mbf'),
+    ('mangseng', 'mbh'),
+    ('mbh', 'mbh'),
+    ('ilianen manobo', 'mbi'),
+    ('mbi', 'mbi'),
+    ('mbj', 'mbj'),
+    ('nadëb', 'mbj'),
+    ('malol', 'mbk'),
+    ('mbk', 'mbk'),
+    ('maxakalí', 'mbl'),
+    ('mbl', 'mbl'),
+    ('mbm', 'mbm'),
+    ('ombamba', 'mbm'),
+    ('macaguán', 'mbn'),
+    ('mbn', 'mbn'),
+    ('mbo', 'mbo'),
+    ('mbo (cameroon)', 'mbo'),
+    ('malayo', 'mbp'),
+    ('mbp', 'mbp'),
+    ('maisin', 'mbq'),
+    ('mbq', 'mbq'),
+    ('mbr', 'mbr'),
+    ('nukak makú', 'mbr'),
+    ('mbs', 'mbs'),
+    ('sarangani manobo', 'mbs'),
+    ('matigsalug manobo', 'mbt'),
+    ('mbt', 'mbt'),
+    ('mbu', 'mbu'),
+    ('mbula-bwazza', 'mbu'),
+    ('mbulungish', 'mbv'),
+    ('mbv', 'mbv'),
+    ('maring', 'mbw'),
+    ('mbw', 'mbw'),
+    ('mari (east sepik province)', 'mbx'),
+    ('mbx', 'mbx'),
+    ('mby', 'mby'),
+    ('memoni', 'mby'),
+    ('amoltepec mixtec', 'mbz'),
+    ('mbz', 'mbz'),
+    ('maca', 'mca'),
+    ('mca', 'mca'),
+    ('machiguenga', 'mcb'),
+    ('mcb', 'mcb'),
+    ('bitur', 'mcc'),
+    ('mcc', 'mcc'),
+    ('mcd', 'mcd'),
+    ('sharanahua', 'mcd'),
+    ('itundujia mixtec', 'mce'),
+    ('mce', 'mce'),
+    ('matsés', 'mcf'),
+    ('mcf', 'mcf'),
+    ('mapoyo', 'mcg'),
+    ('mcg', 'mcg'),
+    ('maquiritari', 'mch'),
+    ('mch', 'mch'),
+    ('mci', 'mci'),
+    ('mese', 'mci'),
+    ('mcj', 'mcj'),
+    ('mvanip', 'mcj'),
+    ('mbunda', 'mck'),
+    ('mck', 'mck'),
+    ('macaguaje', 'mcl'),
+    ('mcl', 'mcl'),
+    ('malaccan creole portuguese', 'mcm'),
+    ('mcm', 'mcm'),
+    ('masana', 'mcn'),
+    ('mcn', 'mcn'),
+    ('coatlán mixe', 'mco'),
+    ('mco', 'mco'),
+    ('makaa', 'mcp'),
+    ('mcp', 'mcp'),
+    ('mcq', 'mcq'),
+    ('mcr', 'mcr'),
+    ('menya', 'mcr'),
+    ('mambai', 'mcs'),
+    ('mcs', 'mcs'),
+    ('mct', 'mct'),
+    ('mengisa', 'mct'),
+    ('cameroon mambila', 'mcu'),
+    ('mcu', 'mcu'),
+    ('mcv', 'mcv'),
+    ('minanibai', 'mcv'),
+    ('mawa', 'mcw'),
+    ('mawa (chad)', 'mcw'),
+    ('mcw', 'mcw'),
+    ('mcx', 'mcx'),
+    ('mpiemo', 'mcx'),
+    ('mcy', 'mcy'),
+    ('south watut', 'mcy'),
+    ('mawan', 'mcz'),
+    ('mcz', 'mcz'),
+    ('mada', 'mda'),
+    ('mada (nigeria)', 'mda'),
+    ('mda', 'mda'),
+    ('mdb', 'mdb'),
+    ('morigi', 'mdb'),
+    ('male', 'mdc'),
+    ('male (papua new guinea)', 'mdc'),
+    ('mdc', 'mdc'),
+    ('mbum', 'mdd'),
+    ('mdd', 'mdd'),
+    ('maba', 'mde'),
+    ('maba (chad)', 'mde'),
+    ('mde', 'mde'),
+    ('mdf', 'mdf'),
+    ('moksha', 'mdf'),
+    ('massalat', 'mdg'),
+    ('mdg', 'mdg'),
+    ('maguindanaon', 'mdh'),
+    ('mdh', 'mdh'),
+    ('mamvu', 'mdi'),
+    ('mdi', 'mdi'),
+    ('mangbetu', 'mdj'),
+    ('mdj', 'mdj'),
+    ('mangbutu', 'mdk'),
+    ('mdk', 'mdk'),
+    ('maltese sign language', 'mdl'),
+    ('mdl', 'mdl'),
+    ('mayogo', 'mdm'),
+    ('mdm', 'mdm'),
+    ('mbati', 'mdn'),
+    ('mdn', 'mdn'),
+    ('mbala', 'mdp'),
+    ('mdp', 'mdp'),
+    ('mbole', 'mdq'),
+    ('mdq', 'mdq'),
+    ('mandar', 'mdr'),
+    ('mdr', 'mdr'),
+    ('maria', 'mds'),
+    ('maria (papua new guinea)', 'mds'),
+    ('mds', 'mds'),
+    ('mbere', 'mdt'),
+    ('mdt', 'mdt'),
+    ('mboko', 'mdu'),
+    ('mdu', 'mdu'),
+    ('mdv', 'mdv'),
+    ('santa lucía monteverde mixtec', 'mdv'),
+    ('mbosi', 'mdw'),
+    ('mdw', 'mdw'),
+    ('dizin', 'mdx'),
+    ('mdx', 'mdx'),
+    ('male (ethiopia)', 'mdy'),
+    ('mdy', 'mdy'),
+    ('mdz', 'mdz'),
+    ('suruí do pará', 'mdz'),
+    ('mea', 'mea'),
+    ('menka', 'mea'),
+    ('ikobi', 'meb'),
+    ('meb', 'meb'),
+    ('marra', 'mec'),
+    ('mec', 'mec'),
+    ('med', 'med'),
+    ('melpa', 'med'),
+    ('mee', 'mee'),
+    ('mengen', 'mee'),
+    ('mef', 'mef'),
+    ('megam', 'mef'),
+    ('meh', 'meh'),
+    ('southwestern tlaxiaco mixtec', 'meh'),
+    ('mei', 'mei'),
+    ('midob', 'mei'),
+    ('mej', 'mej'),
+    ('meyah', 'mej'),
+    ('mek', 'mek'),
+    ('mekeo', 'mek'),
+    ('central melanau', 'mel'),
+    ('mel', 'mel'),
+    ('mangala', 'mem'),
+    ('mem', 'mem'),
+    ('men', 'men'),
+    ('mende', 'men'),
+    ('mende (sierra leone)', 'men'),
+    ('kedah malay', 'meo'),
+    ('meo', 'meo'),
+    ('mep', 'mep'),
+    ('miriwoong', 'mep'),
+    ('meq', 'meq'),
+    ('merey', 'meq'),
+    ('mer', 'mer'),
+    ('meru', 'mer'),
+    ('masmaje', 'mes'),
+    ('mes', 'mes'),
+    ('mato', 'met'),
+    ('met', 'met'),
+    ('meu', 'meu'),
+    ('motu', 'meu'),
+    ('mano', 'mev'),
+    ('mev', 'mev'),
+    ('maaka', 'mew'),
+    ('mew', 'mew'),
+    ('hassaniyya', 'mey'),
+    ('mey', 'mey'),
+    ('menominee', 'mez'),
+    ('mez', 'mez'),
+    ('mfa', 'mfa'),
+    ('pattani malay', 'mfa'),
+    ('bangka', 'mfb'),
+    ('mfb', 'mfb'),
+    ('mfc', 'mfc'),
+    ('mendankwe-nkwen', 'mfd'),
+    ('mfd', 'mfd'),
+    ('mfe', 'mfe'),
+    ('morisyen', 'mfe'),
+    ('mff', 'mff'),
+    ('naki', 'mff'),
+    ('mfg', 'mfg'),
+    ('mogofin', 'mfg'),
+    ('matal', 'mfh'),
+    ('mfh', 'mfh'),
+    ('mfi', 'mfi'),
+    ('wandala', 'mfi'),
+    ('mefele', 'mfj'),
+    ('mfj', 'mfj'),
+    ('mfk', 'mfk'),
+    ('north mofu', 'mfk'),
+    ('mfl', 'mfl'),
+    ('putai', 'mfl'),
+    ('marghi south', 'mfm'),
+    ('mfm', 'mfm'),
+    ('cross river mbembe', 'mfn'),
+    ('mfn', 'mfn'),
+    ('mfo', 'mfo'),
+    ('makassar malay', 'mfp'),
+    ('mfp', 'mfp'),
+    ('mfq', 'mfq'),
+    ('moba', 'mfq'),
+    ('marrithiyel', 'mfr'),
+    ('mfr', 'mfr'),
+    ('mexican sign language', 'mfs'),
+    ('mfs', 'mfs'),
+    ('mft', 'mft'),
+    ('mokerang', 'mft'),
+    ('mbwela', 'mfu'),
+    ('mfu', 'mfu'),
+    ('mandjak', 'mfv'),
+    ('mfv', 'mfv'),
+    ('mfw', 'mfw'),
+    ('mulaha', 'mfw'),
+    ('melo', 'mfx'),
+    ('mfx', 'mfx'),
+    ('mayo', 'mfy'),
+    ('mfy', 'mfy'),
+    ('mabaan', 'mfz'),
+    ('mfz', 'mfz'),
+    ('malagasy', 'mg'),
+    ('mg', 'mg'),
+    ('mlg', 'mg'),
+    ('irish, middle', 'mga'),
+    ('irish, middle (900-1200)', 'mga'),
+    ('mga', 'mga'),
+    ('middle irish', 'mga'),
+    ('middle irish (900-1200)', 'mga'),
+    ('mararit', 'mgb'),
+    ('mgb', 'mgb'),
+    ('mgc', 'mgc'),
+    ('morokodo', 'mgc'),
+    ('mgd', 'mgd'),
+    ('moru', 'mgd'),
+    ('mango', 'mge'),
+    ('mge', 'mge'),
+    ('maklew', 'mgf'),
+    ('mgf', 'mgf'),
+    ('mgg', 'mgg'),
+    ('mpumpong', 'mgg'),
+    ('makhuwa-meetto', 'mgh'),
+    ('mgh', 'mgh'),
+    ('lijili', 'mgi'),
+    ('mgi', 'mgi'),
+    ('abureni', 'mgj'),
+    ('mgj', 'mgj'),
+    ('mawes', 'mgk'),
+    ('mgk', 'mgk'),
+    ('maleu-kilenge', 'mgl'),
+    ('mgl', 'mgl'),
+    ('mambae', 'mgm'),
+    ('mgm', 'mgm'),
+    ('mbangi', 'mgn'),
+    ('mgn', 'mgn'),
+    ('meta''', 'mgo'),
+    ('mgo', 'mgo'),
+    ('eastern magar', 'mgp'),
+    ('mgp', 'mgp'),
+    ('malila', 'mgq'),
+    ('mgq', 'mgq'),
+    ('mambwe-lungu', 'mgr'),
+    ('mgr', 'mgr'),
+    ('manda', 'mgs'),
+    ('manda (tanzania)', 'mgs'),
+    ('mgs', 'mgs'),
+    ('mgt', 'mgt'),
+    ('mongol', 'mgt'),
+    ('mailu', 'mgu'),
+    ('mgu', 'mgu'),
+    ('matengo', 'mgv'),
+    ('mgv', 'mgv'),
+    ('matumbi', 'mgw'),
+    ('mgw', 'mgw'),
+    ('mbunga', 'mgy'),
+    ('mgy', 'mgy'),
+    ('mbugwe', 'mgz'),
+    ('mgz', 'mgz'),
+    ('mah', 'mh'),
+    ('marshallese', 'mh'),
+    ('mh', 'mh'),
+    ('manda (india)', 'mha'),
+    ('mha', 'mha'),
+    ('mahongwe', 'mhb'),
+    ('mhb', 'mhb'),
+    ('mhc', 'mhc'),
+    ('mocho', 'mhc'),
+    ('mbugu', 'mhd'),
+    ('mhd', 'mhd'),
+    ('besisi', 'mhe'),
+    ('mhe', 'mhe'),
+    ('mamaa', 'mhf'),
+    ('mhf', 'mhf'),
+    ('margu', 'mhg'),
+    ('mhg', 'mhg'),
+    ('ma''di', 'mhi'),
+    ('mhi', 'mhi'),
+    ('mhj', 'mhj'),
+    ('mogholi', 'mhj'),
+    ('mhk', 'mhk'),
+    ('mungaka', 'mhk'),
+    ('mauwake', 'mhl'),
+    ('mhl', 'mhl'),
+    ('makhuwa-moniga', 'mhm'),
+    ('mhm', 'mhm'),
+    ('mhn', 'mhn'),
+    ('mócheno', 'mhn'),
+    ('mashi (zambia)', 'mho'),
+    ('mho', 'mho'),
+    ('balinese malay', 'mhp'),
+    ('mhp', 'mhp'),
+    ('mandan', 'mhq'),
+    ('mhq', 'mhq'),
+    ('eastern mari', 'mhr'),
+    ('mhr', 'mhr'),
+    ('buru (indonesia)', 'mhs'),
+    ('mhs', 'mhs'),
+    ('mandahuaca', 'mht'),
+    ('mht', 'mht'),
+    ('digaro-mishmi', 'mhu'),
+    ('mhu', 'mhu'),
+    ('mbukushu', 'mhw'),
+    ('mhw', 'mhw'),
+    ('maru', 'mhx'),
+    ('mhx', 'mhx'),
+    ('ma''anyan', 'mhy'),
+    ('mhy', 'mhy'),
+    ('mhz', 'mhz'),
+    ('mor', 'mhz'),
+    ('mor (mor islands)', 'mhz'),
+    ('mao', 'mi'),
+    ('maori', 'mi'),
+    ('mi', 'mi'),
+    ('mri', 'mi'),
+    ('mia', 'mia'),
+    ('miami', 'mia'),
+    ('atatláhuca mixtec', 'mib'),
+    ('mib', 'mib'),
+    ('mi''kmaq', 'mic'),
+    ('mic', 'mic'),
+    ('micmac', 'mic'),
+    ('mandaic', 'mid'),
+    ('mid', 'mid'),
+    ('mie', 'mie'),
+    ('ocotepec mixtec', 'mie'),
+    ('mif', 'mif'),
+    ('mofu-gudur', 'mif'),
+    ('mig', 'mig'),
+    ('san miguel el grande mixtec', 'mig'),
+    ('chayuco mixtec', 'mih'),
+    ('mih', 'mih'),
+    ('chigmecatitlán mixtec', 'mii'),
+    ('mii', 'mii'),
+    ('abar', 'mij'),
+    ('mij', 'mij'),
+    ('mik', 'mik'),
+    ('mikasuki', 'mik'),
+    ('mil', 'mil'),
+    ('peñoles mixtec', 'mil'),
+    ('alacatlatzala mixtec', 'mim'),
+    ('mim', 'mim'),
+    ('min', 'min'),
+    ('minangkabau', 'min'),
+    ('mio', 'mio'),
+    ('pinotepa nacional mixtec', 'mio'),
+    ('apasco-apoala mixtec', 'mip'),
+    ('mip', 'mip'),
+    ('miq', 'miq'),
+    ('mískito', 'miq'),
+    ('isthmus mixe', 'mir'),
+    ('mir', 'mir'),
+    ('mit', 'mit'),
+    ('southern puebla mixtec', 'mit'),
+    ('cacaloxtepec mixtec', 'miu'),
+    ('miu', 'miu'),
+    ('akoye', 'miw'),
+    ('miw', 'miw'),
+    ('mix', 'mix'),
+    ('mixtepec mixtec', 'mix'),
+    ('ayutla mixtec', 'miy'),
+    ('miy', 'miy'),
+    ('coatzospan mixtec', 'miz'),
+    ('miz', 'miz'),
+    ('makalero', 'mjb'),
+    ('mjb', 'mjb'),
+    ('mjc', 'mjc'),
+    ('san juan colorado mixtec', 'mjc'),
+    ('mjd', 'mjd'),
+    ('northwest maidu', 'mjd'),
+    ('mje', 'mje'),
+    ('muskum', 'mje'),
+    ('mjg', 'mjg'),
+    ('tu', 'mjg'),
+    ('mjh', 'mjh'),
+    ('mwera', 'mjh'),
+    ('mwera (nyasa)', 'mjh'),
+    ('kim mun', 'mji'),
+    ('mji', 'mji'),
+    ('mawak', 'mjj'),
+    ('mjj', 'mjj'),
+    ('matukar', 'mjk'),
+    ('mjk', 'mjk'),
+    ('mandeali', 'mjl'),
+    ('mjl', 'mjl'),
+    ('medebur', 'mjm'),
+    ('mjm', 'mjm'),
+    ('ma', 'mjn'),
+    ('ma (papua new guinea)', 'mjn'),
+    ('mjn', 'mjn'),
+    ('malankuravan', 'mjo'),
+    ('mjo', 'mjo'),
+    ('malapandaram', 'mjp'),
+    ('mjp', 'mjp'),
+    ('malaryan', 'mjq'),
+    ('mjq', 'mjq'),
+    ('malavedan', 'mjr'),
+    ('mjr', 'mjr'),
+    ('miship', 'mjs'),
+    ('mjs', 'mjs'),
+    ('mjt', 'mjt'),
+    ('sauria paharia', 'mjt'),
+    ('manna-dora', 'mju'),
+    ('mju', 'mju'),
+    ('mannan', 'mjv'),
+    ('mjv', 'mjv'),
+    ('karbi', 'mjw'),
+    ('mjw', 'mjw'),
+    ('mahali', 'mjx'),
+    ('mjx', 'mjx'),
+    ('mahican', 'mjy'),
+    ('mjy', 'mjy'),
+    ('majhi', 'mjz'),
+    ('mjz', 'mjz'),
+    ('mac', 'mk'),
+    ('macedonian', 'mk'),
+    ('mk', 'mk'),
+    ('mkd', 'mk'),
+    ('mbre', 'mka'),
+    ('mka', 'mka'),
+    ('mal paharia', 'mkb'),
+    ('mkb', 'mkb'),
+    ('mkc', 'mkc'),
+    ('siliput', 'mkc'),
+    ('mawchi', 'mke'),
+    ('mke', 'mke'),
+    ('miya', 'mkf'),
+    ('mkf', 'mkf'),
+    ('mak (china)', 'mkg'),
+    ('mkg', 'mkg'),
+    ('dhatki', 'mki'),
+    ('mki', 'mki'),
+    ('mkj', 'mkj'),
+    ('mokilese', 'mkj'),
+    ('byep', 'mkk'),
+    ('mkk', 'mkk'),
+    ('mkl', 'mkl'),
+    ('mokole', 'mkl'),
+    ('mkm', 'mkm'),
+    ('moklen', 'mkm'),
+    ('kupang malay', 'mkn'),
+    ('mkn', 'mkn'),
+    ('mingang doso', 'mko'),
+    ('mko', 'mko'),
+    ('mkp', 'mkp'),
+    ('moikodi', 'mkp'),
+    ('bay miwok', 'mkq'),
+    ('mkq', 'mkq'),
+    ('malas', 'mkr'),
+    ('mkr', 'mkr'),
+    ('mks', 'mks'),
+    ('silacayoapan mixtec', 'mks'),
+    ('mkt', 'mkt'),
+    ('vamale', 'mkt'),
+    ('konyanka maninka', 'mku'),
+    ('mku', 'mku'),
+    ('mafea', 'mkv'),
+    ('mkv', 'mkv'),
+    ('kituba (congo)', 'mkw'),
+    ('mkw', 'mkw'),
+    ('kinamiging manobo', 'mkx'),
+    ('mkx', 'mkx'),
+    ('east makian', 'mky'),
+    ('mky', 'mky'),
+    ('makasae', 'mkz'),
+    ('mkz', 'mkz'),
+    ('mal', 'ml'),
+    ('malayalam', 'ml'),
+    ('ml', 'ml'),
+    ('malo', 'mla'),
+    ('mla', 'mla'),
+    ('mbule', 'mlb'),
+    ('mlb', 'mlb'),
+    ('cao lan', 'mlc'),
+    ('mlc', 'mlc'),
+    ('manambu', 'mle'),
+    ('mle', 'mle'),
+    ('mlf', 'mlf'),
+    ('mape', 'mlh'),
+    ('mlh', 'mlh'),
+    ('malimpung', 'mli'),
+    ('mli', 'mli'),
+    ('miltu', 'mlj'),
+    ('mlj', 'mlj'),
+    ('ilwana', 'mlk'),
+    ('mlk', 'mlk'),
+    ('malua bay', 'mll'),
+    ('mll', 'mll'),
+    ('mlm', 'mlm'),
+    ('mulam', 'mlm'),
+    ('malango', 'mln'),
+    ('mln', 'mln'),
+    ('mlo', 'mlo'),
+    ('mlomp', 'mlo'),
+    ('bargam', 'mlp'),
+    ('mlp', 'mlp'),
+    ('mlq', 'mlq'),
+    ('western maninkakan', 'mlq'),
+    ('mlr', 'mlr'),
+    ('vame', 'mlr'),
+    ('masalit', 'mls'),
+    ('mls', 'mls'),
+    ('mlu', 'mlu'),
+    ('to''abaita', 'mlu'),
+    ('mlv', 'mlv'),
+    ('motlav', 'mlv'),
+    ('mlw', 'mlw'),
+    ('moloko', 'mlw'),
+    ('malfaxal', 'mlx'),
+    ('mlx', 'mlx'),
+    ('malaynon', 'mlz'),
+    ('mlz', 'mlz'),
+    ('mama', 'mma'),
+    ('mma', 'mma'),
+    ('mmb', 'mmb'),
+    ('momina', 'mmb'),
+    ('michoacán mazahua', 'mmc'),
+    ('mmc', 'mmc'),
+    ('maonan', 'mmd'),
+    ('mmd', 'mmd'),
+    ('mme', 'mme'),
+    ('mmf', 'mmf'),
+    ('mundat', 'mmf'),
+    ('mmg', 'mmg'),
+    ('north ambrym', 'mmg'),
+    ('mehináku', 'mmh'),
+    ('mmh', 'mmh'),
+    ('mmi', 'mmi'),
+    ('musar', 'mmi'),
+    ('majhwar', 'mmj'),
+    ('mmj', 'mmj'),
+    ('mmk', 'mmk'),
+    ('mukha-dora', 'mmk'),
+    ('man met', 'mml'),
+    ('mml', 'mml'),
+    ('maii', 'mmm'),
+    ('mmm', 'mmm'),
+    ('mamanwa', 'mmn'),
+    ('mmn', 'mmn'),
+    ('mangga buang', 'mmo'),
+    ('mmo', 'mmo'),
+    ('mmp', 'mmp'),
+    ('siawi', 'mmp'),
+    ('mmq', 'mmq'),
+    ('musak', 'mmq'),
+    ('mmr', 'mmr'),
+    ('western xiangxi miao', 'mmr'),
+    ('malalamai', 'mmt'),
+    ('mmt', 'mmt'),
+    ('mmaala', 'mmu'),
+    ('mmu', 'mmu'),
+    ('miriti', 'mmv'),
+    ('mmv', 'mmv'),
+    ('emae', 'mmw'),
+    ('mmw', 'mmw'),
+    ('madak', 'mmx'),
+    ('mmx', 'mmx'),
+    ('migaama', 'mmy'),
+    ('mmy', 'mmy'),
+    ('mabaale', 'mmz'),
+    ('mmz', 'mmz'),
+    ('mn', 'mn'),
+    ('mon', 'mn'),
+    ('mongolian', 'mn'),
+    ('mbula', 'mna'),
+    ('mna', 'mna'),
+    ('mnb', 'mnb'),
+    ('muna', 'mnb'),
+    ('manchu', 'mnc'),
+    ('mnc', 'mnc'),
+    ('mnd', 'mnd'),
+    ('mondé', 'mnd'),
+    ('mne', 'mne'),
+    ('naba', 'mne'),
+    ('mnf', 'mnf'),
+    ('mundani', 'mnf'),
+    ('eastern mnong', 'mng'),
+    ('mng', 'mng'),
+    ('mnh', 'mnh'),
+    ('mono', 'mnh'),
+    ('mono (democratic republic of congo)', 'mnh'),
+    ('manipuri', 'mni'),
+    ('mni', 'mni'),
+    ('mnj', 'mnj'),
+    ('munji', 'mnj'),
+    ('mandinka', 'mnk'),
+    ('mnk', 'mnk'),
+    ('mnl', 'mnl'),
+    ('tiale', 'mnl'),
+    ('mapena', 'mnm'),
+    ('mnm', 'mnm'),
+    ('mnn', 'mnn'),
+    ('southern mnong', 'mnn'),
+    ('min bei chinese', 'mnp'),
+    ('mnp', 'mnp'),
+    ('minriq', 'mnq'),
+    ('mnq', 'mnq'),
+    ('mnr', 'mnr'),
+    ('mono (usa)', 'mnr'),
+    ('mansi', 'mns'),
+    ('mns', 'mns'),
+    ('mnu', 'mnu'),
+    ('mnv', 'mnv'),
+    ('rennell-bellona', 'mnv'),
+    ('mnw', 'mnw'),
+    ('manikion', 'mnx'),
+    ('mnx', 'mnx'),
+    ('manyawa', 'mny'),
+    ('mny', 'mny'),
+    ('mnz', 'mnz'),
+    ('moni', 'mnz'),
+    ('moa', 'moa'),
+    ('mwan', 'moa'),
+    ('moc', 'moc'),
+    ('mocoví', 'moc'),
+    ('mobilian', 'mod'),
+    ('mod', 'mod'),
+    ('innu', 'moe'),
+    ('moe', 'moe'),
+    ('mog', 'mog'),
+    ('mongondow', 'mog'),
+    ('moh', 'moh'),
+    ('mohawk', 'moh'),
+    ('mboi', 'moi'),
+    ('moi', 'moi'),
+    ('moj', 'moj'),
+    ('monzombo', 'moj'),
+    ('mok', 'mok'),
+    ('morori', 'mok'),
+    ('mangue', 'mom'),
+    ('mom', 'mom'),
+    ('monom', 'moo'),
+    ('mop', 'mop'),
+    ('mopán maya', 'mop'),
+    ('moq', 'moq'),
+    ('mor (bomberai peninsula)', 'moq'),
+    ('moro', 'mor'),
+    ('mos', 'mos'),
+    ('mossi', 'mos'),
+    ('barí', 'mot'),
+    ('mot', 'mot'),
+    ('mogum', 'mou'),
+    ('mou', 'mou'),
+    ('mohave', 'mov'),
+    ('mov', 'mov'),
+    ('moi (congo)', 'mow'),
+    ('mow', 'mow'),
+    ('molima', 'mox'),
+    ('mox', 'mox'),
+    ('moy', 'moy'),
+    ('shekkacho', 'moy'),
+    ('moz', 'moz'),
+    ('mukulu', 'moz'),
+    ('mpa', 'mpa'),
+    ('mpoto', 'mpa'),
+    ('malak malak', 'mpb'),
+    ('mpb', 'mpb'),
+    ('mangarrayi', 'mpc'),
+    ('mpc', 'mpc'),
+    ('machinere', 'mpd'),
+    ('mpd', 'mpd'),
+    ('majang', 'mpe'),
+    ('mpe', 'mpe'),
+    ('marba', 'mpg'),
+    ('mpg', 'mpg'),
+    ('maung', 'mph'),
+    ('mph', 'mph'),
+    ('mpade', 'mpi'),
+    ('mpi', 'mpi'),
+    ('martu wangka', 'mpj'),
+    ('mpj', 'mpj'),
+    ('mbara', 'mpk'),
+    ('mbara (chad)', 'mpk'),
+    ('mpk', 'mpk'),
+    ('middle watut', 'mpl'),
+    ('mpl', 'mpl'),
+    ('mpm', 'mpm'),
+    ('yosondúa mixtec', 'mpm'),
+    ('mindiri', 'mpn'),
+    ('mpn', 'mpn'),
+    ('mpo', 'mpo'),
+    ('migabac', 'mpp'),
+    ('mpp', 'mpp'),
+    ('matís', 'mpq'),
+    ('mpq', 'mpq'),
+    ('mpr', 'mpr'),
+    ('vangunu', 'mpr'),
+    ('dadibi', 'mps'),
+    ('mps', 'mps'),
+    ('mian', 'mpt'),
+    ('mpt', 'mpt'),
+    ('makuráp', 'mpu'),
+    ('mpu', 'mpu'),
+    ('mpv', 'mpv'),
+    ('mungkip', 'mpv'),
+    ('mapidian', 'mpw'),
+    ('mpw', 'mpw'),
+    ('misima-panaeati', 'mpx'),
+    ('mpx', 'mpx'),
+    ('mapia', 'mpy'),
+    ('mpy', 'mpy'),
+    ('mpz', 'mpz'),
+    ('maba (indonesia)', 'mqa'),
+    ('mqa', 'mqa'),
+    ('mbuko', 'mqb'),
+    ('mqb', 'mqb'),
+    ('mangole', 'mqc'),
+    ('mqc', 'mqc'),
+    ('matepi', 'mqe'),
+    ('mqe', 'mqe'),
+    ('momuna', 'mqf'),
+    ('mqf', 'mqf'),
+    ('kota bangun kutai malay', 'mqg'),
+    ('mqg', 'mqg'),
+    ('mqh', 'mqh'),
+    ('tlazoyaltepec mixtec', 'mqh'),
+    ('mariri', 'mqi'),
+    ('mqi', 'mqi'),
+    ('mamasa', 'mqj'),
+    ('mqj', 'mqj'),
+    ('mqk', 'mqk'),
+    ('rajah kabunsuwan manobo', 'mqk'),
+    ('mbelime', 'mql'),
+    ('mql', 'mql'),
+    ('mqm', 'mqm'),
+    ('south marquesan', 'mqm'),
+    ('moronene', 'mqn'),
+    ('mqn', 'mqn'),
+    ('modole', 'mqo'),
+    ('mqo', 'mqo'),
+    ('manipa', 'mqp'),
+    ('mqp', 'mqp'),
+    ('minokok', 'mqq'),
+    ('mqq', 'mqq'),
+    ('mander', 'mqr'),
+    ('mqr', 'mqr'),
+    ('mqs', 'mqs'),
+    ('west makian', 'mqs'),
+    ('mqt', 'mqt'),
+    ('mandari', 'mqu'),
+    ('mqu', 'mqu'),
+    ('mosimo', 'mqv'),
+    ('mqv', 'mqv'),
+    ('mqw', 'mqw'),
+    ('murupi', 'mqw'),
+    ('mamuju', 'mqx'),
+    ('mqx', 'mqx'),
+    ('manggarai', 'mqy'),
+    ('mqy', 'mqy'),
+    ('mqz', 'mqz'),
+    ('pano', 'mqz'),
+    ('mar', 'mr'),
+    ('marathi', 'mr'),
+    ('mr', 'mr'),
+    ('mlabri', 'mra'),
+    ('mra', 'mra'),
+    ('marino', 'mrb'),
+    ('mrb', 'mrb'),
+    ('maricopa', 'mrc'),
+    ('mrc', 'mrc'),
+    ('mrd', 'mrd'),
+    ('western magar', 'mrd'),
+    ('martha''s vineyard sign language', 'mre'),
+    ('mre', 'mre'),
+    ('elseng', 'mrf'),
+    ('mrf', 'mrf'),
+    ('mising', 'mrg'),
+    ('mrg', 'mrg'),
+    ('mara chin', 'mrh'),
+    ('mrh', 'mrh'),
+    ('mrj', 'mrj'),
+    ('western mari', 'mrj'),
+    ('hmwaveke', 'mrk'),
+    ('mrk', 'mrk'),
+    ('mortlockese', 'mrl'),
+    ('mrl', 'mrl'),
+    ('merlav', 'mrm'),
+    ('mrm', 'mrm'),
+    ('cheke holo', 'mrn'),
+    ('mrn', 'mrn'),
+    ('mro', 'mro'),
+    ('mru', 'mro'),
+    ('morouas', 'mrp'),
+    ('mrp', 'mrp'),
+    ('mrq', 'mrq'),
+    ('north marquesan', 'mrq'),
+    ('maria (india)', 'mrr'),
+    ('mrr', 'mrr'),
+    ('maragus', 'mrs'),
+    ('mrs', 'mrs'),
+    ('marghi central', 'mrt'),
+    ('mrt', 'mrt'),
+    ('mono (cameroon)', 'mru'),
+    ('mangareva', 'mrv'),
+    ('mrv', 'mrv'),
+    ('maranao', 'mrw'),
+    ('mrw', 'mrw'),
+    ('maremgi', 'mrx'),
+    ('mrx', 'mrx'),
+    ('mandaya', 'mry'),
+    ('mry', 'mry'),
+    ('marind', 'mrz'),
+    ('mrz', 'mrz'),
+    ('malay', 'ms'),
+    ('malay (macrolanguage)', 'ms'),
+    ('may', 'ms'),
+    ('ms', 'ms'),
+    ('msa', 'ms'),
+    ('masbatenyo', 'msb'),
+    ('msb', 'msb'),
+    ('msc', 'msc'),
+    ('sankaran maninka', 'msc'),
+    ('msd', 'msd'),
+    ('yucatec maya sign language', 'msd'),
+    ('mse', 'mse'),
+    ('musey', 'mse'),
+    ('mekwei', 'msf'),
+    ('msf', 'msf'),
+    ('moraid', 'msg'),
+    ('msg', 'msg'),
+    ('masikoro malagasy', 'msh'),
+    ('msh', 'msh'),
+    ('msi', 'msi'),
+    ('sabah malay', 'msi'),
+    ('ma (democratic republic of congo)', 'msj'),
+    ('msj', 'msj'),
+    ('mansaka', 'msk'),
+    ('msk', 'msk'),
+    ('molof', 'msl'),
+    ('msl', 'msl'),
+    ('agusan manobo', 'msm'),
+    ('msm', 'msm'),
+    ('msn', 'msn'),
+    ('vurës', 'msn'),
+    ('mombum', 'mso'),
+    ('mso', 'mso'),
+    ('maritsauá', 'msp'),
+    ('msp', 'msp'),
+    ('caac', 'msq'),
+    ('msq', 'msq'),
+    ('mongolian sign language', 'msr'),
+    ('msr', 'msr'),
+    ('mss', 'mss'),
+    ('west masela', 'mss'),
+    ('msu', 'msu'),
+    ('musom', 'msu'),
+    ('maslam', 'msv'),
+    ('msv', 'msv'),
+    ('mansoanka', 'msw'),
+    ('msw', 'msw'),
+    ('moresada', 'msx'),
+    ('msx', 'msx'),
+    ('aruamu', 'msy'),
+    ('msy', 'msy'),
+    ('momare', 'msz'),
+    ('msz', 'msz'),
+    ('maltese', 'mt'),
+    ('mlt', 'mt'),
+    ('mt', 'mt'),
+    ('cotabato manobo', 'mta'),
+    ('mta', 'mta'),
+    ('anyin morofo', 'mtb'),
+    ('mtb', 'mtb'),
+    ('mtc', 'mtc'),
+    ('munit', 'mtc'),
+    ('mtd', 'mtd'),
+    ('mualang', 'mtd'),
+    ('mono (solomon islands)', 'mte'),
+    ('mte', 'mte'),
+    ('mtf', 'mtf'),
+    ('murik', 'mtf'),
+    ('murik (papua new guinea)', 'mtf'),
+    ('mtg', 'mtg'),
+    ('una', 'mtg'),
+    ('mth', 'mth'),
+    ('munggui', 'mth'),
+    ('maiwa', 'mti'),
+    ('maiwa (papua new guinea)', 'mti'),
+    ('mti', 'mti'),
+    ('moskona', 'mtj'),
+    ('mtj', 'mtj'),
+    ('mbe''', 'mtk'),
+    ('mtk', 'mtk'),
+    ('montol', 'mtl'),
+    ('mtl', 'mtl'),
+    ('mator', 'mtm'),
+    ('mtm', 'mtm'),
+    ('matagalpa', 'mtn'),
+    ('mtn', 'mtn'),
+    ('mto', 'mto'),
+    ('totontepec mixe', 'mto'),
+    ('mtp', 'mtp'),
+    ('wichí lhamtés nocten', 'mtp'),
+    ('mtq', 'mtq'),
+    ('muong', 'mtq'),
+    ('mewari', 'mtr'),
+    ('mtr', 'mtr'),
+    ('mts', 'mts'),
+    ('yora', 'mts'),
+    ('mota', 'mtt'),
+    ('mtt', 'mtt'),
+    ('mtu', 'mtu'),
+    ('tututepec mixtec', 'mtu'),
+    ('asaro''o', 'mtv'),
+    ('mtv', 'mtv'),
+    ('mtw', 'mtw'),
+    ('southern binukidnon', 'mtw'),
+    ('mtx', 'mtx'),
+    ('tidaá mixtec', 'mtx'),
+    ('mty', 'mty'),
+    ('nabi', 'mty'),
+    ('mua', 'mua'),
+    ('mundang', 'mua'),
+    ('mub', 'mub'),
+    ('mubi', 'mub'),
+    ('ajumbu', 'muc'),
+    ('muc', 'muc'),
+    ('mednyj aleut', 'mud'),
+    ('mud', 'mud'),
+    ('media lengua', 'mue'),
+    ('mue', 'mue'),
+    ('mug', 'mug'),
+    ('musgu', 'mug'),
+    ('muh', 'muh'),
+    ('mündü', 'muh'),
+    ('mui', 'mui'),
+    ('musi', 'mui'),
+    ('mabire', 'muj'),
+    ('muj', 'muj'),
+    ('mugom', 'muk'),
+    ('muk', 'muk'),
+    ('maiwala', 'mum'),
+    ('muo', 'muo'),
+    ('nyong', 'muo'),
+    ('malvi', 'mup'),
+    ('mup', 'mup'),
+    ('eastern xiangxi miao', 'muq'),
+    ('muq', 'muq'),
+    ('mur', 'mur'),
+    ('murle', 'mur'),
+    ('creek', 'mus'),
+    ('mus', 'mus'),
+    ('mut', 'mut'),
+    ('western muria', 'mut'),
+    ('muu', 'muu'),
+    ('yaaku', 'muu'),
+    ('muthuvan', 'muv'),
+    ('muv', 'muv'),
+    ('bo-ung', 'mux'),
+    ('mux', 'mux'),
+    ('muy', 'muy'),
+    ('muyang', 'muy'),
+    ('mursi', 'muz'),
+    ('muz', 'muz'),
+    ('manam', 'mva'),
+    ('mva', 'mva'),
+    ('mattole', 'mvb'),
+    ('mvb', 'mvb'),
+    ('mamboru', 'mvd'),
+    ('mvd', 'mvd'),
+    ('marwari', 'mve'),
+    ('marwari (pakistan)', 'mve'),
+    ('mve', 'mve'),
+    ('mvf', 'mvf'),
+    ('peripheral mongolian', 'mvf'),
+    ('mvg', 'mvg'),
+    ('yucuañe mixtec', 'mvg'),
+    ('mulgi', 'mvh'),
+    ('mvh', 'mvh'),
+    ('miyako', 'mvi'),
+    ('mvi', 'mvi'),
+    ('mekmek', 'mvk'),
+    ('mvk', 'mvk'),
+    ('mbara (australia)', 'mvl'),
+    ('mvl', 'mvl'),
+    ('minaveha', 'mvn'),
+    ('mvn', 'mvn'),
+    ('marovo', 'mvo'),
+    ('mvo', 'mvo'),
+    ('duri', 'mvp'),
+    ('mvp', 'mvp'),
+    ('moere', 'mvq'),
+    ('mvq', 'mvq'),
+    ('marau', 'mvr'),
+    ('mvr', 'mvr'),
+    ('massep', 'mvs'),
+    ('mvs', 'mvs'),
+    ('mpotovoro', 'mvt'),
+    ('mvt', 'mvt'),
+    ('marfa', 'mvu'),
+    ('mvu', 'mvu'),
+    ('mvv', 'mvv'),
+    ('tagal murut', 'mvv'),
+    ('machinga', 'mvw'),
+    ('mvw', 'mvw'),
+    ('meoswar', 'mvx'),
+    ('mvx', 'mvx'),
+    ('indus kohistani', 'mvy'),
+    ('mvy', 'mvy'),
+    ('mesqan', 'mvz'),
+    ('mvz', 'mvz'),
+    ('mwa', 'mwa'),
+    ('mwatebu', 'mwa'),
+    ('juwal', 'mwb'),
+    ('mwb', 'mwb'),
+    ('mwc', 'mwc'),
+    ('mwe', 'mwe'),
+    ('mwera (chimwera)', 'mwe'),
+    ('murrinh-patha', 'mwf'),
+    ('mwf', 'mwf'),
+    ('aiklep', 'mwg'),
+    ('mwg', 'mwg'),
+    ('mouk-aria', 'mwh'),
+    ('mwh', 'mwh'),
+    ('labo', 'mwi'),
+    ('mwi', 'mwi'),
+    ('kita maninkakan', 'mwk'),
+    ('mwk', 'mwk'),
+    ('mirandese', 'mwl'),
+    ('mwl', 'mwl'),
+    ('mwm', 'mwm'),
+    ('sar', 'mwm'),
+    ('mwn', 'mwn'),
+    ('nyamwanga', 'mwn'),
+    ('central maewo', 'mwo'),
+    ('mwo', 'mwo'),
+    ('kala lagaw ya', 'mwp'),
+    ('mwp', 'mwp'),
+    ('mwq', 'mwq'),
+    ('mün chin', 'mwq'),
+    ('mwr', 'mwr'),
+    ('mwimbi-muthambi', 'mws'),
+    ('mws', 'mws'),
+    ('moken', 'mwt'),
+    ('mwt', 'mwt'),
+    ('mittu', 'mwu'),
+    ('mwu', 'mwu'),
+    ('mentawai', 'mwv'),
+    ('mwv', 'mwv'),
+    ('hmong daw', 'mww'),
+    ('mww', 'mww'),
+    ('moingi', 'mwz'),
+    ('mwz', 'mwz'),
+    ('mxa', 'mxa'),
+    ('northwest oaxaca mixtec', 'mxa'),
+    ('mxb', 'mxb'),
+    ('tezoatlán mixtec', 'mxb'),
+    ('manyika', 'mxc'),
+    ('mxc', 'mxc'),
+    ('modang', 'mxd'),
+    ('mxd', 'mxd'),
+    ('mele-fila', 'mxe'),
+    ('mxe', 'mxe'),
+    ('malgbe', 'mxf'),
+    ('mxf', 'mxf'),
+    ('mbangala', 'mxg'),
+    ('mxg', 'mxg'),
+    ('mvuba', 'mxh'),
+    ('mxh', 'mxh'),
+    ('mozarabic', 'mxi'),
+    ('mxi', 'mxi'),
+    ('miju-mishmi', 'mxj'),
+    ('mxj', 'mxj'),
+    ('monumbo', 'mxk'),
+    ('mxk', 'mxk'),
+    ('maxi gbe', 'mxl'),
+    ('mxl', 'mxl'),
+    ('meramera', 'mxm'),
+    ('mxm', 'mxm'),
+    ('moi (indonesia)', 'mxn'),
+    ('mxn', 'mxn'),
+    ('mbowe', 'mxo'),
+    ('mxo', 'mxo'),
+    ('mxp', 'mxp'),
+    ('tlahuitoltepec mixe', 'mxp'),
+    ('juquila mixe', 'mxq'),
+    ('mxq', 'mxq'),
+    ('murik (malaysia)', 'mxr'),
+    ('mxr', 'mxr'),
+    ('huitepec mixtec', 'mxs'),
+    ('mxs', 'mxs'),
+    ('jamiltepec mixtec', 'mxt'),
+    ('mxt', 'mxt'),
+    ('mada (cameroon)', 'mxu'),
+    ('mxu', 'mxu'),
+    ('metlatónoc mixtec', 'mxv'),
+    ('mxv', 'mxv'),
+    ('mxw', 'mxw'),
+    ('namo', 'mxw'),
+    ('mahou', 'mxx'),
+    ('mxx', 'mxx'),
+    ('mxy', 'mxy'),
+    ('southeastern nochixtlán mixtec', 'mxy'),
+    ('central masela', 'mxz'),
+    ('mxz', 'mxz'),
+    ('bur', 'my'),
+    ('burmese', 'my'),
+    ('my', 'my'),
+    ('mya', 'my'),
+    ('mbay', 'myb'),
+    ('myb', 'myb'),
+    ('mayeka', 'myc'),
+    ('myc', 'myc'),
+    ('mye', 'mye'),
+    ('myene', 'mye'),
+    ('bambassi', 'myf'),
+    ('myf', 'myf'),
+    ('manta', 'myg'),
+    ('myg', 'myg'),
+    ('makah', 'myh'),
+    ('myh', 'myh'),
+    ('mangayat', 'myj'),
+    ('myj', 'myj'),
+    ('mamara senoufo', 'myk'),
+    ('myk', 'myk'),
+    ('moma', 'myl'),
+    ('myl', 'myl'),
+    ('me''en', 'mym'),
+    ('mym', 'mym'),
+    ('anfillo', 'myo'),
+    ('myo', 'myo'),
+    ('myp', 'myp'),
+    ('pirahã', 'myp'),
+    ('muniche', 'myr'),
+    ('myr', 'myr'),
+    ('mesmes', 'mys'),
+    ('mys', 'mys'),
+    ('mundurukú', 'myu'),
+    ('myu', 'myu'),
+    ('erzya', 'myv'),
+    ('myv', 'myv'),
+    ('muyuw', 'myw'),
+    ('myw', 'myw'),
+    ('masaaba', 'myx'),
+    ('myx', 'myx'),
+    ('macuna', 'myy'),
+    ('myy', 'myy'),
+    ('classical mandaic', 'myz'),
+    ('myz', 'myz'),
+    ('mza', 'mza'),
+    ('santa maría zacatepec mixtec', 'mza'),
+    ('mzb', 'mzb'),
+    ('tumzabt', 'mzb'),
+    ('madagascar sign language', 'mzc'),
+    ('mzc', 'mzc'),
+    ('malimba', 'mzd'),
+    ('mzd', 'mzd'),
+    ('morawa', 'mze'),
+    ('mze', 'mze'),
+    ('monastic sign language', 'mzg'),
+    ('mzg', 'mzg'),
+    ('mzh', 'mzh'),
+    ('wichí lhamtés güisnay', 'mzh'),
+    ('ixcatlán mazatec', 'mzi'),
+    ('mzi', 'mzi'),
+    ('manya', 'mzj'),
+    ('mzj', 'mzj'),
+    ('mzk', 'mzk'),
+    ('nigeria mambila', 'mzk'),
+    ('mazatlán mixe', 'mzl'),
+    ('mzl', 'mzl'),
+    ('mumuye', 'mzm'),
+    ('mzm', 'mzm'),
+    ('mazanderani', 'mzn'),
+    ('mzn', 'mzn'),
+    ('matipuhy', 'mzo'),
+    ('mzo', 'mzo'),
+    ('movima', 'mzp'),
+    ('mzp', 'mzp'),
+    ('mori atas', 'mzq'),
+    ('mzq', 'mzq'),
+    ('marúbo', 'mzr'),
+    ('mzr', 'mzr'),
+    ('macanese', 'mzs'),
+    ('mzs', 'mzs'),
+    ('mintil', 'mzt'),
+    ('mzt', 'mzt'),
+    ('inapang', 'mzu'),
+    ('mzu', 'mzu'),
+    ('manza', 'mzv'),
+    ('mzv', 'mzv'),
+    ('mzw', 'mzw'),
+    ('mawayana', 'mzx'),
+    ('mzx', 'mzx'),
+    ('mozambican sign language', 'mzy'),
+    ('mzy', 'mzy'),
+    ('maiadomu', 'mzz'),
+    ('mzz', 'mzz'),
+    ('na', 'na'),
+    ('nau', 'na'),
+    ('nauru', 'na'),
+    ('naa', 'naa'),
+    ('namla', 'naa'),
+    ('nab', 'nab'),
+    ('southern nambikuára', 'nab'),
+    ('nac', 'nac'),
+    ('narak', 'nac'),
+    ('nae', 'nae'),
+    ('naka''ela', 'nae'),
+    ('nabak', 'naf'),
+    ('naf', 'naf'),
+    ('nag', 'nag'),
+    ('naga pidgin', 'nag'),
+    ('naj', 'naj'),
+    ('nalu', 'naj'),
+    ('nak', 'nak'),
+    ('nakanai', 'nak'),
+    ('nal', 'nal'),
+    ('nalik', 'nal'),
+    ('nam', 'nam'),
+    ('ngan''gityemerri', 'nam'),
+    ('min nan chinese', 'nan'),
+    ('nan', 'nan'),
+    ('naaba', 'nao'),
+    ('nao', 'nao'),
+    ('nap', 'nap'),
+    ('neapolitan', 'nap'),
+    ('khoekhoe', 'naq'),
+    ('naq', 'naq'),
+    ('iguta', 'nar'),
+    ('nar', 'nar'),
+    ('naasioi', 'nas'),
+    ('nas', 'nas'),
+    ('ca̱hungwa̱rya̱', 'nat'),
+    ('nat', 'nat'),
+    ('naw', 'naw'),
+    ('nawuri', 'naw'),
+    ('nakwi', 'nax'),
+    ('nax', 'nax'),
+    ('nay', 'nay'),
+    ('ngarrindjeri', 'nay'),
+    ('coatepec nahuatl', 'naz'),
+    ('naz', 'naz'),
+    ('bokmål', 'nb'),
+    ('bokmål, norwegian', 'nb'),
+    ('nb', 'nb'),
+    ('nob', 'nb'),
+    ('norwegian bokmål', 'nb'),
+    ('nba', 'nba'),
+    ('nyemba', 'nba'),
+    ('nbb', 'nbb'),
+    ('ndoe', 'nbb'),
+    ('chang naga', 'nbc'),
+    ('nbc', 'nbc'),
+    ('nbd', 'nbd'),
+    ('ngbinda', 'nbd'),
+    ('konyak naga', 'nbe'),
+    ('nbe', 'nbe'),
+    ('nagarchal', 'nbg'),
+    ('nbg', 'nbg'),
+    ('nbh', 'nbh'),
+    ('ngamo', 'nbh'),
+    ('mao naga', 'nbi'),
+    ('nbi', 'nbi'),
+    ('nbj', 'nbj'),
+    ('ngarinyman', 'nbj'),
+    ('nake', 'nbk'),
+    ('nbk', 'nbk'),
+    ('nbm', 'nbm'),
+    ('ngbaka ma''bo', 'nbm'),
+    ('kuri', 'nbn'),
+    ('nbn', 'nbn'),
+    ('nbo', 'nbo'),
+    ('nkukoli', 'nbo'),
+    ('nbp', 'nbp'),
+    ('nnam', 'nbp'),
+    ('nbq', 'nbq'),
+    ('nggem', 'nbq'),
+    ('nbr', 'nbr'),
+    ('numana', 'nbr'),
+    ('namibian sign language', 'nbs'),
+    ('nbs', 'nbs'),
+    ('nbt', 'nbt'),
+    ('nbu', 'nbu'),
+    ('rongmei naga', 'nbu'),
+    ('nbv', 'nbv'),
+    ('ngamambo', 'nbv'),
+    ('nbw', 'nbw'),
+    ('southern ngbandi', 'nbw'),
+    ('nby', 'nby'),
+    ('ningera', 'nby'),
+    ('nca', 'nca'),
+    ('central nicobarese', 'ncb'),
+    ('ncb', 'ncb'),
+    ('ncc', 'ncc'),
+    ('ponam', 'ncc'),
+    ('nachering', 'ncd'),
+    ('ncd', 'ncd'),
+    ('nce', 'nce'),
+    ('yale', 'nce'),
+    ('ncf', 'ncf'),
+    ('notsi', 'ncf'),
+    ('ncg', 'ncg'),
+    ('nisga''a', 'ncg'),
+    ('central huasteca nahuatl', 'nch'),
+    ('nch', 'nch'),
+    ('classical nahuatl', 'nci'),
+    ('nci', 'nci'),
+    ('ncj', 'ncj'),
+    ('northern puebla nahuatl', 'ncj'),
+    ('na-kara', 'nck'),
+    ('nck', 'nck'),
+    ('michoacán nahuatl', 'ncl'),
+    ('ncl', 'ncl'),
+    ('nambo', 'ncm'),
+    ('ncm', 'ncm'),
+    ('nauna', 'ncn'),
+    ('ncn', 'ncn'),
+    ('nco', 'nco'),
+    ('sibe', 'nco'),
+    ('ncq', 'ncq'),
+    ('northern katang', 'ncq'),
+    ('ncane', 'ncr'),
+    ('ncr', 'ncr'),
+    ('ncs', 'ncs'),
+    ('nicaraguan sign language', 'ncs'),
+    ('chothe naga', 'nct'),
+    ('nct', 'nct'),
+    ('chumburung', 'ncu'),
+    ('ncu', 'ncu'),
+    ('central puebla nahuatl', 'ncx'),
+    ('ncx', 'ncx'),
+    ('natchez', 'ncz'),
+    ('ncz', 'ncz'),
+    ('nd', 'nd'),
+    ('nde', 'nd'),
+    ('ndebele, north', 'nd'),
+    ('north ndebele', 'nd'),
+    ('nda', 'nda'),
+    ('ndasa', 'nda'),
+    ('kenswei nsei', 'ndb'),
+    ('ndb', 'ndb'),
+    ('ndau', 'ndc'),
+    ('ndc', 'ndc'),
+    ('ndd', 'ndd'),
+    ('nde-nsele-nta', 'ndd'),
+    ('nadruvian', 'ndf'),
+    ('ndf', 'ndf'),
+    ('ndengereko', 'ndg'),
+    ('ndg', 'ndg'),
+    ('ndali', 'ndh'),
+    ('ndh', 'ndh'),
+    ('ndi', 'ndi'),
+    ('samba leko', 'ndi'),
+    ('ndamba', 'ndj'),
+    ('ndj', 'ndj'),
+    ('ndaka', 'ndk'),
+    ('ndk', 'ndk'),
+    ('ndl', 'ndl'),
+    ('ndolo', 'ndl'),
+    ('ndam', 'ndm'),
+    ('ndm', 'ndm'),
+    ('ndn', 'ndn'),
+    ('ngundi', 'ndn'),
+    ('ndp', 'ndp'),
+    ('ndombe', 'ndq'),
+    ('ndq', 'ndq'),
+    ('ndoola', 'ndr'),
+    ('ndr', 'ndr'),
+    ('german, low', 'nds'),
+    ('low german', 'nds'),
+    ('low saxon', 'nds'),
+    ('nds', 'nds'),
+    ('saxon', 'nds'),
+    ('saxon, low', 'nds'),
+    ('ndt', 'ndt'),
+    ('ndunga', 'ndt'),
+    ('dugun', 'ndu'),
+    ('ndu', 'ndu'),
+    ('ndut', 'ndv'),
+    ('ndv', 'ndv'),
+    ('ndobo', 'ndw'),
+    ('ndw', 'ndw'),
+    ('nduga', 'ndx'),
+    ('ndx', 'ndx'),
+    ('lutos', 'ndy'),
+    ('ndy', 'ndy'),
+    ('ndogo', 'ndz'),
+    ('ndz', 'ndz'),
+    ('ne', 'ne'),
+    ('nep', 'ne'),
+    ('nepali', 'ne'),
+    ('nepali (macrolanguage)', 'ne'),
+    ('eastern ngad''a', 'nea'),
+    ('nea', 'nea'),
+    ('neb', 'neb'),
+    ('toura (côte d''ivoire)', 'neb'),
+    ('nec', 'nec'),
+    ('nedebang', 'nec'),
+    ('nde-gbite', 'ned'),
+    ('ned', 'ned'),
+    ('nee', 'nee'),
+    ('nêlêmwa-nixumwak', 'nee'),
+    ('nef', 'nef'),
+    ('nefamese', 'nef'),
+    ('neg', 'neg'),
+    ('negidal', 'neg'),
+    ('neh', 'neh'),
+    ('nyenkha', 'neh'),
+    ('nei', 'nei'),
+    ('neo-hittite', 'nei'),
+    ('nej', 'nej'),
+    ('neko', 'nej'),
+    ('nek', 'nek'),
+    ('neku', 'nek'),
+    ('nem', 'nem'),
+    ('nemi', 'nem'),
+    ('nen', 'nen'),
+    ('nengone', 'nen'),
+    ('neo', 'neo'),
+    ('ná-meo', 'neo'),
+    ('neq', 'neq'),
+    ('north central mixe', 'neq'),
+    ('ner', 'ner'),
+    ('yahadian', 'ner'),
+    ('bhoti kinnauri', 'nes'),
+    ('nes', 'nes'),
+    ('net', 'net'),
+    ('nete', 'net'),
+    ('neu', 'neu'),
+    ('nev', 'nev'),
+    ('nyaheun', 'nev'),
+    ('nepal bhasa', 'new'),
+    ('new', 'new'),
+    ('newari', 'new'),
+    ('neme', 'nex'),
+    ('nex', 'nex'),
+    ('ney', 'ney'),
+    ('neyo', 'ney'),
+    ('nez', 'nez'),
+    ('nez perce', 'nez'),
+    ('dhao', 'nfa'),
+    ('nfa', 'nfa'),
+    ('ahwai', 'nfd'),
+    ('nfd', 'nfd'),
+    ('ayiwo', 'nfl'),
+    ('nfl', 'nfl'),
+    ('nafaanra', 'nfr'),
+    ('nfr', 'nfr'),
+    ('mfumte', 'nfu'),
+    ('nfu', 'nfu'),
+    ('ndo', 'ng'),
+    ('ndonga', 'ng'),
+    ('ng', 'ng'),
+    ('nga', 'nga'),
+    ('ngbaka', 'nga'),
+    ('ngb', 'ngb'),
+    ('northern ngbandi', 'ngb'),
+    ('ngc', 'ngc'),
+    ('ngombe', 'ngc'),
+    ('ngombe (democratic republic of congo)', 'ngc'),
+    ('ngando', 'ngd'),
+    ('ngando (central african republic)', 'ngd'),
+    ('ngd', 'ngd'),
+    ('nge', 'nge'),
+    ('ngemba', 'nge'),
+    ('ngbaka manza', 'ngg'),
+    ('ngg', 'ngg'),
+    ('ngh', 'ngh'),
+    ('nǁng', 'ngh'),
+    ('ngi', 'ngi'),
+    ('ngizim', 'ngi'),
+    ('ngie', 'ngj'),
+    ('ngj', 'ngj'),
+    ('dalabon', 'ngk'),
+    ('ngk', 'ngk'),
+    ('lomwe', 'ngl'),
+    ('ngl', 'ngl'),
+    ('ngatik men''s creole', 'ngm'),
+    ('ngm', 'ngm'),
+    ('ngn', 'ngn'),
+    ('ngwo', 'ngn'),
+    ('ngp', 'ngp'),
+    ('ngulu', 'ngp'),
+    ('ngq', 'ngq'),
+    ('ngurimi', 'ngq'),
+    ('engdewu', 'ngr'),
+    ('ngr', 'ngr'),
+    ('gvoko', 'ngs'),
+    ('ngs', 'ngs'),
+    ('kriang', 'ngt'),
+    ('ngt', 'ngt'),
+    ('guerrero nahuatl', 'ngu'),
+    ('ngu', 'ngu'),
+    ('nagumi', 'ngv'),
+    ('ngv', 'ngv'),
+    ('ngw', 'ngw'),
+    ('ngwaba', 'ngw'),
+    ('nggwahyi', 'ngx'),
+    ('ngx', 'ngx'),
+    ('ngy', 'ngy'),
+    ('tibea', 'ngy'),
+    ('ngungwel', 'ngz'),
+    ('ngz', 'ngz'),
+    ('nha', 'nha'),
+    ('nhanda', 'nha'),
+    ('beng', 'nhb'),
+    ('nhb', 'nhb'),
+    ('nhc', 'nhc'),
+    ('tabasco nahuatl', 'nhc'),
+    ('chiripá', 'nhd'),
+    ('nhd', 'nhd'),
+    ('eastern huasteca nahuatl', 'nhe'),
+    ('nhe', 'nhe'),
+    ('nhf', 'nhf'),
+    ('nhuwala', 'nhf'),
+    ('nhg', 'nhg'),
+    ('tetelcingo nahuatl', 'nhg'),
+    ('nahari', 'nhh'),
+    ('nhh', 'nhh'),
+    ('nhi', 'nhi'),
+    ('zacatlán-ahuacatlán-tepetzintla nahuatl', 'nhi'),
+    ('isthmus-cosoleacaque nahuatl', 'nhk'),
+    ('nhk', 'nhk'),
+    ('morelos nahuatl', 'nhm'),
+    ('nhm', 'nhm'),
+    ('central nahuatl', 'nhn'),
+    ('nhn', 'nhn'),
+    ('nho', 'nho'),
+    ('takuu', 'nho'),
+    ('isthmus-pajapan nahuatl', 'nhp'),
+    ('nhp', 'nhp'),
+    ('huaxcaleca nahuatl', 'nhq'),
+    ('nhq', 'nhq'),
+    ('naro', 'nhr'),
+    ('nhr', 'nhr'),
+    ('nht', 'nht'),
+    ('ometepec nahuatl', 'nht'),
+    ('nhu', 'nhu'),
+    ('noone', 'nhu'),
+    ('nhv', 'nhv'),
+    ('temascaltepec nahuatl', 'nhv'),
+    ('nhw', 'nhw'),
+    ('western huasteca nahuatl', 'nhw'),
+    ('isthmus-mecayapan nahuatl', 'nhx'),
+    ('nhx', 'nhx'),
+    ('nhy', 'nhy'),
+    ('northern oaxaca nahuatl', 'nhy'),
+    ('nhz', 'nhz'),
+    ('santa maría la alta nahuatl', 'nhz'),
+    ('nia', 'nia'),
+    ('nias', 'nia'),
+    ('nakame', 'nib'),
+    ('nib', 'nib'),
+    ('ngandi', 'nid'),
+    ('nid', 'nid'),
+    ('nie', 'nie'),
+    ('niellim', 'nie'),
+    ('nif', 'nif'),
+    ('ngalakgan', 'nig'),
+    ('nig', 'nig'),
+    ('nih', 'nih'),
+    ('nyiha', 'nih'),
+    ('nyiha (tanzania)', 'nih'),
+    ('nii', 'nii'),
+    ('ngaju', 'nij'),
+    ('nij', 'nij'),
+    ('nik', 'nik'),
+    ('southern nicobarese', 'nik'),
+    ('nil', 'nil'),
+    ('nila', 'nil'),
+    ('nilamba', 'nim'),
+    ('nim', 'nim'),
+    ('nin', 'nin'),
+    ('ninzo', 'nin'),
+    ('nganasan', 'nio'),
+    ('nio', 'nio'),
+    ('nandi', 'niq'),
+    ('niq', 'niq'),
+    ('nimboran', 'nir'),
+    ('nir', 'nir'),
+    ('nimi', 'nis'),
+    ('nis', 'nis'),
+    ('nit', 'nit'),
+    ('southeastern kolami', 'nit'),
+    ('niu', 'niu'),
+    ('niuean', 'niu'),
+    ('gilyak', 'niv'),
+    ('niv', 'niv'),
+    ('nimo', 'niw'),
+    ('niw', 'niw'),
+    ('hema', 'nix'),
+    ('nix', 'nix'),
+    ('ngiti', 'niy'),
+    ('niy', 'niy'),
+    ('ningil', 'niz'),
+    ('niz', 'niz'),
+    ('nja', 'nja'),
+    ('nzanyi', 'nja'),
+    ('njb', 'njb'),
+    ('nocte naga', 'njb'),
+    ('ndonde hamba', 'njd'),
+    ('njd', 'njd'),
+    ('lotha naga', 'njh'),
+    ('njh', 'njh'),
+    ('gudanji', 'nji'),
+    ('nji', 'nji'),
+    ('njen', 'njj'),
+    ('njj', 'njj'),
+    ('njalgulgule', 'njl'),
+    ('njl', 'njl'),
+    ('angami naga', 'njm'),
+    ('njm', 'njm'),
+    ('liangmai naga', 'njn'),
+    ('njn', 'njn'),
+    ('ao naga', 'njo'),
+    ('njo', 'njo'),
+    ('njerep', 'njr'),
+    ('njr', 'njr'),
+    ('nisa', 'njs'),
+    ('njs', 'njs'),
+    ('ndyuka-trio pidgin', 'njt'),
+    ('njt', 'njt'),
+    ('ngadjunmaya', 'nju'),
+    ('nju', 'nju'),
+    ('kunyi', 'njx'),
+    ('njx', 'njx'),
+    ('njy', 'njy'),
+    ('njyem', 'njy'),
+    ('njz', 'njz'),
+    ('nyishi', 'njz'),
+    ('nka', 'nka'),
+    ('nkoya', 'nka'),
+    ('khoibu naga', 'nkb'),
+    ('nkb', 'nkb'),
+    ('nkc', 'nkc'),
+    ('nkongho', 'nkc'),
+    ('koireng', 'nkd'),
+    ('nkd', 'nkd'),
+    ('duke', 'nke'),
+    ('nke', 'nke'),
+    ('inpui naga', 'nkf'),
+    ('nkf', 'nkf'),
+    ('nekgini', 'nkg'),
+    ('nkg', 'nkg'),
+    ('khezha naga', 'nkh'),
+    ('nkh', 'nkh'),
+    ('nki', 'nki'),
+    ('thangal naga', 'nki'),
+    ('nakai', 'nkj'),
+    ('nkj', 'nkj'),
+    ('nkk', 'nkk'),
+    ('nokuku', 'nkk'),
+    ('namat', 'nkm'),
+    ('nkm', 'nkm'),
+    ('nkangala', 'nkn'),
+    ('nkn', 'nkn'),
+    ('nko', 'nko'),
+    ('nkonya', 'nko'),
+    ('niuatoputapu', 'nkp'),
+    ('nkp', 'nkp'),
+    ('nkami', 'nkq'),
+    ('nkq', 'nkq'),
+    ('nkr', 'nkr'),
+    ('nukuoro', 'nkr'),
+    ('nks', 'nks'),
+    ('north asmat', 'nks'),
+    ('nkt', 'nkt'),
+    ('nyika', 'nkt'),
+    ('nyika (tanzania)', 'nkt'),
+    ('bouna kulango', 'nku'),
+    ('nku', 'nku'),
+    ('nkv', 'nkv'),
+    ('nyika (malawi and zambia)', 'nkv'),
+    ('nkutu', 'nkw'),
+    ('nkw', 'nkw'),
+    ('nkoroo', 'nkx'),
+    ('nkx', 'nkx'),
+    ('nkari', 'nkz'),
+    ('nkz', 'nkz'),
+    ('dut', 'nl'),
+    ('dutch', 'nl'),
+    ('flemish', 'nl'),
+    ('nl', 'nl'),
+    ('nld', 'nl'),
+    ('ngombale', 'nla'),
+    ('nla', 'nla'),
+    ('nalca', 'nlc'),
+    ('nlc', 'nlc'),
+    ('east nyala', 'nle'),
+    ('nle', 'nle'),
+    ('gela', 'nlg'),
+    ('nlg', 'nlg'),
+    ('grangali', 'nli'),
+    ('nli', 'nli'),
+    ('nlj', 'nlj'),
+    ('nyali', 'nlj'),
+    ('ninia yali', 'nlk'),
+    ('nlk', 'nlk'),
+    ('nihali', 'nll'),
+    ('nll', 'nll'),
+    ('mankiyali', 'nlm'),
+    ('nlm', 'nlm'),
+    ('ngul', 'nlo'),
+    ('nlo', 'nlo'),
+    ('lao naga', 'nlq'),
+    ('nlq', 'nlq'),
+    ('nchumbulu', 'nlu'),
+    ('nlu', 'nlu'),
+    ('nlv', 'nlv'),
+    ('orizaba nahuatl', 'nlv'),
+    ('nlw', 'nlw'),
+    ('walangama', 'nlw'),
+    ('nahali', 'nlx'),
+    ('nlx', 'nlx'),
+    ('nly', 'nly'),
+    ('nyamal', 'nly'),
+    ('nalögo', 'nlz'),
+    ('nlz', 'nlz'),
+    ('maram naga', 'nma'),
+    ('nma', 'nma'),
+    ('big nambas', 'nmb'),
+    ('nmb', 'nmb'),
+    ('ngam', 'nmc'),
+    ('nmc', 'nmc'),
+    ('ndumu', 'nmd'),
+    ('nmd', 'nmd'),
+    ('mzieme naga', 'nme'),
+    ('nme', 'nme'),
+    ('nmf', 'nmf'),
+    ('tangkhul naga', 'nmf'),
+    ('tangkhul naga (india)', 'nmf'),
+    ('kwasio', 'nmg'),
+    ('nmg', 'nmg'),
+    ('monsang naga', 'nmh'),
+    ('nmh', 'nmh'),
+    ('nmi', 'nmi'),
+    ('nyam', 'nmi'),
+    ('ngombe (central african republic)', 'nmj'),
+    ('nmj', 'nmj'),
+    ('namakura', 'nmk'),
+    ('nmk', 'nmk'),
+    ('ndemli', 'nml'),
+    ('nml', 'nml'),
+    ('manangba', 'nmm'),
+    ('nmm', 'nmm'),
+    ('nmn', 'nmn'),
+    ('ǃxóõ', 'nmn'),
+    ('moyon naga', 'nmo'),
+    ('nmo', 'nmo'),
+    ('nimanbur', 'nmp'),
+    ('nmp', 'nmp'),
+    ('nambya', 'nmq'),
+    ('nmq', 'nmq'),
+    ('nimbari', 'nmr'),
+    ('nmr', 'nmr'),
+    ('letemboi', 'nms'),
+    ('nms', 'nms'),
+    ('namonuito', 'nmt'),
+    ('nmt', 'nmt'),
+    ('nmu', 'nmu'),
+    ('northeast maidu', 'nmu'),
+    ('ngamini', 'nmv'),
+    ('nmv', 'nmv'),
+    ('nimoa', 'nmw'),
+    ('nmw', 'nmw'),
+    ('nama', 'nmx'),
+    ('nama (papua new guinea)', 'nmx'),
+    ('nmx', 'nmx'),
+    ('namuyi', 'nmy'),
+    ('nmy', 'nmy'),
+    ('nawdm', 'nmz'),
+    ('nmz', 'nmz'),
+    ('nn', 'nn'),
+    ('nno', 'nn'),
+    ('norwegian nynorsk', 'nn'),
+    ('nynorsk', 'nn'),
+    ('nynorsk, norwegian', 'nn'),
+    ('nna', 'nna'),
+    ('nyangumarta', 'nna'),
+    ('nande', 'nnb'),
+    ('nnb', 'nnb'),
+    ('nancere', 'nnc'),
+    ('nnc', 'nnc'),
+    ('nnd', 'nnd'),
+    ('west ambae', 'nnd'),
+    ('ngandyera', 'nne'),
+    ('nne', 'nne'),
+    ('ngaing', 'nnf'),
+    ('nnf', 'nnf'),
+    ('maring naga', 'nng'),
+    ('nng', 'nng'),
+    ('ngiemboon', 'nnh'),
+    ('nnh', 'nnh'),
+    ('nni', 'nni'),
+    ('north nuaulu', 'nni'),
+    ('nnj', 'nnj'),
+    ('nyangatom', 'nnj'),
+    ('nankina', 'nnk'),
+    ('nnk', 'nnk'),
+    ('nnl', 'nnl'),
+    ('northern rengma naga', 'nnl'),
+    ('namia', 'nnm'),
+    ('nnm', 'nnm'),
+    ('ngete', 'nnn'),
+    ('nnn', 'nnn'),
+    ('nnp', 'nnp'),
+    ('wancho naga', 'nnp'),
+    ('ngindo', 'nnq'),
+    ('nnq', 'nnq'),
+    ('narungga', 'nnr'),
+    ('nnr', 'nnr'),
+    ('nanticoke', 'nnt'),
+    ('nnt', 'nnt'),
+    ('dwang', 'nnu'),
+    ('nnu', 'nnu'),
+    ('nnv', 'nnv'),
+    ('nugunu', 'nnv'),
+    ('nugunu (australia)', 'nnv'),
+    ('nnw', 'nnw'),
+    ('southern nuni', 'nnw'),
+    ('nny', 'nny'),
+    ('nyangga', 'nny'),
+    ('nda''nda''', 'nnz'),
+    ('nnz', 'nnz'),
+    ('no', 'no'),
+    ('nor', 'no'),
+    ('norwegian', 'no'),
+    ('noa', 'noa'),
+    ('woun meu', 'noa'),
+    ('noc', 'noc'),
+    ('nuk', 'noc'),
+    ('nod', 'nod'),
+    ('northern thai', 'nod'),
+    ('nimadi', 'noe'),
+    ('noe', 'noe'),
+    ('nof', 'nof'),
+    ('nomane', 'nof'),
+    ('nog', 'nog'),
+    ('nogai', 'nog'),
+    ('noh', 'noh'),
+    ('nomu', 'noh'),
+    ('noi', 'noi'),
+    ('noiri', 'noi'),
+    ('noj', 'noj'),
+    ('nonuya', 'noj'),
+    ('nok', 'nok'),
+    ('nooksack', 'nok'),
+    ('nol', 'nol'),
+    ('nomlaki', 'nol'),
+    ('nocamán', 'nom'),
+    ('nom', 'nom'),
+    ('non', 'non'),
+    ('norse', 'non'),
+    ('norse, old', 'non'),
+    ('old norse', 'non'),
+    ('nop', 'nop'),
+    ('numanggang', 'nop'),
+    ('ngongo', 'noq'),
+    ('noq', 'noq'),
+    ('eastern nisu', 'nos'),
+    ('nos', 'nos'),
+    ('nomatsiguenga', 'not'),
+    ('not', 'not'),
+    ('ewage-notu', 'nou'),
+    ('nou', 'nou'),
+    ('nov', 'nov'),
+    ('novial', 'nov'),
+    ('now', 'now'),
+    ('nyambo', 'now'),
+    ('noy', 'noy'),
+    ('nayi', 'noz'),
+    ('noz', 'noz'),
+    ('nar phu', 'npa'),
+    ('npa', 'npa'),
+    ('npb', 'npb'),
+    ('nupbikha', 'npb'),
+    ('npg', 'npg'),
+    ('ponyo-gongwang naga', 'npg'),
+    ('nph', 'nph'),
+    ('phom naga', 'nph'),
+    ('nepali (individual language)', 'npi'),
+    ('npi', 'npi'),
+    ('npl', 'npl'),
+    ('southeastern puebla nahuatl', 'npl'),
+    ('mondropolon', 'npn'),
+    ('npn', 'npn'),
+    ('npo', 'npo'),
+    ('pochuri naga', 'npo'),
+    ('nipsan', 'nps'),
+    ('nps', 'nps'),
+    ('npu', 'npu'),
+    ('puimei naga', 'npu'),
+    ('noipx', 'npx'),
+    ('npx', 'npx'),
+    ('napu', 'npy'),
+    ('npy', 'npy'),
+    ('nqg', 'nqg'),
+    ('southern nago', 'nqg'),
+    ('kura ede nago', 'nqk'),
+    ('nqk', 'nqk'),
+    ('ngendelengo', 'nql'),
+    ('nql', 'nql'),
+    ('ndom', 'nqm'),
+    ('nqm', 'nqm'),
+    ('nqn', 'nqn'),
+    ('n''ko', 'nqo'),
+    ('nqo', 'nqo'),
+    ('kyan-karyaw naga', 'nqq'),
+    ('nqq', 'nqq'),
+    ('nqt', 'nqt'),
+    ('nteng', 'nqt'),
+    ('akyaung ari naga', 'nqy'),
+    ('nqy', 'nqy'),
+    ('nbl', 'nr'),
+    ('ndebele', 'nr'),
+    ('ndebele, south', 'nr'),
+    ('nr', 'nr'),
+    ('south ndebele', 'nr'),
+    ('ngom', 'nra'),
+    ('nra', 'nra'),
+    ('nara', 'nrb'),
+    ('nrb', 'nrb'),
+    ('noric', 'nrc'),
+    ('nrc', 'nrc'),
+    ('nre', 'nre'),
+    ('southern rengma naga', 'nre'),
+    ('jèrriais', 'nrf'),
+    ('nrf', 'nrf'),
+    ('narango', 'nrg'),
+    ('nrg', 'nrg'),
+    ('chokri naga', 'nri'),
+    ('nri', 'nri'),
+    ('ngarla', 'nrk'),
+    ('nrk', 'nrk'),
+    ('ngarluma', 'nrl'),
+    ('nrl', 'nrl'),
+    ('narom', 'nrm'),
+    ('nrm', 'nrm'),
+    ('norn', 'nrn'),
+    ('nrn', 'nrn'),
+    ('north picene', 'nrp'),
+    ('nrp', 'nrp'),
+    ('norra', 'nrr'),
+    ('nrr', 'nrr'),
+    ('northern kalapuya', 'nrt'),
+    ('nrt', 'nrt'),
+    ('narua', 'nru'),
+    ('nru', 'nru'),
+    ('ngurmbur', 'nrx'),
+    ('nrx', 'nrx'),
+    ('lala', 'nrz'),
+    ('nrz', 'nrz'),
+    ('nsa', 'nsa'),
+    ('sangtam naga', 'nsa'),
+    ('lower nossob', 'nsb'),
+    ('nsb', 'nsb'),
+    ('nsc', 'nsc'),
+    ('nshi', 'nsc'),
+    ('nsd', 'nsd'),
+    ('southern nisu', 'nsd'),
+    ('nse', 'nse'),
+    ('nsenga', 'nse'),
+    ('northwestern nisu', 'nsf'),
+    ('nsf', 'nsf'),
+    ('ngasa', 'nsg'),
+    ('nsg', 'nsg'),
+    ('ngoshie', 'nsh'),
+    ('nsh', 'nsh'),
+    ('nigerian sign language', 'nsi'),
+    ('nsi', 'nsi'),
+    ('naskapi', 'nsk'),
+    ('nsk', 'nsk'),
+    ('norwegian sign language', 'nsl'),
+    ('nsl', 'nsl'),
+    ('nsm', 'nsm'),
+    ('sumi naga', 'nsm'),
+    ('nehan', 'nsn'),
+    ('nsn', 'nsn'),
+    ('northern sotho', 'nso'),
+    ('nso', 'nso'),
+    ('pedi', 'nso'),
+    ('sepedi', 'nso'),
+    ('nepalese sign language', 'nsp'),
+    ('nsp', 'nsp'),
+    ('northern sierra miwok', 'nsq'),
+    ('nsq', 'nsq'),
+    ('maritime sign language', 'nsr'),
+    ('nsr', 'nsr'),
+    ('nali', 'nss'),
+    ('nss', 'nss'),
+    ('nst', 'nst'),
+    ('tase naga', 'nst'),
+    ('nsu', 'nsu'),
+    ('sierra negra nahuatl', 'nsu'),
+    ('nsv', 'nsv'),
+    ('southwestern nisu', 'nsv'),
+    ('navut', 'nsw'),
+    ('nsw', 'nsw'),
+    ('nsongo', 'nsx'),
+    ('nsx', 'nsx'),
+    ('nasal', 'nsy'),
+    ('nsy', 'nsy'),
+    ('nisenan', 'nsz'),
+    ('nsz', 'nsz'),
+    ('northern tidung', 'ntd'),
+    ('ntd', 'ntd'),
+    ('nathembo', 'nte'),
+    ('nte', 'nte'),
+    ('ngantangarra', 'ntg'),
+    ('ntg', 'ntg'),
+    ('natioro', 'nti'),
+    ('nti', 'nti'),
+    ('ngaanyatjarra', 'ntj'),
+    ('ntj', 'ntj'),
+    ('ikoma-nata-isenye', 'ntk'),
+    ('ntk', 'ntk'),
+    ('nateni', 'ntm'),
+    ('ntm', 'ntm'),
+    ('nto', 'nto'),
+    ('ntomba', 'nto'),
+    ('northern tepehuan', 'ntp'),
+    ('ntp', 'ntp'),
+    ('delo', 'ntr'),
+    ('ntr', 'ntr'),
+    ('natügu', 'ntu'),
+    ('ntu', 'ntu'),
+    ('nottoway', 'ntw'),
+    ('ntw', 'ntw'),
+    ('ntx', 'ntx'),
+    ('tangkhul naga (myanmar)', 'ntx'),
+    ('mantsi', 'nty'),
+    ('nty', 'nty'),
+    ('natanzi', 'ntz'),
+    ('ntz', 'ntz'),
+    ('nua', 'nua'),
+    ('yuanga', 'nua'),
+    ('nuc', 'nuc'),
+    ('nukuini', 'nuc'),
+    ('ngala', 'nud'),
+    ('nud', 'nud'),
+    ('ngundu', 'nue'),
+    ('nue', 'nue'),
+    ('nuf', 'nuf'),
+    ('nusu', 'nuf'),
+    ('nug', 'nug'),
+    ('nungali', 'nug'),
+    ('ndunda', 'nuh'),
+    ('nuh', 'nuh'),
+    ('ngumbi', 'nui'),
+    ('nui', 'nui'),
+    ('nuj', 'nuj'),
+    ('nyole', 'nuj'),
+    ('nuu-chah-nulth', 'nuk'),
+    ('nul', 'nul'),
+    ('nusa laut', 'nul'),
+    ('niuafo''ou', 'num'),
+    ('num', 'num'),
+    ('anong', 'nun'),
+    ('nun', 'nun'),
+    ('nguôn', 'nuo'),
+    ('nuo', 'nuo'),
+    ('nup', 'nup'),
+    ('nupe-nupe-tako', 'nup'),
+    ('nukumanu', 'nuq'),
+    ('nuq', 'nuq'),
+    ('nukuria', 'nur'),
+    ('nur', 'nur'),
+    ('nuer', 'nus'),
+    ('nus', 'nus'),
+    ('nung', 'nut'),
+    ('nung (viet nam)', 'nut'),
+    ('nut', 'nut'),
+    ('ngbundu', 'nuu'),
+    ('nuu', 'nuu'),
+    ('northern nuni', 'nuv'),
+    ('nuv', 'nuv'),
+    ('nguluwan', 'nuw'),
+    ('nuw', 'nuw'),
+    ('mehek', 'nux'),
+    ('nux', 'nux'),
+    ('nunggubuyu', 'nuy'),
+    ('nuy', 'nuy'),
+    ('nuz', 'nuz'),
+    ('tlamacazapa nahuatl', 'nuz'),
+    ('nav', 'nv'),
+    ('navaho', 'nv'),
+    ('navajo', 'nv'),
+    ('nv', 'nv'),
+    ('nasarian', 'nvh'),
+    ('nvh', 'nvh'),
+    ('namiae', 'nvm'),
+    ('nvm', 'nvm'),
+    ('nvo', 'nvo'),
+    ('nyokon', 'nvo'),
+    ('nawathinehena', 'nwa'),
+    ('nwa', 'nwa'),
+    ('nwb', 'nwb'),
+    ('nyabwa', 'nwb'),
+    ('classical nepal bhasa', 'nwc'),
+    ('classical newari', 'nwc'),
+    ('nwc', 'nwc'),
+    ('old newari', 'nwc'),
+    ('ngwe', 'nwe'),
+    ('nwe', 'nwe'),
+    ('ngayawung', 'nwg'),
+    ('nwg', 'nwg'),
+    ('nwi', 'nwi'),
+    ('southwest tanna', 'nwi'),
+    ('nwm', 'nwm'),
+    ('nyamusa-molo', 'nwm'),
+    ('nauo', 'nwo'),
+    ('nwo', 'nwo'),
+    ('nawaru', 'nwr'),
+    ('nwr', 'nwr'),
+    ('ndwewe', 'nww'),
+    ('nww', 'nww'),
+    ('middle newar', 'nwx'),
+    ('nwx', 'nwx'),
+    ('nottoway-meherrin', 'nwy'),
+    ('nwy', 'nwy'),
+    ('nauete', 'nxa'),
+    ('nxa', 'nxa'),
+    ('ngando (democratic republic of congo)', 'nxd'),
+    ('nxd', 'nxd'),
+    ('nage', 'nxe'),
+    ('nxe', 'nxe'),
+    ('ngad''a', 'nxg'),
+    ('nxg', 'nxg'),
+    ('nindi', 'nxi'),
+    ('nxi', 'nxi'),
+    ('koki naga', 'nxk'),
+    ('nxk', 'nxk'),
+    ('nxl', 'nxl'),
+    ('south nuaulu', 'nxl'),
+    ('numidian', 'nxm'),
+    ('nxm', 'nxm'),
+    ('ngawun', 'nxn'),
+    ('nxn', 'nxn'),
+    ('ndambomo', 'nxo'),
+    ('nxo', 'nxo'),
+    ('naxi', 'nxq'),
+    ('nxq', 'nxq'),
+    ('ninggerum', 'nxr'),
+    ('nxr', 'nxr'),
+    ('nafri', 'nxx'),
+    ('nxx', 'nxx'),
+    ('chewa', 'ny'),
+    ('chichewa', 'ny'),
+    ('ny', 'ny'),
+    ('nya', 'ny'),
+    ('nyanja', 'ny'),
+    ('nyangbo', 'nyb'),
+    ('nyb', 'nyb'),
+    ('nyanga-li', 'nyc'),
+    ('nyc', 'nyc'),
+    ('nyd', 'nyd'),
+    ('nyore', 'nyd'),
+    ('nye', 'nye'),
+    ('nyengo', 'nye'),
+    ('giryama', 'nyf'),
+    ('nyf', 'nyf'),
+    ('nyg', 'nyg'),
+    ('nyindu', 'nyg'),
+    ('nyh', 'nyh'),
+    ('nyikina', 'nyh'),
+    ('ama (sudan)', 'nyi'),
+    ('nyi', 'nyi'),
+    ('nyanga', 'nyj'),
+    ('nyj', 'nyj'),
+    ('nyaneka', 'nyk'),
+    ('nyk', 'nyk'),
+    ('nyeu', 'nyl'),
+    ('nyl', 'nyl'),
+    ('nyamwezi', 'nym'),
+    ('nym', 'nym'),
+    ('nyankole', 'nyn'),
+    ('nyn', 'nyn'),
+    ('nyo', 'nyo'),
+    ('nyoro', 'nyo'),
+    ('nyang''i', 'nyp'),
+    ('nyp', 'nyp'),
+    ('nayini', 'nyq'),
+    ('nyq', 'nyq'),
+    ('nyiha (malawi)', 'nyr'),
+    ('nyr', 'nyr'),
+    ('nys', 'nys'),
+    ('nyungar', 'nys'),
+    ('nyawaygi', 'nyt'),
+    ('nyt', 'nyt'),
+    ('nyu', 'nyu'),
+    ('nyungwe', 'nyu'),
+    ('nyulnyul', 'nyv'),
+    ('nyv', 'nyv'),
+    ('nyaw', 'nyw'),
+    ('nyw', 'nyw'),
+    ('nganyaywana', 'nyx'),
+    ('nyx', 'nyx'),
+    ('nyakyusa-ngonde', 'nyy'),
+    ('nyy', 'nyy'),
+    ('nza', 'nza'),
+    ('tigon mbembe', 'nza'),
+    ('njebi', 'nzb'),
+    ('nzb', 'nzb'),
+    ('nzadi', 'nzd'),
+    ('nzd', 'nzd'),
+    ('nzi', 'nzi'),
+    ('nzima', 'nzi'),
+    ('nzakara', 'nzk'),
+    ('nzk', 'nzk'),
+    ('nzm', 'nzm'),
+    ('zeme naga', 'nzm'),
+    ('new zealand sign language', 'nzs'),
+    ('nzs', 'nzs'),
+    ('nzu', 'nzu'),
+    ('teke-nzikou', 'nzu'),
+    ('nzakambay', 'nzy'),
+    ('nzy', 'nzy'),
+    ('nanga dama dogon', 'nzz'),
+    ('nzz', 'nzz'),
+    ('oaa', 'oaa'),
+    ('orok', 'oaa'),
+    ('oac', 'oac'),
+    ('oroch', 'oac'),
+    ('oar', 'oar'),
+    ('old aramaic', 'oar'),
+    ('old aramaic (up to 700 bce)', 'oar'),
+    ('oav', 'oav'),
+    ('old avar', 'oav'),
+    ('obi', 'obi'),
+    ('obispeño', 'obi'),
+    ('obk', 'obk'),
+    ('southern bontok', 'obk'),
+    ('obl', 'obl'),
+    ('oblo', 'obl'),
+    ('moabite', 'obm'),
+    ('obm', 'obm'),
+    ('obo', 'obo'),
+    ('obo manobo', 'obo'),
+    ('obr', 'obr'),
+    ('old burmese', 'obr'),
+    ('obt', 'obt'),
+    ('old breton', 'obt'),
+    ('obu', 'obu'),
+    ('obulom', 'obu'),
+    ('oc', 'oc'),
+    ('occitan', 'oc'),
+    ('occitan (post 1500)', 'oc'),
+    ('oci', 'oc'),
+    ('provençal', 'oc'),
+    ('oca', 'oca'),
+    ('ocaina', 'oca'),
+    ('och', 'och'),
+    ('old chinese', 'och'),
+    ('ocm', 'ocm'),
+    ('old cham', 'ocm'),
+    ('oco', 'oco'),
+    ('old cornish', 'oco'),
+    ('atzingo matlatzinca', 'ocu'),
+    ('ocu', 'ocu'),
+    ('oda', 'oda'),
+    ('odut', 'oda'),
+    ('od', 'odk'),
+    ('odk', 'odk'),
+    ('odt', 'odt'),
+    ('old dutch', 'odt'),
+    ('odu', 'odu'),
+    ('odual', 'odu'),
+    ('ofo', 'ofo'),
+    ('ofs', 'ofs'),
+    ('old frisian', 'ofs'),
+    ('efutop', 'ofu'),
+    ('ofu', 'ofu'),
+    ('ogb', 'ogb'),
+    ('ogbia', 'ogb'),
+    ('ogbah', 'ogc'),
+    ('ogc', 'ogc'),
+    ('oge', 'oge'),
+    ('old georgian', 'oge'),
+    ('ogbogolo', 'ogg'),
+    ('ogg', 'ogg'),
+    ('khana', 'ogo'),
+    ('ogo', 'ogo'),
+    ('ogbronuagum', 'ogu'),
+    ('ogu', 'ogu'),
+    ('oht', 'oht'),
+    ('old hittite', 'oht'),
+    ('ohu', 'ohu'),
+    ('old hungarian', 'ohu'),
+    ('oia', 'oia'),
+    ('oirata', 'oia'),
+    ('oie', 'oie'),
+    ('okolie', 'oie'),
+    ('inebu one', 'oin'),
+    ('oin', 'oin'),
+    ('oj', 'oj'),
+    ('oji', 'oj'),
+    ('ojibwa', 'oj'),
+    ('northwestern ojibwa', 'ojb'),
+    ('ojb', 'ojb'),
+    ('central ojibwa', 'ojc'),
+    ('ojc', 'ojc'),
+    ('eastern ojibwa', 'ojg'),
+    ('ojg', 'ojg'),
+    ('ojp', 'ojp'),
+    ('old japanese', 'ojp'),
+    ('ojs', 'ojs'),
+    ('severn ojibwa', 'ojs'),
+    ('ojv', 'ojv'),
+    ('ontong java', 'ojv'),
+    ('ojw', 'ojw'),
+    ('western ojibwa', 'ojw'),
+    ('oka', 'oka'),
+    ('okanagan', 'oka'),
+    ('okb', 'okb'),
+    ('okobo', 'okb'),
+    ('kobo', 'okc'),
+    ('okc', 'okc'),
+    ('okd', 'okd'),
+    ('okodia', 'okd'),
+    ('oke', 'oke'),
+    ('okpe', 'oke'),
+    ('okpe (southwestern edo)', 'oke'),
+    ('koko babangk', 'okg'),
+    ('okg', 'okg'),
+    ('koresh-e rostam', 'okh'),
+    ('okh', 'okh'),
+    ('oki', 'oki'),
+    ('okiek', 'oki'),
+    ('okj', 'okj'),
+    ('oko-juwoi', 'okj'),
+    ('kwamtim one', 'okk'),
+    ('okk', 'okk'),
+    ('okl', 'okl'),
+    ('old kentish sign language', 'okl'),
+    ('middle korean', 'okm'),
+    ('middle korean (10th-16th cent.)', 'okm'),
+    ('okm', 'okm'),
+    ('oki-no-erabu', 'okn'),
+    ('okn', 'okn'),
+    ('oko', 'oko'),
+    ('old korean', 'oko'),
+    ('old korean (3rd-9th cent.)', 'oko'),
+    ('kirike', 'okr'),
+    ('okr', 'okr'),
+    ('oko-eni-osayen', 'oks'),
+    ('oks', 'oks'),
+    ('oku', 'oku'),
+    ('okv', 'okv'),
+    ('orokaiva', 'okv'),
+    ('okpe (northwestern edo)', 'okx'),
+    ('okx', 'okx'),
+    ('okz', 'okz'),
+    ('old khmer', 'okz'),
+    ('ola', 'ola'),
+    ('walungge', 'ola'),
+    ('mochi', 'old'),
+    ('old', 'old'),
+    ('ole', 'ole'),
+    ('olekha', 'ole'),
+    ('olk', 'olk'),
+    ('olkol', 'olk'),
+    ('olm', 'olm'),
+    ('oloma', 'olm'),
+    ('livvi', 'olo'),
+    ('olo', 'olo'),
+    ('olr', 'olr'),
+    ('olrat', 'olr'),
+    ('old lithuanian', 'olt'),
+    ('olt', 'olt'),
+    ('kuvale', 'olu'),
+    ('olu', 'olu'),
+    ('om', 'om'),
+    ('orm', 'om'),
+    ('oromo', 'om'),
+    ('oma', 'oma'),
+    ('omaha-ponca', 'oma'),
+    ('east ambae', 'omb'),
+    ('omb', 'omb'),
+    ('mochica', 'omc'),
+    ('omc', 'omc'),
+    ('omagua', 'omg'),
+    ('omg', 'omg'),
+    ('omi', 'omi'),
+    ('omk', 'omk'),
+    ('omok', 'omk'),
+    ('ombo', 'oml'),
+    ('oml', 'oml'),
+    ('minoan', 'omn'),
+    ('omn', 'omn'),
+    ('omo', 'omo'),
+    ('utarmbung', 'omo'),
+    ('old manipuri', 'omp'),
+    ('omp', 'omp'),
+    ('old marathi', 'omr'),
+    ('omr', 'omr'),
+    ('omotik', 'omt'),
+    ('omt', 'omt'),
+    ('omu', 'omu'),
+    ('omurano', 'omu'),
+    ('omw', 'omw'),
+    ('south tairora', 'omw'),
+    ('old mon', 'omx'),
+    ('omx', 'omx'),
+    ('old malay', 'omy'),
+    ('omy', 'omy'),
+    ('ona', 'ona'),
+    ('lingao', 'onb'),
+    ('onb', 'onb'),
+    ('one', 'one'),
+    ('oneida', 'one'),
+    ('ong', 'ong'),
+    ('oni', 'oni'),
+    ('onin', 'oni'),
+    ('onj', 'onj'),
+    ('onjob', 'onj'),
+    ('kabore one', 'onk'),
+    ('onk', 'onk'),
+    ('onn', 'onn'),
+    ('onobasulu', 'onn'),
+    ('ono', 'ono'),
+    ('onondaga', 'ono'),
+    ('onp', 'onp'),
+    ('sartang', 'onp'),
+    ('northern one', 'onr'),
+    ('onr', 'onr'),
+    ('ons', 'ons'),
+    ('ont', 'ont'),
+    ('ontenu', 'ont'),
+    ('onu', 'onu'),
+    ('unua', 'onu'),
+    ('old nubian', 'onw'),
+    ('onw', 'onw'),
+    ('onin based pidgin', 'onx'),
+    ('onx', 'onx'),
+    ('ood', 'ood'),
+    ('tohono o''odham', 'ood'),
+    ('oog', 'oog'),
+    ('oon', 'oon'),
+    ('önge', 'oon'),
+    ('oor', 'oor'),
+    ('oorlams', 'oor'),
+    ('old ossetic', 'oos'),
+    ('oos', 'oos'),
+    ('okpamheri', 'opa'),
+    ('opa', 'opa'),
+    ('kopkaka', 'opk'),
+    ('opk', 'opk'),
+    ('oksapmin', 'opm'),
+    ('opm', 'opm'),
+    ('opao', 'opo'),
+    ('opo', 'opo'),
+    ('opata', 'opt'),
+    ('opt', 'opt'),
+    ('ofayé', 'opy'),
+    ('opy', 'opy'),
+    ('or', 'or'),
+    ('ori', 'or'),
+    ('oriya', 'or'),
+    ('oriya (macrolanguage)', 'or'),
+    ('ora', 'ora'),
+    ('oroha', 'ora'),
+    ('orc', 'orc'),
+    ('orma', 'orc'),
+    ('ore', 'ore'),
+    ('orejón', 'ore'),
+    ('org', 'org'),
+    ('oring', 'org'),
+    ('orh', 'orh'),
+    ('oroqen', 'orh'),
+    ('orang kanaq', 'orn'),
+    ('orn', 'orn'),
+    ('oro', 'oro'),
+    ('orokolo', 'oro'),
+    ('orr', 'orr'),
+    ('oruma', 'orr'),
+    ('orang seletar', 'ors'),
+    ('ors', 'ors'),
+    ('adivasi oriya', 'ort'),
+    ('ort', 'ort'),
+    ('ormuri', 'oru'),
+    ('oru', 'oru'),
+    ('old russian', 'orv'),
+    ('orv', 'orv'),
+    ('oro win', 'orw'),
+    ('orw', 'orw'),
+    ('orx', 'orx'),
+    ('odia', 'ory'),
+    ('ory', 'ory'),
+    ('ormu', 'orz'),
+    ('orz', 'orz'),
+    ('os', 'os'),
+    ('oss', 'os'),
+    ('ossetian', 'os'),
+    ('ossetic', 'os'),
+    ('osa', 'osa'),
+    ('osage', 'osa'),
+    ('osc', 'osc'),
+    ('oscan', 'osc'),
+    ('osi', 'osi'),
+    ('osing', 'osi'),
+    ('old sundanese', 'osn'),
+    ('osn', 'osn'),
+    ('oso', 'oso'),
+    ('ososo', 'oso'),
+    ('old spanish', 'osp'),
+    ('osp', 'osp'),
+    ('osatu', 'ost'),
+    ('ost', 'ost'),
+    ('osu', 'osu'),
+    ('southern one', 'osu'),
+    ('old saxon', 'osx'),
+    ('osx', 'osx'),
+    ('ota', 'ota'),
+    ('ottoman turkish', 'ota'),
+    ('ottoman turkish (1500-1928)', 'ota'),
+    ('turkish, ottoman', 'ota'),
+    ('turkish, ottoman (1500-1928)', 'ota'),
+    ('old tibetan', 'otb'),
+    ('otb', 'otb'),
+    ('ot danum', 'otd'),
+    ('otd', 'otd'),
+    ('mezquital otomi', 'ote'),
+    ('ote', 'ote'),
+    ('oti', 'oti'),
+    ('old turkish', 'otk'),
+    ('otk', 'otk'),
+    ('otl', 'otl'),
+    ('tilapa otomi', 'otl'),
+    ('eastern highland otomi', 'otm'),
+    ('otm', 'otm'),
+    ('otn', 'otn'),
+    ('tenango otomi', 'otn'),
+    ('otq', 'otq'),
+    ('querétaro otomi', 'otq'),
+    ('otoro', 'otr'),
+    ('otr', 'otr'),
+    ('estado de méxico otomi', 'ots'),
+    ('ots', 'ots'),
+    ('ott', 'ott'),
+    ('temoaya otomi', 'ott'),
+    ('otu', 'otu'),
+    ('otuke', 'otu'),
+    ('ottawa', 'otw'),
+    ('otw', 'otw'),
+    ('otx', 'otx'),
+    ('texcatepec otomi', 'otx'),
+    ('old tamil', 'oty'),
+    ('oty', 'oty'),
+    ('ixtenco otomi', 'otz'),
+    ('otz', 'otz'),
+    ('oua', 'oua'),
+    ('tagargrent', 'oua'),
+    ('glio-oubi', 'oub'),
+    ('oub', 'oub'),
+    ('oue', 'oue'),
+    ('oune', 'oue'),
+    ('old uighur', 'oui'),
+    ('oui', 'oui'),
+    ('oum', 'oum'),
+    ('ouma', 'oum'),
+    ('elfdalian', 'ovd'),
+    ('ovd', 'ovd'),
+    ('owi', 'owi'),
+    ('owiniga', 'owi'),
+    ('old welsh', 'owl'),
+    ('owl', 'owl'),
+    ('oy', 'oyb'),
+    ('oyb', 'oyb'),
+    ('oyd', 'oyd'),
+    ('oyda', 'oyd'),
+    ('oym', 'oym'),
+    ('wayampi', 'oym'),
+    ('oya''oya', 'oyy'),
+    ('oyy', 'oyy'),
+    ('koonzime', 'ozm'),
+    ('ozm', 'ozm'),
+    ('pa', 'pa'),
+    ('pan', 'pa'),
+    ('panjabi', 'pa'),
+    ('punjabi', 'pa'),
+    ('pab', 'pab'),
+    ('parecís', 'pab'),
+    ('pac', 'pac'),
+    ('pacoh', 'pac'),
+    ('pad', 'pad'),
+    ('paumarí', 'pad'),
+    ('pae', 'pae'),
+    ('pagibete', 'pae'),
+    ('paf', 'paf'),
+    ('paranawát', 'paf'),
+    ('pag', 'pag'),
+    ('pangasinan', 'pag'),
+    ('pah', 'pah'),
+    ('tenharim', 'pah'),
+    ('pai', 'pai'),
+    ('pe', 'pai'),
+    ('pak', 'pak'),
+    ('parakanã', 'pak'),
+    ('pahlavi', 'pal'),
+    ('kapampangan', 'pam'),
+    ('pam', 'pam'),
+    ('pampanga', 'pam'),
+    ('northern paiute', 'pao'),
+    ('pao', 'pao'),
+    ('pap', 'pap'),
+    ('papiamento', 'pap'),
+    ('paq', 'paq'),
+    ('parya', 'paq'),
+    ('panamint', 'par'),
+    ('par', 'par'),
+    ('papasena', 'pas'),
+    ('pas', 'pas'),
+    ('palauan', 'pau'),
+    ('pau', 'pau'),
+    ('pakaásnovos', 'pav'),
+    ('pav', 'pav'),
+    ('paw', 'paw'),
+    ('pawnee', 'paw'),
+    ('pankararé', 'pax'),
+    ('pax', 'pax'),
+    ('pay', 'pay'),
+    ('pech', 'pay'),
+    ('pankararú', 'paz'),
+    ('paz', 'paz'),
+    ('pbb', 'pbb'),
+    ('páez', 'pbb'),
+    ('patamona', 'pbc'),
+    ('pbc', 'pbc'),
+    ('mezontla popoloca', 'pbe'),
+    ('pbe', 'pbe'),
+    ('coyotepec popoloca', 'pbf'),
+    ('pbf', 'pbf'),
+    ('paraujano', 'pbg'),
+    ('pbg', 'pbg'),
+    ('e''ñapa woromaipu', 'pbh'),
+    ('pbh', 'pbh'),
+    ('parkwa', 'pbi'),
+    ('pbi', 'pbi'),
+    ('mak (nigeria)', 'pbl'),
+    ('pbl', 'pbl'),
+    ('pbm', 'pbm'),
+    ('puebla mazatec', 'pbm'),
+    ('kpasam', 'pbn'),
+    ('pbn', 'pbn'),
+    ('papel', 'pbo'),
+    ('pbo', 'pbo'),
+    ('badyara', 'pbp'),
+    ('pbp', 'pbp'),
+    ('pangwa', 'pbr'),
+    ('pbr', 'pbr'),
+    ('central pame', 'pbs'),
+    ('pbs', 'pbs'),
+    ('pbt', 'pbt'),
+    ('southern pashto', 'pbt'),
+    ('northern pashto', 'pbu'),
+    ('pbu', 'pbu'),
+    ('pbv', 'pbv'),
+    ('pnar', 'pbv'),
+    ('pby', 'pby'),
+    ('pyu', 'pby'),
+    ('pyu (papua new guinea)', 'pby'),
+    ('pca', 'pca'),
+    ('santa inés ahuatempan popoloca', 'pca'),
+    ('pcb', 'pcb'),
+    ('pear', 'pcb'),
+    ('bouyei', 'pcc'),
+    ('pcc', 'pcc'),
+    ('pcd', 'pcd'),
+    ('picard', 'pcd'),
+    ('pce', 'pce'),
+    ('ruching palaung', 'pce'),
+    ('paliyan', 'pcf'),
+    ('pcf', 'pcf'),
+    ('paniya', 'pcg'),
+    ('pcg', 'pcg'),
+    ('pardhan', 'pch'),
+    ('pch', 'pch'),
+    ('duruwa', 'pci'),
+    ('pci', 'pci'),
+    ('parenga', 'pcj'),
+    ('pcj', 'pcj'),
+    ('paite chin', 'pck'),
+    ('pck', 'pck'),
+    ('pardhi', 'pcl'),
+    ('pcl', 'pcl'),
+    ('nigerian pidgin', 'pcm'),
+    ('pcm', 'pcm'),
+    ('pcn', 'pcn'),
+    ('piti', 'pcn'),
+    ('pacahuara', 'pcp'),
+    ('pcp', 'pcp'),
+    ('pcw', 'pcw'),
+    ('pyapun', 'pcw'),
+    ('anam', 'pda'),
+    ('pda', 'pda'),
+    ('pdc', 'pdc'),
+    ('pennsylvania german', 'pdc'),
+    ('pa di', 'pdi'),
+    ('pdi', 'pdi'),
+    ('pdn', 'pdn'),
+    ('podena', 'pdn'),
+    ('padoe', 'pdo'),
+    ('pdo', 'pdo'),
+    ('pdt', 'pdt'),
+    ('plautdietsch', 'pdt'),
+    ('kayan', 'pdu'),
+    ('pdu', 'pdu'),
+    ('pea', 'pea'),
+    ('peranakan indonesian', 'pea'),
+    ('eastern pomo', 'peb'),
+    ('peb', 'peb'),
+    ('mala', 'ped'),
+    ('mala (papua new guinea)', 'ped'),
+    ('ped', 'ped'),
+    ('pee', 'pee'),
+    ('taje', 'pee'),
+    ('northeastern pomo', 'pef'),
+    ('pef', 'pef'),
+    ('peg', 'peg'),
+    ('pengo', 'peg'),
+    ('bonan', 'peh'),
+    ('peh', 'peh'),
+    ('chichimeca-jonaz', 'pei'),
+    ('pei', 'pei'),
+    ('northern pomo', 'pej'),
+    ('pej', 'pej'),
+    ('pek', 'pek'),
+    ('penchal', 'pek'),
+    ('pekal', 'pel'),
+    ('pel', 'pel'),
+    ('pem', 'pem'),
+    ('phende', 'pem'),
+    ('old persian', 'peo'),
+    ('old persian (ca. 600-400 b.c.)', 'peo'),
+    ('peo', 'peo'),
+    ('persian, old', 'peo'),
+    ('persian, old (ca. 600-400 b.c.)', 'peo'),
+    ('kunja', 'pep'),
+    ('pep', 'pep'),
+    ('peq', 'peq'),
+    ('southern pomo', 'peq'),
+    ('iranian persian', 'pes'),
+    ('pes', 'pes'),
+    ('pev', 'pev'),
+    ('pémono', 'pev'),
+    ('petats', 'pex'),
+    ('pex', 'pex'),
+    ('petjo', 'pey'),
+    ('pey', 'pey'),
+    ('eastern penan', 'pez'),
+    ('pez', 'pez'),
+    ('pfa', 'pfa'),
+    ('pááfang', 'pfa'),
+    ('pere', 'pfe'),
+    ('pfe', 'pfe'),
+    ('pfaelzisch', 'pfl'),
+    ('pfl', 'pfl'),
+    ('pga', 'pga'),
+    ('sudanese creole arabic', 'pga'),
+    ('gāndhārī', 'pgd'),
+    ('pgd', 'pgd'),
+    ('pangwali', 'pgg'),
+    ('pgg', 'pgg'),
+    ('pagi', 'pgi'),
+    ('pgi', 'pgi'),
+    ('pgk', 'pgk'),
+    ('rerep', 'pgk'),
+    ('pgl', 'pgl'),
+    ('primitive irish', 'pgl'),
+    ('paelignian', 'pgn'),
+    ('pgn', 'pgn'),
+    ('pangseng', 'pgs'),
+    ('pgs', 'pgs'),
+    ('pagu', 'pgu'),
+    ('pgu', 'pgu'),
+    ('papua new guinean sign language', 'pgz'),
+    ('pgz', 'pgz'),
+    ('pa-hng', 'pha'),
+    ('pha', 'pha'),
+    ('phd', 'phd'),
+    ('phudagi', 'phd'),
+    ('phg', 'phg'),
+    ('phuong', 'phg'),
+    ('phh', 'phh'),
+    ('phukha', 'phh'),
+    ('pahari', 'phj'),
+    ('phj', 'phj'),
+    ('phake', 'phk'),
+    ('phk', 'phk'),
+    ('phalura', 'phl'),
+    ('phl', 'phl'),
+    ('phimbi', 'phm'),
+    ('phm', 'phm'),
+    ('phn', 'phn'),
+    ('phoenician', 'phn'),
+    ('pho', 'pho'),
+    ('phunoi', 'pho'),
+    ('phana''', 'phq'),
+    ('phq', 'phq'),
+    ('pahari-potwari', 'phr'),
+    ('phr', 'phr'),
+    ('pht', 'pht'),
+    ('phu thai', 'pht'),
+    ('phu', 'phu'),
+    ('phuan', 'phu'),
+    ('pahlavani', 'phv'),
+    ('phv', 'phv'),
+    ('phangduwali', 'phw'),
+    ('phw', 'phw'),
+    ('pali', 'pi'),
+    ('pi', 'pi'),
+    ('pli', 'pi'),
+    ('pia', 'pia'),
+    ('pima bajo', 'pia'),
+    ('pib', 'pib'),
+    ('yine', 'pib'),
+    ('pic', 'pic'),
+    ('pinji', 'pic'),
+    ('piaroa', 'pid'),
+    ('pid', 'pid'),
+    ('pie', 'pie'),
+    ('piro', 'pie'),
+    ('pif', 'pif'),
+    ('pingelapese', 'pif'),
+    ('pig', 'pig'),
+    ('pisabo', 'pig'),
+    ('pih', 'pih'),
+    ('pitcairn-norfolk', 'pih'),
+    ('pij', 'pij'),
+    ('pijao', 'pij'),
+    ('pil', 'pil'),
+    ('yom', 'pil'),
+    ('pim', 'pim'),
+    ('powhatan', 'pim'),
+    ('piame', 'pin'),
+    ('pin', 'pin'),
+    ('piapoco', 'pio'),
+    ('pio', 'pio'),
+    ('pero', 'pip'),
+    ('pip', 'pip'),
+    ('pir', 'pir'),
+    ('piratapuyo', 'pir'),
+    ('pijin', 'pis'),
+    ('pis', 'pis'),
+    ('pit', 'pit'),
+    ('pitta pitta', 'pit'),
+    ('pintupi-luritja', 'piu'),
+    ('piu', 'piu'),
+    ('pileni', 'piv'),
+    ('piv', 'piv'),
+    ('pimbwe', 'piw'),
+    ('piw', 'piw'),
+    ('pix', 'pix'),
+    ('piy', 'piy'),
+    ('piya-kwonci', 'piy'),
+    ('pije', 'piz'),
+    ('piz', 'piz'),
+    ('pitjantjatjara', 'pjt'),
+    ('pjt', 'pjt'),
+    ('ardhamāgadhī prākrit', 'pka'),
+    ('pka', 'pka'),
+    ('pkb', 'pkb'),
+    ('pokomo', 'pkb'),
+    ('paekche', 'pkc'),
+    ('pkc', 'pkc'),
+    ('pak-tong', 'pkg'),
+    ('pkg', 'pkg'),
+    ('pankhu', 'pkh'),
+    ('pkh', 'pkh'),
+    ('pakanha', 'pkn'),
+    ('pkn', 'pkn'),
+    ('pko', 'pko'),
+    ('pökoot', 'pko'),
+    ('pkp', 'pkp'),
+    ('pukapuka', 'pkp'),
+    ('attapady kurumba', 'pkr'),
+    ('pkr', 'pkr'),
+    ('pakistan sign language', 'pks'),
+    ('pks', 'pks'),
+    ('maleng', 'pkt'),
+    ('pkt', 'pkt'),
+    ('paku', 'pku'),
+    ('pku', 'pku'),
+    ('pl', 'pl'),
+    ('pol', 'pl'),
+    ('polish', 'pl'),
+    ('miani', 'pla'),
+    ('pla', 'pla'),
+    ('plb', 'plb'),
+    ('polonombauk', 'plb'),
+    ('central palawano', 'plc'),
+    ('plc', 'plc'),
+    ('pld', 'pld'),
+    ('polari', 'pld'),
+    ('palu''e', 'ple'),
+    ('ple', 'ple'),
+    ('pilagá', 'plg'),
+    ('plg', 'plg'),
+    ('paulohi', 'plh'),
+    ('plh', 'plh'),
+    ('plj', 'plj'),
+    ('polci', 'plj'),
+    ('kohistani shina', 'plk'),
+    ('plk', 'plk'),
+    ('pll', 'pll'),
+    ('shwe palaung', 'pll'),
+    ('palenquero', 'pln'),
+    ('pln', 'pln'),
+    ('oluta popoluca', 'plo'),
+    ('plo', 'plo'),
+    ('palaic', 'plq'),
+    ('plq', 'plq'),
+    ('palaka senoufo', 'plr'),
+    ('plr', 'plr'),
+    ('pls', 'pls'),
+    ('san marcos tlacoyalco popoloca', 'pls'),
+    ('plateau malagasy', 'plt'),
+    ('plt', 'plt'),
+    ('palikúr', 'plu'),
+    ('plu', 'plu'),
+    ('plv', 'plv'),
+    ('southwest palawano', 'plv'),
+    ('brooke''s point palawano', 'plw'),
+    ('plw', 'plw'),
+    ('bolyu', 'ply'),
+    ('ply', 'ply'),
+    ('paluan', 'plz'),
+    ('plz', 'plz'),
+    ('paama', 'pma'),
+    ('pma', 'pma'),
+    ('pambia', 'pmb'),
+    ('pmb', 'pmb'),
+    ('pallanganmiddang', 'pmd'),
+    ('pmd', 'pmd'),
+    ('pme', 'pme'),
+    ('pwaamei', 'pme'),
+    ('pamona', 'pmf'),
+    ('pmf', 'pmf'),
+    ('māhārāṣṭri prākrit', 'pmh'),
+    ('pmh', 'pmh'),
+    ('northern pumi', 'pmi'),
+    ('pmi', 'pmi'),
+    ('pmj', 'pmj'),
+    ('southern pumi', 'pmj'),
+    ('pamlico', 'pmk'),
+    ('pmk', 'pmk'),
+    ('lingua franca', 'pml'),
+    ('pml', 'pml'),
+    ('pmm', 'pmm'),
+    ('pomo', 'pmm'),
+    ('pmn', 'pmn'),
+    ('pmo', 'pmo'),
+    ('pom', 'pmo'),
+    ('northern pame', 'pmq'),
+    ('pmq', 'pmq'),
+    ('paynamar', 'pmr'),
+    ('pmr', 'pmr'),
+    ('piemontese', 'pms'),
+    ('pms', 'pms'),
+    ('pmt', 'pmt'),
+    ('tuamotuan', 'pmt'),
+    ('plains miwok', 'pmw'),
+    ('pmw', 'pmw'),
+    ('pmx', 'pmx'),
+    ('poumei naga', 'pmx'),
+    ('papuan malay', 'pmy'),
+    ('pmy', 'pmy'),
+    ('pmz', 'pmz'),
+    ('southern pame', 'pmz'),
+    ('pna', 'pna'),
+    ('punan bah-biau', 'pna'),
+    ('pnb', 'pnb'),
+    ('western panjabi', 'pnb'),
+    ('pannei', 'pnc'),
+    ('pnc', 'pnc'),
+    ('mpinda', 'pnd'),
+    ('pnd', 'pnd'),
+    ('pne', 'pne'),
+    ('western penan', 'pne'),
+    ('pangu', 'png'),
+    ('png', 'png'),
+    ('penrhyn', 'pnh'),
+    ('pnh', 'pnh'),
+    ('aoheng', 'pni'),
+    ('pni', 'pni'),
+    ('pinjarup', 'pnj'),
+    ('pnj', 'pnj'),
+    ('paunaka', 'pnk'),
+    ('pnk', 'pnk'),
+    ('paleni', 'pnl'),
+    ('pnl', 'pnl'),
+    ('pnm', 'pnm'),
+    ('punan batu 1', 'pnm'),
+    ('pinai-hagahai', 'pnn'),
+    ('pnn', 'pnn'),
+    ('panobo', 'pno'),
+    ('pno', 'pno'),
+    ('pancana', 'pnp'),
+    ('pnp', 'pnp'),
+    ('pana', 'pnq'),
+    ('pana (burkina faso)', 'pnq'),
+    ('pnq', 'pnq'),
+    ('panim', 'pnr'),
+    ('pnr', 'pnr'),
+    ('pns', 'pns'),
+    ('ponosakan', 'pns'),
+    ('pnt', 'pnt'),
+    ('pontic', 'pnt'),
+    ('jiongnai bunu', 'pnu'),
+    ('pnu', 'pnu'),
+    ('pinigura', 'pnv'),
+    ('pnv', 'pnv'),
+    ('banyjima', 'pnw'),
+    ('pnw', 'pnw'),
+    ('phong-kniang', 'pnx'),
+    ('pnx', 'pnx'),
+    ('pinyin', 'pny'),
+    ('pny', 'pny'),
+    ('pana (central african republic)', 'pnz'),
+    ('pnz', 'pnz'),
+    ('poc', 'poc'),
+    ('poqomam', 'poc'),
+    ('poe', 'poe'),
+    ('san juan atzingo popoloca', 'poe'),
+    ('pof', 'pof'),
+    ('poke', 'pof'),
+    ('pog', 'pog'),
+    ('potiguára', 'pog'),
+    ('poh', 'poh'),
+    ('poqomchi''', 'poh'),
+    ('highland popoluca', 'poi'),
+    ('poi', 'poi'),
+    ('pok', 'pok'),
+    ('pokangá', 'pok'),
+    ('southeastern pomo', 'pom'),
+    ('pohnpeian', 'pon'),
+    ('pon', 'pon'),
+    ('central pomo', 'poo'),
+    ('poo', 'poo'),
+    ('pop', 'pop'),
+    ('pwapwâ', 'pop'),
+    ('poq', 'poq'),
+    ('texistepec popoluca', 'poq'),
+    ('pos', 'pos'),
+    ('sayula popoluca', 'pos'),
+    ('pot', 'pot'),
+    ('potawatomi', 'pot'),
+    ('pov', 'pov'),
+    ('upper guinea crioulo', 'pov'),
+    ('pow', 'pow'),
+    ('san felipe otlaltepec popoloca', 'pow'),
+    ('polabian', 'pox'),
+    ('pox', 'pox'),
+    ('pogolo', 'poy'),
+    ('poy', 'poy'),
+    ('papi', 'ppe'),
+    ('ppe', 'ppe'),
+    ('paipai', 'ppi'),
+    ('ppi', 'ppi'),
+    ('ppk', 'ppk'),
+    ('uma', 'ppk'),
+    ('pipil', 'ppl'),
+    ('ppl', 'ppl'),
+    ('papuma', 'ppm'),
+    ('ppm', 'ppm'),
+    ('papapana', 'ppn'),
+    ('ppn', 'ppn'),
+    ('folopa', 'ppo'),
+    ('ppo', 'ppo'),
+    ('pelende', 'ppp'),
+    ('ppp', 'ppp'),
+    ('ppq', 'ppq'),
+    ('pps', 'pps'),
+    ('san luís temalacayuca popoloca', 'pps'),
+    ('pare', 'ppt'),
+    ('ppt', 'ppt'),
+    ('papora', 'ppu'),
+    ('ppu', 'ppu'),
+    ('pa''a', 'pqa'),
+    ('pqa', 'pqa'),
+    ('malecite-passamaquoddy', 'pqm'),
+    ('pqm', 'pqm'),
+    ('parachi', 'prc'),
+    ('prc', 'prc'),
+    ('parsi-dari', 'prd'),
+    ('prd', 'prd'),
+    ('pre', 'pre'),
+    ('principense', 'pre'),
+    ('paranan', 'prf'),
+    ('prf', 'prf'),
+    ('prg', 'prg'),
+    ('prussian', 'prg'),
+    ('porohanon', 'prh'),
+    ('prh', 'prh'),
+    ('paicî', 'pri'),
+    ('pri', 'pri'),
+    ('parauk', 'prk'),
+    ('prk', 'prk'),
+    ('peruvian sign language', 'prl'),
+    ('prl', 'prl'),
+    ('kibiri', 'prm'),
+    ('prm', 'prm'),
+    ('prasuni', 'prn'),
+    ('prn', 'prn'),
+    ('old provençal', 'pro'),
+    ('old provençal (to 1500)', 'pro'),
+    ('pro', 'pro'),
+    ('provençal, old', 'pro'),
+    ('provençal, old (to 1500)', 'pro'),
+    ('parsi', 'prp'),
+    ('prp', 'prp'),
+    ('ashéninka perené', 'prq'),
+    ('prq', 'prq'),
+    ('prr', 'prr'),
+    ('puri', 'prr'),
+    ('dari', 'prs'),
+    ('prs', 'prs'),
+    ('phai', 'prt'),
+    ('prt', 'prt'),
+    ('pru', 'pru'),
+    ('puragi', 'pru'),
+    ('parawen', 'prw'),
+    ('prw', 'prw'),
+    ('prx', 'prx'),
+    ('purik', 'prx'),
+    ('providencia sign language', 'prz'),
+    ('prz', 'prz'),
+    ('pashto', 'ps'),
+    ('ps', 'ps'),
+    ('pus', 'ps'),
+    ('pushto', 'ps'),
+    ('asue awyu', 'psa'),
+    ('psa', 'psa'),
+    ('iranian sign language', 'psc'),
+    ('psc', 'psc'),
+    ('plains indian sign language', 'psd'),
+    ('psd', 'psd'),
+    ('central malay', 'pse'),
+    ('pse', 'pse'),
+    ('penang sign language', 'psg'),
+    ('psg', 'psg'),
+    ('psh', 'psh'),
+    ('southwest pashai', 'psh'),
+    ('psi', 'psi'),
+    ('southeast pashai', 'psi'),
+    ('psl', 'psl'),
+    ('puerto rican sign language', 'psl'),
+    ('pauserna', 'psm'),
+    ('psm', 'psm'),
+    ('panasuan', 'psn'),
+    ('psn', 'psn'),
+    ('polish sign language', 'pso'),
+    ('pso', 'pso'),
+    ('philippine sign language', 'psp'),
+    ('psp', 'psp'),
+    ('pasi', 'psq'),
+    ('psq', 'psq'),
+    ('portuguese sign language', 'psr'),
+    ('psr', 'psr'),
+    ('kaulong', 'pss'),
+    ('pss', 'pss'),
+    ('central pashto', 'pst'),
+    ('pst', 'pst'),
+    ('psu', 'psu'),
+    ('sauraseni prākrit', 'psu'),
+    ('port sandwich', 'psw'),
+    ('psw', 'psw'),
+    ('piscataway', 'psy'),
+    ('psy', 'psy'),
+    ('por', 'pt'),
+    ('portuguese', 'pt'),
+    ('pt', 'pt'),
+    ('pai tavytera', 'pta'),
+    ('pta', 'pta'),
+    ('pataxó hã-ha-hãe', 'pth'),
+    ('pth', 'pth'),
+    ('pindiini', 'pti'),
+    ('pti', 'pti'),
+    ('patani', 'ptn'),
+    ('ptn', 'ptn'),
+    ('pto', 'pto'),
+    ('zo''é', 'pto'),
+    ('patep', 'ptp'),
+    ('ptp', 'ptp'),
+    ('pattapu', 'ptq'),
+    ('ptq', 'ptq'),
+    ('piamatsina', 'ptr'),
+    ('ptr', 'ptr'),
+    ('enrekang', 'ptt'),
+    ('ptt', 'ptt'),
+    ('bambam', 'ptu'),
+    ('ptu', 'ptu'),
+    ('port vato', 'ptv'),
+    ('ptv', 'ptv'),
+    ('pentlatch', 'ptw'),
+    ('ptw', 'ptw'),
+    ('pathiya', 'pty'),
+    ('pty', 'pty'),
+    ('pua', 'pua'),
+    ('western highland purepecha', 'pua'),
+    ('pub', 'pub'),
+    ('purum', 'pub'),
+    ('puc', 'puc'),
+    ('punan merap', 'puc'),
+    ('pud', 'pud'),
+    ('punan aput', 'pud'),
+    ('pue', 'pue'),
+    ('puelche', 'pue'),
+    ('puf', 'puf'),
+    ('punan merah', 'puf'),
+    ('phuie', 'pug'),
+    ('pug', 'pug'),
+    ('pui', 'pui'),
+    ('puinave', 'pui'),
+    ('puj', 'puj'),
+    ('punan tubu', 'puj'),
+    ('pum', 'pum'),
+    ('puma', 'pum'),
+    ('puo', 'puo'),
+    ('puoc', 'puo'),
+    ('pulabu', 'pup'),
+    ('pup', 'pup'),
+    ('puq', 'puq'),
+    ('puquina', 'puq'),
+    ('pur', 'pur'),
+    ('puruborá', 'pur'),
+    ('put', 'put'),
+    ('putoh', 'put'),
+    ('punu', 'puu'),
+    ('puu', 'puu'),
+    ('puluwatese', 'puw'),
+    ('puw', 'puw'),
+    ('puare', 'pux'),
+    ('pux', 'pux'),
+    ('purisimeño', 'puy'),
+    ('puy', 'puy'),
+    ('pawaia', 'pwa'),
+    ('pwa', 'pwa'),
+    ('panawa', 'pwb'),
+    ('pwb', 'pwb'),
+    ('gapapaiwa', 'pwg'),
+    ('pwg', 'pwg'),
+    ('patwin', 'pwi'),
+    ('pwi', 'pwi'),
+    ('molbog', 'pwm'),
+    ('pwm', 'pwm'),
+    ('paiwan', 'pwn'),
+    ('pwn', 'pwn'),
+    ('pwo', 'pwo'),
+    ('pwo western karen', 'pwo'),
+    ('powari', 'pwr'),
+    ('pwr', 'pwr'),
+    ('pwo northern karen', 'pww'),
+    ('pww', 'pww'),
+    ('pxm', 'pxm'),
+    ('quetzaltepec mixe', 'pxm'),
+    ('pye', 'pye'),
+    ('pye krumen', 'pye'),
+    ('fyam', 'pym'),
+    ('pym', 'pym'),
+    ('poyanáwa', 'pyn'),
+    ('pyn', 'pyn'),
+    ('paraguayan sign language', 'pys'),
+    ('pys', 'pys'),
+    ('puyuma', 'pyu'),
+    ('pyu (myanmar)', 'pyx'),
+    ('pyx', 'pyx'),
+    ('pyen', 'pyy'),
+    ('pyy', 'pyy'),
+    ('pazeh', 'pzh'),
+    ('pzh', 'pzh'),
+    ('jejara naga', 'pzn'),
+    ('pzn', 'pzn'),
+    ('qu', 'qu'),
+    ('que', 'qu'),
+    ('quechua', 'qu'),
+    ('qua', 'qua'),
+    ('quapaw', 'qua'),
+    ('huallaga huánuco quechua', 'qub'),
+    ('qub', 'qub'),
+    ('k''iche''', 'quc'),
+    ('quc', 'quc'),
+    ('calderón highland quichua', 'qud'),
+    ('qud', 'qud'),
+    ('lambayeque quechua', 'quf'),
+    ('quf', 'quf'),
+    ('chimborazo highland quichua', 'qug'),
+    ('qug', 'qug'),
+    ('quh', 'quh'),
+    ('south bolivian quechua', 'quh'),
+    ('qui', 'qui'),
+    ('quileute', 'qui'),
+    ('chachapoyas quechua', 'quk'),
+    ('quk', 'quk'),
+    ('north bolivian quechua', 'qul'),
+    ('qul', 'qul'),
+    ('qum', 'qum'),
+    ('sipacapense', 'qum'),
+    ('quinault', 'qun'),
+    ('qun', 'qun'),
+    ('qup', 'qup'),
+    ('southern pastaza quechua', 'qup'),
+    ('quinqui', 'quq'),
+    ('quq', 'quq'),
+    ('qur', 'qur'),
+    ('yanahuanca pasco quechua', 'qur'),
+    ('qus', 'qus'),
+    ('santiago del estero quichua', 'qus'),
+    ('quv', 'quv'),
+    ('sacapulteco', 'quv'),
+    ('quw', 'quw'),
+    ('tena lowland quichua', 'quw'),
+    ('qux', 'qux'),
+    ('yauyos quechua', 'qux'),
+    ('ayacucho quechua', 'quy'),
+    ('quy', 'quy'),
+    ('cusco quechua', 'quz'),
+    ('quz', 'quz'),
+    ('ambo-pasco quechua', 'qva'),
+    ('qva', 'qva'),
+    ('cajamarca quechua', 'qvc'),
+    ('qvc', 'qvc'),
+    ('eastern apurímac quechua', 'qve'),
+    ('qve', 'qve'),
+    ('huamalíes-dos de mayo huánuco quechua', 'qvh'),
+    ('qvh', 'qvh'),
+    ('imbabura highland quichua', 'qvi'),
+    ('qvi', 'qvi'),
+    ('loja highland quichua', 'qvj'),
+    ('qvj', 'qvj'),
+    ('cajatambo north lima quechua', 'qvl'),
+    ('qvl', 'qvl'),
+    ('margos-yarowilca-lauricocha quechua', 'qvm'),
+    ('qvm', 'qvm'),
+    ('north junín quechua', 'qvn'),
+    ('qvn', 'qvn'),
+    ('napo lowland quechua', 'qvo'),
+    ('qvo', 'qvo'),
+    ('pacaraos quechua', 'qvp'),
+    ('qvp', 'qvp'),
+    ('qvs', 'qvs'),
+    ('san martín quechua', 'qvs'),
+    ('huaylla wanca quechua', 'qvw'),
+    ('qvw', 'qvw'),
+    ('queyu', 'qvy'),
+    ('qvy', 'qvy'),
+    ('northern pastaza quichua', 'qvz'),
+    ('qvz', 'qvz'),
+    ('corongo ancash quechua', 'qwa'),
+    ('qwa', 'qwa'),
+    ('classical quechua', 'qwc'),
+    ('qwc', 'qwc'),
+    ('huaylas ancash quechua', 'qwh'),
+    ('qwh', 'qwh'),
+    ('kuman (russia)', 'qwm'),
+    ('qwm', 'qwm'),
+    ('qws', 'qws'),
+    ('sihuas ancash quechua', 'qws'),
+    ('kwalhioqua-tlatskanai', 'qwt'),
+    ('qwt', 'qwt'),
+    ('chiquián ancash quechua', 'qxa'),
+    ('qxa', 'qxa'),
+    ('chincha quechua', 'qxc'),
+    ('qxc', 'qxc'),
+    ('panao huánuco quechua', 'qxh'),
+    ('qxh', 'qxh'),
+    ('qxl', 'qxl'),
+    ('salasaca highland quichua', 'qxl'),
+    ('northern conchucos ancash quechua', 'qxn'),
+    ('qxn', 'qxn'),
+    ('qxo', 'qxo'),
+    ('southern conchucos ancash quechua', 'qxo'),
+    ('puno quechua', 'qxp'),
+    ('qxp', 'qxp'),
+    ('qashqa''i', 'qxq'),
+    ('qxq', 'qxq'),
+    ('cañar highland quichua', 'qxr'),
+    ('qxr', 'qxr'),
+    ('qxs', 'qxs'),
+    ('southern qiang', 'qxs'),
+    ('qxt', 'qxt'),
+    ('santa ana de tusi pasco quechua', 'qxt'),
+    ('arequipa-la unión quechua', 'qxu'),
+    ('qxu', 'qxu'),
+    ('jauja wanca quechua', 'qxw'),
+    ('qxw', 'qxw'),
+    ('quenya', 'qya'),
+    ('qya', 'qya'),
+    ('quiripi', 'qyp'),
+    ('qyp', 'qyp'),
+    ('dungmali', 'raa'),
+    ('raa', 'raa'),
+    ('camling', 'rab'),
+    ('rab', 'rab'),
+    ('rac', 'rac'),
+    ('rasawa', 'rac'),
+    ('rad', 'rad'),
+    ('rade', 'rad'),
+    ('raf', 'raf'),
+    ('western meohang', 'raf'),
+    ('logooli', 'rag'),
+    ('rag', 'rag'),
+    ('rabha', 'rah'),
+    ('rah', 'rah'),
+    ('rai', 'rai'),
+    ('ramoaaina', 'rai'),
+    ('raj', 'raj'),
+    ('rajasthani', 'raj'),
+    ('rak', 'rak'),
+    ('tulu-bohuai', 'rak'),
+    ('ral', 'ral'),
+    ('ralte', 'ral'),
+    ('canela', 'ram'),
+    ('ram', 'ram'),
+    ('ran', 'ran'),
+    ('riantana', 'ran'),
+    ('rao', 'rao'),
+    ('rap', 'rap'),
+    ('rapanui', 'rap'),
+    ('raq', 'raq'),
+    ('saam', 'raq'),
+    ('cook islands maori', 'rar'),
+    ('rar', 'rar'),
+    ('rarotongan', 'rar'),
+    ('ras', 'ras'),
+    ('tegali', 'ras'),
+    ('rat', 'rat'),
+    ('razajerdi', 'rat'),
+    ('rau', 'rau'),
+    ('raute', 'rau'),
+    ('rav', 'rav'),
+    ('sampang', 'rav'),
+    ('raw', 'raw'),
+    ('rawang', 'raw'),
+    ('rang', 'rax'),
+    ('rax', 'rax'),
+    ('rapa', 'ray'),
+    ('ray', 'ray'),
+    ('rahambuu', 'raz'),
+    ('raz', 'raz'),
+    ('rbb', 'rbb'),
+    ('rumai palaung', 'rbb'),
+    ('northern bontok', 'rbk'),
+    ('rbk', 'rbk'),
+    ('miraya bikol', 'rbl'),
+    ('rbl', 'rbl'),
+    ('barababaraba', 'rbp'),
+    ('rbp', 'rbp'),
+    ('rcf', 'rcf'),
+    ('réunion creole french', 'rcf'),
+    ('rdb', 'rdb'),
+    ('rudbari', 'rdb'),
+    ('rea', 'rea'),
+    ('rerau', 'rea'),
+    ('reb', 'reb'),
+    ('rembong', 'reb'),
+    ('ree', 'ree'),
+    ('rejang kayan', 'ree'),
+    ('kara (tanzania)', 'reg'),
+    ('reg', 'reg'),
+    ('rei', 'rei'),
+    ('reli', 'rei'),
+    ('rej', 'rej'),
+    ('rejang', 'rej'),
+    ('rel', 'rel'),
+    ('rendille', 'rel'),
+    ('rem', 'rem'),
+    ('remo', 'rem'),
+    ('ren', 'ren'),
+    ('rengao', 'ren'),
+    ('rer', 'rer'),
+    ('rer bare', 'rer'),
+    ('res', 'res'),
+    ('reshe', 'res'),
+    ('ret', 'ret'),
+    ('retta', 'ret'),
+    ('rey', 'rey'),
+    ('reyesano', 'rey'),
+    ('rga', 'rga'),
+    ('roria', 'rga'),
+    ('rge', 'rge'),
+    ('romano-greek', 'rge'),
+    ('rangkas', 'rgk'),
+    ('rgk', 'rgk'),
+    ('rgn', 'rgn'),
+    ('romagnol', 'rgn'),
+    ('resígaro', 'rgr'),
+    ('rgr', 'rgr'),
+    ('rgs', 'rgs'),
+    ('southern roglai', 'rgs'),
+    ('rgu', 'rgu'),
+    ('ringgou', 'rgu'),
+    ('rhg', 'rhg'),
+    ('rohingya', 'rhg'),
+    ('rhp', 'rhp'),
+    ('yahang', 'rhp'),
+    ('ria', 'ria'),
+    ('riang', 'ria'),
+    ('riang (india)', 'ria'),
+    ('bribri sign language', 'rib'),
+    ('rib', 'rib'),
+    ('rif', 'rif'),
+    ('tarifit', 'rif'),
+    ('riang lang', 'ril'),
+    ('ril', 'ril'),
+    ('nyaturu', 'rim'),
+    ('rim', 'rim'),
+    ('nungu', 'rin'),
+    ('rin', 'rin'),
+    ('ribun', 'rir'),
+    ('rir', 'rir'),
+    ('rit', 'rit'),
+    ('ritharrngu', 'rit'),
+    ('riu', 'riu'),
+    ('riung', 'riu'),
+    ('rajong', 'rjg'),
+    ('rjg', 'rjg'),
+    ('raji', 'rji'),
+    ('rji', 'rji'),
+    ('rajbanshi', 'rjs'),
+    ('rjs', 'rjs'),
+    ('kraol', 'rka'),
+    ('rka', 'rka'),
+    ('rikbaktsa', 'rkb'),
+    ('rkb', 'rkb'),
+    ('rakahanga-manihiki', 'rkh'),
+    ('rkh', 'rkh'),
+    ('rakhine', 'rki'),
+    ('rki', 'rki'),
+    ('marka', 'rkm'),
+    ('rkm', 'rkm'),
+    ('rangpuri', 'rkt'),
+    ('rkt', 'rkt'),
+    ('arakwal', 'rkw'),
+    ('rkw', 'rkw'),
+    ('rm', 'rm'),
+    ('roh', 'rm'),
+    ('romansh', 'rm'),
+    ('rama', 'rma'),
+    ('rma', 'rma'),
+    ('rembarrnga', 'rmb'),
+    ('rmb', 'rmb'),
+    ('carpathian romani', 'rmc'),
+    ('rmc', 'rmc'),
+    ('rmd', 'rmd'),
+    ('traveller danish', 'rmd'),
+    ('angloromani', 'rme'),
+    ('rme', 'rme'),
+    ('kalo finnish romani', 'rmf'),
+    ('rmf', 'rmf'),
+    ('rmg', 'rmg'),
+    ('traveller norwegian', 'rmg'),
+    ('murkim', 'rmh'),
+    ('rmh', 'rmh'),
+    ('lomavren', 'rmi'),
+    ('rmi', 'rmi'),
+    ('rmk', 'rmk'),
+    ('romkun', 'rmk'),
+    ('baltic romani', 'rml'),
+    ('rml', 'rml'),
+    ('rmm', 'rmm'),
+    ('roma', 'rmm'),
+    ('balkan romani', 'rmn'),
+    ('rmn', 'rmn'),
+    ('rmo', 'rmo'),
+    ('sinte romani', 'rmo'),
+    ('rempi', 'rmp'),
+    ('rmp', 'rmp'),
+    ('caló', 'rmq'),
+    ('rmq', 'rmq'),
+    ('rms', 'rms'),
+    ('romanian sign language', 'rms'),
+    ('domari', 'rmt'),
+    ('rmt', 'rmt'),
+    ('rmu', 'rmu'),
+    ('tavringer romani', 'rmu'),
+    ('rmv', 'rmv'),
+    ('romanova', 'rmv'),
+    ('rmw', 'rmw'),
+    ('welsh romani', 'rmw'),
+    ('rmx', 'rmx'),
+    ('romam', 'rmx'),
+    ('rmy', 'rmy'),
+    ('vlax romani', 'rmy'),
+    ('marma', 'rmz'),
+    ('rmz', 'rmz'),
+    ('rn', 'rn'),
+    ('run', 'rn'),
+    ('rundi', 'rn'),
+    ('brunca sign language', 'rnb'),
+    ('rnb', 'rnb'),
+    ('rnd', 'rnd'),
+    ('ruund', 'rnd'),
+    ('rng', 'rng'),
+    ('ronga', 'rng'),
+    ('ranglong', 'rnl'),
+    ('rnl', 'rnl'),
+    ('rnn', 'rnn'),
+    ('roon', 'rnn'),
+    ('rnp', 'rnp'),
+    ('rongpo', 'rnp'),
+    ('nari nari', 'rnr'),
+    ('rnr', 'rnr'),
+    ('rnw', 'rnw'),
+    ('rungwa', 'rnw'),
+    ('moldavian', 'ro'),
+    ('moldovan', 'ro'),
+    ('ro', 'ro'),
+    ('romanian', 'ro'),
+    ('ron', 'ro'),
+    ('rum', 'ro'),
+    ('rob', 'rob'),
+    ('tae''', 'rob'),
+    ('cacgia roglai', 'roc'),
+    ('roc', 'roc'),
+    ('rod', 'rod'),
+    ('rogo', 'rod'),
+    ('roe', 'roe'),
+    ('ronji', 'roe'),
+    ('rof', 'rof'),
+    ('rombo', 'rof'),
+    ('northern roglai', 'rog'),
+    ('rog', 'rog'),
+    ('rol', 'rol'),
+    ('romblomanon', 'rol'),
+    ('rom', 'rom'),
+    ('romany', 'rom'),
+    ('roo', 'roo'),
+    ('rotokas', 'roo'),
+    ('kriol', 'rop'),
+    ('rop', 'rop'),
+    ('rongga', 'ror'),
+    ('ror', 'ror'),
+    ('rou', 'rou'),
+    ('runga', 'rou'),
+    ('dela-oenale', 'row'),
+    ('row', 'row'),
+    ('repanbitip', 'rpn'),
+    ('rpn', 'rpn'),
+    ('rapting', 'rpt'),
+    ('rpt', 'rpt'),
+    ('ririo', 'rri'),
+    ('rri', 'rri'),
+    ('rro', 'rro'),
+    ('waima', 'rro'),
+    ('arritinngithigh', 'rrt'),
+    ('rrt', 'rrt'),
+    ('romano-serbian', 'rsb'),
+    ('rsb', 'rsb'),
+    ('rsk', 'rsk'),
+    ('ruthenian', 'rsk'),
+    ('rsl', 'rsl'),
+    ('russian sign language', 'rsl'),
+    ('miriwoong sign language', 'rsm'),
+    ('rsm', 'rsm'),
+    ('rsn', 'rsn'),
+    ('rwandan sign language', 'rsn'),
+    ('rtc', 'rtc'),
+    ('rungtu chin', 'rtc'),
+    ('ratahan', 'rth'),
+    ('rth', 'rth'),
+    ('rotuman', 'rtm'),
+    ('rtm', 'rtm'),
+    ('rts', 'rts'),
+    ('yurats', 'rts'),
+    ('rathawi', 'rtw'),
+    ('rtw', 'rtw'),
+    ('ru', 'ru'),
+    ('rus', 'ru'),
+    ('russian', 'ru'),
+    ('gungu', 'rub'),
+    ('rub', 'rub'),
+    ('ruc', 'ruc'),
+    ('ruuli', 'ruc'),
+    ('rue', 'rue'),
+    ('rusyn', 'rue'),
+    ('luguru', 'ruf'),
+    ('ruf', 'ruf'),
+    ('roviana', 'rug'),
+    ('rug', 'rug'),
+    ('ruga', 'ruh'),
+    ('ruh', 'ruh'),
+    ('rufiji', 'rui'),
+    ('rui', 'rui'),
+    ('ruk', 'ruk'),
+    ('istro romanian', 'ruo'),
+    ('ruo', 'ruo'),
+    ('aromanian', 'rup'),
+    ('arumanian', 'rup'),
+    ('macedo-romanian', 'rup'),
+    ('rup', 'rup'),
+    ('megleno romanian', 'ruq'),
+    ('ruq', 'ruq'),
+    ('rut', 'rut'),
+    ('rutul', 'rut'),
+    ('lanas lobu', 'ruu'),
+    ('ruu', 'ruu'),
+    ('mala (nigeria)', 'ruy'),
+    ('ruy', 'ruy'),
+    ('ruma', 'ruz'),
+    ('ruz', 'ruz'),
+    ('kin', 'rw'),
+    ('kinyarwanda', 'rw'),
+    ('rw', 'rw'),
+    ('rawo', 'rwa'),
+    ('rwa', 'rwa'),
+    ('rwk', 'rwk'),
+    ('ruwila', 'rwl'),
+    ('rwl', 'rwl'),
+    ('amba', 'rwm'),
+    ('amba (uganda)', 'rwm'),
+    ('rwm', 'rwm'),
+    ('rawa', 'rwo'),
+    ('rwo', 'rwo'),
+    ('marwari (india)', 'rwr'),
+    ('rwr', 'rwr'),
+    ('ngardi', 'rxd'),
+    ('rxd', 'rxd'),
+    ('karuwali', 'rxw'),
+    ('rxw', 'rxw'),
+    ('northern amami-oshima', 'ryn'),
+    ('ryn', 'ryn'),
+    ('rys', 'rys'),
+    ('yaeyama', 'rys'),
+    ('central okinawan', 'ryu'),
+    ('ryu', 'ryu'),
+    ('rzh', 'rzh'),
+    ('rāziḥī', 'rzh'),
+    ('sa', 'sa'),
+    ('san', 'sa'),
+    ('sanskrit', 'sa'),
+    ('saa', 'saa'),
+    ('saba', 'saa'),
+    ('buglere', 'sab'),
+    ('sab', 'sab'),
+    ('meskwaki', 'sac'),
+    ('sac', 'sac'),
+    ('sad', 'sad'),
+    ('sandawe', 'sad'),
+    ('sabanê', 'sae'),
+    ('sae', 'sae'),
+    ('saf', 'saf'),
+    ('safaliba', 'saf'),
+    ('sah', 'sah'),
+    ('yakut', 'sah'),
+    ('sahu', 'saj'),
+    ('saj', 'saj'),
+    ('sak', 'sak'),
+    ('sake', 'sak'),
+    ('sam', 'sam'),
+    ('samaritan aramaic', 'sam'),
+    ('sao', 'sao'),
+    ('sause', 'sao'),
+    ('samburu', 'saq'),
+    ('saq', 'saq'),
+    ('saraveca', 'sar'),
+    ('sas', 'sas'),
+    ('sasak', 'sas'),
+    ('santali', 'sat'),
+    ('sat', 'sat'),
+    ('saleman', 'sau'),
+    ('sau', 'sau'),
+    ('saafi-saafi', 'sav'),
+    ('sav', 'sav'),
+    ('saw', 'saw'),
+    ('sawi', 'saw'),
+    ('sax', 'sax'),
+    ('say', 'say'),
+    ('saya', 'say'),
+    ('saurashtra', 'saz'),
+    ('saz', 'saz'),
+    ('ngambay', 'sba'),
+    ('sba', 'sba'),
+    ('sbb', 'sbb'),
+    ('simbo', 'sbb'),
+    ('kele (papua new guinea)', 'sbc'),
+    ('sbc', 'sbc'),
+    ('sbd', 'sbd'),
+    ('southern samo', 'sbd'),
+    ('saliba', 'sbe'),
+    ('sbe', 'sbe'),
+    ('chabu', 'sbf'),
+    ('sbf', 'sbf'),
+    ('sbg', 'sbg'),
+    ('seget', 'sbg'),
+    ('sbh', 'sbh'),
+    ('sori-harengan', 'sbh'),
+    ('sbi', 'sbi'),
+    ('seti', 'sbi'),
+    ('sbj', 'sbj'),
+    ('surbakhal', 'sbj'),
+    ('safwa', 'sbk'),
+    ('sbk', 'sbk'),
+    ('botolan sambal', 'sbl'),
+    ('sbl', 'sbl'),
+    ('sagala', 'sbm'),
+    ('sbm', 'sbm'),
+    ('sbn', 'sbn'),
+    ('sindhi bhil', 'sbn'),
+    ('sabüm', 'sbo'),
+    ('sbo', 'sbo'),
+    ('sangu', 'sbp'),
+    ('sangu (tanzania)', 'sbp'),
+    ('sbp', 'sbp'),
+    ('sbq', 'sbq'),
+    ('sileibi', 'sbq'),
+    ('sbr', 'sbr'),
+    ('sembakung murut', 'sbr'),
+    ('sbs', 'sbs'),
+    ('subiya', 'sbs'),
+    ('kimki', 'sbt'),
+    ('sbt', 'sbt'),
+    ('sbu', 'sbu'),
+    ('stod bhoti', 'sbu'),
+    ('sabine', 'sbv'),
+    ('sbv', 'sbv'),
+    ('sbw', 'sbw'),
+    ('simba', 'sbw'),
+    ('sbx', 'sbx'),
+    ('seberuang', 'sbx'),
+    ('sby', 'sby'),
+    ('soli', 'sby'),
+    ('sara kaba', 'sbz'),
+    ('sbz', 'sbz'),
+    ('sardinian', 'sc'),
+    ('sc', 'sc'),
+    ('srd', 'sc'),
+    ('chut', 'scb'),
+    ('scb', 'scb'),
+    ('dongxiang', 'sce'),
+    ('sce', 'sce'),
+    ('san miguel creole french', 'scf'),
+    ('scf', 'scf'),
+    ('sanggau', 'scg'),
+    ('scg', 'scg'),
+    ('sakachep', 'sch'),
+    ('sch', 'sch'),
+    ('sci', 'sci'),
+    ('sri lankan creole malay', 'sci'),
+    ('sadri', 'sck'),
+    ('sck', 'sck'),
+    ('scl', 'scl'),
+    ('shina', 'scl'),
+    ('scn', 'scn'),
+    ('sicilian', 'scn'),
+    ('sco', 'sco'),
+    ('scots', 'sco'),
+    ('hyolmo', 'scp'),
+    ('scp', 'scp'),
+    ('sa''och', 'scq'),
+    ('scq', 'scq'),
+    ('north slavey', 'scs'),
+    ('scs', 'scs'),
+    ('sct', 'sct'),
+    ('southern katang', 'sct'),
+    ('scu', 'scu'),
+    ('shumcho', 'scu'),
+    ('scv', 'scv'),
+    ('sheni', 'scv'),
+    ('scw', 'scw'),
+    ('sha', 'scw'),
+    ('scx', 'scx'),
+    ('sicel', 'scx'),
+    ('sd', 'sd'),
+    ('sindhi', 'sd'),
+    ('snd', 'sd'),
+    ('sda', 'sda'),
+    ('toraja-sa''dan', 'sda'),
+    ('sdb', 'sdb'),
+    ('shabak', 'sdb'),
+    ('sassarese sardinian', 'sdc'),
+    ('sdc', 'sdc'),
+    ('sde', 'sde'),
+    ('surubu', 'sde'),
+    ('sarli', 'sdf'),
+    ('sdf', 'sdf'),
+    ('savi', 'sdg'),
+    ('sdg', 'sdg'),
+    ('sdh', 'sdh'),
+    ('southern kurdish', 'sdh'),
+    ('sdj', 'sdj'),
+    ('suundi', 'sdj'),
+    ('sdk', 'sdk'),
+    ('sos kundi', 'sdk'),
+    ('saudi arabian sign language', 'sdl'),
+    ('sdl', 'sdl'),
+    ('gallurese sardinian', 'sdn'),
+    ('sdn', 'sdn'),
+    ('bukar-sadung bidayuh', 'sdo'),
+    ('sdo', 'sdo'),
+    ('sdp', 'sdp'),
+    ('sherdukpen', 'sdp'),
+    ('sdq', 'sdq'),
+    ('semandang', 'sdq'),
+    ('oraon sadri', 'sdr'),
+    ('sdr', 'sdr'),
+    ('sds', 'sds'),
+    ('sened', 'sds'),
+    ('sdt', 'sdt'),
+    ('shuadit', 'sdt'),
+    ('sarudu', 'sdu'),
+    ('sdu', 'sdu'),
+    ('sdx', 'sdx'),
+    ('sibu melanau', 'sdx'),
+    ('sallands', 'sdz'),
+    ('sdz', 'sdz'),
+    ('northern sami', 'se'),
+    ('se', 'se'),
+    ('sme', 'se'),
+    ('sea', 'sea'),
+    ('semai', 'sea'),
+    ('seb', 'seb'),
+    ('shempire senoufo', 'seb'),
+    ('sec', 'sec'),
+    ('sechelt', 'sec'),
+    ('sed', 'sed'),
+    ('sedang', 'sed'),
+    ('see', 'see'),
+    ('seneca', 'see'),
+    ('cebaara senoufo', 'sef'),
+    ('sef', 'sef'),
+    ('seg', 'seg'),
+    ('segeju', 'seg'),
+    ('seh', 'seh'),
+    ('sena', 'seh'),
+    ('sei', 'sei'),
+    ('seri', 'sei'),
+    ('sej', 'sej'),
+    ('sene', 'sej'),
+    ('sek', 'sek'),
+    ('sekani', 'sek'),
+    ('sel', 'sel'),
+    ('selkup', 'sel'),
+    ('nanerigé sénoufo', 'sen'),
+    ('sen', 'sen'),
+    ('seo', 'seo'),
+    ('suarmin', 'seo'),
+    ('sep', 'sep'),
+    ('sìcìté sénoufo', 'sep'),
+    ('senara sénoufo', 'seq'),
+    ('seq', 'seq'),
+    ('ser', 'ser'),
+    ('serrano', 'ser'),
+    ('koyraboro senni songhai', 'ses'),
+    ('ses', 'ses'),
+    ('sentani', 'set'),
+    ('set', 'set'),
+    ('serui-laut', 'seu'),
+    ('seu', 'seu'),
+    ('nyarafolo senoufo', 'sev'),
+    ('sev', 'sev'),
+    ('sew', 'sew'),
+    ('sewa bay', 'sew'),
+    ('secoya', 'sey'),
+    ('sey', 'sey'),
+    ('senthang chin', 'sez'),
+    ('sez', 'sez'),
+    ('langue des signes de belgique francophone', 'sfb'),
+    ('sfb', 'sfb'),
+    ('eastern subanen', 'sfe'),
+    ('sfe', 'sfe'),
+    ('sfm', 'sfm'),
+    ('small flowery miao', 'sfm'),
+    ('sfs', 'sfs'),
+    ('south african sign language', 'sfs'),
+    ('sehwi', 'sfw'),
+    ('sfw', 'sfw'),
+    ('sag', 'sg'),
+    ('sango', 'sg'),
+    ('sg', 'sg'),
+    ('irish, old', 'sga'),
+    ('irish, old (to 900)', 'sga'),
+    ('old irish', 'sga'),
+    ('old irish (to 900)', 'sga'),
+    ('sga', 'sga'),
+    ('mag-antsi ayta', 'sgb'),
+    ('sgb', 'sgb'),
+    ('kipsigis', 'sgc'),
+    ('sgc', 'sgc'),
+    ('sgd', 'sgd'),
+    ('surigaonon', 'sgd'),
+    ('segai', 'sge'),
+    ('sge', 'sge'),
+    ('sgg', 'sgg'),
+    ('swiss-german sign language', 'sgg'),
+    ('sgh', 'sgh'),
+    ('shughni', 'sgh'),
+    ('sgi', 'sgi'),
+    ('suga', 'sgi'),
+    ('sgj', 'sgj'),
+    ('surgujia', 'sgj'),
+    ('sangkong', 'sgk'),
+    ('sgk', 'sgk'),
+    ('sgm', 'sgm'),
+    ('singa', 'sgm'),
+    ('sgp', 'sgp'),
+    ('singpho', 'sgp'),
+    ('sangisari', 'sgr'),
+    ('sgr', 'sgr'),
+    ('samogitian', 'sgs'),
+    ('sgs', 'sgs'),
+    ('brokpake', 'sgt'),
+    ('sgt', 'sgt'),
+    ('salas', 'sgu'),
+    ('sgu', 'sgu'),
+    ('sebat bet gurage', 'sgw'),
+    ('sgw', 'sgw'),
+    ('sgx', 'sgx'),
+    ('sierra leone sign language', 'sgx'),
+    ('sanglechi', 'sgy'),
+    ('sgy', 'sgy'),
+    ('sgz', 'sgz'),
+    ('sursurunga', 'sgz'),
+    ('hbs', 'sh'),
+    ('serbo-croatian', 'sh'),
+    ('sh', 'sh'),
+    ('shall-zwall', 'sha'),
+    ('ninam', 'shb'),
+    ('shb', 'shb'),
+    ('shc', 'shc'),
+    ('sonde', 'shc'),
+    ('kundal shahi', 'shd'),
+    ('shd', 'shd'),
+    ('she', 'she'),
+    ('sheko', 'she'),
+    ('shg', 'shg'),
+    ('shua', 'shg'),
+    ('shh', 'shh'),
+    ('shoshoni', 'shh'),
+    ('shi', 'shi'),
+    ('tachelhit', 'shi'),
+    ('shatt', 'shj'),
+    ('shj', 'shj'),
+    ('shilluk', 'shk'),
+    ('shk', 'shk'),
+    ('shendu', 'shl'),
+    ('shl', 'shl'),
+    ('shahrudi', 'shm'),
+    ('shm', 'shm'),
+    ('shan', 'shn'),
+    ('shn', 'shn'),
+    ('shanga', 'sho'),
+    ('sho', 'sho'),
+    ('shipibo-conibo', 'shp'),
+    ('shp', 'shp'),
+    ('sala', 'shq'),
+    ('shq', 'shq'),
+    ('shr', 'shr'),
+    ('shs', 'shs'),
+    ('shuswap', 'shs'),
+    ('shasta', 'sht'),
+    ('sht', 'sht'),
+    ('chadian arabic', 'shu'),
+    ('shu', 'shu'),
+    ('shehri', 'shv'),
+    ('shv', 'shv'),
+    ('shw', 'shw'),
+    ('shwai', 'shw'),
+    ('shx', 'shx'),
+    ('shy', 'shy'),
+    ('tachawit', 'shy'),
+    ('shz', 'shz'),
+    ('syenara senoufo', 'shz'),
+    ('si', 'si'),
+    ('sin', 'si'),
+    ('sinhala', 'si'),
+    ('sinhalese', 'si'),
+    ('akkala sami', 'sia'),
+    ('sia', 'sia'),
+    ('sebop', 'sib'),
+    ('sib', 'sib'),
+    ('sid', 'sid'),
+    ('sidamo', 'sid'),
+    ('simaa', 'sie'),
+    ('siamou', 'sif'),
+    ('sif', 'sif'),
+    ('paasaal', 'sig'),
+    ('sig', 'sig'),
+    ('sih', 'sih'),
+    ('zire', 'sih'),
+    ('shom peng', 'sii'),
+    ('sii', 'sii'),
+    ('numbami', 'sij'),
+    ('sij', 'sij'),
+    ('sik', 'sik'),
+    ('sikiana', 'sik'),
+    ('sil', 'sil'),
+    ('tumulung sisaala', 'sil'),
+    ('mende (papua new guinea)', 'sim'),
+    ('sim', 'sim'),
+    ('sikkimese', 'sip'),
+    ('sip', 'sip'),
+    ('siq', 'siq'),
+    ('sonia', 'siq'),
+    ('sir', 'sir'),
+    ('siri', 'sir'),
+    ('sis', 'sis'),
+    ('siuslaw', 'sis'),
+    ('sinagen', 'siu'),
+    ('siu', 'siu'),
+    ('siv', 'siv'),
+    ('sumariup', 'siv'),
+    ('siw', 'siw'),
+    ('siwai', 'siw'),
+    ('six', 'six'),
+    ('sumau', 'six'),
+    ('sivandi', 'siy'),
+    ('siy', 'siy'),
+    ('siwi', 'siz'),
+    ('siz', 'siz'),
+    ('epena', 'sja'),
+    ('sja', 'sja'),
+    ('sajau basap', 'sjb'),
+    ('sjb', 'sjb'),
+    ('kildin sami', 'sjd'),
+    ('sjd', 'sjd'),
+    ('pite sami', 'sje'),
+    ('sje', 'sje'),
+    ('assangori', 'sjg'),
+    ('sjg', 'sjg'),
+    ('kemi sami', 'sjk'),
+    ('sjk', 'sjk'),
+    ('sajalong', 'sjl'),
+    ('sjl', 'sjl'),
+    ('mapun', 'sjm'),
+    ('sjm', 'sjm'),
+    ('sindarin', 'sjn'),
+    ('sjn', 'sjn'),
+    ('sjo', 'sjo'),
+    ('xibe', 'sjo'),
+    ('sjp', 'sjp'),
+    ('surjapuri', 'sjp'),
+    ('siar-lak', 'sjr'),
+    ('sjr', 'sjr'),
+    ('senhaja de srair', 'sjs'),
+    ('sjs', 'sjs'),
+    ('sjt', 'sjt'),
+    ('ter sami', 'sjt'),
+    ('sju', 'sju'),
+    ('ume sami', 'sju'),
+    ('shawnee', 'sjw'),
+    ('sjw', 'sjw'),
+    ('sk', 'sk'),
+    ('slk', 'sk'),
+    ('slo', 'sk'),
+    ('slovak', 'sk'),
+    ('ska', 'ska'),
+    ('skagit', 'ska'),
+    ('saek', 'skb'),
+    ('skb', 'skb'),
+    ('ma manda', 'skc'),
+    ('skc', 'skc'),
+    ('skd', 'skd'),
+    ('southern sierra miwok', 'skd'),
+    ('seke', 'ske'),
+    ('seke (vanuatu)', 'ske'),
+    ('ske', 'ske'),
+    ('sakirabiá', 'skf'),
+    ('skf', 'skf'),
+    ('sakalava malagasy', 'skg'),
+    ('skg', 'skg'),
+    ('sikule', 'skh'),
+    ('skh', 'skh'),
+    ('sika', 'ski'),
+    ('ski', 'ski'),
+    ('seke (nepal)', 'skj'),
+    ('skj', 'skj'),
+    ('kutong', 'skm'),
+    ('skm', 'skm'),
+    ('kolibugan subanon', 'skn'),
+    ('skn', 'skn'),
+    ('seko tengah', 'sko'),
+    ('sko', 'sko'),
+    ('sekapan', 'skp'),
+    ('skp', 'skp'),
+    ('sininkere', 'skq'),
+    ('skq', 'skq'),
+    ('saraiki', 'skr'),
+    ('skr', 'skr'),
+    ('maia', 'sks'),
+    ('sks', 'sks'),
+    ('sakata', 'skt'),
+    ('skt', 'skt'),
+    ('sakao', 'sku'),
+    ('sku', 'sku'),
+    ('skou', 'skv'),
+    ('skv', 'skv'),
+    ('skepi creole dutch', 'skw'),
+    ('skw', 'skw'),
+    ('seko padang', 'skx'),
+    ('skx', 'skx'),
+    ('sikaiana', 'sky'),
+    ('sky', 'sky'),
+    ('sekar', 'skz'),
+    ('skz', 'skz'),
+    ('sl', 'sl'),
+    ('slovenian', 'sl'),
+    ('slv', 'sl'),
+    ('slc', 'slc'),
+    ('sáliba', 'slc'),
+    ('sissala', 'sld'),
+    ('sld', 'sld'),
+    ('sholaga', 'sle'),
+    ('sle', 'sle'),
+    ('slf', 'slf'),
+    ('swiss-italian sign language', 'slf'),
+    ('selungai murut', 'slg'),
+    ('slg', 'slg'),
+    ('slh', 'slh'),
+    ('southern puget sound salish', 'slh'),
+    ('lower silesian', 'sli'),
+    ('sli', 'sli'),
+    ('salumá', 'slj'),
+    ('slj', 'slj'),
+    ('salt-yui', 'sll'),
+    ('sll', 'sll'),
+    ('pangutaran sama', 'slm'),
+    ('slm', 'slm'),
+    ('salinan', 'sln'),
+    ('sln', 'sln'),
+    ('lamaholot', 'slp'),
+    ('slp', 'slp'),
+    ('salchuq', 'slq'),
+    ('slq', 'slq'),
+    ('salar', 'slr'),
+    ('slr', 'slr'),
+    ('singapore sign language', 'sls'),
+    ('sls', 'sls'),
+    ('sila', 'slt'),
+    ('slt', 'slt'),
+    ('selaru', 'slu'),
+    ('slu', 'slu'),
+    ('sialum', 'slw'),
+    ('slw', 'slw'),
+    ('salampasu', 'slx'),
+    ('slx', 'slx'),
+    ('selayar', 'sly'),
+    ('sly', 'sly'),
+    ('ma''ya', 'slz'),
+    ('slz', 'slz'),
+    ('samoan', 'sm'),
+    ('sm', 'sm'),
+    ('smo', 'sm'),
+    ('sma', 'sma'),
+    ('southern sami', 'sma'),
+    ('simbari', 'smb'),
+    ('smb', 'smb'),
+    ('smc', 'smc'),
+    ('auwe', 'smf'),
+    ('smf', 'smf'),
+    ('simbali', 'smg'),
+    ('smg', 'smg'),
+    ('samei', 'smh'),
+    ('smh', 'smh'),
+    ('lule sami', 'smj'),
+    ('smj', 'smj'),
+    ('bolinao', 'smk'),
+    ('smk', 'smk'),
+    ('central sama', 'sml'),
+    ('sml', 'sml'),
+    ('musasa', 'smm'),
+    ('smm', 'smm'),
+    ('inari sami', 'smn'),
+    ('smn', 'smn'),
+    ('samaritan', 'smp'),
+    ('smp', 'smp'),
+    ('samo', 'smq'),
+    ('smq', 'smq'),
+    ('simeulue', 'smr'),
+    ('smr', 'smr'),
+    ('skolt sami', 'sms'),
+    ('sms', 'sms'),
+    ('simte', 'smt'),
+    ('smt', 'smt'),
+    ('smu', 'smu'),
+    ('somray', 'smu'),
+    ('samvedi', 'smv'),
+    ('smv', 'smv'),
+    ('smw', 'smw'),
+    ('sumbawa', 'smw'),
+    ('samba', 'smx'),
+    ('smx', 'smx'),
+    ('semnani', 'smy'),
+    ('smy', 'smy'),
+    ('simeku', 'smz'),
+    ('smz', 'smz'),
+    ('shona', 'sn'),
+    ('sn', 'sn'),
+    ('sna', 'sn'),
+    ('sinaugoro', 'snc'),
+    ('snc', 'snc'),
+    ('bau bidayuh', 'sne'),
+    ('sne', 'sne'),
+    ('noon', 'snf'),
+    ('snf', 'snf'),
+    ('sanga', 'sng'),
+    ('sanga (democratic republic of congo)', 'sng'),
+    ('sng', 'sng'),
+    ('sensi', 'sni'),
+    ('sni', 'sni'),
+    ('riverain sango', 'snj'),
+    ('snj', 'snj'),
+    ('snk', 'snk'),
+    ('soninke', 'snk'),
+    ('sangil', 'snl'),
+    ('snl', 'snl'),
+    ('snm', 'snm'),
+    ('southern ma''di', 'snm'),
+    ('siona', 'snn'),
+    ('snn', 'snn'),
+    ('sno', 'sno'),
+    ('snohomish', 'sno'),
+    ('siane', 'snp'),
+    ('snp', 'snp'),
+    ('sangu (gabon)', 'snq'),
+    ('snq', 'snq'),
+    ('sihan', 'snr'),
+    ('snr', 'snr'),
+    ('sns', 'sns'),
+    ('south west bay', 'sns'),
+    ('senggi', 'snu'),
+    ('snu', 'snu'),
+    ('sa''ban', 'snv'),
+    ('snv', 'snv'),
+    ('selee', 'snw'),
+    ('snw', 'snw'),
+    ('snx', 'snx'),
+    ('saniyo-hiyewe', 'sny'),
+    ('sny', 'sny'),
+    ('snz', 'snz'),
+    ('so', 'so'),
+    ('som', 'so'),
+    ('somali', 'so'),
+    ('soa', 'soa'),
+    ('thai song', 'soa'),
+    ('sob', 'sob'),
+    ('sobei', 'sob'),
+    ('so (democratic republic of congo)', 'soc'),
+    ('soc', 'soc'),
+    ('sod', 'sod'),
+    ('songoora', 'sod'),
+    ('soe', 'soe'),
+    ('songomeno', 'soe'),
+    ('sog', 'sog'),
+    ('sogdian', 'sog'),
+    ('soh', 'soh'),
+    ('soi', 'soi'),
+    ('sonha', 'soi'),
+    ('soj', 'soj'),
+    ('sok', 'sok'),
+    ('sokoro', 'sok'),
+    ('sol', 'sol'),
+    ('solos', 'sol'),
+    ('songo', 'soo'),
+    ('soo', 'soo'),
+    ('songe', 'sop'),
+    ('sop', 'sop'),
+    ('kanasi', 'soq'),
+    ('soq', 'soq'),
+    ('somrai', 'sor'),
+    ('sor', 'sor'),
+    ('seeku', 'sos'),
+    ('sos', 'sos'),
+    ('sou', 'sou'),
+    ('southern thai', 'sou'),
+    ('sonsorol', 'sov'),
+    ('sov', 'sov'),
+    ('sow', 'sow'),
+    ('sowanda', 'sow'),
+    ('sox', 'sox'),
+    ('swo', 'sox'),
+    ('miyobe', 'soy'),
+    ('soy', 'soy'),
+    ('soz', 'soz'),
+    ('temi', 'soz'),
+    ('sepa', 'spb'),
+    ('sepa (indonesia)', 'spb'),
+    ('spb', 'spb'),
+    ('sapé', 'spc'),
+    ('spc', 'spc'),
+    ('saep', 'spd'),
+    ('spd', 'spd'),
+    ('sepa (papua new guinea)', 'spe'),
+    ('spe', 'spe'),
+    ('sian', 'spg'),
+    ('spg', 'spg'),
+    ('saponi', 'spi'),
+    ('spi', 'spi'),
+    ('sengo', 'spk'),
+    ('spk', 'spk'),
+    ('selepet', 'spl'),
+    ('spl', 'spl'),
+    ('akukem', 'spm'),
+    ('spm', 'spm'),
+    ('sanapaná', 'spn'),
+    ('spn', 'spn'),
+    ('spo', 'spo'),
+    ('spokane', 'spo'),
+    ('spp', 'spp'),
+    ('supyire senoufo', 'spp'),
+    ('loreto-ucayali spanish', 'spq'),
+    ('spq', 'spq'),
+    ('saparua', 'spr'),
+    ('spr', 'spr'),
+    ('saposa', 'sps'),
+    ('sps', 'sps'),
+    ('spiti bhoti', 'spt'),
+    ('spt', 'spt'),
+    ('sapuan', 'spu'),
+    ('spu', 'spu'),
+    ('sambalpuri', 'spv'),
+    ('spv', 'spv'),
+    ('south picene', 'spx'),
+    ('spx', 'spx'),
+    ('sabaot', 'spy'),
+    ('spy', 'spy'),
+    ('alb', 'sq'),
+    ('albanian', 'sq'),
+    ('sq', 'sq'),
+    ('sqi', 'sq'),
+    ('shama-sambuga', 'sqa'),
+    ('sqa', 'sqa'),
+    ('shau', 'sqh'),
+    ('sqh', 'sqh'),
+    ('albanian sign language', 'sqk'),
+    ('sqk', 'sqk'),
+    ('sqm', 'sqm'),
+    ('suma', 'sqm'),
+    ('sqn', 'sqn'),
+    ('susquehannock', 'sqn'),
+    ('sorkhei', 'sqo'),
+    ('sqo', 'sqo'),
+    ('sqq', 'sqq'),
+    ('siculo arabic', 'sqr'),
+    ('sqr', 'sqr'),
+    ('sqs', 'sqs'),
+    ('sri lankan sign language', 'sqs'),
+    ('soqotri', 'sqt'),
+    ('sqt', 'sqt'),
+    ('squ', 'squ'),
+    ('squamish', 'squ'),
+    ('kufr qassem sign language', 'sqx'),
+    ('kufr qassem sign language (kqsl)', 'sqx'),
+    ('sqx', 'sqx'),
+    ('serbian', 'sr'),
+    ('sr', 'sr'),
+    ('srp', 'sr'),
+    ('saruga', 'sra'),
+    ('sra', 'sra'),
+    ('sora', 'srb'),
+    ('srb', 'srb'),
+    ('logudorese sardinian', 'src'),
+    ('src', 'src'),
+    ('sara', 'sre'),
+    ('sre', 'sre'),
+    ('nafi', 'srf'),
+    ('srf', 'srf'),
+    ('srg', 'srg'),
+    ('sulod', 'srg'),
+    ('sarikoli', 'srh'),
+    ('srh', 'srh'),
+    ('siriano', 'sri'),
+    ('sri', 'sri'),
+    ('serudung murut', 'srk'),
+    ('srk', 'srk'),
+    ('isirawa', 'srl'),
+    ('srl', 'srl'),
+    ('saramaccan', 'srm'),
+    ('srm', 'srm'),
+    ('sranan tongo', 'srn'),
+    ('srn', 'srn'),
+    ('campidanese sardinian', 'sro'),
+    ('sro', 'sro'),
+    ('sirionó', 'srq'),
+    ('srq', 'srq'),
+    ('serer', 'srr'),
+    ('srr', 'srr'),
+    ('sarsi', 'srs'),
+    ('srs', 'srs'),
+    ('sauri', 'srt'),
+    ('srt', 'srt'),
+    ('sru', 'sru'),
+    ('suruí', 'sru'),
+    ('southern sorsoganon', 'srv'),
+    ('srv', 'srv'),
+    ('serua', 'srw'),
+    ('srw', 'srw'),
+    ('sirmauri', 'srx'),
+    ('srx', 'srx'),
+    ('sera', 'sry'),
+    ('sry', 'sry'),
+    ('shahmirzadi', 'srz'),
+    ('srz', 'srz'),
+    ('ss', 'ss'),
+    ('ssw', 'ss'),
+    ('swati', 'ss'),
+    ('southern sama', 'ssb'),
+    ('ssb', 'ssb'),
+    ('ssc', 'ssc'),
+    ('suba-simbiti', 'ssc'),
+    ('siroi', 'ssd'),
+    ('ssd', 'ssd'),
+    ('balangingi', 'sse'),
+    ('sse', 'sse'),
+    ('ssf', 'ssf'),
+    ('thao', 'ssf'),
+    ('seimat', 'ssg'),
+    ('ssg', 'ssg'),
+    ('shihhi arabic', 'ssh'),
+    ('ssh', 'ssh'),
+    ('sansi', 'ssi'),
+    ('ssi', 'ssi'),
+    ('sausi', 'ssj'),
+    ('ssj', 'ssj'),
+    ('ssk', 'ssk'),
+    ('sunam', 'ssk'),
+    ('ssl', 'ssl'),
+    ('western sisaala', 'ssl'),
+    ('semnam', 'ssm'),
+    ('ssm', 'ssm'),
+    ('ssn', 'ssn'),
+    ('waata', 'ssn'),
+    ('sissano', 'sso'),
+    ('sso', 'sso'),
+    ('spanish sign language', 'ssp'),
+    ('ssp', 'ssp'),
+    ('so''a', 'ssq'),
+    ('ssq', 'ssq'),
+    ('ssr', 'ssr'),
+    ('swiss-french sign language', 'ssr'),
+    ('sss', 'sss'),
+    ('sô', 'sss'),
+    ('sinasina', 'sst'),
+    ('sst', 'sst'),
+    ('ssu', 'ssu'),
+    ('susuami', 'ssu'),
+    ('shark bay', 'ssv'),
+    ('ssv', 'ssv'),
+    ('samberigi', 'ssx'),
+    ('ssx', 'ssx'),
+    ('saho', 'ssy'),
+    ('ssy', 'ssy'),
+    ('sengseng', 'ssz'),
+    ('ssz', 'ssz'),
+    ('sot', 'st'),
+    ('sotho', 'st'),
+    ('sotho, southern', 'st'),
+    ('southern sotho', 'st'),
+    ('st', 'st'),
+    ('settla', 'sta'),
+    ('sta', 'sta'),
+    ('northern subanen', 'stb'),
+    ('stb', 'stb'),
+    ('sentinel', 'std'),
+    ('std', 'std'),
+    ('liana-seti', 'ste'),
+    ('ste', 'ste'),
+    ('seta', 'stf'),
+    ('stf', 'stf'),
+    ('stg', 'stg'),
+    ('trieng', 'stg'),
+    ('shelta', 'sth'),
+    ('sth', 'sth'),
+    ('bulo stieng', 'sti'),
+    ('sti', 'sti'),
+    ('matya samo', 'stj'),
+    ('stj', 'stj'),
+    ('arammba', 'stk'),
+    ('stk', 'stk'),
+    ('stellingwerfs', 'stl'),
+    ('stl', 'stl'),
+    ('setaman', 'stm'),
+    ('stm', 'stm'),
+    ('owa', 'stn'),
+    ('stn', 'stn'),
+    ('sto', 'sto'),
+    ('stoney', 'sto'),
+    ('southeastern tepehuan', 'stp'),
+    ('stp', 'stp'),
+    ('saterfriesisch', 'stq'),
+    ('stq', 'stq'),
+    ('str', 'str'),
+    ('straits salish', 'str'),
+    ('shumashti', 'sts'),
+    ('sts', 'sts'),
+    ('budeh stieng', 'stt'),
+    ('stt', 'stt'),
+    ('samtao', 'stu'),
+    ('stu', 'stu'),
+    ('silt''e', 'stv'),
+    ('stv', 'stv'),
+    ('satawalese', 'stw'),
+    ('stw', 'stw'),
+    ('siberian tatar', 'sty'),
+    ('sty', 'sty'),
+    ('su', 'su'),
+    ('sun', 'su'),
+    ('sundanese', 'su'),
+    ('sua', 'sua'),
+    ('sulka', 'sua'),
+    ('sub', 'sub'),
+    ('suku', 'sub'),
+    ('suc', 'suc'),
+    ('western subanon', 'suc'),
+    ('sue', 'sue'),
+    ('suena', 'sue'),
+    ('sug', 'sug'),
+    ('suganga', 'sug'),
+    ('sui', 'sui'),
+    ('suki', 'sui'),
+    ('shubi', 'suj'),
+    ('suj', 'suj'),
+    ('suk', 'suk'),
+    ('sukuma', 'suk'),
+    ('bouni', 'suo'),
+    ('suo', 'suo'),
+    ('suq', 'suq'),
+    ('tirmaga-chai suri', 'suq'),
+    ('mwaghavul', 'sur'),
+    ('sur', 'sur'),
+    ('sus', 'sus'),
+    ('susu', 'sus'),
+    ('subtiaba', 'sut'),
+    ('sut', 'sut'),
+    ('puroik', 'suv'),
+    ('suv', 'suv'),
+    ('sumbwa', 'suw'),
+    ('suw', 'suw'),
+    ('sumerian', 'sux'),
+    ('sux', 'sux'),
+    ('suy', 'suy'),
+    ('suyá', 'suy'),
+    ('sunwar', 'suz'),
+    ('suz', 'suz'),
+    ('sv', 'sv'),
+    ('swe', 'sv'),
+    ('swedish', 'sv'),
+    ('sva', 'sva'),
+    ('svan', 'sva'),
+    ('svb', 'svb'),
+    ('ulau-suain', 'svb'),
+    ('svc', 'svc'),
+    ('vincentian creole english', 'svc'),
+    ('serili', 'sve'),
+    ('sve', 'sve'),
+    ('slovakian sign language', 'svk'),
+    ('svk', 'svk'),
+    ('slavomolisano', 'svm'),
+    ('svm', 'svm'),
+    ('savosavo', 'svs'),
+    ('svs', 'svs'),
+    ('skalvian', 'svx'),
+    ('svx', 'svx'),
+    ('sw', 'sw'),
+    ('swa', 'sw'),
+    ('swahili', 'sw'),
+    ('swahili (macrolanguage)', 'sw'),
+    ('maore comorian', 'swb'),
+    ('swb', 'swb'),
+    ('congo swahili', 'swc'),
+    ('swc', 'swc'),
+    ('sere', 'swf'),
+    ('swf', 'swf'),
+    ('swabian', 'swg'),
+    ('swg', 'swg'),
+    ('swahili (individual language)', 'swh'),
+    ('swh', 'swh'),
+    ('swi', 'swi'),
+    ('sira', 'swj'),
+    ('swj', 'swj'),
+    ('malawi sena', 'swk'),
+    ('swk', 'swk'),
+    ('swedish sign language', 'swl'),
+    ('swl', 'swl'),
+    ('samosa', 'swm'),
+    ('swm', 'swm'),
+    ('sawknah', 'swn'),
+    ('swn', 'swn'),
+    ('shanenawa', 'swo'),
+    ('suau', 'swp'),
+    ('swp', 'swp'),
+    ('sharwa', 'swq'),
+    ('swq', 'swq'),
+    ('saweru', 'swr'),
+    ('swr', 'swr'),
+    ('seluwasan', 'sws'),
+    ('sws', 'sws'),
+    ('sawila', 'swt'),
+    ('swt', 'swt'),
+    ('suwawa', 'swu'),
+    ('swu', 'swu'),
+    ('shekhawati', 'swv'),
+    ('swv', 'swv'),
+    ('sowa', 'sww'),
+    ('sww', 'sww'),
+    ('suruahá', 'swx'),
+    ('swx', 'swx'),
+    ('sarua', 'swy'),
+    ('swy', 'swy'),
+    ('suba', 'sxb'),
+    ('sxb', 'sxb'),
+    ('sicanian', 'sxc'),
+    ('sxc', 'sxc'),
+    ('sighu', 'sxe'),
+    ('sxe', 'sxe'),
+    ('shuhi', 'sxg'),
+    ('sxg', 'sxg'),
+    ('southern kalapuya', 'sxk'),
+    ('sxk', 'sxk'),
+    ('selian', 'sxl'),
+    ('sxl', 'sxl'),
+    ('samre', 'sxm'),
+    ('sxm', 'sxm'),
+    ('sangir', 'sxn'),
+    ('sxn', 'sxn'),
+    ('sorothaptic', 'sxo'),
+    ('sxo', 'sxo'),
+    ('saaroa', 'sxr'),
+    ('sxr', 'sxr'),
+    ('sasaru', 'sxs'),
+    ('sxs', 'sxs'),
+    ('sxu', 'sxu'),
+    ('upper saxon', 'sxu'),
+    ('saxwe gbe', 'sxw'),
+    ('sxw', 'sxw'),
+    ('siang', 'sya'),
+    ('sya', 'sya'),
+    ('central subanen', 'syb'),
+    ('syb', 'syb'),
+    ('classical syriac', 'syc'),
+    ('syc', 'syc'),
+    ('seki', 'syi'),
+    ('syi', 'syi'),
+    ('sukur', 'syk'),
+    ('syk', 'syk'),
+    ('syl', 'syl'),
+    ('sylheti', 'syl'),
+    ('maya samo', 'sym'),
+    ('sym', 'sym'),
+    ('senaya', 'syn'),
+    ('syn', 'syn'),
+    ('suoy', 'syo'),
+    ('syo', 'syo'),
+    ('syr', 'syr'),
+    ('syriac', 'syr'),
+    ('sinyar', 'sys'),
+    ('sys', 'sys'),
+    ('kagate', 'syw'),
+    ('syw', 'syw'),
+    ('samay', 'syx'),
+    ('syx', 'syx'),
+    ('al-sayyid bedouin sign language', 'syy'),
+    ('syy', 'syy'),
+    ('semelai', 'sza'),
+    ('sza', 'sza'),
+    ('ngalum', 'szb'),
+    ('szb', 'szb'),
+    ('semaq beri', 'szc'),
+    ('szc', 'szc'),
+    ('seru', 'szd'),
+    ('szd', 'szd'),
+    ('seze', 'sze'),
+    ('sze', 'sze'),
+    ('sengele', 'szg'),
+    ('szg', 'szg'),
+    ('silesian', 'szl'),
+    ('szl', 'szl'),
+    ('sula', 'szn'),
+    ('szn', 'szn'),
+    ('suabo', 'szp'),
+    ('szp', 'szp'),
+    ('solomon islands sign language', 'szs'),
+    ('szs', 'szs'),
+    ('isu (fako division)', 'szv'),
+    ('szv', 'szv'),
+    ('sawai', 'szw'),
+    ('szw', 'szw'),
+    ('sakizaya', 'szy'),
+    ('szy', 'szy'),
+    ('ta', 'ta'),
+    ('tam', 'ta'),
+    ('tamil', 'ta'),
+    ('lower tanana', 'taa'),
+    ('taa', 'taa'),
+    ('tab', 'tab'),
+    ('tabassaran', 'tab'),
+    ('lowland tarahumara', 'tac'),
+    ('tac', 'tac'),
+    ('tad', 'tad'),
+    ('tause', 'tad'),
+    ('tae', 'tae'),
+    ('tariana', 'tae'),
+    ('taf', 'taf'),
+    ('tapirapé', 'taf'),
+    ('tag', 'tag'),
+    ('tagoi', 'tag'),
+    ('eastern tamang', 'taj'),
+    ('taj', 'taj'),
+    ('tak', 'tak'),
+    ('tala', 'tak'),
+    ('tal', 'tal'),
+    ('tan', 'tan'),
+    ('tangale', 'tan'),
+    ('tao', 'tao'),
+    ('yami', 'tao'),
+    ('taabwa', 'tap'),
+    ('tap', 'tap'),
+    ('tamasheq', 'taq'),
+    ('taq', 'taq'),
+    ('central tarahumara', 'tar'),
+    ('tar', 'tar'),
+    ('tas', 'tas'),
+    ('tay boi', 'tas'),
+    ('tau', 'tau'),
+    ('upper tanana', 'tau'),
+    ('tatuyo', 'tav'),
+    ('tav', 'tav'),
+    ('tai', 'taw'),
+    ('taw', 'taw'),
+    ('tamki', 'tax'),
+    ('tax', 'tax'),
+    ('atayal', 'tay'),
+    ('tay', 'tay'),
+    ('taz', 'taz'),
+    ('tocho', 'taz'),
+    ('aikanã', 'tba'),
+    ('tba', 'tba'),
+    ('takia', 'tbc'),
+    ('tbc', 'tbc'),
+    ('kaki ae', 'tbd'),
+    ('tbd', 'tbd'),
+    ('tanimbili', 'tbe'),
+    ('tbe', 'tbe'),
+    ('mandara', 'tbf'),
+    ('tbf', 'tbf'),
+    ('north tairora', 'tbg'),
+    ('tbg', 'tbg'),
+    ('dharawal', 'tbh'),
+    ('tbh', 'tbh'),
+    ('gaam', 'tbi'),
+    ('tbi', 'tbi'),
+    ('tbj', 'tbj'),
+    ('tiang', 'tbj'),
+    ('calamian tagbanwa', 'tbk'),
+    ('tbk', 'tbk'),
+    ('tbl', 'tbl'),
+    ('tboli', 'tbl'),
+    ('tagbu', 'tbm'),
+    ('tbm', 'tbm'),
+    ('barro negro tunebo', 'tbn'),
+    ('tbn', 'tbn'),
+    ('tawala', 'tbo'),
+    ('tbo', 'tbo'),
+    ('taworta', 'tbp'),
+    ('tbp', 'tbp'),
+    ('tbr', 'tbr'),
+    ('tumtum', 'tbr'),
+    ('tanguat', 'tbs'),
+    ('tbs', 'tbs'),
+    ('tbt', 'tbt'),
+    ('tembo', 'tbt'),
+    ('tembo (kitembo)', 'tbt'),
+    ('tbu', 'tbu'),
+    ('tubar', 'tbu'),
+    ('tbv', 'tbv'),
+    ('tobo', 'tbv'),
+    ('tagbanwa', 'tbw'),
+    ('tbw', 'tbw'),
+    ('kapin', 'tbx'),
+    ('tbx', 'tbx'),
+    ('tabaru', 'tby'),
+    ('tby', 'tby'),
+    ('ditammari', 'tbz'),
+    ('tbz', 'tbz'),
+    ('tca', 'tca'),
+    ('ticuna', 'tca'),
+    ('tanacross', 'tcb'),
+    ('tcb', 'tcb'),
+    ('datooga', 'tcc'),
+    ('tcc', 'tcc'),
+    ('tafi', 'tcd'),
+    ('tcd', 'tcd'),
+    ('southern tutchone', 'tce'),
+    ('tce', 'tce'),
+    ('malinaltepec me''phaa', 'tcf'),
+    ('tcf', 'tcf'),
+    ('tamagario', 'tcg'),
+    ('tcg', 'tcg'),
+    ('tch', 'tch'),
+    ('turks and caicos creole english', 'tch'),
+    ('tci', 'tci'),
+    ('wára', 'tci'),
+    ('tchitchege', 'tck'),
+    ('tck', 'tck'),
+    ('taman', 'tcl'),
+    ('taman (myanmar)', 'tcl'),
+    ('tcl', 'tcl'),
+    ('tanahmerah', 'tcm'),
+    ('tcm', 'tcm'),
+    ('tcn', 'tcn'),
+    ('tichurong', 'tcn'),
+    ('taungyo', 'tco'),
+    ('tco', 'tco'),
+    ('tawr chin', 'tcp'),
+    ('tcp', 'tcp'),
+    ('kaiy', 'tcq'),
+    ('tcq', 'tcq'),
+    ('tcs', 'tcs'),
+    ('torres strait creole', 'tcs'),
+    ('t''en', 'tct'),
+    ('tct', 'tct'),
+    ('southeastern tarahumara', 'tcu'),
+    ('tcu', 'tcu'),
+    ('tcw', 'tcw'),
+    ('tecpatlán totonac', 'tcw'),
+    ('tcx', 'tcx'),
+    ('toda', 'tcx'),
+    ('tcy', 'tcy'),
+    ('tulu', 'tcy'),
+    ('tcz', 'tcz'),
+    ('thado chin', 'tcz'),
+    ('tagdal', 'tda'),
+    ('tda', 'tda'),
+    ('panchpargania', 'tdb'),
+    ('tdb', 'tdb'),
+    ('emberá-tadó', 'tdc'),
+    ('tdc', 'tdc'),
+    ('tai nüa', 'tdd'),
+    ('tdd', 'tdd'),
+    ('tde', 'tde'),
+    ('tiranige diga dogon', 'tde'),
+    ('talieng', 'tdf'),
+    ('tdf', 'tdf'),
+    ('tdg', 'tdg'),
+    ('western tamang', 'tdg'),
+    ('tdh', 'tdh'),
+    ('thulung', 'tdh'),
+    ('tdi', 'tdi'),
+    ('tomadino', 'tdi'),
+    ('tajio', 'tdj'),
+    ('tdj', 'tdj'),
+    ('tambas', 'tdk'),
+    ('tdk', 'tdk'),
+    ('tdl', 'tdl'),
+    ('taruma', 'tdm'),
+    ('tdm', 'tdm'),
+    ('tdn', 'tdn'),
+    ('tondano', 'tdn'),
+    ('tdo', 'tdo'),
+    ('teme', 'tdo'),
+    ('tdq', 'tdq'),
+    ('tita', 'tdq'),
+    ('tdr', 'tdr'),
+    ('todrah', 'tdr'),
+    ('doutai', 'tds'),
+    ('tds', 'tds'),
+    ('tdt', 'tdt'),
+    ('tetun dili', 'tdt'),
+    ('tdv', 'tdv'),
+    ('toro', 'tdv'),
+    ('tandroy-mahafaly malagasy', 'tdx'),
+    ('tdx', 'tdx'),
+    ('tadyawan', 'tdy'),
+    ('tdy', 'tdy'),
+    ('te', 'te'),
+    ('tel', 'te'),
+    ('telugu', 'te'),
+    ('tea', 'tea'),
+    ('temiar', 'tea'),
+    ('teb', 'teb'),
+    ('tetete', 'teb'),
+    ('tec', 'tec'),
+    ('terik', 'tec'),
+    ('ted', 'ted'),
+    ('tepo krumen', 'ted'),
+    ('huehuetla tepehua', 'tee'),
+    ('tee', 'tee'),
+    ('tef', 'tef'),
+    ('teressa', 'tef'),
+    ('teg', 'teg'),
+    ('teke-tege', 'teg'),
+    ('teh', 'teh'),
+    ('tehuelche', 'teh'),
+    ('tei', 'tei'),
+    ('torricelli', 'tei'),
+    ('ibali teke', 'tek'),
+    ('tek', 'tek'),
+    ('timne', 'tem'),
+    ('tama', 'ten'),
+    ('tama (colombia)', 'ten'),
+    ('ten', 'ten'),
+    ('teo', 'teo'),
+    ('teso', 'teo'),
+    ('tep', 'tep'),
+    ('tepecano', 'tep'),
+    ('temein', 'teq'),
+    ('teq', 'teq'),
+    ('ter', 'ter'),
+    ('tereno', 'ter'),
+    ('tengger', 'tes'),
+    ('tes', 'tes'),
+    ('tet', 'tet'),
+    ('tetum', 'tet'),
+    ('teu', 'teu'),
+    ('teor', 'tev'),
+    ('tev', 'tev'),
+    ('tew', 'tew'),
+    ('tewa', 'tew'),
+    ('tewa (usa)', 'tew'),
+    ('tennet', 'tex'),
+    ('tex', 'tex'),
+    ('tey', 'tey'),
+    ('tulishi', 'tey'),
+    ('tetserret', 'tez'),
+    ('tez', 'tez'),
+    ('tfi', 'tfi'),
+    ('tofin gbe', 'tfi'),
+    ('tanaina', 'tfn'),
+    ('tfn', 'tfn'),
+    ('tefaro', 'tfo'),
+    ('tfo', 'tfo'),
+    ('teribe', 'tfr'),
+    ('tfr', 'tfr'),
+    ('ternate', 'tft'),
+    ('tft', 'tft'),
+    ('tajik', 'tg'),
+    ('tg', 'tg'),
+    ('tgk', 'tg'),
+    ('sagalla', 'tga'),
+    ('tga', 'tga'),
+    ('tgb', 'tgb'),
+    ('tobilung', 'tgb'),
+    ('tgc', 'tgc'),
+    ('tigak', 'tgc'),
+    ('ciwogai', 'tgd'),
+    ('tgd', 'tgd'),
+    ('eastern gorkha tamang', 'tge'),
+    ('tge', 'tge'),
+    ('chalikha', 'tgf'),
+    ('tgf', 'tgf'),
+    ('tgh', 'tgh'),
+    ('tobagonian creole english', 'tgh'),
+    ('lawunuia', 'tgi'),
+    ('tgi', 'tgi'),
+    ('tagin', 'tgj'),
+    ('tgj', 'tgj'),
+    ('tandaganon', 'tgn'),
+    ('tgn', 'tgn'),
+    ('sudest', 'tgo'),
+    ('tgo', 'tgo'),
+    ('tangoa', 'tgp'),
+    ('tgp', 'tgp'),
+    ('tgq', 'tgq'),
+    ('tring', 'tgq'),
+    ('tareng', 'tgr'),
+    ('tgr', 'tgr'),
+    ('nume', 'tgs'),
+    ('tgs', 'tgs'),
+    ('central tagbanwa', 'tgt'),
+    ('tgt', 'tgt'),
+    ('tanggu', 'tgu'),
+    ('tgu', 'tgu'),
+    ('tgv', 'tgv'),
+    ('tingui-boto', 'tgv'),
+    ('tagwana senoufo', 'tgw'),
+    ('tgw', 'tgw'),
+    ('tagish', 'tgx'),
+    ('tgx', 'tgx'),
+    ('tgy', 'tgy'),
+    ('togoyo', 'tgy'),
+    ('tagalaka', 'tgz'),
+    ('tgz', 'tgz'),
+    ('th', 'th'),
+    ('tha', 'th'),
+    ('thai', 'th'),
+    ('kuuk thaayorre', 'thd'),
+    ('thd', 'thd'),
+    ('chitwania tharu', 'the'),
+    ('the', 'the'),
+    ('thangmi', 'thf'),
+    ('thf', 'thf'),
+    ('northern tarahumara', 'thh'),
+    ('thh', 'thh'),
+    ('tai long', 'thi'),
+    ('thi', 'thi'),
+    ('tharaka', 'thk'),
+    ('thk', 'thk'),
+    ('dangaura tharu', 'thl'),
+    ('thl', 'thl'),
+    ('aheu', 'thm'),
+    ('thm', 'thm'),
+    ('thachanadan', 'thn'),
+    ('thn', 'thn'),
+    ('thompson', 'thp'),
+    ('thp', 'thp'),
+    ('kochila tharu', 'thq'),
+    ('thq', 'thq'),
+    ('rana tharu', 'thr'),
+    ('thr', 'thr'),
+    ('thakali', 'ths'),
+    ('ths', 'ths'),
+    ('tahltan', 'tht'),
+    ('tht', 'tht'),
+    ('thu', 'thu'),
+    ('thuri', 'thu'),
+    ('tahaggart tamahaq', 'thv'),
+    ('thv', 'thv'),
+    ('thy', 'thy'),
+    ('tayart tamajeq', 'thz'),
+    ('thz', 'thz'),
+    ('ti', 'ti'),
+    ('tigrinya', 'ti'),
+    ('tir', 'ti'),
+    ('tia', 'tia'),
+    ('tidikelt tamazight', 'tia'),
+    ('tic', 'tic'),
+    ('tira', 'tic'),
+    ('tif', 'tif'),
+    ('tifal', 'tif'),
+    ('tig', 'tig'),
+    ('tigre', 'tig'),
+    ('tih', 'tih'),
+    ('timugon murut', 'tih'),
+    ('tiene', 'tii'),
+    ('tii', 'tii'),
+    ('tij', 'tij'),
+    ('tilung', 'tij'),
+    ('tik', 'tik'),
+    ('tikar', 'tik'),
+    ('til', 'til'),
+    ('tillamook', 'til'),
+    ('tim', 'tim'),
+    ('timbe', 'tim'),
+    ('tin', 'tin'),
+    ('tindi', 'tin'),
+    ('teop', 'tio'),
+    ('tio', 'tio'),
+    ('tip', 'tip'),
+    ('trimuris', 'tip'),
+    ('tiq', 'tiq'),
+    ('tiéfo', 'tiq'),
+    ('masadiit itneg', 'tis'),
+    ('tis', 'tis'),
+    ('tinigua', 'tit'),
+    ('tit', 'tit'),
+    ('adasen', 'tiu'),
+    ('tiu', 'tiu'),
+    ('tiv', 'tiv'),
+    ('tiw', 'tiw'),
+    ('tiwi', 'tiw'),
+    ('southern tiwa', 'tix'),
+    ('tix', 'tix'),
+    ('tiruray', 'tiy'),
+    ('tiy', 'tiy'),
+    ('tai hongjin', 'tiz'),
+    ('tiz', 'tiz'),
+    ('tajuasohn', 'tja'),
+    ('tja', 'tja'),
+    ('tjg', 'tjg'),
+    ('tunjung', 'tjg'),
+    ('northern tujia', 'tji'),
+    ('tji', 'tji'),
+    ('tjj', 'tjj'),
+    ('tjungundji', 'tjj'),
+    ('tai laing', 'tjl'),
+    ('tjl', 'tjl'),
+    ('timucua', 'tjm'),
+    ('tjm', 'tjm'),
+    ('tjn', 'tjn'),
+    ('tonjon', 'tjn'),
+    ('temacine tamazight', 'tjo'),
+    ('tjo', 'tjo'),
+    ('tjp', 'tjp'),
+    ('tjupany', 'tjp'),
+    ('southern tujia', 'tjs'),
+    ('tjs', 'tjs'),
+    ('tju', 'tju'),
+    ('tjurruru', 'tju'),
+    ('djabwurrung', 'tjw'),
+    ('tjw', 'tjw'),
+    ('tk', 'tk'),
+    ('tuk', 'tk'),
+    ('turkmen', 'tk'),
+    ('tka', 'tka'),
+    ('truká', 'tka'),
+    ('buksa', 'tkb'),
+    ('tkb', 'tkb'),
+    ('tkd', 'tkd'),
+    ('tukudede', 'tkd'),
+    ('takwane', 'tke'),
+    ('tke', 'tke'),
+    ('tkf', 'tkf'),
+    ('tukumanféd', 'tkf'),
+    ('tesaka malagasy', 'tkg'),
+    ('tkg', 'tkg'),
+    ('tkl', 'tkl'),
+    ('tokelau', 'tkl'),
+    ('takelma', 'tkm'),
+    ('tkm', 'tkm'),
+    ('tkn', 'tkn'),
+    ('toku-no-shima', 'tkn'),
+    ('tikopia', 'tkp'),
+    ('tkp', 'tkp'),
+    ('tkq', 'tkq'),
+    ('tkr', 'tkr'),
+    ('tsakhur', 'tkr'),
+    ('takestani', 'tks'),
+    ('tks', 'tks'),
+    ('kathoriya tharu', 'tkt'),
+    ('tkt', 'tkt'),
+    ('tku', 'tku'),
+    ('upper necaxa totonac', 'tku'),
+    ('mur pano', 'tkv'),
+    ('tkv', 'tkv'),
+    ('teanu', 'tkw'),
+    ('tkw', 'tkw'),
+    ('tangko', 'tkx'),
+    ('tkx', 'tkx'),
+    ('takua', 'tkz'),
+    ('tkz', 'tkz'),
+    ('tagalog', 'tl'),
+    ('tgl', 'tl'),
+    ('tl', 'tl'),
+    ('southwestern tepehuan', 'tla'),
+    ('tla', 'tla'),
+    ('tlb', 'tlb'),
+    ('tobelo', 'tlb'),
+    ('tlc', 'tlc'),
+    ('yecuatla totonac', 'tlc'),
+    ('talaud', 'tld'),
+    ('tld', 'tld'),
+    ('telefol', 'tlf'),
+    ('tlf', 'tlf'),
+    ('tlg', 'tlg'),
+    ('tofanma', 'tlg'),
+    ('klingon', 'tlh'),
+    ('tlh', 'tlh'),
+    ('tlhingan-hol', 'tlh'),
+    ('tli', 'tli'),
+    ('tlingit', 'tli'),
+    ('talinga-bwisi', 'tlj'),
+    ('tlj', 'tlj'),
+    ('taloki', 'tlk'),
+    ('tlk', 'tlk'),
+    ('tetela', 'tll'),
+    ('tll', 'tll'),
+    ('tlm', 'tlm'),
+    ('tolomako', 'tlm'),
+    ('talondo''', 'tln'),
+    ('tln', 'tln'),
+    ('talodi', 'tlo'),
+    ('tlo', 'tlo'),
+    ('filomena mata-coahuitlán totonac', 'tlp'),
+    ('tlp', 'tlp'),
+    ('tai loi', 'tlq'),
+    ('tlq', 'tlq'),
+    ('talise', 'tlr'),
+    ('tlr', 'tlr'),
+    ('tambotalo', 'tls'),
+    ('tls', 'tls'),
+    ('sou nama', 'tlt'),
+    ('tlt', 'tlt'),
+    ('tlu', 'tlu'),
+    ('tulehu', 'tlu'),
+    ('taliabu', 'tlv'),
+    ('tlv', 'tlv'),
+    ('khehek', 'tlx'),
+    ('tlx', 'tlx'),
+    ('talysh', 'tly'),
+    ('tly', 'tly'),
+    ('tama (chad)', 'tma'),
+    ('tma', 'tma'),
+    ('katbol', 'tmb'),
+    ('tmb', 'tmb'),
+    ('tmc', 'tmc'),
+    ('tumak', 'tmc'),
+    ('haruai', 'tmd'),
+    ('tmd', 'tmd'),
+    ('tme', 'tme'),
+    ('tremembé', 'tme'),
+    ('tmf', 'tmf'),
+    ('toba-maskoy', 'tmf'),
+    ('ternateño', 'tmg'),
+    ('tmg', 'tmg'),
+    ('tamashek', 'tmh'),
+    ('tmh', 'tmh'),
+    ('tmi', 'tmi'),
+    ('tutuba', 'tmi'),
+    ('samarokena', 'tmj'),
+    ('tmj', 'tmj'),
+    ('northwestern tamang', 'tmk'),
+    ('tmk', 'tmk'),
+    ('tamnim citak', 'tml'),
+    ('tml', 'tml'),
+    ('tai thanh', 'tmm'),
+    ('tmm', 'tmm'),
+    ('taman (indonesia)', 'tmn'),
+    ('tmn', 'tmn'),
+    ('temoq', 'tmo'),
+    ('tmo', 'tmo'),
+    ('tmq', 'tmq'),
+    ('tumleo', 'tmq'),
+    ('jewish babylonian aramaic', 'tmr'),
+    ('jewish babylonian aramaic (ca. 200-1200 ce)', 'tmr'),
+    ('tmr', 'tmr'),
+    ('tima', 'tms'),
+    ('tms', 'tms'),
+    ('tasmate', 'tmt'),
+    ('tmt', 'tmt'),
+    ('iau', 'tmu'),
+    ('tmu', 'tmu'),
+    ('tembo (motembo)', 'tmv'),
+    ('tmv', 'tmv'),
+    ('temuan', 'tmw'),
+    ('tmw', 'tmw'),
+    ('tami', 'tmy'),
+    ('tmy', 'tmy'),
+    ('tamanaku', 'tmz'),
+    ('tmz', 'tmz'),
+    ('tn', 'tn'),
+    ('tsn', 'tn'),
+    ('tswana', 'tn'),
+    ('tacana', 'tna'),
+    ('tna', 'tna'),
+    ('tnb', 'tnb'),
+    ('western tunebo', 'tnb'),
+    ('tanimuca-retuarã', 'tnc'),
+    ('tnc', 'tnc'),
+    ('angosturas tunebo', 'tnd'),
+    ('tnd', 'tnd'),
+    ('tng', 'tng'),
+    ('tobanga', 'tng'),
+    ('maiani', 'tnh'),
+    ('tnh', 'tnh'),
+    ('tandia', 'tni'),
+    ('tni', 'tni'),
+    ('kwamera', 'tnk'),
+    ('tnk', 'tnk'),
+    ('lenakel', 'tnl'),
+    ('tnl', 'tnl'),
+    ('tabla', 'tnm'),
+    ('tnm', 'tnm'),
+    ('north tanna', 'tnn'),
+    ('tnn', 'tnn'),
+    ('tno', 'tno'),
+    ('toromono', 'tno'),
+    ('tnp', 'tnp'),
+    ('whitesands', 'tnp'),
+    ('taino', 'tnq'),
+    ('tnq', 'tnq'),
+    ('ménik', 'tnr'),
+    ('tnr', 'tnr'),
+    ('tenis', 'tns'),
+    ('tns', 'tns'),
+    ('tnt', 'tnt'),
+    ('tontemboan', 'tnt'),
+    ('tay khang', 'tnu'),
+    ('tnu', 'tnu'),
+    ('tangchangya', 'tnv'),
+    ('tnv', 'tnv'),
+    ('tnw', 'tnw'),
+    ('tonsawang', 'tnw'),
+    ('tanema', 'tnx'),
+    ('tnx', 'tnx'),
+    ('tny', 'tny'),
+    ('tongwe', 'tny'),
+    ('ten''edn', 'tnz'),
+    ('tnz', 'tnz'),
+    ('to', 'to'),
+    ('ton', 'to'),
+    ('tonga', 'to'),
+    ('tonga (tonga islands)', 'to'),
+    ('tob', 'tob'),
+    ('toba', 'tob'),
+    ('coyutla totonac', 'toc'),
+    ('toc', 'toc'),
+    ('tod', 'tod'),
+    ('toma', 'tod'),
+    ('gizrra', 'tof'),
+    ('tof', 'tof'),
+    ('tog', 'tog'),
+    ('tonga (nyasa)', 'tog'),
+    ('gitonga', 'toh'),
+    ('toh', 'toh'),
+    ('toi', 'toi'),
+    ('tonga (zambia)', 'toi'),
+    ('toj', 'toj'),
+    ('tojolabal', 'toj'),
+    ('tok', 'tok'),
+    ('toki pona', 'tok'),
+    ('tolowa', 'tol'),
+    ('tom', 'tom'),
+    ('tombulu', 'tom'),
+    ('too', 'too'),
+    ('xicotepec de juárez totonac', 'too'),
+    ('papantla totonac', 'top'),
+    ('top', 'top'),
+    ('toposa', 'toq'),
+    ('toq', 'toq'),
+    ('togbo-vara banda', 'tor'),
+    ('tor', 'tor'),
+    ('highland totonac', 'tos'),
+    ('tos', 'tos'),
+    ('tho', 'tou'),
+    ('tou', 'tou'),
+    ('tov', 'tov'),
+    ('upper taromi', 'tov'),
+    ('jemez', 'tow'),
+    ('tow', 'tow'),
+    ('tobian', 'tox'),
+    ('tox', 'tox'),
+    ('topoiyo', 'toy'),
+    ('toy', 'toy'),
+    ('toz', 'toz'),
+    ('taupota', 'tpa'),
+    ('tpa', 'tpa'),
+    ('azoyú me''phaa', 'tpc'),
+    ('tpc', 'tpc'),
+    ('tippera', 'tpe'),
+    ('tpe', 'tpe'),
+    ('tarpia', 'tpf'),
+    ('tpf', 'tpf'),
+    ('kula', 'tpg'),
+    ('tpg', 'tpg'),
+    ('tok pisin', 'tpi'),
+    ('tpi', 'tpi'),
+    ('tapieté', 'tpj'),
+    ('tpj', 'tpj'),
+    ('tpk', 'tpk'),
+    ('tupinikin', 'tpk'),
+    ('tlacoapa me''phaa', 'tpl'),
+    ('tpl', 'tpl'),
+    ('tampulma', 'tpm'),
+    ('tpm', 'tpm'),
+    ('tpn', 'tpn'),
+    ('tupinambá', 'tpn'),
+    ('tai pao', 'tpo'),
+    ('tpo', 'tpo'),
+    ('pisaflores tepehua', 'tpp'),
+    ('tpp', 'tpp'),
+    ('tpq', 'tpq'),
+    ('tukpa', 'tpq'),
+    ('tpr', 'tpr'),
+    ('tuparí', 'tpr'),
+    ('tlachichilco tepehua', 'tpt'),
+    ('tpt', 'tpt'),
+    ('tampuan', 'tpu'),
+    ('tpu', 'tpu'),
+    ('tanapag', 'tpv'),
+    ('tpv', 'tpv'),
+    ('tpw', 'tpw'),
+    ('tupí', 'tpw'),
+    ('acatepec me''phaa', 'tpx'),
+    ('tpx', 'tpx'),
+    ('tpy', 'tpy'),
+    ('trumai', 'tpy'),
+    ('tinputz', 'tpz'),
+    ('tpz', 'tpz'),
+    ('tembé', 'tqb'),
+    ('tqb', 'tqb'),
+    ('lehali', 'tql'),
+    ('tql', 'tql'),
+    ('tqm', 'tqm'),
+    ('turumsa', 'tqm'),
+    ('tenino', 'tqn'),
+    ('tqn', 'tqn'),
+    ('toaripi', 'tqo'),
+    ('tqo', 'tqo'),
+    ('tomoip', 'tqp'),
+    ('tqp', 'tqp'),
+    ('tqq', 'tqq'),
+    ('tunni', 'tqq'),
+    ('torona', 'tqr'),
+    ('tqr', 'tqr'),
+    ('tqt', 'tqt'),
+    ('western totonac', 'tqt'),
+    ('touo', 'tqu'),
+    ('tqu', 'tqu'),
+    ('tonkawa', 'tqw'),
+    ('tqw', 'tqw'),
+    ('tr', 'tr'),
+    ('tur', 'tr'),
+    ('turkish', 'tr'),
+    ('tirahi', 'tra'),
+    ('tra', 'tra'),
+    ('terebu', 'trb'),
+    ('trb', 'trb'),
+    ('copala triqui', 'trc'),
+    ('trc', 'trc'),
+    ('trd', 'trd'),
+    ('turi', 'trd'),
+    ('east tarangan', 'tre'),
+    ('tre', 'tre'),
+    ('trf', 'trf'),
+    ('trinidadian creole english', 'trf'),
+    ('lishán didán', 'trg'),
+    ('trg', 'trg'),
+    ('trh', 'trh'),
+    ('turaka', 'trh'),
+    ('tri', 'tri'),
+    ('trió', 'tri'),
+    ('toram', 'trj'),
+    ('trj', 'trj'),
+    ('traveller scottish', 'trl'),
+    ('trl', 'trl'),
+    ('tregami', 'trm'),
+    ('trm', 'trm'),
+    ('trinitario', 'trn'),
+    ('trn', 'trn'),
+    ('tarao naga', 'tro'),
+    ('tro', 'tro'),
+    ('kok borok', 'trp'),
+    ('trp', 'trp'),
+    ('san martín itunyoso triqui', 'trq'),
+    ('trq', 'trq'),
+    ('taushiro', 'trr'),
+    ('trr', 'trr'),
+    ('chicahuaxtla triqui', 'trs'),
+    ('trs', 'trs'),
+    ('trt', 'trt'),
+    ('tunggare', 'trt'),
+    ('tru', 'tru'),
+    ('turoyo', 'tru'),
+    ('sediq', 'trv'),
+    ('trv', 'trv'),
+    ('torwali', 'trw'),
+    ('trw', 'trw'),
+    ('tringgus-sembaan bidayuh', 'trx'),
+    ('trx', 'trx'),
+    ('try', 'try'),
+    ('turung', 'try'),
+    ('torá', 'trz'),
+    ('trz', 'trz'),
+    ('ts', 'ts'),
+    ('tso', 'ts'),
+    ('tsonga', 'ts'),
+    ('tsa', 'tsa'),
+    ('tsaangi', 'tsa'),
+    ('tsamai', 'tsb'),
+    ('tsb', 'tsb'),
+    ('tsc', 'tsc'),
+    ('tswa', 'tsc'),
+    ('tsakonian', 'tsd'),
+    ('tsd', 'tsd'),
+    ('tse', 'tse'),
+    ('tunisian sign language', 'tse'),
+    ('tausug', 'tsg'),
+    ('tsg', 'tsg'),
+    ('tsh', 'tsh'),
+    ('tsuvan', 'tsh'),
+    ('tsi', 'tsi'),
+    ('tsimshian', 'tsi'),
+    ('tshangla', 'tsj'),
+    ('tsj', 'tsj'),
+    ('tseku', 'tsk'),
+    ('tsk', 'tsk'),
+    ('ts''ün-lao', 'tsl'),
+    ('tsl', 'tsl'),
+    ('tsm', 'tsm'),
+    ('turkish sign language', 'tsm'),
+    ('northern toussian', 'tsp'),
+    ('tsp', 'tsp'),
+    ('thai sign language', 'tsq'),
+    ('tsq', 'tsq'),
+    ('akei', 'tsr'),
+    ('tsr', 'tsr'),
+    ('taiwan sign language', 'tss'),
+    ('tss', 'tss'),
+    ('tondi songway kiini', 'tst'),
+    ('tst', 'tst'),
+    ('tsou', 'tsu'),
+    ('tsu', 'tsu'),
+    ('tsogo', 'tsv'),
+    ('tsv', 'tsv'),
+    ('tsishingini', 'tsw'),
+    ('tsw', 'tsw'),
+    ('mubami', 'tsx'),
+    ('tsx', 'tsx'),
+    ('tebul sign language', 'tsy'),
+    ('tsy', 'tsy'),
+    ('purepecha', 'tsz'),
+    ('tsz', 'tsz'),
+    ('tat', 'tt'),
+    ('tatar', 'tt'),
+    ('tt', 'tt'),
+    ('tta', 'tta'),
+    ('tutelo', 'tta'),
+    ('ttb', 'ttb'),
+    ('tektiteko', 'ttc'),
+    ('ttc', 'ttc'),
+    ('tauade', 'ttd'),
+    ('ttd', 'ttd'),
+    ('bwanabwana', 'tte'),
+    ('tte', 'tte'),
+    ('ttf', 'ttf'),
+    ('tuotomb', 'ttf'),
+    ('ttg', 'ttg'),
+    ('tutong', 'ttg'),
+    ('tth', 'tth'),
+    ('upper ta''oih', 'tth'),
+    ('tobati', 'tti'),
+    ('tti', 'tti'),
+    ('tooro', 'ttj'),
+    ('ttj', 'ttj'),
+    ('totoro', 'ttk'),
+    ('ttk', 'ttk'),
+    ('totela', 'ttl'),
+    ('ttl', 'ttl'),
+    ('northern tutchone', 'ttm'),
+    ('ttm', 'ttm'),
+    ('towei', 'ttn'),
+    ('ttn', 'ttn'),
+    ('lower ta''oih', 'tto'),
+    ('tto', 'tto'),
+    ('tombelala', 'ttp'),
+    ('ttp', 'ttp'),
+    ('tawallammat tamajaq', 'ttq'),
+    ('ttq', 'ttq'),
+    ('tera', 'ttr'),
+    ('ttr', 'ttr'),
+    ('northeastern thai', 'tts'),
+    ('tts', 'tts'),
+    ('muslim tat', 'ttt'),
+    ('ttt', 'ttt'),
+    ('torau', 'ttu'),
+    ('ttu', 'ttu'),
+    ('titan', 'ttv'),
+    ('ttv', 'ttv'),
+    ('long wat', 'ttw'),
+    ('ttw', 'ttw'),
+    ('sikaritai', 'tty'),
+    ('tty', 'tty'),
+    ('tsum', 'ttz'),
+    ('ttz', 'ttz'),
+    ('tua', 'tua'),
+    ('wiarumus', 'tua'),
+    ('tub', 'tub'),
+    ('tübatulabal', 'tub'),
+    ('mutu', 'tuc'),
+    ('tuc', 'tuc'),
+    ('tud', 'tud'),
+    ('tuxá', 'tud'),
+    ('tue', 'tue'),
+    ('tuyuca', 'tue'),
+    ('central tunebo', 'tuf'),
+    ('tuf', 'tuf'),
+    ('tug', 'tug'),
+    ('tunia', 'tug'),
+    ('taulil', 'tuh'),
+    ('tuh', 'tuh'),
+    ('tui', 'tui'),
+    ('tupuri', 'tui'),
+    ('tugutil', 'tuj'),
+    ('tuj', 'tuj'),
+    ('tul', 'tul'),
+    ('tula', 'tul'),
+    ('tum', 'tum'),
+    ('tumbuka', 'tum'),
+    ('tun', 'tun'),
+    ('tunica', 'tun'),
+    ('tucano', 'tuo'),
+    ('tuo', 'tuo'),
+    ('tedaga', 'tuq'),
+    ('tuq', 'tuq'),
+    ('tus', 'tus'),
+    ('tuscarora', 'tus'),
+    ('tututni', 'tuu'),
+    ('tuu', 'tuu'),
+    ('turkana', 'tuv'),
+    ('tuv', 'tuv'),
+    ('tux', 'tux'),
+    ('tuxináwa', 'tux'),
+    ('tugen', 'tuy'),
+    ('tuy', 'tuy'),
+    ('turka', 'tuz'),
+    ('tuz', 'tuz'),
+    ('tva', 'tva'),
+    ('vaghua', 'tva'),
+    ('tsuvadi', 'tvd'),
+    ('tvd', 'tvd'),
+    ('te''un', 'tve'),
+    ('tve', 'tve'),
+    ('southeast ambrym', 'tvk'),
+    ('tvk', 'tvk'),
+    ('tuvalu', 'tvl'),
+    ('tvl', 'tvl'),
+    ('tela-masbuar', 'tvm'),
+    ('tvm', 'tvm'),
+    ('tavoyan', 'tvn'),
+    ('tvn', 'tvn'),
+    ('tidore', 'tvo'),
+    ('tvo', 'tvo'),
+    ('taveta', 'tvs'),
+    ('tvs', 'tvs'),
+    ('tutsa naga', 'tvt'),
+    ('tvt', 'tvt'),
+    ('tunen', 'tvu'),
+    ('tvu', 'tvu'),
+    ('sedoa', 'tvw'),
+    ('tvw', 'tvw'),
+    ('taivoan', 'tvx'),
+    ('tvx', 'tvx'),
+    ('timor pidgin', 'tvy'),
+    ('tvy', 'tvy'),
+    ('tw', 'tw'),
+    ('twi', 'tw'),
+    ('twa', 'twa'),
+    ('twana', 'twa'),
+    ('twb', 'twb'),
+    ('western tawbuid', 'twb'),
+    ('teshenawa', 'twc'),
+    ('twc', 'twc'),
+    ('twd', 'twd'),
+    ('twents', 'twd'),
+    ('tewa (indonesia)', 'twe'),
+    ('twe', 'twe'),
+    ('northern tiwa', 'twf'),
+    ('twf', 'twf'),
+    ('tereweng', 'twg'),
+    ('twg', 'twg'),
+    ('tai dón', 'twh'),
+    ('twh', 'twh'),
+    ('tawara', 'twl'),
+    ('twl', 'twl'),
+    ('tawang monpa', 'twm'),
+    ('twm', 'twm'),
+    ('twendi', 'twn'),
+    ('twn', 'twn'),
+    ('tswapong', 'two'),
+    ('two', 'two'),
+    ('ere', 'twp'),
+    ('twp', 'twp'),
+    ('tasawaq', 'twq'),
+    ('twq', 'twq'),
+    ('southwestern tarahumara', 'twr'),
+    ('twr', 'twr'),
+    ('turiwára', 'twt'),
+    ('twt', 'twt'),
+    ('termanu', 'twu'),
+    ('twu', 'twu'),
+    ('tuwari', 'tww'),
+    ('tww', 'tww'),
+    ('tewe', 'twx'),
+    ('twx', 'twx'),
+    ('tawoyan', 'twy'),
+    ('twy', 'twy'),
+    ('tombonuo', 'txa'),
+    ('txa', 'txa'),
+    ('tokharian b', 'txb'),
+    ('txb', 'txb'),
+    ('tsetsaut', 'txc'),
+    ('txc', 'txc'),
+    ('totoli', 'txe'),
+    ('txe', 'txe'),
+    ('tangut', 'txg'),
+    ('txg', 'txg'),
+    ('thracian', 'txh'),
+    ('txh', 'txh'),
+    ('ikpeng', 'txi'),
+    ('txi', 'txi'),
+    ('tarjumo', 'txj'),
+    ('txj', 'txj'),
+    ('tomini', 'txm'),
+    ('txm', 'txm'),
+    ('txn', 'txn'),
+    ('west tarangan', 'txn'),
+    ('toto', 'txo'),
+    ('txo', 'txo'),
+    ('txq', 'txq'),
+    ('tartessian', 'txr'),
+    ('txr', 'txr'),
+    ('tonsea', 'txs'),
+    ('txs', 'txs'),
+    ('citak', 'txt'),
+    ('txt', 'txt'),
+    ('kayapó', 'txu'),
+    ('txu', 'txu'),
+    ('tatana', 'txx'),
+    ('txx', 'txx'),
+    ('tanosy malagasy', 'txy'),
+    ('txy', 'txy'),
+    ('tah', 'ty'),
+    ('tahitian', 'ty'),
+    ('ty', 'ty'),
+    ('tauya', 'tya'),
+    ('tya', 'tya'),
+    ('kyanga', 'tye'),
+    ('tye', 'tye'),
+    ('o''du', 'tyh'),
+    ('tyh', 'tyh'),
+    ('teke-tsaayi', 'tyi'),
+    ('tyi', 'tyi'),
+    ('tai do', 'tyj'),
+    ('tyj', 'tyj'),
+    ('thu lao', 'tyl'),
+    ('tyl', 'tyl'),
+    ('kombai', 'tyn'),
+    ('tyn', 'tyn'),
+    ('thaypan', 'typ'),
+    ('typ', 'typ'),
+    ('tai daeng', 'tyr'),
+    ('tyr', 'tyr'),
+    ('tys', 'tys'),
+    ('tày sa pa', 'tys'),
+    ('tyt', 'tyt'),
+    ('tày tac', 'tyt'),
+    ('tyu', 'tyu'),
+    ('tuvinian', 'tyv'),
+    ('tyv', 'tyv'),
+    ('teke-tyee', 'tyx'),
+    ('tyx', 'tyx'),
+    ('tiyaa', 'tyy'),
+    ('tyy', 'tyy'),
+    ('tyz', 'tyz'),
+    ('tày', 'tyz'),
+    ('tanzanian sign language', 'tza'),
+    ('tza', 'tza'),
+    ('tzeltal', 'tzh'),
+    ('tzh', 'tzh'),
+    ('tz''utujil', 'tzj'),
+    ('tzj', 'tzj'),
+    ('talossan', 'tzl'),
+    ('tzl', 'tzl'),
+    ('central atlas tamazight', 'tzm'),
+    ('tzm', 'tzm'),
+    ('tugun', 'tzn'),
+    ('tzn', 'tzn'),
+    ('tzo', 'tzo'),
+    ('tzotzil', 'tzo'),
+    ('tabriak', 'tzx'),
+    ('tzx', 'tzx'),
+    ('uam', 'uam'),
+    ('uamué', 'uam'),
+    ('kuan', 'uan'),
+    ('uan', 'uan'),
+    ('tairuma', 'uar'),
+    ('uar', 'uar'),
+    ('uba', 'uba'),
+    ('ubang', 'uba'),
+    ('ubi', 'ubi'),
+    ('buhi''non bikol', 'ubl'),
+    ('ubl', 'ubl'),
+    ('ubir', 'ubr'),
+    ('ubr', 'ubr'),
+    ('ubu', 'ubu'),
+    ('umbu-ungu', 'ubu'),
+    ('uby', 'uby'),
+    ('ubykh', 'uby'),
+    ('uda', 'uda'),
+    ('ude', 'ude'),
+    ('udihe', 'ude'),
+    ('muduga', 'udg'),
+    ('udg', 'udg'),
+    ('udi', 'udi'),
+    ('udj', 'udj'),
+    ('ujir', 'udj'),
+    ('udl', 'udl'),
+    ('wuzlam', 'udl'),
+    ('udm', 'udm'),
+    ('udmurt', 'udm'),
+    ('udu', 'udu'),
+    ('uduk', 'udu'),
+    ('kioko', 'ues'),
+    ('ues', 'ues'),
+    ('ufi', 'ufi'),
+    ('ufim', 'ufi'),
+    ('ug', 'ug'),
+    ('uig', 'ug'),
+    ('uighur', 'ug'),
+    ('uyghur', 'ug'),
+    ('uga', 'uga'),
+    ('ugaritic', 'uga'),
+    ('kuku-ugbanh', 'ugb'),
+    ('ugb', 'ugb'),
+    ('uge', 'uge'),
+    ('ughele', 'uge'),
+    ('kubachi', 'ugh'),
+    ('ugh', 'ugh'),
+    ('ugandan sign language', 'ugn'),
+    ('ugn', 'ugn'),
+    ('ugo', 'ugo'),
+    ('ugong', 'ugo'),
+    ('ugy', 'ugy'),
+    ('uruguayan sign language', 'ugy'),
+    ('uha', 'uha'),
+    ('uhami', 'uha'),
+    ('damal', 'uhn'),
+    ('uhn', 'uhn'),
+    ('uis', 'uis'),
+    ('uisai', 'uis'),
+    ('iyive', 'uiv'),
+    ('uiv', 'uiv'),
+    ('tanjijili', 'uji'),
+    ('uji', 'uji'),
+    ('uk', 'uk'),
+    ('ukr', 'uk'),
+    ('ukrainian', 'uk'),
+    ('kaburi', 'uka'),
+    ('uka', 'uka'),
+    ('ukg', 'ukg'),
+    ('ukuriguma', 'ukg'),
+    ('ukh', 'ukh'),
+    ('ukhwejo', 'ukh'),
+    ('kui (india)', 'uki'),
+    ('uki', 'uki'),
+    ('muak sa-aak', 'ukk'),
+    ('ukk', 'ukk'),
+    ('ukl', 'ukl'),
+    ('ukrainian sign language', 'ukl'),
+    ('ukp', 'ukp'),
+    ('ukpe-bayobiri', 'ukp'),
+    ('ukq', 'ukq'),
+    ('ukwa', 'ukq'),
+    ('uks', 'uks'),
+    ('urubú-kaapor sign language', 'uks'),
+    ('uku', 'uku'),
+    ('ukue', 'uku'),
+    ('kuku', 'ukv'),
+    ('ukv', 'ukv'),
+    ('ukw', 'ukw'),
+    ('ukwuani-aboh-ndoni', 'ukw'),
+    ('kuuk-yak', 'uky'),
+    ('uky', 'uky'),
+    ('fungwa', 'ula'),
+    ('ula', 'ula'),
+    ('ulb', 'ulb'),
+    ('ulukwumi', 'ulb'),
+    ('ulc', 'ulc'),
+    ('ulch', 'ulc'),
+    ('lule', 'ule'),
+    ('ule', 'ule'),
+    ('ulf', 'ulf'),
+    ('usku', 'ulf'),
+    ('uli', 'uli'),
+    ('ulithian', 'uli'),
+    ('meriam mir', 'ulk'),
+    ('ulk', 'ulk'),
+    ('ull', 'ull'),
+    ('ullatan', 'ull'),
+    ('ulm', 'ulm'),
+    ('ulumanda''', 'ulm'),
+    ('uln', 'uln'),
+    ('unserdeutsch', 'uln'),
+    ('ulu', 'ulu'),
+    ('uma'' lung', 'ulu'),
+    ('ulw', 'ulw'),
+    ('ulwa', 'ulw'),
+    ('umatilla', 'uma'),
+    ('umb', 'umb'),
+    ('umbundu', 'umb'),
+    ('marrucinian', 'umc'),
+    ('umc', 'umc'),
+    ('umbindhamu', 'umd'),
+    ('umd', 'umd'),
+    ('morrobalama', 'umg'),
+    ('umg', 'umg'),
+    ('ukit', 'umi'),
+    ('umi', 'umi'),
+    ('umm', 'umm'),
+    ('umon', 'umm'),
+    ('makyan naga', 'umn'),
+    ('umn', 'umn'),
+    ('umo', 'umo'),
+    ('umotína', 'umo'),
+    ('ump', 'ump'),
+    ('umpila', 'ump'),
+    ('umbugarla', 'umr'),
+    ('umr', 'umr'),
+    ('pendau', 'ums'),
+    ('ums', 'ums'),
+    ('munsee', 'umu'),
+    ('umu', 'umu'),
+    ('north watut', 'una'),
+    ('une', 'une'),
+    ('uneme', 'une'),
+    ('ngarinyin', 'ung'),
+    ('ung', 'ung'),
+    ('uni', 'uni'),
+    ('enawené-nawé', 'unk'),
+    ('unk', 'unk'),
+    ('unami', 'unm'),
+    ('unm', 'unm'),
+    ('kurnai', 'unn'),
+    ('unn', 'unn'),
+    ('mundari', 'unr'),
+    ('unr', 'unr'),
+    ('unu', 'unu'),
+    ('unubahe', 'unu'),
+    ('munda', 'unx'),
+    ('unx', 'unx'),
+    ('unde kaili', 'unz'),
+    ('unz', 'unz'),
+    ('kulon', 'uon'),
+    ('uon', 'uon'),
+    ('umeda', 'upi'),
+    ('upi', 'upi'),
+    ('upv', 'upv'),
+    ('uripiv-wala-rano-atchin', 'upv'),
+    ('ur', 'ur'),
+    ('urd', 'ur'),
+    ('urdu', 'ur'),
+    ('ura', 'ura'),
+    ('urarina', 'ura'),
+    ('urb', 'urb'),
+    ('urubú-kaapor', 'urb'),
+    ('urc', 'urc'),
+    ('urningangg', 'urc'),
+    ('ure', 'ure'),
+    ('uru', 'ure'),
+    ('uradhi', 'urf'),
+    ('urf', 'urf'),
+    ('urg', 'urg'),
+    ('urigina', 'urg'),
+    ('urh', 'urh'),
+    ('urhobo', 'urh'),
+    ('uri', 'uri'),
+    ('urim', 'uri'),
+    ('urak lawoi''', 'urk'),
+    ('urk', 'urk'),
+    ('urali', 'url'),
+    ('url', 'url'),
+    ('urapmin', 'urm'),
+    ('urm', 'urm'),
+    ('urn', 'urn'),
+    ('uruangnirin', 'urn'),
+    ('ura (papua new guinea)', 'uro'),
+    ('uro', 'uro'),
+    ('urp', 'urp'),
+    ('uru-pa-in', 'urp'),
+    ('lehalurup', 'urr'),
+    ('urr', 'urr'),
+    ('urat', 'urt'),
+    ('urt', 'urt'),
+    ('urumi', 'uru'),
+    ('uruava', 'urv'),
+    ('urv', 'urv'),
+    ('urw', 'urw'),
+    ('urimo', 'urx'),
+    ('urx', 'urx'),
+    ('orya', 'ury'),
+    ('ury', 'ury'),
+    ('uru-eu-wau-wau', 'urz'),
+    ('urz', 'urz'),
+    ('usa', 'usa'),
+    ('usarufa', 'usa'),
+    ('ush', 'ush'),
+    ('ushojo', 'ush'),
+    ('usi', 'usi'),
+    ('usui', 'usi'),
+    ('usaghade', 'usk'),
+    ('usk', 'usk'),
+    ('usp', 'usp'),
+    ('uspanteco', 'usp'),
+    ('us-saare', 'uss'),
+    ('uss', 'uss'),
+    ('usu', 'usu'),
+    ('uya', 'usu'),
+    ('otank', 'uta'),
+    ('uta', 'uta'),
+    ('ute', 'ute'),
+    ('ute-southern paiute', 'ute'),
+    ('ut-hun', 'uth'),
+    ('uth', 'uth'),
+    ('amba (solomon islands)', 'utp'),
+    ('utp', 'utp'),
+    ('etulo', 'utr'),
+    ('utr', 'utr'),
+    ('utu', 'utu'),
+    ('urum', 'uum'),
+    ('uum', 'uum'),
+    ('ura (vanuatu)', 'uur'),
+    ('uur', 'uur'),
+    ('u', 'uuu'),
+    ('uuu', 'uuu'),
+    ('uve', 'uve'),
+    ('west uvean', 'uve'),
+    ('uvh', 'uvh'),
+    ('lote', 'uvl'),
+    ('uvl', 'uvl'),
+    ('kuku-uwanh', 'uwa'),
+    ('uwa', 'uwa'),
+    ('doko-uyanga', 'uya'),
+    ('uz', 'uz'),
+    ('uzb', 'uz'),
+    ('uzbek', 'uz'),
+    ('northern uzbek', 'uzn'),
+    ('uzn', 'uzn'),
+    ('southern uzbek', 'uzs'),
+    ('uzs', 'uzs'),
+    ('vaa', 'vaa'),
+    ('vaagri booli', 'vaa'),
+    ('vae', 'vae'),
+    ('vale', 'vae'),
+    ('vaf', 'vaf'),
+    ('vafsi', 'vaf'),
+    ('vag', 'vag'),
+    ('vagla', 'vag'),
+    ('vah', 'vah'),
+    ('varhadi-nagpuri', 'vah'),
+    ('vai', 'vai'),
+    ('sekele', 'vaj'),
+    ('vaj', 'vaj'),
+    ('val', 'val'),
+    ('vehes', 'val'),
+    ('vam', 'vam'),
+    ('vanimo', 'vam'),
+    ('valman', 'van'),
+    ('van', 'van'),
+    ('vao', 'vao'),
+    ('vaiphei', 'vap'),
+    ('vap', 'vap'),
+    ('huarijio', 'var'),
+    ('var', 'var'),
+    ('vas', 'vas'),
+    ('vasavi', 'vas'),
+    ('vanuma', 'vau'),
+    ('vau', 'vau'),
+    ('varli', 'vav'),
+    ('vav', 'vav'),
+    ('vay', 'vay'),
+    ('wayu', 'vay'),
+    ('southeast babar', 'vbb'),
+    ('vbb', 'vbb'),
+    ('southwestern bontok', 'vbk'),
+    ('vbk', 'vbk'),
+    ('ve', 've'),
+    ('ven', 've'),
+    ('venda', 've'),
+    ('vec', 'vec'),
+    ('venetian', 'vec'),
+    ('ved', 'ved'),
+    ('veddah', 'ved'),
+    ('vel', 'vel'),
+    ('veluws', 'vel'),
+    ('vem', 'vem'),
+    ('vemgo-mabas', 'vem'),
+    ('ventureño', 'veo'),
+    ('veo', 'veo'),
+    ('vep', 'vep'),
+    ('veps', 'vep'),
+    ('mom jango', 'ver'),
+    ('ver', 'ver'),
+    ('vaghri', 'vgr'),
+    ('vgr', 'vgr'),
+    ('vgt', 'vgt'),
+    ('vlaamse gebarentaal', 'vgt'),
+    ('vi', 'vi'),
+    ('vie', 'vi'),
+    ('vietnamese', 'vi'),
+    ('vic', 'vic'),
+    ('virgin islands creole english', 'vic'),
+    ('vid', 'vid'),
+    ('vidunda', 'vid'),
+    ('vif', 'vif'),
+    ('vili', 'vif'),
+    ('viemo', 'vig'),
+    ('vig', 'vig'),
+    ('vil', 'vil'),
+    ('vilela', 'vil'),
+    ('vin', 'vin'),
+    ('vinza', 'vin'),
+    ('vis', 'vis'),
+    ('vishavan', 'vis'),
+    ('vit', 'vit'),
+    ('viti', 'vit'),
+    ('iduna', 'viv'),
+    ('viv', 'viv'),
+    ('kariyarra', 'vka'),
+    ('vka', 'vka'),
+    ('kujarge', 'vkj'),
+    ('vkj', 'vkj'),
+    ('kaur', 'vkk'),
+    ('vkk', 'vkk'),
+    ('kulisusu', 'vkl'),
+    ('vkl', 'vkl'),
+    ('kamakan', 'vkm'),
+    ('vkm', 'vkm'),
+    ('koro nulu', 'vkn'),
+    ('vkn', 'vkn'),
+    ('kodeoha', 'vko'),
+    ('vko', 'vko'),
+    ('korlai creole portuguese', 'vkp'),
+    ('vkp', 'vkp'),
+    ('tenggarong kutai malay', 'vkt'),
+    ('vkt', 'vkt'),
+    ('kurrama', 'vku'),
+    ('vku', 'vku'),
+    ('koro zuba', 'vkz'),
+    ('vkz', 'vkz'),
+    ('valpei', 'vlp'),
+    ('vlp', 'vlp'),
+    ('vlaams', 'vls'),
+    ('vls', 'vls'),
+    ('martuyhunira', 'vma'),
+    ('vma', 'vma'),
+    ('barbaram', 'vmb'),
+    ('vmb', 'vmb'),
+    ('juxtlahuaca mixtec', 'vmc'),
+    ('vmc', 'vmc'),
+    ('mudu koraga', 'vmd'),
+    ('vmd', 'vmd'),
+    ('east masela', 'vme'),
+    ('vme', 'vme'),
+    ('mainfränkisch', 'vmf'),
+    ('vmf', 'vmf'),
+    ('lungalunga', 'vmg'),
+    ('vmg', 'vmg'),
+    ('maraghei', 'vmh'),
+    ('vmh', 'vmh'),
+    ('miwa', 'vmi'),
+    ('vmi', 'vmi'),
+    ('ixtayutla mixtec', 'vmj'),
+    ('vmj', 'vmj'),
+    ('makhuwa-shirima', 'vmk'),
+    ('vmk', 'vmk'),
+    ('malgana', 'vml'),
+    ('vml', 'vml'),
+    ('mitlatongo mixtec', 'vmm'),
+    ('vmm', 'vmm'),
+    ('soyaltepec mazatec', 'vmp'),
+    ('vmp', 'vmp'),
+    ('soyaltepec mixtec', 'vmq'),
+    ('vmq', 'vmq'),
+    ('marenje', 'vmr'),
+    ('vmr', 'vmr'),
+    ('moksela', 'vms'),
+    ('vms', 'vms'),
+    ('muluridyi', 'vmu'),
+    ('vmu', 'vmu'),
+    ('valley maidu', 'vmv'),
+    ('vmv', 'vmv'),
+    ('makhuwa', 'vmw'),
+    ('vmw', 'vmw'),
+    ('tamazola mixtec', 'vmx'),
+    ('vmx', 'vmx'),
+    ('ayautla mazatec', 'vmy'),
+    ('vmy', 'vmy'),
+    ('mazatlán mazatec', 'vmz'),
+    ('vmz', 'vmz'),
+    ('vano', 'vnk'),
+    ('vnk', 'vnk'),
+    ('vinmavis', 'vnm'),
+    ('vnm', 'vnm'),
+    ('vnp', 'vnp'),
+    ('vunapu', 'vnp'),
+    ('vo', 'vo'),
+    ('vol', 'vo'),
+    ('volapük', 'vo'),
+    ('vor', 'vor'),
+    ('voro', 'vor'),
+    ('vot', 'vot'),
+    ('votic', 'vot'),
+    ('vera''a', 'vra'),
+    ('vra', 'vra'),
+    ('vro', 'vro'),
+    ('võro', 'vro'),
+    ('varisi', 'vrs'),
+    ('vrs', 'vrs'),
+    ('burmbar', 'vrt'),
+    ('vrt', 'vrt'),
+    ('moldova sign language', 'vsi'),
+    ('vsi', 'vsi'),
+    ('venezuelan sign language', 'vsl'),
+    ('vsl', 'vsl'),
+    ('valencian sign language', 'vsv'),
+    ('vsv', 'vsv'),
+    ('vitou', 'vto'),
+    ('vto', 'vto'),
+    ('vum', 'vum'),
+    ('vumbu', 'vum'),
+    ('vun', 'vun'),
+    ('vunjo', 'vun'),
+    ('vut', 'vut'),
+    ('vute', 'vut'),
+    ('awa (china)', 'vwa'),
+    ('vwa', 'vwa'),
+    ('wa', 'wa'),
+    ('walloon', 'wa'),
+    ('wln', 'wa'),
+    ('waa', 'waa'),
+    ('walla walla', 'waa'),
+    ('wab', 'wab'),
+    ('wac', 'wac'),
+    ('wasco-wishram', 'wac'),
+    ('wad', 'wad'),
+    ('wamesa', 'wad'),
+    ('wae', 'wae'),
+    ('walser', 'wae'),
+    ('waf', 'waf'),
+    ('wakoná', 'waf'),
+    ('wa''ema', 'wag'),
+    ('wag', 'wag'),
+    ('wah', 'wah'),
+    ('watubela', 'wah'),
+    ('wai', 'wai'),
+    ('wares', 'wai'),
+    ('waffa', 'waj'),
+    ('waj', 'waj'),
+    ('wal', 'wal'),
+    ('walamo', 'wal'),
+    ('wolaytta', 'wal'),
+    ('wam', 'wam'),
+    ('wampanoag', 'wam'),
+    ('wan', 'wan'),
+    ('wao', 'wao'),
+    ('wappo', 'wao'),
+    ('wap', 'wap'),
+    ('wapishana', 'wap'),
+    ('wagiman', 'waq'),
+    ('waq', 'waq'),
+    ('war', 'war'),
+    ('waray', 'war'),
+    ('waray (philippines)', 'war'),
+    ('was', 'was'),
+    ('washo', 'was'),
+    ('kaninuwa', 'wat'),
+    ('wat', 'wat'),
+    ('wau', 'wau'),
+    ('waurá', 'wau'),
+    ('waka', 'wav'),
+    ('wav', 'wav'),
+    ('waiwai', 'waw'),
+    ('waw', 'waw'),
+    ('watam', 'wax'),
+    ('wax', 'wax'),
+    ('way', 'way'),
+    ('wayana', 'way'),
+    ('wampur', 'waz'),
+    ('waz', 'waz'),
+    ('warao', 'wba'),
+    ('wba', 'wba'),
+    ('wabo', 'wbb'),
+    ('wbb', 'wbb'),
+    ('waritai', 'wbe'),
+    ('wbe', 'wbe'),
+    ('wara', 'wbf'),
+    ('wbf', 'wbf'),
+    ('wanda', 'wbh'),
+    ('wbh', 'wbh'),
+    ('vwanji', 'wbi'),
+    ('wbi', 'wbi'),
+    ('alagwa', 'wbj'),
+    ('wbj', 'wbj'),
+    ('waigali', 'wbk'),
+    ('wbk', 'wbk'),
+    ('wakhi', 'wbl'),
+    ('wbl', 'wbl'),
+    ('wbm', 'wbm'),
+    ('warlpiri', 'wbp'),
+    ('wbp', 'wbp'),
+    ('waddar', 'wbq'),
+    ('wbq', 'wbq'),
+    ('wagdi', 'wbr'),
+    ('wbr', 'wbr'),
+    ('wbs', 'wbs'),
+    ('west bengal sign language', 'wbs'),
+    ('warnman', 'wbt'),
+    ('wbt', 'wbt'),
+    ('wajarri', 'wbv'),
+    ('wbv', 'wbv'),
+    ('wbw', 'wbw'),
+    ('woi', 'wbw'),
+    ('wca', 'wca'),
+    ('yanomámi', 'wca'),
+    ('waci gbe', 'wci'),
+    ('wci', 'wci'),
+    ('wandji', 'wdd'),
+    ('wdd', 'wdd'),
+    ('wadaginam', 'wdg'),
+    ('wdg', 'wdg'),
+    ('wadjiginy', 'wdj'),
+    ('wdj', 'wdj'),
+    ('wadikali', 'wdk'),
+    ('wdk', 'wdk'),
+    ('wdt', 'wdt'),
+    ('wendat', 'wdt'),
+    ('wadjigu', 'wdu'),
+    ('wdu', 'wdu'),
+    ('wadjabangayi', 'wdy'),
+    ('wdy', 'wdy'),
+    ('wea', 'wea'),
+    ('wewaw', 'wea'),
+    ('wec', 'wec'),
+    ('wè western', 'wec'),
+    ('wed', 'wed'),
+    ('wedau', 'wed'),
+    ('weg', 'weg'),
+    ('wergaia', 'weg'),
+    ('weh', 'weh'),
+    ('kiunum', 'wei'),
+    ('wei', 'wei'),
+    ('wem', 'wem'),
+    ('weme gbe', 'wem'),
+    ('wemale', 'weo'),
+    ('weo', 'weo'),
+    ('wep', 'wep'),
+    ('westphalien', 'wep'),
+    ('wer', 'wer'),
+    ('weri', 'wer'),
+    ('cameroon pidgin', 'wes'),
+    ('wes', 'wes'),
+    ('perai', 'wet'),
+    ('wet', 'wet'),
+    ('rawngtu chin', 'weu'),
+    ('weu', 'weu'),
+    ('wejewa', 'wew'),
+    ('wew', 'wew'),
+    ('wfg', 'wfg'),
+    ('yafi', 'wfg'),
+    ('wagaya', 'wga'),
+    ('wga', 'wga'),
+    ('wagawaga', 'wgb'),
+    ('wgb', 'wgb'),
+    ('wangkangurru', 'wgg'),
+    ('wgg', 'wgg'),
+    ('wahgi', 'wgi'),
+    ('wgi', 'wgi'),
+    ('waigeo', 'wgo'),
+    ('wgo', 'wgo'),
+    ('wgu', 'wgu'),
+    ('wirangu', 'wgu'),
+    ('warrgamay', 'wgy'),
+    ('wgy', 'wgy'),
+    ('sou upaa', 'wha'),
+    ('wha', 'wha'),
+    ('north wahgi', 'whg'),
+    ('whg', 'whg'),
+    ('wahau kenyah', 'whk'),
+    ('whk', 'whk'),
+    ('wahau kayan', 'whu'),
+    ('whu', 'whu'),
+    ('southern toussian', 'wib'),
+    ('wib', 'wib'),
+    ('wic', 'wic'),
+    ('wichita', 'wic'),
+    ('wie', 'wie'),
+    ('wik-epa', 'wie'),
+    ('wif', 'wif'),
+    ('wik-keyangan', 'wif'),
+    ('wig', 'wig'),
+    ('wik ngathan', 'wig'),
+    ('wih', 'wih'),
+    ('wik-me''anha', 'wih'),
+    ('minidien', 'wii'),
+    ('wii', 'wii'),
+    ('wij', 'wij'),
+    ('wik-iiyanh', 'wij'),
+    ('wik', 'wik'),
+    ('wikalkan', 'wik'),
+    ('wil', 'wil'),
+    ('wilawila', 'wil'),
+    ('wik-mungkan', 'wim'),
+    ('wim', 'wim'),
+    ('ho-chunk', 'win'),
+    ('win', 'win'),
+    ('wir', 'wir'),
+    ('wiraféd', 'wir'),
+    ('wiru', 'wiu'),
+    ('wiu', 'wiu'),
+    ('vitu', 'wiv'),
+    ('wiv', 'wiv'),
+    ('wiy', 'wiy'),
+    ('wiyot', 'wiy'),
+    ('waja', 'wja'),
+    ('wja', 'wja'),
+    ('warji', 'wji'),
+    ('wji', 'wji'),
+    ('kw''adza', 'wka'),
+    ('wka', 'wka'),
+    ('kumbaran', 'wkb'),
+    ('wkb', 'wkb'),
+    ('wakde', 'wkd'),
+    ('wkd', 'wkd'),
+    ('kalanadi', 'wkl'),
+    ('wkl', 'wkl'),
+    ('keerray-woorroong', 'wkr'),
+    ('wkr', 'wkr'),
+    ('kunduvadi', 'wku'),
+    ('wku', 'wku'),
+    ('wakawaka', 'wkw'),
+    ('wkw', 'wkw'),
+    ('wangkayutyuru', 'wky'),
+    ('wky', 'wky'),
+    ('walio', 'wla'),
+    ('wla', 'wla'),
+    ('mwali comorian', 'wlc'),
+    ('wlc', 'wlc'),
+    ('wle', 'wle'),
+    ('wolane', 'wle'),
+    ('kunbarlang', 'wlg'),
+    ('wlg', 'wlg'),
+    ('welaun', 'wlh'),
+    ('wlh', 'wlh'),
+    ('waioli', 'wli'),
+    ('wli', 'wli'),
+    ('wailaki', 'wlk'),
+    ('wlk', 'wlk'),
+    ('wali', 'wll'),
+    ('wali (sudan)', 'wll'),
+    ('wll', 'wll'),
+    ('middle welsh', 'wlm'),
+    ('wlm', 'wlm'),
+    ('wlo', 'wlo'),
+    ('wolio', 'wlo'),
+    ('wailapa', 'wlr'),
+    ('wlr', 'wlr'),
+    ('wallisian', 'wls'),
+    ('wls', 'wls'),
+    ('wlu', 'wlu'),
+    ('wuliwuli', 'wlu'),
+    ('wichí lhamtés vejoz', 'wlv'),
+    ('wlv', 'wlv'),
+    ('walak', 'wlw'),
+    ('wlw', 'wlw'),
+    ('wali (ghana)', 'wlx'),
+    ('wlx', 'wlx'),
+    ('waling', 'wly'),
+    ('wly', 'wly'),
+    ('mawa (nigeria)', 'wma'),
+    ('wma', 'wma'),
+    ('wambaya', 'wmb'),
+    ('wmb', 'wmb'),
+    ('wamas', 'wmc'),
+    ('wmc', 'wmc'),
+    ('mamaindé', 'wmd'),
+    ('wmd', 'wmd'),
+    ('wambule', 'wme'),
+    ('wme', 'wme'),
+    ('western minyag', 'wmg'),
+    ('wmg', 'wmg'),
+    ('waima''a', 'wmh'),
+    ('wmh', 'wmh'),
+    ('wamin', 'wmi'),
+    ('wmi', 'wmi'),
+    ('maiwa (indonesia)', 'wmm'),
+    ('wmm', 'wmm'),
+    ('waamwang', 'wmn'),
+    ('wmn', 'wmn'),
+    ('wmo', 'wmo'),
+    ('wom', 'wmo'),
+    ('wom (papua new guinea)', 'wmo'),
+    ('wambon', 'wms'),
+    ('wms', 'wms'),
+    ('walmajarri', 'wmt'),
+    ('wmt', 'wmt'),
+    ('mwani', 'wmw'),
+    ('wmw', 'wmw'),
+    ('wmx', 'wmx'),
+    ('womo', 'wmx'),
+    ('wanambre', 'wnb'),
+    ('wnb', 'wnb'),
+    ('wantoat', 'wnc'),
+    ('wnc', 'wnc'),
+    ('wandarang', 'wnd'),
+    ('wnd', 'wnd'),
+    ('waneci', 'wne'),
+    ('wne', 'wne'),
+    ('wanggom', 'wng'),
+    ('wng', 'wng'),
+    ('ndzwani comorian', 'wni'),
+    ('wni', 'wni'),
+    ('wanukaka', 'wnk'),
+    ('wnk', 'wnk'),
+    ('wanggamala', 'wnm'),
+    ('wnm', 'wnm'),
+    ('wnn', 'wnn'),
+    ('wunumara', 'wnn'),
+    ('wano', 'wno'),
+    ('wno', 'wno'),
+    ('wanap', 'wnp'),
+    ('wnp', 'wnp'),
+    ('usan', 'wnu'),
+    ('wnu', 'wnu'),
+    ('wintu', 'wnw'),
+    ('wnw', 'wnw'),
+    ('wanyi', 'wny'),
+    ('wny', 'wny'),
+    ('wo', 'wo'),
+    ('wol', 'wo'),
+    ('wolof', 'wo'),
+    ('kuwema', 'woa'),
+    ('woa', 'woa'),
+    ('wob', 'wob'),
+    ('wè northern', 'wob'),
+    ('woc', 'woc'),
+    ('wogeo', 'woc'),
+    ('wod', 'wod'),
+    ('wolani', 'wod'),
+    ('woe', 'woe'),
+    ('woleaian', 'woe'),
+    ('gambian wolof', 'wof'),
+    ('wof', 'wof'),
+    ('wog', 'wog'),
+    ('wogamusin', 'wog'),
+    ('kamang', 'woi'),
+    ('longto', 'wok'),
+    ('wok', 'wok'),
+    ('wom (nigeria)', 'wom'),
+    ('won', 'won'),
+    ('wongo', 'won'),
+    ('manombai', 'woo'),
+    ('woo', 'woo'),
+    ('wor', 'wor'),
+    ('woria', 'wor'),
+    ('hanga hundi', 'wos'),
+    ('wos', 'wos'),
+    ('wawonii', 'wow'),
+    ('wow', 'wow'),
+    ('weyto', 'woy'),
+    ('woy', 'woy'),
+    ('maco', 'wpc'),
+    ('wpc', 'wpc'),
+    ('waluwarra', 'wrb'),
+    ('wrb', 'wrb'),
+    ('warungu', 'wrg'),
+    ('wrg', 'wrg'),
+    ('wiradjuri', 'wrh'),
+    ('wrh', 'wrh'),
+    ('wariyangga', 'wri'),
+    ('wri', 'wri'),
+    ('garrwa', 'wrk'),
+    ('wrk', 'wrk'),
+    ('warlmanpa', 'wrl'),
+    ('wrl', 'wrl'),
+    ('warumungu', 'wrm'),
+    ('wrm', 'wrm'),
+    ('warnang', 'wrn'),
+    ('wrn', 'wrn'),
+    ('worrorra', 'wro'),
+    ('wro', 'wro'),
+    ('waropen', 'wrp'),
+    ('wrp', 'wrp'),
+    ('wardaman', 'wrr'),
+    ('wrr', 'wrr'),
+    ('waris', 'wrs'),
+    ('wrs', 'wrs'),
+    ('waru', 'wru'),
+    ('wru', 'wru'),
+    ('waruna', 'wrv'),
+    ('wrv', 'wrv'),
+    ('gugu warra', 'wrw'),
+    ('wrw', 'wrw'),
+    ('wae rana', 'wrx'),
+    ('wrx', 'wrx'),
+    ('merwari', 'wry'),
+    ('wry', 'wry'),
+    ('waray (australia)', 'wrz'),
+    ('wrz', 'wrz'),
+    ('warembori', 'wsa'),
+    ('wsa', 'wsa'),
+    ('adilabad gondi', 'wsg'),
+    ('wsg', 'wsg'),
+    ('wsi', 'wsi'),
+    ('wusi', 'wsi'),
+    ('waskia', 'wsk'),
+    ('wsk', 'wsk'),
+    ('owenia', 'wsr'),
+    ('wsr', 'wsr'),
+    ('wasa', 'wss'),
+    ('wss', 'wss'),
+    ('wasu', 'wsu'),
+    ('wsu', 'wsu'),
+    ('wotapuri-katarqalai', 'wsv'),
+    ('wsv', 'wsv'),
+    ('watiwa', 'wtf'),
+    ('wtf', 'wtf'),
+    ('wathawurrung', 'wth'),
+    ('wth', 'wth'),
+    ('berta', 'wti'),
+    ('wti', 'wti'),
+    ('watakataui', 'wtk'),
+    ('wtk', 'wtk'),
+    ('mewati', 'wtm'),
+    ('wtm', 'wtm'),
+    ('wotu', 'wtw'),
+    ('wtw', 'wtw'),
+    ('wikngenchera', 'wua'),
+    ('wua', 'wua'),
+    ('wub', 'wub'),
+    ('wunambal', 'wub'),
+    ('wud', 'wud'),
+    ('wudu', 'wud'),
+    ('wuh', 'wuh'),
+    ('wutunhua', 'wuh'),
+    ('silimo', 'wul'),
+    ('wul', 'wul'),
+    ('wum', 'wum'),
+    ('wumbvu', 'wum'),
+    ('bungu', 'wun'),
+    ('wun', 'wun'),
+    ('wur', 'wur'),
+    ('wurrugu', 'wur'),
+    ('wut', 'wut'),
+    ('wutung', 'wut'),
+    ('wu chinese', 'wuu'),
+    ('wuu', 'wuu'),
+    ('wuv', 'wuv'),
+    ('wuvulu-aua', 'wuv'),
+    ('wulna', 'wux'),
+    ('wux', 'wux'),
+    ('wauyai', 'wuy'),
+    ('wuy', 'wuy'),
+    ('waama', 'wwa'),
+    ('wwa', 'wwa'),
+    ('wakabunga', 'wwb'),
+    ('wwb', 'wwb'),
+    ('wetamut', 'wwo'),
+    ('wwo', 'wwo'),
+    ('warrwa', 'wwr'),
+    ('wwr', 'wwr'),
+    ('wawa', 'www'),
+    ('www', 'www'),
+    ('waxianghua', 'wxa'),
+    ('wxa', 'wxa'),
+    ('wardandi', 'wxw'),
+    ('wxw', 'wxw'),
+    ('wangaaybuwan-ngiyambaa', 'wyb'),
+    ('wyb', 'wyb'),
+    ('woiwurrung', 'wyi'),
+    ('wyi', 'wyi'),
+    ('wym', 'wym'),
+    ('wymysorys', 'wym'),
+    ('wyandot', 'wyn'),
+    ('wyn', 'wyn'),
+    ('wayoró', 'wyr'),
+    ('wyr', 'wyr'),
+    ('western fijian', 'wyy'),
+    ('wyy', 'wyy'),
+    ('andalusian arabic', 'xaa'),
+    ('xaa', 'xaa'),
+    ('sambe', 'xab'),
+    ('xab', 'xab'),
+    ('kachari', 'xac'),
+    ('xac', 'xac'),
+    ('adai', 'xad'),
+    ('xad', 'xad'),
+    ('aequian', 'xae'),
+    ('xae', 'xae'),
+    ('aghwan', 'xag'),
+    ('xag', 'xag'),
+    ('kaimbé', 'xai'),
+    ('xai', 'xai'),
+    ('ararandewára', 'xaj'),
+    ('xaj', 'xaj'),
+    ('máku', 'xak'),
+    ('xak', 'xak'),
+    ('kalmyk', 'xal'),
+    ('oirat', 'xal'),
+    ('xal', 'xal'),
+    ('xam', 'xam'),
+    ('ǀxam', 'xam'),
+    ('xamtanga', 'xan'),
+    ('xan', 'xan'),
+    ('khao', 'xao'),
+    ('xao', 'xao'),
+    ('apalachee', 'xap'),
+    ('xap', 'xap'),
+    ('aquitanian', 'xaq'),
+    ('xaq', 'xaq'),
+    ('karami', 'xar'),
+    ('xar', 'xar'),
+    ('kamas', 'xas'),
+    ('xas', 'xas'),
+    ('katawixi', 'xat'),
+    ('xat', 'xat'),
+    ('kauwera', 'xau'),
+    ('xau', 'xau'),
+    ('xav', 'xav'),
+    ('xavánte', 'xav'),
+    ('kawaiisu', 'xaw'),
+    ('xaw', 'xaw'),
+    ('kayan mahakam', 'xay'),
+    ('xay', 'xay'),
+    ('lower burdekin', 'xbb'),
+    ('xbb', 'xbb'),
+    ('bactrian', 'xbc'),
+    ('xbc', 'xbc'),
+    ('bindal', 'xbd'),
+    ('xbd', 'xbd'),
+    ('bigambal', 'xbe'),
+    ('xbe', 'xbe'),
+    ('bunganditj', 'xbg'),
+    ('xbg', 'xbg'),
+    ('kombio', 'xbi'),
+    ('xbi', 'xbi'),
+    ('birrpayi', 'xbj'),
+    ('xbj', 'xbj'),
+    ('middle breton', 'xbm'),
+    ('xbm', 'xbm'),
+    ('kenaboi', 'xbn'),
+    ('xbn', 'xbn'),
+    ('bolgarian', 'xbo'),
+    ('xbo', 'xbo'),
+    ('bibbulman', 'xbp'),
+    ('xbp', 'xbp'),
+    ('kambera', 'xbr'),
+    ('xbr', 'xbr'),
+    ('kambiwá', 'xbw'),
+    ('xbw', 'xbw'),
+    ('batjala', 'xby'),
+    ('xby', 'xby'),
+    ('cumbric', 'xcb'),
+    ('xcb', 'xcb'),
+    ('camunic', 'xcc'),
+    ('xcc', 'xcc'),
+    ('celtiberian', 'xce'),
+    ('xce', 'xce'),
+    ('cisalpine gaulish', 'xcg'),
+    ('xcg', 'xcg'),
+    ('chemakum', 'xch'),
+    ('xch', 'xch'),
+    ('classical armenian', 'xcl'),
+    ('xcl', 'xcl'),
+    ('comecrudo', 'xcm'),
+    ('xcm', 'xcm'),
+    ('cotoname', 'xcn'),
+    ('xcn', 'xcn'),
+    ('chorasmian', 'xco'),
+    ('xco', 'xco'),
+    ('carian', 'xcr'),
+    ('xcr', 'xcr'),
+    ('classical tibetan', 'xct'),
+    ('xct', 'xct'),
+    ('curonian', 'xcu'),
+    ('xcu', 'xcu'),
+    ('chuvantsy', 'xcv'),
+    ('xcv', 'xcv'),
+    ('coahuilteco', 'xcw'),
+    ('xcw', 'xcw'),
+    ('cayuse', 'xcy'),
+    ('xcy', 'xcy'),
+    ('darkinyung', 'xda'),
+    ('xda', 'xda'),
+    ('dacian', 'xdc'),
+    ('xdc', 'xdc'),
+    ('dharuk', 'xdk'),
+    ('xdk', 'xdk'),
+    ('edomite', 'xdm'),
+    ('xdm', 'xdm'),
+    ('kwandu', 'xdo'),
+    ('xdo', 'xdo'),
+    ('kaitag', 'xdq'),
+    ('xdq', 'xdq'),
+    ('malayic dayak', 'xdy'),
+    ('xdy', 'xdy'),
+    ('eblan', 'xeb'),
+    ('xeb', 'xeb'),
+    ('hdi', 'xed'),
+    ('xed', 'xed'),
+    ('xeg', 'xeg'),
+    ('ǁxegwi', 'xeg'),
+    ('kelo', 'xel'),
+    ('xel', 'xel'),
+    ('kembayan', 'xem'),
+    ('xem', 'xem'),
+    ('epi-olmec', 'xep'),
+    ('xep', 'xep'),
+    ('xer', 'xer'),
+    ('xerénte', 'xer'),
+    ('kesawai', 'xes'),
+    ('xes', 'xes'),
+    ('xet', 'xet'),
+    ('xetá', 'xet'),
+    ('keoru-ahia', 'xeu'),
+    ('xeu', 'xeu'),
+    ('faliscan', 'xfa'),
+    ('xfa', 'xfa'),
+    ('galatian', 'xga'),
+    ('xga', 'xga'),
+    ('gbin', 'xgb'),
+    ('xgb', 'xgb'),
+    ('gudang', 'xgd'),
+    ('xgd', 'xgd'),
+    ('gabrielino-fernandeño', 'xgf'),
+    ('xgf', 'xgf'),
+    ('goreng', 'xgg'),
+    ('xgg', 'xgg'),
+    ('garingbal', 'xgi'),
+    ('xgi', 'xgi'),
+    ('galindan', 'xgl'),
+    ('xgl', 'xgl'),
+    ('dharumbal', 'xgm'),
+    ('xgm', 'xgm'),
+    ('garza', 'xgr'),
+    ('xgr', 'xgr'),
+    ('unggumi', 'xgu'),
+    ('xgu', 'xgu'),
+    ('guwa', 'xgw'),
+    ('xgw', 'xgw'),
+    ('xh', 'xh'),
+    ('xho', 'xh'),
+    ('xhosa', 'xh'),
+    ('harami', 'xha'),
+    ('xha', 'xha'),
+    ('hunnic', 'xhc'),
+    ('xhc', 'xhc'),
+    ('hadrami', 'xhd'),
+    ('xhd', 'xhd'),
+    ('khetrani', 'xhe'),
+    ('xhe', 'xhe'),
+    ('middle khmer', 'xhm'),
+    ('middle khmer (1400 to 1850 ce)', 'xhm'),
+    ('xhm', 'xhm'),
+    ('hernican', 'xhr'),
+    ('xhr', 'xhr'),
+    ('hattic', 'xht'),
+    ('xht', 'xht'),
+    ('hurrian', 'xhu'),
+    ('xhu', 'xhu'),
+    ('khua', 'xhv'),
+    ('xhv', 'xhv'),
+    ('iberian', 'xib'),
+    ('xib', 'xib'),
+    ('xii', 'xii'),
+    ('xiri', 'xii'),
+    ('illyrian', 'xil'),
+    ('xil', 'xil'),
+    ('xin', 'xin'),
+    ('xinca', 'xin'),
+    ('xir', 'xir'),
+    ('xiriâna', 'xir'),
+    ('kisan', 'xis'),
+    ('xis', 'xis'),
+    ('indus valley language', 'xiv'),
+    ('xiv', 'xiv'),
+    ('xipaya', 'xiy'),
+    ('xiy', 'xiy'),
+    ('minjungbal', 'xjb'),
+    ('xjb', 'xjb'),
+    ('jaitmatang', 'xjt'),
+    ('xjt', 'xjt'),
+    ('kalkoti', 'xka'),
+    ('xka', 'xka'),
+    ('northern nago', 'xkb'),
+    ('xkb', 'xkb'),
+    ('kho''ini', 'xkc'),
+    ('xkc', 'xkc'),
+    ('mendalam kayan', 'xkd'),
+    ('xkd', 'xkd'),
+    ('kereho', 'xke'),
+    ('xke', 'xke'),
+    ('khengkha', 'xkf'),
+    ('xkf', 'xkf'),
+    ('kagoro', 'xkg'),
+    ('xkg', 'xkg'),
+    ('kenyan sign language', 'xki'),
+    ('xki', 'xki'),
+    ('kajali', 'xkj'),
+    ('xkj', 'xkj'),
+    ('kachok', 'xkk'),
+    ('xkk', 'xkk'),
+    ('mainstream kenyah', 'xkl'),
+    ('xkl', 'xkl'),
+    ('kayan river kayan', 'xkn'),
+    ('xkn', 'xkn'),
+    ('kiorr', 'xko'),
+    ('xko', 'xko'),
+    ('kabatei', 'xkp'),
+    ('xkp', 'xkp'),
+    ('koroni', 'xkq'),
+    ('xkq', 'xkq'),
+    ('xakriabá', 'xkr'),
+    ('xkr', 'xkr'),
+    ('kumbewaha', 'xks'),
+    ('xks', 'xks'),
+    ('kantosi', 'xkt'),
+    ('xkt', 'xkt'),
+    ('kaamba', 'xku'),
+    ('xku', 'xku'),
+    ('kgalagadi', 'xkv'),
+    ('xkv', 'xkv'),
+    ('kembra', 'xkw'),
+    ('xkw', 'xkw'),
+    ('karore', 'xkx'),
+    ('xkx', 'xkx'),
+    ('uma'' lasan', 'xky'),
+    ('xky', 'xky'),
+    ('kurtokha', 'xkz'),
+    ('xkz', 'xkz'),
+    ('kamula', 'xla'),
+    ('xla', 'xla'),
+    ('loup b', 'xlb'),
+    ('xlb', 'xlb'),
+    ('lycian', 'xlc'),
+    ('xlc', 'xlc'),
+    ('lydian', 'xld'),
+    ('xld', 'xld'),
+    ('lemnian', 'xle'),
+    ('xle', 'xle'),
+    ('ligurian (ancient)', 'xlg'),
+    ('xlg', 'xlg'),
+    ('liburnian', 'xli'),
+    ('xli', 'xli'),
+    ('alanic', 'xln'),
+    ('xln', 'xln'),
+    ('loup a', 'xlo'),
+    ('xlo', 'xlo'),
+    ('lepontic', 'xlp'),
+    ('xlp', 'xlp'),
+    ('lusitanian', 'xls'),
+    ('xls', 'xls'),
+    ('cuneiform luwian', 'xlu'),
+    ('xlu', 'xlu'),
+    ('elymian', 'xly'),
+    ('xly', 'xly'),
+    ('mushungulu', 'xma'),
+    ('xma', 'xma'),
+    ('mbonga', 'xmb'),
+    ('xmb', 'xmb'),
+    ('makhuwa-marrevone', 'xmc'),
+    ('xmc', 'xmc'),
+    ('mbudum', 'xmd'),
+    ('xmd', 'xmd'),
+    ('median', 'xme'),
+    ('xme', 'xme'),
+    ('mingrelian', 'xmf'),
+    ('xmf', 'xmf'),
+    ('mengaka', 'xmg'),
+    ('xmg', 'xmg'),
+    ('kugu-muminh', 'xmh'),
+    ('xmh', 'xmh'),
+    ('majera', 'xmj'),
+    ('xmj', 'xmj'),
+    ('ancient macedonian', 'xmk'),
+    ('xmk', 'xmk'),
+    ('malaysian sign language', 'xml'),
+    ('xml', 'xml'),
+    ('manado malay', 'xmm'),
+    ('xmm', 'xmm'),
+    ('manichaean middle persian', 'xmn'),
+    ('xmn', 'xmn'),
+    ('morerebi', 'xmo'),
+    ('xmo', 'xmo'),
+    ('kuku-mu''inh', 'xmp'),
+    ('xmp', 'xmp'),
+    ('kuku-mangk', 'xmq'),
+    ('xmq', 'xmq'),
+    ('meroitic', 'xmr'),
+    ('xmr', 'xmr'),
+    ('moroccan sign language', 'xms'),
+    ('xms', 'xms'),
+    ('matbat', 'xmt'),
+    ('xmt', 'xmt'),
+    ('kamu', 'xmu'),
+    ('xmu', 'xmu'),
+    ('antankarana malagasy', 'xmv'),
+    ('xmv', 'xmv'),
+    ('tsimihety malagasy', 'xmw'),
+    ('xmw', 'xmw'),
+    ('salawati', 'xmx'),
+    ('xmx', 'xmx'),
+    ('mayaguduna', 'xmy'),
+    ('xmy', 'xmy'),
+    ('mori bawah', 'xmz'),
+    ('xmz', 'xmz'),
+    ('ancient north arabian', 'xna'),
+    ('xna', 'xna'),
+    ('kanakanabu', 'xnb'),
+    ('xnb', 'xnb'),
+    ('middle mongolian', 'xng'),
+    ('xng', 'xng'),
+    ('kuanhua', 'xnh'),
+    ('xnh', 'xnh'),
+    ('ngarigu', 'xni'),
+    ('xni', 'xni'),
+    ('ngoni', 'xnj'),
+    ('ngoni (tanzania)', 'xnj'),
+    ('xnj', 'xnj'),
+    ('nganakarti', 'xnk'),
+    ('xnk', 'xnk'),
+    ('ngumbarl', 'xnm'),
+    ('xnm', 'xnm'),
+    ('northern kankanay', 'xnn'),
+    ('xnn', 'xnn'),
+    ('anglo-norman', 'xno'),
+    ('xno', 'xno'),
+    ('ngoni (mozambique)', 'xnq'),
+    ('xnq', 'xnq'),
+    ('kangri', 'xnr'),
+    ('xnr', 'xnr'),
+    ('kanashi', 'xns'),
+    ('xns', 'xns'),
+    ('narragansett', 'xnt'),
+    ('xnt', 'xnt'),
+    ('nukunul', 'xnu'),
+    ('xnu', 'xnu'),
+    ('nyiyaparli', 'xny'),
+    ('xny', 'xny'),
+    ('kenzi', 'xnz'),
+    ('xnz', 'xnz'),
+    ('o''chi''chi''', 'xoc'),
+    ('xoc', 'xoc'),
+    ('kokoda', 'xod'),
+    ('xod', 'xod'),
+    ('soga', 'xog'),
+    ('xog', 'xog'),
+    ('kominimung', 'xoi'),
+    ('xoi', 'xoi'),
+    ('xok', 'xok'),
+    ('xokleng', 'xok'),
+    ('komo (sudan)', 'xom'),
+    ('xom', 'xom'),
+    ('konkomba', 'xon'),
+    ('xon', 'xon'),
+    ('xoo', 'xoo'),
+    ('xukurú', 'xoo'),
+    ('kopar', 'xop'),
+    ('xop', 'xop'),
+    ('korubo', 'xor'),
+    ('xor', 'xor'),
+    ('kowaki', 'xow'),
+    ('xow', 'xow'),
+    ('pirriya', 'xpa'),
+    ('xpa', 'xpa'),
+    ('northeastern tasmanian', 'xpb'),
+    ('xpb', 'xpb'),
+    ('pecheneg', 'xpc'),
+    ('xpc', 'xpc'),
+    ('oyster bay tasmanian', 'xpd'),
+    ('xpd', 'xpd'),
+    ('liberia kpelle', 'xpe'),
+    ('xpe', 'xpe'),
+    ('southeast tasmanian', 'xpf'),
+    ('xpf', 'xpf'),
+    ('phrygian', 'xpg'),
+    ('xpg', 'xpg'),
+    ('north midlands tasmanian', 'xph'),
+    ('xph', 'xph'),
+    ('pictish', 'xpi'),
+    ('xpi', 'xpi'),
+    ('mpalitjanh', 'xpj'),
+    ('xpj', 'xpj'),
+    ('kulina pano', 'xpk'),
+    ('xpk', 'xpk'),
+    ('port sorell tasmanian', 'xpl'),
+    ('xpl', 'xpl'),
+    ('pumpokol', 'xpm'),
+    ('xpm', 'xpm'),
+    ('kapinawá', 'xpn'),
+    ('xpn', 'xpn'),
+    ('pochutec', 'xpo'),
+    ('xpo', 'xpo'),
+    ('puyo-paekche', 'xpp'),
+    ('xpp', 'xpp'),
+    ('mohegan-pequot', 'xpq'),
+    ('xpq', 'xpq'),
+    ('parthian', 'xpr'),
+    ('xpr', 'xpr'),
+    ('pisidian', 'xps'),
+    ('xps', 'xps'),
+    ('punthamara', 'xpt'),
+    ('xpt', 'xpt'),
+    ('punic', 'xpu'),
+    ('xpu', 'xpu'),
+    ('northern tasmanian', 'xpv'),
+    ('xpv', 'xpv'),
+    ('northwestern tasmanian', 'xpw'),
+    ('xpw', 'xpw'),
+    ('southwestern tasmanian', 'xpx'),
+    ('xpx', 'xpx'),
+    ('puyo', 'xpy'),
+    ('xpy', 'xpy'),
+    ('bruny island tasmanian', 'xpz'),
+    ('xpz', 'xpz'),
+    ('karakhanid', 'xqa'),
+    ('xqa', 'xqa'),
+    ('qatabanian', 'xqt'),
+    ('xqt', 'xqt'),
+    ('krahô', 'xra'),
+    ('xra', 'xra'),
+    ('eastern karaboro', 'xrb'),
+    ('xrb', 'xrb'),
+    ('gundungurra', 'xrd'),
+    ('xrd', 'xrd'),
+    ('kreye', 'xre'),
+    ('xre', 'xre'),
+    ('minang', 'xrg'),
+    ('xrg', 'xrg'),
+    ('krikati-timbira', 'xri'),
+    ('xri', 'xri'),
+    ('armazic', 'xrm'),
+    ('xrm', 'xrm'),
+    ('arin', 'xrn'),
+    ('xrn', 'xrn'),
+    ('raetic', 'xrr'),
+    ('xrr', 'xrr'),
+    ('aranama-tamique', 'xrt'),
+    ('xrt', 'xrt'),
+    ('marriammu', 'xru'),
+    ('xru', 'xru'),
+    ('karawa', 'xrw'),
+    ('xrw', 'xrw'),
+    ('sabaean', 'xsa'),
+    ('xsa', 'xsa'),
+    ('sambal', 'xsb'),
+    ('xsb', 'xsb'),
+    ('scythian', 'xsc'),
+    ('xsc', 'xsc'),
+    ('sidetic', 'xsd'),
+    ('xsd', 'xsd'),
+    ('sempan', 'xse'),
+    ('xse', 'xse'),
+    ('shamang', 'xsh'),
+    ('xsh', 'xsh'),
+    ('sio', 'xsi'),
+    ('xsi', 'xsi'),
+    ('subi', 'xsj'),
+    ('xsj', 'xsj'),
+    ('south slavey', 'xsl'),
+    ('xsl', 'xsl'),
+    ('kasem', 'xsm'),
+    ('xsm', 'xsm'),
+    ('sanga (nigeria)', 'xsn'),
+    ('xsn', 'xsn'),
+    ('solano', 'xso'),
+    ('xso', 'xso'),
+    ('silopi', 'xsp'),
+    ('xsp', 'xsp'),
+    ('makhuwa-saka', 'xsq'),
+    ('xsq', 'xsq'),
+    ('sherpa', 'xsr'),
+    ('xsr', 'xsr'),
+    ('assan', 'xss'),
+    ('xss', 'xss'),
+    ('sanumá', 'xsu'),
+    ('xsu', 'xsu'),
+    ('sudovian', 'xsv'),
+    ('xsv', 'xsv'),
+    ('saisiyat', 'xsy'),
+    ('xsy', 'xsy'),
+    ('alcozauca mixtec', 'xta'),
+    ('xta', 'xta'),
+    ('chazumba mixtec', 'xtb'),
+    ('xtb', 'xtb'),
+    ('katcha-kadugli-miri', 'xtc'),
+    ('xtc', 'xtc'),
+    ('diuxi-tilantongo mixtec', 'xtd'),
+    ('xtd', 'xtd'),
+    ('ketengban', 'xte'),
+    ('xte', 'xte'),
+    ('transalpine gaulish', 'xtg'),
+    ('xtg', 'xtg'),
+    ('xth', 'xth'),
+    ('yitha yitha', 'xth'),
+    ('sinicahua mixtec', 'xti'),
+    ('xti', 'xti'),
+    ('san juan teita mixtec', 'xtj'),
+    ('xtj', 'xtj'),
+    ('tijaltepec mixtec', 'xtl'),
+    ('xtl', 'xtl'),
+    ('magdalena peñasco mixtec', 'xtm'),
+    ('xtm', 'xtm'),
+    ('northern tlaxiaco mixtec', 'xtn'),
+    ('xtn', 'xtn'),
+    ('tokharian a', 'xto'),
+    ('xto', 'xto'),
+    ('san miguel piedras mixtec', 'xtp'),
+    ('xtp', 'xtp'),
+    ('tumshuqese', 'xtq'),
+    ('xtq', 'xtq'),
+    ('early tripuri', 'xtr'),
+    ('xtr', 'xtr'),
+    ('sindihui mixtec', 'xts'),
+    ('xts', 'xts'),
+    ('tacahua mixtec', 'xtt'),
+    ('xtt', 'xtt'),
+    ('cuyamecalco mixtec', 'xtu'),
+    ('xtu', 'xtu'),
+    ('thawa', 'xtv'),
+    ('xtv', 'xtv'),
+    ('tawandê', 'xtw'),
+    ('xtw', 'xtw'),
+    ('xty', 'xty'),
+    ('yoloxochitl mixtec', 'xty'),
+    ('alu kurumba', 'xua'),
+    ('xua', 'xua'),
+    ('betta kurumba', 'xub'),
+    ('xub', 'xub'),
+    ('umiida', 'xud'),
+    ('xud', 'xud'),
+    ('kunigami', 'xug'),
+    ('xug', 'xug'),
+    ('jennu kurumba', 'xuj'),
+    ('xuj', 'xuj'),
+    ('ngunawal', 'xul'),
+    ('xul', 'xul'),
+    ('umbrian', 'xum'),
+    ('xum', 'xum'),
+    ('unggaranggu', 'xun'),
+    ('xun', 'xun'),
+    ('xuo', 'xuo'),
+    ('upper umpqua', 'xup'),
+    ('xup', 'xup'),
+    ('urartian', 'xur'),
+    ('xur', 'xur'),
+    ('kuthant', 'xut'),
+    ('xut', 'xut'),
+    ('kxoe', 'xuu'),
+    ('xuu', 'xuu'),
+    ('venetic', 'xve'),
+    ('xve', 'xve'),
+    ('kamviri', 'xvi'),
+    ('xvi', 'xvi'),
+    ('vandalic', 'xvn'),
+    ('xvn', 'xvn'),
+    ('volscian', 'xvo'),
+    ('xvo', 'xvo'),
+    ('vestinian', 'xvs'),
+    ('xvs', 'xvs'),
+    ('kwaza', 'xwa'),
+    ('xwa', 'xwa'),
+    ('woccon', 'xwc'),
+    ('xwc', 'xwc'),
+    ('wadi wadi', 'xwd'),
+    ('xwd', 'xwd'),
+    ('xwe', 'xwe'),
+    ('xwela gbe', 'xwe'),
+    ('kwegu', 'xwg'),
+    ('xwg', 'xwg'),
+    ('wajuk', 'xwj'),
+    ('xwj', 'xwj'),
+    ('wangkumara', 'xwk'),
+    ('xwk', 'xwk'),
+    ('western xwla gbe', 'xwl'),
+    ('xwl', 'xwl'),
+    ('written oirat', 'xwo'),
+    ('xwo', 'xwo'),
+    ('kwerba mamberamo', 'xwr'),
+    ('xwr', 'xwr'),
+    ('wotjobaluk', 'xwt'),
+    ('xwt', 'xwt'),
+    ('wemba wemba', 'xww'),
+    ('xww', 'xww'),
+    ('boro (ghana)', 'xxb'),
+    ('xxb', 'xxb'),
+    ('ke''o', 'xxk'),
+    ('xxk', 'xxk'),
+    ('minkin', 'xxm'),
+    ('xxm', 'xxm'),
+    ('koropó', 'xxr'),
+    ('xxr', 'xxr'),
+    ('tambora', 'xxt'),
+    ('xxt', 'xxt'),
+    ('xya', 'xya'),
+    ('yaygir', 'xya'),
+    ('xyb', 'xyb'),
+    ('yandjibara', 'xyb'),
+    ('mayi-yapi', 'xyj'),
+    ('xyj', 'xyj'),
+    ('mayi-kulan', 'xyk'),
+    ('xyk', 'xyk'),
+    ('xyl', 'xyl'),
+    ('yalakalore', 'xyl'),
+    ('mayi-thakurti', 'xyt'),
+    ('xyt', 'xyt'),
+    ('xyy', 'xyy'),
+    ('yorta yorta', 'xyy'),
+    ('xzh', 'xzh'),
+    ('zhang-zhung', 'xzh'),
+    ('xzm', 'xzm'),
+    ('zemgalian', 'xzm'),
+    ('ancient zapotec', 'xzp'),
+    ('xzp', 'xzp'),
+    ('yaa', 'yaa'),
+    ('yaminahua', 'yaa'),
+    ('yab', 'yab'),
+    ('yuhup', 'yab'),
+    ('pass valley yali', 'yac'),
+    ('yac', 'yac'),
+    ('yad', 'yad'),
+    ('yagua', 'yad'),
+    ('pumé', 'yae'),
+    ('yae', 'yae'),
+    ('yaf', 'yaf'),
+    ('yaka (democratic republic of congo)', 'yaf'),
+    ('yag', 'yag'),
+    ('yámana', 'yag'),
+    ('yah', 'yah'),
+    ('yazgulyam', 'yah'),
+    ('yagnobi', 'yai'),
+    ('yai', 'yai'),
+    ('banda-yangere', 'yaj'),
+    ('yaj', 'yaj'),
+    ('yak', 'yak'),
+    ('yakama', 'yak'),
+    ('yal', 'yal'),
+    ('yalunka', 'yal'),
+    ('yam', 'yam'),
+    ('yamba', 'yam'),
+    ('mayangna', 'yan'),
+    ('yan', 'yan'),
+    ('yao', 'yao'),
+    ('yap', 'yap'),
+    ('yapese', 'yap'),
+    ('yaq', 'yaq'),
+    ('yaqui', 'yaq'),
+    ('yabarana', 'yar'),
+    ('yar', 'yar'),
+    ('nugunu (cameroon)', 'yas'),
+    ('yas', 'yas'),
+    ('yambeta', 'yat'),
+    ('yat', 'yat'),
+    ('yau', 'yau'),
+    ('yuwana', 'yau'),
+    ('yangben', 'yav'),
+    ('yav', 'yav'),
+    ('yaw', 'yaw'),
+    ('yawalapití', 'yaw'),
+    ('yauma', 'yax'),
+    ('yax', 'yax'),
+    ('agwagwune', 'yay'),
+    ('yay', 'yay'),
+    ('lokaa', 'yaz'),
+    ('yaz', 'yaz'),
+    ('yala', 'yba'),
+    ('yba', 'yba'),
+    ('ybb', 'ybb'),
+    ('yemba', 'ybb'),
+    ('west yugur', 'ybe'),
+    ('ybe', 'ybe'),
+    ('yakha', 'ybh'),
+    ('ybh', 'ybh'),
+    ('yamphu', 'ybi'),
+    ('ybi', 'ybi'),
+    ('hasha', 'ybj'),
+    ('ybj', 'ybj'),
+    ('bokha', 'ybk'),
+    ('ybk', 'ybk'),
+    ('ybl', 'ybl'),
+    ('yukuben', 'ybl'),
+    ('yaben', 'ybm'),
+    ('ybm', 'ybm'),
+    ('yabaâna', 'ybn'),
+    ('ybn', 'ybn'),
+    ('yabong', 'ybo'),
+    ('ybo', 'ybo'),
+    ('yawiyo', 'ybx'),
+    ('ybx', 'ybx'),
+    ('yaweyuha', 'yby'),
+    ('yby', 'yby'),
+    ('chesu', 'ych'),
+    ('ych', 'ych'),
+    ('lolopo', 'ycl'),
+    ('ycl', 'ycl'),
+    ('ycn', 'ycn'),
+    ('yucuna', 'ycn'),
+    ('chepya', 'ycp'),
+    ('ycp', 'ycp'),
+    ('yanda', 'yda'),
+    ('yda', 'yda'),
+    ('eastern yiddish', 'ydd'),
+    ('ydd', 'ydd'),
+    ('yangum dey', 'yde'),
+    ('yde', 'yde'),
+    ('ydg', 'ydg'),
+    ('yidgha', 'ydg'),
+    ('ydk', 'ydk'),
+    ('yoidik', 'ydk'),
+    ('ravula', 'yea'),
+    ('yea', 'yea'),
+    ('yec', 'yec'),
+    ('yeniche', 'yec'),
+    ('yee', 'yee'),
+    ('yimas', 'yee'),
+    ('yeni', 'yei'),
+    ('yej', 'yej'),
+    ('yevanic', 'yej'),
+    ('yel', 'yel'),
+    ('yela', 'yel'),
+    ('tarok', 'yer'),
+    ('yer', 'yer'),
+    ('nyankpa', 'yes'),
+    ('yes', 'yes'),
+    ('yet', 'yet'),
+    ('yetfa', 'yet'),
+    ('yerukula', 'yeu'),
+    ('yeu', 'yeu'),
+    ('yapunda', 'yev'),
+    ('yev', 'yev'),
+    ('yey', 'yey'),
+    ('yeyi', 'yey'),
+    ('malyangapa', 'yga'),
+    ('yga', 'yga'),
+    ('ygi', 'ygi'),
+    ('yiningayi', 'ygi'),
+    ('yangum gel', 'ygl'),
+    ('ygl', 'ygl'),
+    ('yagomi', 'ygm'),
+    ('ygm', 'ygm'),
+    ('gepo', 'ygp'),
+    ('ygp', 'ygp'),
+    ('yagaria', 'ygr'),
+    ('ygr', 'ygr'),
+    ('ygs', 'ygs'),
+    ('yolŋu sign language', 'ygs'),
+    ('ygu', 'ygu'),
+    ('yugul', 'ygu'),
+    ('yagwoia', 'ygw'),
+    ('ygw', 'ygw'),
+    ('baha buyang', 'yha'),
+    ('yha', 'yha'),
+    ('judeo-iraqi arabic', 'yhd'),
+    ('yhd', 'yhd'),
+    ('hlepho phowa', 'yhl'),
+    ('yhl', 'yhl'),
+    ('yan-nhaŋu sign language', 'yhs'),
+    ('yhs', 'yhs'),
+    ('yi', 'yi'),
+    ('yid', 'yi'),
+    ('yiddish', 'yi'),
+    ('yia', 'yia'),
+    ('yinggarda', 'yia'),
+    ('ache', 'yif'),
+    ('yif', 'yif'),
+    ('wusa nasu', 'yig'),
+    ('yig', 'yig'),
+    ('western yiddish', 'yih'),
+    ('yih', 'yih'),
+    ('yidiny', 'yii'),
+    ('yii', 'yii'),
+    ('yij', 'yij'),
+    ('yindjibarndi', 'yij'),
+    ('dongshanba lalo', 'yik'),
+    ('yik', 'yik'),
+    ('yil', 'yil'),
+    ('yindjilandji', 'yil'),
+    ('yim', 'yim'),
+    ('yimchungru naga', 'yim'),
+    ('riang lai', 'yin'),
+    ('yin', 'yin'),
+    ('pholo', 'yip'),
+    ('yip', 'yip'),
+    ('miqie', 'yiq'),
+    ('yiq', 'yiq'),
+    ('north awyu', 'yir'),
+    ('yir', 'yir'),
+    ('yis', 'yis'),
+    ('eastern lalu', 'yit'),
+    ('yit', 'yit'),
+    ('yiu', 'yiu'),
+    ('northern nisu', 'yiv'),
+    ('yiv', 'yiv'),
+    ('axi yi', 'yix'),
+    ('yix', 'yix'),
+    ('azhe', 'yiz'),
+    ('yiz', 'yiz'),
+    ('yakan', 'yka'),
+    ('yka', 'yka'),
+    ('northern yukaghir', 'ykg'),
+    ('ykg', 'ykg'),
+    ('yki', 'yki'),
+    ('yoke', 'yki'),
+    ('yakaikeke', 'ykk'),
+    ('ykk', 'ykk'),
+    ('khlula', 'ykl'),
+    ('ykl', 'ykl'),
+    ('ykm', 'ykm'),
+    ('kua-nsi', 'ykn'),
+    ('ykn', 'ykn'),
+    ('yasa', 'yko'),
+    ('yko', 'yko'),
+    ('yekora', 'ykr'),
+    ('ykr', 'ykr'),
+    ('kathu', 'ykt'),
+    ('ykt', 'ykt'),
+    ('kuamasi', 'yku'),
+    ('yku', 'yku'),
+    ('yakoma', 'yky'),
+    ('yky', 'yky'),
+    ('yaul', 'yla'),
+    ('yla', 'yla'),
+    ('yaleba', 'ylb'),
+    ('ylb', 'ylb'),
+    ('yele', 'yle'),
+    ('yle', 'yle'),
+    ('yelogu', 'ylg'),
+    ('ylg', 'ylg'),
+    ('angguruk yali', 'yli'),
+    ('yli', 'yli'),
+    ('yll', 'yll'),
+    ('limi', 'ylm'),
+    ('ylm', 'ylm'),
+    ('langnian buyang', 'yln'),
+    ('yln', 'yln'),
+    ('naluo yi', 'ylo'),
+    ('ylo', 'ylo'),
+    ('yalarnnga', 'ylr'),
+    ('ylr', 'ylr'),
+    ('aribwaung', 'ylu'),
+    ('ylu', 'ylu'),
+    ('nyâlayu', 'yly'),
+    ('yly', 'yly'),
+    ('yambes', 'ymb'),
+    ('ymb', 'ymb'),
+    ('southern muji', 'ymc'),
+    ('ymc', 'ymc'),
+    ('muda', 'ymd'),
+    ('ymd', 'ymd'),
+    ('yameo', 'yme'),
+    ('yme', 'yme'),
+    ('yamongeri', 'ymg'),
+    ('ymg', 'ymg'),
+    ('mili', 'ymh'),
+    ('ymh', 'ymh'),
+    ('moji', 'ymi'),
+    ('ymi', 'ymi'),
+    ('makwe', 'ymk'),
+    ('ymk', 'ymk'),
+    ('iamalele', 'yml'),
+    ('yml', 'yml'),
+    ('maay', 'ymm'),
+    ('ymm', 'ymm'),
+    ('yamna', 'ymn'),
+    ('ymn', 'ymn'),
+    ('yangum mon', 'ymo'),
+    ('ymo', 'ymo'),
+    ('yamap', 'ymp'),
+    ('ymp', 'ymp'),
+    ('qila muji', 'ymq'),
+    ('ymq', 'ymq'),
+    ('malasar', 'ymr'),
+    ('ymr', 'ymr'),
+    ('mysian', 'yms'),
+    ('yms', 'yms'),
+    ('northern muji', 'ymx'),
+    ('ymx', 'ymx'),
+    ('muzi', 'ymz'),
+    ('ymz', 'ymz'),
+    ('aluo', 'yna'),
+    ('yna', 'yna'),
+    ('yandruwandha', 'ynd'),
+    ('ynd', 'ynd'),
+    ('lang''e', 'yne'),
+    ('yne', 'yne'),
+    ('yango', 'yng'),
+    ('yng', 'yng'),
+    ('naukan yupik', 'ynk'),
+    ('ynk', 'ynk'),
+    ('yangulam', 'ynl'),
+    ('ynl', 'ynl'),
+    ('yana', 'ynn'),
+    ('ynn', 'ynn'),
+    ('yno', 'yno'),
+    ('yong', 'yno'),
+    ('yendang', 'ynq'),
+    ('ynq', 'ynq'),
+    ('yansi', 'yns'),
+    ('yns', 'yns'),
+    ('yahuna', 'ynu'),
+    ('ynu', 'ynu'),
+    ('yo', 'yo'),
+    ('yor', 'yo'),
+    ('yoruba', 'yo'),
+    ('yob', 'yob'),
+    ('yoba', 'yob'),
+    ('yog', 'yog'),
+    ('yogad', 'yog'),
+    ('yoi', 'yoi'),
+    ('yonaguni', 'yoi'),
+    ('yok', 'yok'),
+    ('yokuts', 'yok'),
+    ('yol', 'yol'),
+    ('yola', 'yol'),
+    ('yombe', 'yom'),
+    ('yon', 'yon'),
+    ('yongkom', 'yon'),
+    ('yot', 'yot'),
+    ('yotti', 'yot'),
+    ('yoron', 'yox'),
+    ('yox', 'yox'),
+    ('yoy', 'yoy'),
+    ('phala', 'ypa'),
+    ('ypa', 'ypa'),
+    ('labo phowa', 'ypb'),
+    ('ypb', 'ypb'),
+    ('phola', 'ypg'),
+    ('ypg', 'ypg'),
+    ('phupha', 'yph'),
+    ('yph', 'yph'),
+    ('phuma', 'ypm'),
+    ('ypm', 'ypm'),
+    ('ani phowa', 'ypn'),
+    ('ypn', 'ypn'),
+    ('alo phola', 'ypo'),
+    ('ypo', 'ypo'),
+    ('phupa', 'ypp'),
+    ('ypp', 'ypp'),
+    ('phuza', 'ypz'),
+    ('ypz', 'ypz'),
+    ('yerakai', 'yra'),
+    ('yra', 'yra'),
+    ('yareba', 'yrb'),
+    ('yrb', 'yrb'),
+    ('yaouré', 'yre'),
+    ('yre', 'yre'),
+    ('nenets', 'yrk'),
+    ('yrk', 'yrk'),
+    ('nhengatu', 'yrl'),
+    ('yrl', 'yrl'),
+    ('yirrk-mel', 'yrm'),
+    ('yrm', 'yrm'),
+    ('yerong', 'yrn'),
+    ('yrn', 'yrn'),
+    ('yaroamë', 'yro'),
+    ('yro', 'yro'),
+    ('yarsun', 'yrs'),
+    ('yrs', 'yrs'),
+    ('yarawata', 'yrw'),
+    ('yrw', 'yrw'),
+    ('yarluyandi', 'yry'),
+    ('yry', 'yry'),
+    ('yassic', 'ysc'),
+    ('ysc', 'ysc'),
+    ('samatao', 'ysd'),
+    ('ysd', 'ysd'),
+    ('sonaga', 'ysg'),
+    ('ysg', 'ysg'),
+    ('ysl', 'ysl'),
+    ('yugoslavian sign language', 'ysl'),
+    ('myanmar sign language', 'ysm'),
+    ('ysm', 'ysm'),
+    ('sani', 'ysn'),
+    ('ysn', 'ysn'),
+    ('nisi', 'yso'),
+    ('nisi (china)', 'yso'),
+    ('yso', 'yso'),
+    ('southern lolopo', 'ysp'),
+    ('ysp', 'ysp'),
+    ('sirenik yupik', 'ysr'),
+    ('ysr', 'ysr'),
+    ('yessan-mayo', 'yss'),
+    ('yss', 'yss'),
+    ('sanie', 'ysy'),
+    ('ysy', 'ysy'),
+    ('talu', 'yta'),
+    ('yta', 'yta'),
+    ('tanglang', 'ytl'),
+    ('ytl', 'ytl'),
+    ('thopho', 'ytp'),
+    ('ytp', 'ytp'),
+    ('yout wam', 'ytw'),
+    ('ytw', 'ytw'),
+    ('yatay', 'yty'),
+    ('yty', 'yty'),
+    ('yua', 'yua'),
+    ('yucateco', 'yua'),
+    ('yub', 'yub'),
+    ('yugambal', 'yub'),
+    ('yuc', 'yuc'),
+    ('yuchi', 'yuc'),
+    ('judeo-tripolitanian arabic', 'yud'),
+    ('yud', 'yud'),
+    ('yue', 'yue'),
+    ('yue chinese', 'yue'),
+    ('havasupai-walapai-yavapai', 'yuf'),
+    ('yuf', 'yuf'),
+    ('yug', 'yug'),
+    ('yui', 'yui'),
+    ('yurutí', 'yui'),
+    ('karkar-yuri', 'yuj'),
+    ('yuj', 'yuj'),
+    ('yuk', 'yuk'),
+    ('yuki', 'yuk'),
+    ('yul', 'yul'),
+    ('yulu', 'yul'),
+    ('quechan', 'yum'),
+    ('yum', 'yum'),
+    ('bena (nigeria)', 'yun'),
+    ('yun', 'yun'),
+    ('yukpa', 'yup'),
+    ('yup', 'yup'),
+    ('yuq', 'yuq'),
+    ('yuqui', 'yuq'),
+    ('yur', 'yur'),
+    ('yurok', 'yur'),
+    ('yopno', 'yut'),
+    ('yut', 'yut'),
+    ('yau (morobe province)', 'yuw'),
+    ('yuw', 'yuw'),
+    ('southern yukaghir', 'yux'),
+    ('yux', 'yux'),
+    ('east yugur', 'yuy'),
+    ('yuy', 'yuy'),
+    ('yuracare', 'yuz'),
+    ('yuz', 'yuz'),
+    ('yawa', 'yva'),
+    ('yva', 'yva'),
+    ('yavitero', 'yvt'),
+    ('yvt', 'yvt'),
+    ('kalou', 'ywa'),
+    ('ywa', 'ywa'),
+    ('yinhawangka', 'ywg'),
+    ('ywg', 'ywg'),
+    ('western lalu', 'ywl'),
+    ('ywl', 'ywl'),
+    ('yawanawa', 'ywn'),
+    ('ywn', 'ywn'),
+    ('wuding-luquan yi', 'ywq'),
+    ('ywq', 'ywq'),
+    ('yawuru', 'ywr'),
+    ('ywr', 'ywr'),
+    ('xishanba lalo', 'ywt'),
+    ('ywt', 'ywt'),
+    ('wumeng nasu', 'ywu'),
+    ('ywu', 'ywu'),
+    ('yawarawarga', 'yww'),
+    ('yww', 'yww'),
+    ('mayawali', 'yxa'),
+    ('yxa', 'yxa'),
+    ('yagara', 'yxg'),
+    ('yxg', 'yxg'),
+    ('yardliyawarra', 'yxl'),
+    ('yxl', 'yxl'),
+    ('yinwum', 'yxm'),
+    ('yxm', 'yxm'),
+    ('yuyu', 'yxu'),
+    ('yxu', 'yxu'),
+    ('yabula yabula', 'yxy'),
+    ('yxy', 'yxy'),
+    ('yir yoront', 'yyr'),
+    ('yyr', 'yyr'),
+    ('yau (sandaun province)', 'yyu'),
+    ('yyu', 'yyu'),
+    ('ayizi', 'yyz'),
+    ('yyz', 'yyz'),
+    ('e''ma buyang', 'yzg'),
+    ('yzg', 'yzg'),
+    ('yzk', 'yzk'),
+    ('zokhuo', 'yzk'),
+    ('chuang', 'za'),
+    ('za', 'za'),
+    ('zha', 'za'),
+    ('zhuang', 'za'),
+    ('sierra de juárez zapotec', 'zaa'),
+    ('zaa', 'zaa'),
+    ('western tlacolula valley zapotec', 'zab'),
+    ('zab', 'zab'),
+    ('ocotlán zapotec', 'zac'),
+    ('zac', 'zac'),
+    ('cajonos zapotec', 'zad'),
+    ('zad', 'zad'),
+    ('yareni zapotec', 'zae'),
+    ('zae', 'zae'),
+    ('ayoquesco zapotec', 'zaf'),
+    ('zaf', 'zaf'),
+    ('zag', 'zag'),
+    ('zaghawa', 'zag'),
+    ('zah', 'zah'),
+    ('zangwal', 'zah'),
+    ('isthmus zapotec', 'zai'),
+    ('zai', 'zai'),
+    ('zaj', 'zaj'),
+    ('zaramo', 'zaj'),
+    ('zak', 'zak'),
+    ('zanaki', 'zak'),
+    ('zal', 'zal'),
+    ('zauzou', 'zal'),
+    ('miahuatlán zapotec', 'zam'),
+    ('zam', 'zam'),
+    ('ozolotepec zapotec', 'zao'),
+    ('zao', 'zao'),
+    ('zap', 'zap'),
+    ('zapotec', 'zap'),
+    ('aloápam zapotec', 'zaq'),
+    ('zaq', 'zaq'),
+    ('rincón zapotec', 'zar'),
+    ('zar', 'zar'),
+    ('santo domingo albarradas zapotec', 'zas'),
+    ('zas', 'zas'),
+    ('tabaa zapotec', 'zat'),
+    ('zat', 'zat'),
+    ('zangskari', 'zau'),
+    ('zau', 'zau'),
+    ('yatzachi zapotec', 'zav'),
+    ('zav', 'zav'),
+    ('mitla zapotec', 'zaw'),
+    ('zaw', 'zaw'),
+    ('xadani zapotec', 'zax'),
+    ('zax', 'zax'),
+    ('zay', 'zay'),
+    ('zayse-zergulla', 'zay'),
+    ('zari', 'zaz'),
+    ('zaz', 'zaz'),
+    ('balaibalan', 'zba'),
+    ('zba', 'zba'),
+    ('central berawan', 'zbc'),
+    ('zbc', 'zbc'),
+    ('east berawan', 'zbe'),
+    ('zbe', 'zbe'),
+    ('bliss', 'zbl'),
+    ('blissymbolics', 'zbl'),
+    ('blissymbols', 'zbl'),
+    ('zbl', 'zbl'),
+    ('batui', 'zbt'),
+    ('zbt', 'zbt'),
+    ('bu (bauchi state)', 'zbu'),
+    ('zbu', 'zbu'),
+    ('west berawan', 'zbw'),
+    ('zbw', 'zbw'),
+    ('coatecas altas zapotec', 'zca'),
+    ('zca', 'zca'),
+    ('las delicias zapotec', 'zcd'),
+    ('zcd', 'zcd'),
+    ('central hongshuihe zhuang', 'zch'),
+    ('zch', 'zch'),
+    ('ngazidja comorian', 'zdj'),
+    ('zdj', 'zdj'),
+    ('zea', 'zea'),
+    ('zeeuws', 'zea'),
+    ('zeg', 'zeg'),
+    ('zenag', 'zeg'),
+    ('eastern hongshuihe zhuang', 'zeh'),
+    ('zeh', 'zeh'),
+    ('zen', 'zen'),
+    ('zenaga', 'zen'),
+    ('kinga', 'zga'),
+    ('zga', 'zga'),
+    ('guibei zhuang', 'zgb'),
+    ('zgb', 'zgb'),
+    ('standard moroccan tamazight', 'zgh'),
+    ('zgh', 'zgh'),
+    ('minz zhuang', 'zgm'),
+    ('zgm', 'zgm'),
+    ('guibian zhuang', 'zgn'),
+    ('zgn', 'zgn'),
+    ('magori', 'zgr'),
+    ('zgr', 'zgr'),
+    ('chi', 'zh'),
+    ('chinese', 'zh'),
+    ('zh', 'zh'),
+    ('zho', 'zh'),
+    ('zhaba', 'zhb'),
+    ('zhb', 'zhb'),
+    ('dai zhuang', 'zhd'),
+    ('zhd', 'zhd'),
+    ('zhi', 'zhi'),
+    ('zhire', 'zhi'),
+    ('nong zhuang', 'zhn'),
+    ('zhn', 'zhn'),
+    ('zhoa', 'zhw'),
+    ('zhw', 'zhw'),
+    ('zia', 'zia'),
+    ('zib', 'zib'),
+    ('zimbabwe sign language', 'zib'),
+    ('zik', 'zik'),
+    ('zimakani', 'zik'),
+    ('zialo', 'zil'),
+    ('zil', 'zil'),
+    ('mesme', 'zim'),
+    ('zim', 'zim'),
+    ('zin', 'zin'),
+    ('zinza', 'zin'),
+    ('zigula', 'ziw'),
+    ('ziw', 'ziw'),
+    ('ziz', 'ziz'),
+    ('zizilivakan', 'ziz'),
+    ('kaimbulawa', 'zka'),
+    ('zka', 'zka'),
+    ('koibal', 'zkb'),
+    ('zkb', 'zkb'),
+    ('kadu', 'zkd'),
+    ('zkd', 'zkd'),
+    ('koguryo', 'zkg'),
+    ('zkg', 'zkg'),
+    ('khorezmian', 'zkh'),
+    ('zkh', 'zkh'),
+    ('karankawa', 'zkk'),
+    ('zkk', 'zkk'),
+    ('kanan', 'zkn'),
+    ('zkn', 'zkn'),
+    ('kott', 'zko'),
+    ('zko', 'zko'),
+    ('são paulo kaingáng', 'zkp'),
+    ('zkp', 'zkp'),
+    ('zakhring', 'zkr'),
+    ('zkr', 'zkr'),
+    ('kitan', 'zkt'),
+    ('zkt', 'zkt'),
+    ('kaurna', 'zku'),
+    ('zku', 'zku'),
+    ('krevinian', 'zkv'),
+    ('zkv', 'zkv'),
+    ('khazar', 'zkz'),
+    ('zkz', 'zkz'),
+    ('zla', 'zla'),
+    ('zula', 'zla'),
+    ('liujiang zhuang', 'zlj'),
+    ('zlj', 'zlj'),
+    ('malay (individual language)', 'zlm'),
+    ('zlm', 'zlm'),
+    ('lianshan zhuang', 'zln'),
+    ('zln', 'zln'),
+    ('liuqian zhuang', 'zlq'),
+    ('zlq', 'zlq'),
+    ('manda (australia)', 'zma'),
+    ('zma', 'zma'),
+    ('zimba', 'zmb'),
+    ('zmb', 'zmb'),
+    ('margany', 'zmc'),
+    ('zmc', 'zmc'),
+    ('maridan', 'zmd'),
+    ('zmd', 'zmd'),
+    ('mangerr', 'zme'),
+    ('zme', 'zme'),
+    ('mfinu', 'zmf'),
+    ('zmf', 'zmf'),
+    ('marti ke', 'zmg'),
+    ('zmg', 'zmg'),
+    ('makolkol', 'zmh'),
+    ('zmh', 'zmh'),
+    ('negeri sembilan malay', 'zmi'),
+    ('zmi', 'zmi'),
+    ('maridjabin', 'zmj'),
+    ('zmj', 'zmj'),
+    ('mandandanyi', 'zmk'),
+    ('zmk', 'zmk'),
+    ('matngala', 'zml'),
+    ('zml', 'zml'),
+    ('marimanindji', 'zmm'),
+    ('zmm', 'zmm'),
+    ('mbangwe', 'zmn'),
+    ('zmn', 'zmn'),
+    ('molo', 'zmo'),
+    ('zmo', 'zmo'),
+    ('mpuono', 'zmp'),
+    ('zmp', 'zmp'),
+    ('mituku', 'zmq'),
+    ('zmq', 'zmq'),
+    ('maranunggu', 'zmr'),
+    ('zmr', 'zmr'),
+    ('mbesa', 'zms'),
+    ('zms', 'zms'),
+    ('maringarr', 'zmt'),
+    ('zmt', 'zmt'),
+    ('muruwari', 'zmu'),
+    ('zmu', 'zmu'),
+    ('mbariman-gudhinma', 'zmv'),
+    ('zmv', 'zmv'),
+    ('mbo (democratic republic of congo)', 'zmw'),
+    ('zmw', 'zmw'),
+    ('bomitaba', 'zmx'),
+    ('zmx', 'zmx'),
+    ('mariyedi', 'zmy'),
+    ('zmy', 'zmy'),
+    ('mbandja', 'zmz'),
+    ('zmz', 'zmz'),
+    ('zan gula', 'zna'),
+    ('zna', 'zna'),
+    ('zande', 'zne'),
+    ('zande (individual language)', 'zne'),
+    ('zne', 'zne'),
+    ('mang', 'zng'),
+    ('zng', 'zng'),
+    ('manangkari', 'znk'),
+    ('znk', 'znk'),
+    ('mangas', 'zns'),
+    ('zns', 'zns'),
+    ('copainalá zoque', 'zoc'),
+    ('zoc', 'zoc'),
+    ('chimalapa zoque', 'zoh'),
+    ('zoh', 'zoh'),
+    ('zom', 'zom'),
+    ('zou', 'zom'),
+    ('asunción mixtepec zapotec', 'zoo'),
+    ('zoo', 'zoo'),
+    ('tabasco zoque', 'zoq'),
+    ('zoq', 'zoq'),
+    ('rayón zoque', 'zor'),
+    ('zor', 'zor'),
+    ('francisco león zoque', 'zos'),
+    ('zos', 'zos'),
+    ('lachiguiri zapotec', 'zpa'),
+    ('zpa', 'zpa'),
+    ('yautepec zapotec', 'zpb'),
+    ('zpb', 'zpb'),
+    ('choapan zapotec', 'zpc'),
+    ('zpc', 'zpc'),
+    ('southeastern ixtlán zapotec', 'zpd'),
+    ('zpd', 'zpd'),
+    ('petapa zapotec', 'zpe'),
+    ('zpe', 'zpe'),
+    ('san pedro quiatoni zapotec', 'zpf'),
+    ('zpf', 'zpf'),
+    ('guevea de humboldt zapotec', 'zpg'),
+    ('zpg', 'zpg'),
+    ('totomachapan zapotec', 'zph'),
+    ('zph', 'zph'),
+    ('santa maría quiegolani zapotec', 'zpi'),
+    ('zpi', 'zpi'),
+    ('quiavicuzas zapotec', 'zpj'),
+    ('zpj', 'zpj'),
+    ('tlacolulita zapotec', 'zpk'),
+    ('zpk', 'zpk'),
+    ('lachixío zapotec', 'zpl'),
+    ('zpl', 'zpl'),
+    ('mixtepec zapotec', 'zpm'),
+    ('zpm', 'zpm'),
+    ('santa inés yatzechi zapotec', 'zpn'),
+    ('zpn', 'zpn'),
+    ('amatlán zapotec', 'zpo'),
+    ('zpo', 'zpo'),
+    ('el alto zapotec', 'zpp'),
+    ('zpp', 'zpp'),
+    ('zoogocho zapotec', 'zpq'),
+    ('zpq', 'zpq'),
+    ('santiago xanica zapotec', 'zpr'),
+    ('zpr', 'zpr'),
+    ('coatlán zapotec', 'zps'),
+    ('zps', 'zps'),
+    ('san vicente coatlán zapotec', 'zpt'),
+    ('zpt', 'zpt'),
+    ('yalálag zapotec', 'zpu'),
+    ('zpu', 'zpu'),
+    ('chichicapan zapotec', 'zpv'),
+    ('zpv', 'zpv'),
+    ('zaniza zapotec', 'zpw'),
+    ('zpw', 'zpw'),
+    ('san baltazar loxicha zapotec', 'zpx'),
+    ('zpx', 'zpx'),
+    ('mazaltepec zapotec', 'zpy'),
+    ('zpy', 'zpy'),
+    ('texmelucan zapotec', 'zpz'),
+    ('zpz', 'zpz'),
+    ('qiubei zhuang', 'zqe'),
+    ('zqe', 'zqe'),
+    ('kara (korea)', 'zra'),
+    ('zra', 'zra'),
+    ('mirgan', 'zrg'),
+    ('zrg', 'zrg'),
+    ('zerenkel', 'zrn'),
+    ('zrn', 'zrn'),
+    ('zro', 'zro'),
+    ('záparo', 'zro'),
+    ('zarphatic', 'zrp'),
+    ('zrp', 'zrp'),
+    ('mairasi', 'zrs'),
+    ('zrs', 'zrs'),
+    ('sarasira', 'zsa'),
+    ('zsa', 'zsa'),
+    ('kaskean', 'zsk'),
+    ('zsk', 'zsk'),
+    ('zambian sign language', 'zsl'),
+    ('zsl', 'zsl'),
+    ('standard malay', 'zsm'),
+    ('zsm', 'zsm'),
+    ('southern rincon zapotec', 'zsr'),
+    ('zsr', 'zsr'),
+    ('sukurum', 'zsu'),
+    ('zsu', 'zsu'),
+    ('elotepec zapotec', 'zte'),
+    ('zte', 'zte'),
+    ('xanaguía zapotec', 'ztg'),
+    ('ztg', 'ztg'),
+    ('lapaguía-guivini zapotec', 'ztl'),
+    ('ztl', 'ztl'),
+    ('san agustín mixtepec zapotec', 'ztm'),
+    ('ztm', 'ztm'),
+    ('santa catarina albarradas zapotec', 'ztn'),
+    ('ztn', 'ztn'),
+    ('loxicha zapotec', 'ztp'),
+    ('ztp', 'ztp'),
+    ('quioquitani-quierí zapotec', 'ztq'),
+    ('ztq', 'ztq'),
+    ('tilquiapan zapotec', 'zts'),
+    ('zts', 'zts'),
+    ('tejalapan zapotec', 'ztt'),
+    ('ztt', 'ztt'),
+    ('güilá zapotec', 'ztu'),
+    ('ztu', 'ztu'),
+    ('zaachila zapotec', 'ztx'),
+    ('ztx', 'ztx'),
+    ('yatee zapotec', 'zty'),
+    ('zty', 'zty'),
+    ('zu', 'zu'),
+    ('zul', 'zu'),
+    ('zulu', 'zu'),
+    ('zeem', 'zua'),
+    ('zua', 'zua'),
+    ('tokano', 'zuh'),
+    ('zuh', 'zuh'),
+    ('kumzari', 'zum'),
+    ('zum', 'zum'),
+    ('zun', 'zun'),
+    ('zuni', 'zun'),
+    ('zumaya', 'zuy'),
+    ('zuy', 'zuy'),
+    ('zwa', 'zwa'),
+    ('yongbei zhuang', 'zyb'),
+    ('zyb', 'zyb'),
+    ('yang zhuang', 'zyg'),
+    ('zyg', 'zyg'),
+    ('youjiang zhuang', 'zyj'),
+    ('zyj', 'zyj'),
+    ('yongnan zhuang', 'zyn'),
+    ('zyn', 'zyn'),
+    ('zyp', 'zyp'),
+    ('zyphe chin', 'zyp'),
+    ('dimili', 'zza'),
+    ('kirdki', 'zza'),
+    ('zaza', 'zza'),
+    ('zazaki', 'zza'),
+    ('zza', 'zza'),
+    ('zuojiang zhuang', 'zzj'),
+    ('zzj', 'zzj');
+
+UPDATE books
+SET language = language_codes.code
+FROM language_codes
+WHERE lower(trim(books.language)) = language_codes.name AND books.language <> language_codes.code;
+
+UPDATE saved_searches
+SET language = ARRAY(
+    SELECT COALESCE(language_codes.code, saved_language)
+    FROM unnest(saved_searches.language) WITH ORDINALITY AS saved_languages (saved_language, position)
+    LEFT JOIN language_codes ON language_codes.name = lower(trim(saved_language))
+    ORDER BY position
+)
+WHERE language <> '{}';
+
+DROP TABLE language_codes;
+
+CREATE INDEX IF NOT EXISTS books_language_code_idx ON books (language);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS books_checksum_idx;
+ALTER TABLE books DROP COLUMN IF EXISTS checksum;
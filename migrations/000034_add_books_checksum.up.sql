@@ -0,0 +1,3 @@
+ALTER TABLE books ADD COLUMN IF NOT EXISTS checksum text NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS books_checksum_idx ON books (checksum) WHERE checksum <> '';
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS book_duplicates (
+    book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    duplicate_book_id bigint NOT NULL REFERENCES books ON DELETE CASCADE,
+    reason text NOT NULL,
+    created_at timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (book_id, duplicate_book_id),
+    CHECK (book_id < duplicate_book_id)
+);
+
+CREATE INDEX IF NOT EXISTS book_duplicates_duplicate_book_id_idx ON book_duplicates (duplicate_book_id);
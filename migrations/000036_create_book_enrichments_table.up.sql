@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS book_enrichments (
+    book_id bigint PRIMARY KEY REFERENCES books ON DELETE CASCADE,
+    source text NOT NULL,
+    metadata jsonb NOT NULL,
+    created_at timestamp(0) with time zone NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,2 @@
+ALTER TABLE requests ADD COLUMN IF NOT EXISTS author text[] NOT NULL DEFAULT '{}';
+ALTER TABLE requests ADD COLUMN IF NOT EXISTS source text NOT NULL DEFAULT '';
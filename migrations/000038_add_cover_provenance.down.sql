@@ -0,0 +1,2 @@
+ALTER TABLE requests DROP COLUMN IF EXISTS cover_path;
+ALTER TABLE books DROP COLUMN IF EXISTS cover_source;
@@ -0,0 +1,4 @@
+ALTER TABLE books ADD COLUMN IF NOT EXISTS cover_source text NOT NULL DEFAULT '';
+-- covers uploaded so far were all uploaded by the books' owners
+UPDATE books SET cover_source = 'owner' WHERE cover_path <> '';
+ALTER TABLE requests ADD COLUMN IF NOT EXISTS cover_path text NOT NULL DEFAULT '';
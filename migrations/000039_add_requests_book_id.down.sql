@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS requests_active_isbn_idx;
+ALTER TABLE requests DROP COLUMN IF EXISTS book_id;
@@ -0,0 +1,2 @@
+ALTER TABLE requests ADD COLUMN IF NOT EXISTS book_id bigint REFERENCES books ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS requests_active_isbn_idx ON requests (isbn) WHERE status = 'active';
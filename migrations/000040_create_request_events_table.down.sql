@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS request_events;
+
+DROP INDEX IF EXISTS requests_open_isbn_idx;
+CREATE INDEX IF NOT EXISTS requests_active_isbn_idx ON requests (isbn) WHERE status = 'active';
+
+ALTER TABLE requests DROP CONSTRAINT IF EXISTS requests_status_check;
+ALTER TABLE requests DROP COLUMN IF EXISTS claimed_by;
+
+UPDATE requests SET status = 'active' WHERE status IN ('open', 'in_progress', 'reopened');
@@ -0,0 +1,25 @@
+UPDATE requests SET status = 'open' WHERE status IN ('active', '');
+UPDATE requests SET status = 'fulfilled' WHERE status = 'completed';
+
+ALTER TABLE requests ADD COLUMN IF NOT EXISTS claimed_by bigint REFERENCES users ON DELETE SET NULL;
+ALTER TABLE requests ADD CONSTRAINT requests_status_check CHECK (status IN ('open', 'in_progress', 'fulfilled', 'rejected', 'expired', 'reopened'));
+
+DROP INDEX IF EXISTS requests_active_isbn_idx;
+CREATE INDEX IF NOT EXISTS requests_open_isbn_idx ON requests (isbn) WHERE status IN ('open', 'in_progress', 'reopened');
+
+CREATE TABLE IF NOT EXISTS request_events (
+    id bigserial PRIMARY KEY,
+    request_id bigint NOT NULL REFERENCES requests ON DELETE CASCADE,
+    user_id bigint REFERENCES users ON DELETE SET NULL,
+    from_status text NOT NULL DEFAULT '',
+    to_status text NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    created_at timestamp(0) with time zone NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS request_events_request_id_idx ON request_events (request_id, created_at);
+
+-- every existing request starts its history with its creation
+INSERT INTO request_events (request_id, user_id, to_status, created_at)
+SELECT id, user_id, 'open', created_at
+FROM requests;
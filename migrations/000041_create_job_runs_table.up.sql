@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS job_runs (
+    id bigserial PRIMARY KEY,
+    job_name text NOT NULL,
+    trigger text NOT NULL,
+    user_id bigint REFERENCES users ON DELETE SET NULL,
+    status text NOT NULL,
+    error text NOT NULL DEFAULT '',
+    scheduled_at timestamp(0) with time zone NOT NULL,
+    started_at timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    finished_at timestamp(0) with time zone,
+    UNIQUE (job_name, scheduled_at)
+);
+
+CREATE INDEX IF NOT EXISTS job_runs_job_name_idx ON job_runs (job_name, started_at DESC);
+
+-- remove the pg_cron jobs from databases that had them, now that the API server runs them
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_cron') THEN
+        PERFORM cron.unschedule(jobname) FROM cron.job
+        WHERE jobname IN ('dlcount-reset-everyday', 'expire-requests-every-5mins');
+    END IF;
+END
+$$;
@@ -0,0 +1,3 @@
+ALTER TABLE requests ADD COLUMN IF NOT EXISTS category_id bigint REFERENCES categories ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS requests_category_id_idx ON requests (category_id) WHERE category_id IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS requests_open_isbn_idx;
+CREATE INDEX IF NOT EXISTS requests_open_isbn_idx ON requests (isbn) WHERE status IN ('open', 'in_progress', 'reopened');
@@ -0,0 +1,42 @@
+-- store every request ISBN as a bare ISBN-13
+UPDATE requests SET isbn = upper(regexp_replace(isbn, '[^0-9Xx]', '', 'g')) WHERE isbn <> '';
+UPDATE requests
+SET isbn = '978' || left(isbn, 9) || ((10 - (
+    SELECT sum(substr('978' || left(isbn, 9), i, 1)::int * CASE WHEN i % 2 = 0 THEN 3 ELSE 1 END)
+    FROM generate_series(1, 12) AS i
+) % 10) % 10)::text
+WHERE isbn ~ '^[0-9]{9}[0-9X]$';
+
+-- merge active requests for the same ISBN into the oldest one, moving their subscribers
+CREATE TEMPORARY TABLE duplicate_requests AS
+SELECT id, first_value(id) OVER (PARTITION BY isbn ORDER BY created_at, id) AS kept_id
+FROM requests
+WHERE isbn <> '' AND status IN ('open', 'in_progress', 'reopened');
+
+DELETE FROM duplicate_requests WHERE id = kept_id;
+
+INSERT INTO users_requests (user_id, request_id, datetime, expiry)
+SELECT users_requests.user_id, duplicate_requests.kept_id, users_requests.datetime, users_requests.expiry
+FROM users_requests
+INNER JOIN duplicate_requests ON duplicate_requests.id = users_requests.request_id
+ON CONFLICT DO NOTHING;
+
+INSERT INTO request_events (request_id, from_status, to_status, reason)
+SELECT requests.id, requests.status, 'rejected', 'duplicate of request ' || duplicate_requests.kept_id
+FROM requests
+INNER JOIN duplicate_requests ON duplicate_requests.id = requests.id;
+
+UPDATE requests
+SET status = 'rejected', claimed_by = NULL, version = version + 1
+FROM duplicate_requests
+WHERE duplicate_requests.id = requests.id;
+
+UPDATE requests
+SET waitlist = (SELECT count(*) FROM users_requests WHERE users_requests.request_id = requests.id), version = version + 1
+WHERE id IN (SELECT kept_id FROM duplicate_requests);
+
+DROP TABLE duplicate_requests;
+
+-- only one active request per ISBN from now on
+DROP INDEX IF EXISTS requests_open_isbn_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS requests_open_isbn_idx ON requests (isbn) WHERE isbn <> '' AND status IN ('open', 'in_progress', 'reopened');
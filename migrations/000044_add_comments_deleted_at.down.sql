@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS comments_parent_id_idx;
+DROP INDEX IF EXISTS comments_booklist_id_idx;
+
+UPDATE comments SET content = '[deleted]' WHERE deleted_at IS NOT NULL;
+ALTER TABLE comments DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,4 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS deleted_at timestamp(0) with time zone;
+
+CREATE INDEX IF NOT EXISTS comments_booklist_id_idx ON comments (booklist_id, created_at) WHERE parent_id IS NULL;
+CREATE INDEX IF NOT EXISTS comments_parent_id_idx ON comments (parent_id, created_at);
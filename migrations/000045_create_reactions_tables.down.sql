@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS reviews_reactions;
+DROP TABLE IF EXISTS comments_reactions;
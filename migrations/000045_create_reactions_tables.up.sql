@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS comments_reactions (
+    comment_id bigint NOT NULL REFERENCES comments ON DELETE CASCADE,
+    user_id bigint NOT NULL REFERENCES users ON DELETE CASCADE,
+    reaction text NOT NULL CHECK (reaction IN ('like', 'insightful', 'funny', 'love', 'sad')),
+    datetime timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (comment_id, user_id, reaction)
+);
+
+CREATE TABLE IF NOT EXISTS reviews_reactions (
+    review_id bigint NOT NULL REFERENCES reviews ON DELETE CASCADE,
+    user_id bigint NOT NULL REFERENCES users ON DELETE CASCADE,
+    reaction text NOT NULL CHECK (reaction IN ('like', 'insightful', 'funny', 'love', 'sad')),
+    datetime timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (review_id, user_id, reaction)
+);
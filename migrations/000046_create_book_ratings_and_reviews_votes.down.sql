@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS reviews_votes_counts ON reviews_votes;
+DROP FUNCTION IF EXISTS reviews_votes_update_counts();
+DROP TABLE IF EXISTS reviews_votes;
+ALTER TABLE reviews DROP COLUMN IF EXISTS unhelpful_votes;
+ALTER TABLE reviews DROP COLUMN IF EXISTS helpful_votes;
+DROP TRIGGER IF EXISTS reviews_book_ratings ON reviews;
+DROP FUNCTION IF EXISTS reviews_update_book_ratings();
+ALTER TABLE books ALTER COLUMN popularity TYPE numeric(2, 1);
+DROP FUNCTION IF EXISTS book_popularity(integer, integer);
+DROP TABLE IF EXISTS book_ratings;
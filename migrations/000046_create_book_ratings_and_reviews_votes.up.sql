@@ -0,0 +1,119 @@
+CREATE TABLE IF NOT EXISTS book_ratings (
+    book_id bigint PRIMARY KEY REFERENCES books ON DELETE CASCADE,
+    one_star integer NOT NULL DEFAULT 0,
+    two_stars integer NOT NULL DEFAULT 0,
+    three_stars integer NOT NULL DEFAULT 0,
+    four_stars integer NOT NULL DEFAULT 0,
+    five_stars integer NOT NULL DEFAULT 0,
+    total integer NOT NULL DEFAULT 0,
+    sum integer NOT NULL DEFAULT 0
+);
+
+INSERT INTO book_ratings (book_id, one_star, two_stars, three_stars, four_stars, five_stars, total, sum)
+SELECT book_id,
+    count(*) FILTER (WHERE rating = 1),
+    count(*) FILTER (WHERE rating = 2),
+    count(*) FILTER (WHERE rating = 3),
+    count(*) FILTER (WHERE rating = 4),
+    count(*) FILTER (WHERE rating = 5),
+    count(*),
+    sum(rating)
+FROM reviews
+GROUP BY book_id;
+
+-- the bayesian average of a book's ratings: its ratings plus 5 ratings at the mean of all
+-- ratings, so that books with few reviews don't outrank well reviewed ones
+CREATE OR REPLACE FUNCTION book_popularity(ratings_total integer, ratings_sum integer) RETURNS numeric AS $$
+    SELECT CASE WHEN ratings_total = 0 THEN 0 ELSE
+        round((5 * (SELECT COALESCE(sum(book_ratings.sum)::numeric / NULLIF(sum(book_ratings.total), 0), 0) FROM book_ratings) + ratings_sum) / (5 + ratings_total), 2)
+    END
+$$ LANGUAGE sql STABLE;
+
+ALTER TABLE books ALTER COLUMN popularity TYPE numeric(3, 2);
+
+UPDATE books
+SET popularity = book_popularity(book_ratings.total, book_ratings.sum)
+FROM book_ratings
+WHERE books.id = book_ratings.book_id;
+
+UPDATE books
+SET popularity = 0
+WHERE popularity <> 0 AND NOT EXISTS (SELECT 1 FROM book_ratings WHERE book_id = books.id);
+
+-- keep book_ratings and books.popularity in step with the reviews of each book. Popularity
+-- isn't edited by owners, so updating it leaves the book's version alone
+CREATE OR REPLACE FUNCTION reviews_update_book_ratings() RETURNS trigger AS $$
+DECLARE
+    ratings book_ratings;
+BEGIN
+    IF TG_OP IN ('DELETE', 'UPDATE') THEN
+        UPDATE book_ratings SET
+            one_star = one_star - (OLD.rating = 1)::integer,
+            two_stars = two_stars - (OLD.rating = 2)::integer,
+            three_stars = three_stars - (OLD.rating = 3)::integer,
+            four_stars = four_stars - (OLD.rating = 4)::integer,
+            five_stars = five_stars - (OLD.rating = 5)::integer,
+            total = total - 1,
+            sum = sum - OLD.rating
+        WHERE book_id = OLD.book_id
+        RETURNING * INTO ratings;
+        UPDATE books SET popularity = book_popularity(ratings.total, ratings.sum)
+        WHERE id = OLD.book_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO book_ratings (book_id, one_star, two_stars, three_stars, four_stars, five_stars, total, sum)
+        VALUES (NEW.book_id, (NEW.rating = 1)::integer, (NEW.rating = 2)::integer, (NEW.rating = 3)::integer,
+            (NEW.rating = 4)::integer, (NEW.rating = 5)::integer, 1, NEW.rating)
+        ON CONFLICT (book_id) DO UPDATE SET
+            one_star = book_ratings.one_star + EXCLUDED.one_star,
+            two_stars = book_ratings.two_stars + EXCLUDED.two_stars,
+            three_stars = book_ratings.three_stars + EXCLUDED.three_stars,
+            four_stars = book_ratings.four_stars + EXCLUDED.four_stars,
+            five_stars = book_ratings.five_stars + EXCLUDED.five_stars,
+            total = book_ratings.total + 1,
+            sum = book_ratings.sum + EXCLUDED.sum
+        RETURNING * INTO ratings;
+        UPDATE books SET popularity = book_popularity(ratings.total, ratings.sum)
+        WHERE id = NEW.book_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER reviews_book_ratings
+AFTER INSERT OR DELETE OR UPDATE OF rating, book_id ON reviews
+FOR EACH ROW EXECUTE FUNCTION reviews_update_book_ratings();
+
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS helpful_votes integer NOT NULL DEFAULT 0;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS unhelpful_votes integer NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS reviews_votes (
+    review_id bigint NOT NULL REFERENCES reviews ON DELETE CASCADE,
+    user_id bigint NOT NULL REFERENCES users ON DELETE CASCADE,
+    helpful boolean NOT NULL,
+    datetime timestamp(0) with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (review_id, user_id)
+);
+
+-- keep reviews.helpful_votes and reviews.unhelpful_votes equal to the votes on each review
+CREATE OR REPLACE FUNCTION reviews_votes_update_counts() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('DELETE', 'UPDATE') THEN
+        UPDATE reviews
+        SET helpful_votes = helpful_votes - OLD.helpful::integer,
+            unhelpful_votes = unhelpful_votes - (NOT OLD.helpful)::integer
+        WHERE id = OLD.review_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE reviews
+        SET helpful_votes = helpful_votes + NEW.helpful::integer,
+            unhelpful_votes = unhelpful_votes + (NOT NEW.helpful)::integer
+        WHERE id = NEW.review_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER reviews_votes_counts
+AFTER INSERT OR DELETE OR UPDATE OF helpful ON reviews_votes
+FOR EACH ROW EXECUTE FUNCTION reviews_votes_update_counts();
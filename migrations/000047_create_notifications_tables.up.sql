@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS notifications (
+    id bigserial PRIMARY KEY,
+    user_id bigint NOT NULL REFERENCES users ON DELETE CASCADE,
+    event text NOT NULL,
+    actor_id bigint REFERENCES users ON DELETE SET NULL,
+    message text NOT NULL,
+    book_id bigint,
+    booklist_id bigint,
+    comment_id bigint,
+    review_id bigint,
+    request_id bigint,
+    read_at timestamp(0) with time zone,
+    created_at timestamp(0) with time zone NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS notifications_user_id_idx ON notifications (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS notifications_unread_idx ON notifications (user_id) WHERE read_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id bigint NOT NULL REFERENCES users ON DELETE CASCADE,
+    event text NOT NULL,
+    in_app boolean NOT NULL,
+    email boolean NOT NULL,
+    PRIMARY KEY (user_id, event)
+);
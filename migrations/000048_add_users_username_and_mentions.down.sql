@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS reviews_mentions;
+DROP TABLE IF EXISTS comments_mentions;
+ALTER TABLE users DROP COLUMN IF EXISTS username;
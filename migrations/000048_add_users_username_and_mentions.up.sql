@@ -0,0 +1,44 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS username citext;
+
+-- give existing users a username from their email address, suffixed with their ID where it
+-- is taken. Addresses too short for a username give 'user'
+WITH bases AS (
+    SELECT id, COALESCE(substring(left(regexp_replace(lower(split_part(email, '@', 1)), '[^a-zA-Z0-9_]', '', 'g'), 20) FROM '^.{3,}$'), 'user') AS base
+    FROM users
+)
+UPDATE users
+SET username = bases.base || '_' || users.id
+FROM bases
+WHERE users.id = bases.id;
+
+WITH bases AS (
+    SELECT id, COALESCE(substring(left(regexp_replace(lower(split_part(email, '@', 1)), '[^a-zA-Z0-9_]', '', 'g'), 20) FROM '^.{3,}$'), 'user') AS base
+    FROM users
+), unique_bases AS (
+    SELECT base, min(id) AS id
+    FROM bases
+    GROUP BY base
+    HAVING count(*) = 1
+)
+UPDATE users
+SET username = unique_bases.base
+FROM unique_bases
+WHERE users.id = unique_bases.id AND NOT EXISTS (SELECT 1 FROM users AS taken WHERE taken.username = unique_bases.base);
+
+ALTER TABLE users ALTER COLUMN username SET NOT NULL;
+ALTER TABLE users ADD CONSTRAINT users_username_key UNIQUE (username);
+ALTER TABLE users ADD CONSTRAINT users_username_check CHECK (username ~ '^[a-zA-Z0-9_]{3,30}$');
+
+CREATE TABLE IF NOT EXISTS comments_mentions (
+    comment_id bigint NOT NULL REFERENCES comments ON DELETE CASCADE,
+    user_id bigint NOT NULL REFERENCES users ON DELETE CASCADE,
+    handle citext NOT NULL,
+    PRIMARY KEY (comment_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS reviews_mentions (
+    review_id bigint NOT NULL REFERENCES reviews ON DELETE CASCADE,
+    user_id bigint NOT NULL REFERENCES users ON DELETE CASCADE,
+    handle citext NOT NULL,
+    PRIMARY KEY (review_id, user_id)
+);
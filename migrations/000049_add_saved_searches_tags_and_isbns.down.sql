@@ -0,0 +1,2 @@
+ALTER TABLE saved_searches DROP COLUMN IF EXISTS isbns;
+ALTER TABLE saved_searches DROP COLUMN IF EXISTS tags;
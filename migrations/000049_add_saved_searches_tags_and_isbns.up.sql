@@ -0,0 +1,2 @@
+ALTER TABLE saved_searches ADD COLUMN IF NOT EXISTS tags text[] NOT NULL DEFAULT '{}';
+ALTER TABLE saved_searches ADD COLUMN IF NOT EXISTS isbns text[] NOT NULL DEFAULT '{}';
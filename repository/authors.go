@@ -0,0 +1,408 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/iso639"
+	"github.com/lib/pq"
+)
+
+type authors interface {
+	GetAuthor(authorID int64) (*data.Author, error)
+	GetAuthorByName(name string) (*data.Author, error)
+	GetAllAuthors(search string, filters data.Filters) ([]*data.Author, data.Metadata, error)
+	UpdateAuthor(author *data.Author) error
+	GetAllBooksForAuthor(authorID int64, filters data.Filters) ([]*data.Book, data.Metadata, error)
+	RefreshAuthorNamesForBooks(authorID int64) error
+	MergeAuthors(author *data.Author, duplicateIDs []int64) error
+}
+
+// GetAuthor retrieves an author record by its ID.
+func (r *repository) GetAuthor(authorID int64) (*data.Author, error) {
+	if authorID < 1 {
+		return nil, ErrRecordNotFound
+	}
+	query := `
+		SELECT authors.id, authors.created_at, authors.name, authors.sort_name, authors.alternate_names, authors.bio, authors.birth_year, authors.death_year, count(books_authors.book_id), authors.version
+		FROM authors
+		LEFT JOIN books_authors ON books_authors.author_id = authors.id
+		WHERE authors.id = $1
+		GROUP BY authors.id`
+	var author data.Author
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, authorID).Scan(
+		&author.ID,
+		&author.CreatedAt,
+		&author.Name,
+		&author.SortName,
+		pq.Array(&author.AlternateNames),
+		&author.Bio,
+		&author.BirthYear,
+		&author.DeathYear,
+		&author.BooksCount,
+		&author.Version,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return &author, nil
+}
+
+// GetAuthorByName retrieves an author record whose canonical or alternate names match name.
+func (r *repository) GetAuthorByName(name string) (*data.Author, error) {
+	key := data.AuthorNameKey(name)
+	if key == "" {
+		return nil, ErrRecordNotFound
+	}
+	query := `
+		SELECT authors.id, authors.created_at, authors.name, authors.sort_name, authors.alternate_names, authors.bio, authors.birth_year, authors.death_year, count(books_authors.book_id), authors.version
+		FROM authors
+		LEFT JOIN books_authors ON books_authors.author_id = authors.id
+		WHERE $1 = ANY(authors.name_keys)
+		GROUP BY authors.id
+		ORDER BY authors.id ASC
+		LIMIT 1`
+	var author data.Author
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, key).Scan(
+		&author.ID,
+		&author.CreatedAt,
+		&author.Name,
+		&author.SortName,
+		pq.Array(&author.AlternateNames),
+		&author.Bio,
+		&author.BirthYear,
+		&author.DeathYear,
+		&author.BooksCount,
+		&author.Version,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return &author, nil
+}
+
+// GetAllAuthors retrieves a paginated list of all author records.
+// Records can be searched by canonical or alternate name and sorted.
+func (r *repository) GetAllAuthors(search string, filters data.Filters) ([]*data.Author, data.Metadata, error) {
+	query := fmt.Sprintf(`
+		SELECT count(*) OVER(), authors.id, authors.created_at, authors.name, authors.sort_name, authors.alternate_names, authors.bio, authors.birth_year, authors.death_year, count(books_authors.book_id) AS books_count, authors.version
+		FROM authors
+		LEFT JOIN books_authors ON books_authors.author_id = authors.id
+		WHERE (
+			to_tsvector('simple', authors.name || ' ' || array_to_string(authors.alternate_names, ' '))
+			@@ plainto_tsquery('simple', $1) OR $1 = ''
+		)
+		GROUP BY authors.id
+		ORDER BY %s %s, authors.id ASC
+		LIMIT $2 OFFSET $3`,
+		filters.SortColumn(), filters.SortDirection(),
+	)
+	args := []interface{}{search, filters.Limit(), filters.Offset()}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	defer rows.Close()
+	totalRecords := 0
+	authors := []*data.Author{}
+	for rows.Next() {
+		var author data.Author
+		err := rows.Scan(
+			&totalRecords,
+			&author.ID,
+			&author.CreatedAt,
+			&author.Name,
+			&author.SortName,
+			pq.Array(&author.AlternateNames),
+			&author.Bio,
+			&author.BirthYear,
+			&author.DeathYear,
+			&author.BooksCount,
+			&author.Version,
+		)
+		if err != nil {
+			return nil, data.Metadata{}, err
+		}
+		authors = append(authors, &author)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, data.Metadata{}, err
+	}
+	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
+	return authors, metadata, nil
+}
+
+// UpdateAuthor updates an author record.
+func (r *repository) UpdateAuthor(author *data.Author) error {
+	query := `
+		UPDATE authors
+		SET name = $1, sort_name = $2, alternate_names = $3, name_keys = $4, bio = $5, birth_year = $6, death_year = $7, version = version + 1
+		WHERE id = $8 AND version = $9
+		RETURNING version`
+	args := []interface{}{
+		author.Name,
+		author.SortName,
+		pq.Array(author.AlternateNames),
+		pq.Array(author.NameKeys()),
+		author.Bio,
+		author.BirthYear,
+		author.DeathYear,
+		author.ID,
+		author.Version,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&author.Version)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrEditConflict
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllBooksForAuthor retrieves a paginated list of all book records for an author.
+func (r *repository) GetAllBooksForAuthor(authorID int64, filters data.Filters) ([]*data.Book, data.Metadata, error) {
+	query := fmt.Sprintf(`
+		SELECT count(*) OVER(), books.id, books.user_id, books.created_at, books.title, books.description, books.author, books.category, books.publisher, books.language, books.series, books.volume, books.edition, books.year, books.page_count, books.isbn_10, books.isbn_13, books.cover_path, books.s3_file_key, books.fname, books.extension, books.size, books.popularity, books.version
+		FROM books
+		INNER JOIN books_authors ON books_authors.book_id = books.id
+		WHERE books_authors.author_id = $1
+		ORDER BY %s %s, books.id ASC
+		LIMIT $2 OFFSET $3`,
+		filters.SortColumn(), filters.SortDirection(),
+	)
+	args := []interface{}{authorID, filters.Limit(), filters.Offset()}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	defer rows.Close()
+	totalRecords := 0
+	books := []*data.Book{}
+	for rows.Next() {
+		var book data.Book
+		err := rows.Scan(
+			&totalRecords,
+			&book.ID,
+			&book.UserID,
+			&book.CreatedAt,
+			&book.Title,
+			&book.Description,
+			pq.Array(&book.Author),
+			&book.Category,
+			&book.Publisher,
+			&book.Language,
+			&book.Series,
+			&book.Volume,
+			&book.Edition,
+			&book.Year,
+			&book.PageCount,
+			&book.Isbn10,
+			&book.Isbn13,
+			&book.CoverPath,
+			&book.S3FileKey,
+			&book.Filename,
+			&book.Extension,
+			&book.Size,
+			&book.Popularity,
+			&book.Version,
+		)
+		if err != nil {
+			return nil, data.Metadata{}, err
+		}
+		book.LanguageName = iso639.Name(book.Language)
+		books = append(books, &book)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, data.Metadata{}, err
+	}
+	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
+	return books, metadata, nil
+}
+
+// resolveAuthorForBook gives an author that doesn't exist yet the ID of the author with the
+// same name, or creates the author when there's none. It runs in the transaction of tx.
+func (r *repository) resolveAuthorForBook(ctx context.Context, tx *sql.Tx, author *data.Author) error {
+	if author.ID != 0 {
+		return nil
+	}
+	query := `
+		SELECT id
+		FROM authors
+		WHERE $1 = ANY(name_keys)
+		ORDER BY id ASC
+		LIMIT 1`
+	err := tx.QueryRowContext(ctx, query, data.AuthorNameKey(author.Name)).Scan(&author.ID)
+	if err == nil || !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	query = `
+		INSERT INTO authors (name, sort_name, alternate_names, name_keys, bio, birth_year, death_year)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, version`
+	args := []interface{}{
+		author.Name,
+		author.SortName,
+		pq.Array(author.AlternateNames),
+		pq.Array(author.NameKeys()),
+		author.Bio,
+		author.BirthYear,
+		author.DeathYear,
+	}
+	return tx.QueryRowContext(ctx, query, args...).Scan(&author.ID, &author.CreatedAt, &author.Version)
+}
+
+// setAuthorsForBook replaces the authors associated with a book record, in the transaction of
+// tx. The order of authorIDs is kept so that the book's authors are listed as they were provided.
+func (r *repository) setAuthorsForBook(ctx context.Context, tx *sql.Tx, bookID int64, authorIDs []int64) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM books_authors WHERE book_id = $1`, bookID)
+	if err != nil {
+		return err
+	}
+	query := `
+		INSERT INTO books_authors (book_id, author_id, position)
+		SELECT $1, t.author_id, t.ord
+		FROM unnest($2::bigint[]) WITH ORDINALITY AS t(author_id, ord)
+		ON CONFLICT DO NOTHING`
+	_, err = tx.ExecContext(ctx, query, bookID, pq.Array(authorIDs))
+	return err
+}
+
+// RefreshAuthorNamesForBooks rewrites the author names stored on an author's book records
+// after the author's canonical name has changed.
+func (r *repository) RefreshAuthorNamesForBooks(authorID int64) error {
+	query := `
+		UPDATE books
+		SET author = ARRAY(
+			SELECT authors.name
+			FROM books_authors
+			INNER JOIN authors ON books_authors.author_id = authors.id
+			WHERE books_authors.book_id = books.id
+			ORDER BY books_authors.position
+		), version = version + 1
+		WHERE id IN (SELECT book_id FROM books_authors WHERE author_id = $1)`
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := r.db.ExecContext(ctx, query, authorID)
+	return err
+}
+
+// MergeAuthors re-points every book of the duplicate authors to author, refreshes the
+// author names stored on those books and deletes the duplicates. The author record is
+// expected to already carry the duplicates' names as alternate names. All changes are
+// made in a single transaction.
+func (r *repository) MergeAuthors(author *data.Author, duplicateIDs []int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	query := `
+		UPDATE authors
+		SET name = $1, sort_name = $2, alternate_names = $3, name_keys = $4, bio = $5, birth_year = $6, death_year = $7, version = version + 1
+		WHERE id = $8 AND version = $9
+		RETURNING version`
+	args := []interface{}{
+		author.Name,
+		author.SortName,
+		pq.Array(author.AlternateNames),
+		pq.Array(author.NameKeys()),
+		author.Bio,
+		author.BirthYear,
+		author.DeathYear,
+		author.ID,
+		author.Version,
+	}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&author.Version)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrEditConflict
+		default:
+			return err
+		}
+	}
+	// Remember which books are affected before the duplicate links are removed
+	rows, err := tx.QueryContext(ctx, `SELECT DISTINCT book_id FROM books_authors WHERE author_id = ANY($1)`, pq.Array(duplicateIDs))
+	if err != nil {
+		return err
+	}
+	bookIDs := []int64{}
+	for rows.Next() {
+		var bookID int64
+		if err := rows.Scan(&bookID); err != nil {
+			rows.Close()
+			return err
+		}
+		bookIDs = append(bookIDs, bookID)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	query = `
+		INSERT INTO books_authors (book_id, author_id, position)
+		SELECT book_id, $1, min(position)
+		FROM books_authors
+		WHERE author_id = ANY($2)
+		GROUP BY book_id
+		ON CONFLICT DO NOTHING`
+	_, err = tx.ExecContext(ctx, query, author.ID, pq.Array(duplicateIDs))
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM authors WHERE id = ANY($1)`, pq.Array(duplicateIDs))
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != int64(len(duplicateIDs)) {
+		return ErrRecordNotFound
+	}
+	query = `
+		UPDATE books
+		SET author = ARRAY(
+			SELECT authors.name
+			FROM books_authors
+			INNER JOIN authors ON books_authors.author_id = authors.id
+			WHERE books_authors.book_id = books.id
+			ORDER BY books_authors.position
+		), version = version + 1
+		WHERE id = ANY($1)`
+	_, err = tx.ExecContext(ctx, query, pq.Array(bookIDs))
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/iso639"
 	"github.com/lib/pq"
 )
 
@@ -172,6 +173,7 @@ func (r *repository) GetAllBooksForBooklist(booklistID int64, filters data.Filte
 		if err != nil {
 			return nil, data.Metadata{}, err
 		}
+		book.LanguageName = iso639.Name(book.Language)
 		books = append(books, &book)
 	}
 	if err = rows.Err(); err != nil {
@@ -384,6 +386,7 @@ func (r *repository) SearchBooksInBooklist(search string, filters data.Filters)
 		if err != nil {
 			return nil, data.Metadata{}, err
 		}
+		book.LanguageName = iso639.Name(book.Language)
 		books = append(books, &book)
 	}
 	if err = rows.Err(); err != nil {
@@ -8,14 +8,17 @@ import (
 	"time"
 
 	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/iso639"
 	"github.com/lib/pq"
 )
 
 type books interface {
 	CreateBook(book *data.Book) error
 	GetBook(ID int64) (*data.Book, error)
-	GetAllBooks(search string, fromYear, toYear int, language, extension []string, filters data.Filters) ([]*data.Book, data.Metadata, error)
-	UpdateBook(book *data.Book) error
+	GetBookByIsbn(isbns []string) (*data.Book, error)
+	GetLanguageCounts() ([]*data.LanguageCount, error)
+	GetAllBooks(search string, fromYear, toYear int, language, extension, tags, isbns []string, filters data.Filters) ([]*data.Book, data.Metadata, error)
+	UpdateBook(book *data.Book, authors []*data.Author) error
 	DeleteBook(bookID int64) error
 	AddDownloadForUser(userID int64, bookID int64) error
 	RemoveDownloadForUser(userID int64, bookID int64) error
@@ -26,10 +29,10 @@ type books interface {
 // CreateBook creates a new book record.
 func (r *repository) CreateBook(book *data.Book) error {
 	query := `
-			INSERT INTO books (user_id, title, s3_file_key, fname, extension, size)
-			VALUES ($1, $2, $3, $4, $5, $6)
+			INSERT INTO books (user_id, title, s3_file_key, fname, extension, size, checksum)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
 		  	RETURNING id, created_at, version`
-	args := []interface{}{book.UserID, book.Title, book.S3FileKey, book.Filename, book.Extension, book.Size}
+	args := []interface{}{book.UserID, book.Title, book.S3FileKey, book.Filename, book.Extension, book.Size, book.Checksum}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	return r.db.QueryRowContext(ctx, query, args...).Scan(&book.ID, &book.CreatedAt, &book.Version)
@@ -41,7 +44,22 @@ func (r *repository) GetBook(ID int64) (*data.Book, error) {
 		return nil, ErrRecordNotFound
 	}
 	query := `
-		SELECT id, user_id, created_at, title, description, author, category, publisher, language, series, volume, edition, year, page_count, isbn_10, isbn_13, cover_path, s3_file_key, fname, extension, size, popularity, version
+		SELECT id, user_id, created_at, title, description, author, ARRAY(SELECT author_id FROM books_authors WHERE book_id = books.id ORDER BY position), category,
+		ARRAY(
+			SELECT categories.name
+			FROM books_categories
+			INNER JOIN categories ON categories.id = books_categories.category_id
+			WHERE books_categories.book_id = books.id
+			ORDER BY categories.name = books.category DESC, categories.name ASC
+		),
+		ARRAY(
+			SELECT tags.name
+			FROM books_tags
+			INNER JOIN tags ON tags.id = books_tags.tag_id
+			WHERE books_tags.book_id = books.id AND books_tags.status = 'approved'
+			ORDER BY tags.name ASC
+		),
+		publisher, language, series, volume, edition, year, page_count, isbn_10, isbn_13, cover_path, cover_source, s3_file_key, fname, extension, size, popularity, version
 		FROM books 
 		WHERE id = $1`
 	var book data.Book
@@ -54,7 +72,10 @@ func (r *repository) GetBook(ID int64) (*data.Book, error) {
 		&book.Title,
 		&book.Description,
 		pq.Array(&book.Author),
+		pq.Array(&book.AuthorIDs),
 		&book.Category,
+		pq.Array(&book.Categories),
+		pq.Array(&book.Tags),
 		&book.Publisher,
 		&book.Language,
 		&book.Series,
@@ -65,6 +86,7 @@ func (r *repository) GetBook(ID int64) (*data.Book, error) {
 		&book.Isbn10,
 		&book.Isbn13,
 		&book.CoverPath,
+		&book.CoverSource,
 		&book.S3FileKey,
 		&book.Filename,
 		&book.Extension,
@@ -80,35 +102,120 @@ func (r *repository) GetBook(ID int64) (*data.Book, error) {
 			return nil, err
 		}
 	}
+	book.LanguageName = iso639.Name(book.Language)
 	return &book, nil
 }
 
-// GetAllBooks retrieves retrieves a paginated list of all book records.
-// Records can be filtered and sorted.
-func (r *repository) GetAllBooks(search string, fromYear, toYear int, language, extension []string, filters data.Filters) ([]*data.Book, data.Metadata, error) {
-	query := fmt.Sprintf(`
-		SELECT count(*) OVER(), id, user_id, created_at, title, description, author, category, publisher, language, series, volume, edition, year, page_count, isbn_10, isbn_13, cover_path, s3_file_key, fname, extension, size, popularity, version
-		FROM books  
-		WHERE (
-			to_tsvector('simple', title) || 
-			to_tsvector(array_to_string(author,' '::text)) ||
-			to_tsvector('simple', isbn_10) || 
-			to_tsvector('simple', isbn_13) || 
-			to_tsvector('simple', publisher) 
-			@@ plainto_tsquery('simple', $1) OR $1 = ''
-		) 
+// GetBookByIsbn retrieves the oldest book record with an ISBN-10 or ISBN-13 in isbns.
+func (r *repository) GetBookByIsbn(isbns []string) (*data.Book, error) {
+	if len(isbns) == 0 {
+		return nil, ErrRecordNotFound
+	}
+	query := `
+		SELECT id
+		FROM books
+		WHERE isbn_10 = ANY($1) OR isbn_13 = ANY($1)
+		ORDER BY id ASC
+		LIMIT 1`
+	var bookID int64
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, pq.Array(isbns)).Scan(&bookID)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return r.GetBook(bookID)
+}
+
+// GetLanguageCounts retrieves the number of books in each language, most used first.
+func (r *repository) GetLanguageCounts() ([]*data.LanguageCount, error) {
+	query := `
+		SELECT language, count(*)
+		FROM books
+		WHERE language <> ''
+		GROUP BY language
+		ORDER BY count(*) DESC, language ASC`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	languages := []*data.LanguageCount{}
+	for rows.Next() {
+		var language data.LanguageCount
+		err := rows.Scan(&language.Code, &language.Books)
+		if err != nil {
+			return nil, err
+		}
+		language.Name = iso639.Name(language.Code)
+		languages = append(languages, &language)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return languages, nil
+}
+
+// bookSearchConditions returns the conditions a book must meet to match a search, for the
+// WHERE clause of a query on books. The arguments are the SQL expressions of the search text,
+// the year range, and the languages, extensions, tags and ISBNs to filter by, so the same
+// conditions match books against query parameters and against saved searches.
+func bookSearchConditions(search, fromYear, toYear, language, extension, tags, isbns string) string {
+	return fmt.Sprintf(`(
+			to_tsvector('simple', books.title) ||
+			to_tsvector(array_to_string(books.author,' '::text)) ||
+			to_tsvector('simple', books.isbn_10) ||
+			to_tsvector('simple', books.isbn_13) ||
+			to_tsvector('simple', books.publisher) ||
+			to_tsvector('simple', COALESCE((
+				SELECT string_agg(tags.name, ' ')
+				FROM books_tags
+				INNER JOIN tags ON tags.id = books_tags.tag_id
+				WHERE books_tags.book_id = books.id AND books_tags.status = 'approved'
+			), ''))
+			@@ plainto_tsquery('simple', %[1]s) OR %[1]s = ''
+		)
 		AND (
-			CASE 
-				WHEN $2 > 0 AND $3 = 0 THEN year BETWEEN $2 AND EXTRACT(YEAR FROM CURRENT_DATE)
-				WHEN ($2 = 0 AND $3 > 0) OR ($2 > 0 AND $3 > 0) THEN year BETWEEN $2 AND $3
-				ELSE year BETWEEN 1900 AND EXTRACT(YEAR FROM CURRENT_DATE)
+			CASE
+				WHEN %[2]s > 0 AND %[3]s = 0 THEN books.year BETWEEN %[2]s AND EXTRACT(YEAR FROM CURRENT_DATE)
+				WHEN (%[2]s = 0 AND %[3]s > 0) OR (%[2]s > 0 AND %[3]s > 0) THEN books.year BETWEEN %[2]s AND %[3]s
+				ELSE books.year BETWEEN 1900 AND EXTRACT(YEAR FROM CURRENT_DATE)
 			END
 		)
-		AND (language ILIKE ANY($4) OR $4 = '{}') 
-		AND (extension ILIKE ANY($5) OR $5 = '{}')
+		AND (books.language = ANY(%[4]s) OR %[4]s = '{}')
+		AND (books.extension ILIKE ANY(%[5]s) OR %[5]s = '{}')
+		AND (%[6]s = '{}' OR books.id IN (
+			SELECT books_tags.book_id
+			FROM books_tags
+			INNER JOIN tags ON tags.id = books_tags.tag_id
+			WHERE tags.name = ANY(%[6]s) AND books_tags.status = 'approved'
+			GROUP BY books_tags.book_id
+			HAVING count(*) = cardinality(%[6]s::text[])
+		))
+		AND (%[7]s = '{}' OR books.isbn_10 = ANY(%[7]s) OR books.isbn_13 = ANY(%[7]s))`,
+		search, fromYear, toYear, language, extension, tags, isbns,
+	)
+}
+
+// GetAllBooks retrieves retrieves a paginated list of all book records.
+// Records can be filtered and sorted. When tags are given, only books with
+// all of the tags approved are listed. When isbns are given, only books with
+// one of the ISBNs are listed.
+func (r *repository) GetAllBooks(search string, fromYear, toYear int, language, extension, tags, isbns []string, filters data.Filters) ([]*data.Book, data.Metadata, error) {
+	query := fmt.Sprintf(`
+		SELECT count(*) OVER(), id, user_id, created_at, title, description, author, category, publisher, language, series, volume, edition, year, page_count, isbn_10, isbn_13, cover_path, s3_file_key, fname, extension, size, popularity, version
+		FROM books
+		WHERE %s
 		ORDER BY %s %s, id ASC
 		LIMIT $6 OFFSET $7`,
-		filters.SortColumn(), filters.SortDirection(),
+		bookSearchConditions("$1", "$2", "$3", "$4", "$5", "$8", "$9"), filters.SortColumn(), filters.SortDirection(),
 	)
 	args := []interface{}{
 		search,
@@ -118,6 +225,8 @@ func (r *repository) GetAllBooks(search string, fromYear, toYear int, language,
 		pq.Array(extension),
 		filters.Limit(),
 		filters.Offset(),
+		pq.Array(tags),
+		pq.Array(isbns),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
@@ -159,6 +268,7 @@ func (r *repository) GetAllBooks(search string, fromYear, toYear int, language,
 		if err != nil {
 			return nil, data.Metadata{}, err
 		}
+		book.LanguageName = iso639.Name(book.Language)
 		books = append(books, &book)
 	}
 	if err = rows.Err(); err != nil {
@@ -168,12 +278,31 @@ func (r *repository) GetAllBooks(search string, fromYear, toYear int, language,
 	return books, metadata, nil
 }
 
-// UpdateBook updates a book record.
-func (r *repository) UpdateBook(book *data.Book) error {
+// UpdateBook updates a book record. Its popularity is maintained from its reviews and isn't updated.
+// When authors isn't nil, the book is linked to the authors in the same transaction, and the
+// authors that don't exist yet are created.
+func (r *repository) UpdateBook(book *data.Book, authors []*data.Author) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if authors != nil {
+		book.AuthorIDs = make([]int64, len(authors))
+		for i, author := range authors {
+			err = r.resolveAuthorForBook(ctx, tx, author)
+			if err != nil {
+				return err
+			}
+			book.AuthorIDs[i] = author.ID
+		}
+	}
 	query := `
 		UPDATE books
 		SET title = $1, description = $2, author = $3, category = $4, publisher = $5, language = $6, series = $7, volume = $8, 
-		edition = $9, year = $10, page_count = $11, isbn_10 = $12, isbn_13 = $13, cover_path = $14, popularity = $15, version = version + 1
+		edition = $9, year = $10, page_count = $11, isbn_10 = $12, isbn_13 = $13, cover_path = $14, cover_source = $15, version = version + 1
 		WHERE id = $16 AND version = $17
 		RETURNING version`
 	args := []interface{}{
@@ -191,13 +320,11 @@ func (r *repository) UpdateBook(book *data.Book) error {
 		book.Isbn10,
 		book.Isbn13,
 		book.CoverPath,
-		book.Popularity,
+		book.CoverSource,
 		book.ID,
 		book.Version,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-	err := r.db.QueryRowContext(ctx, query, args...).Scan(&book.Version)
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&book.Version)
 	if err != nil {
 		switch {
 		case errors.Is(err, sql.ErrNoRows):
@@ -206,7 +333,13 @@ func (r *repository) UpdateBook(book *data.Book) error {
 			return err
 		}
 	}
-	return nil
+	if authors != nil {
+		err = r.setAuthorsForBook(ctx, tx, book.ID, book.AuthorIDs)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
 }
 
 // DeleteBook deletes a book record.
@@ -8,16 +8,47 @@ import (
 	"time"
 
 	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/iso639"
 	"github.com/lib/pq"
 )
 
 type categories interface {
+	CreateCategory(category *data.Category) error
 	GetCategory(categoryID int64) (*data.Category, error)
+	GetCategoryBySlug(slug string) (*data.Category, error)
+	GetCategoryByName(name string) (*data.Category, error)
 	GetAllCategories() ([]*data.Category, error)
-	GetCategoryForBook(ID int64) (*data.Category, error)
-	AddCategoryForBook(bookID int64, category string) error
-	DeleteCategoryForBook(bookID, categoryID int64) error
-	GetAllBooksForCategory(categoryID int64, filters data.Filters) ([]*data.Book, data.Metadata, error)
+	UpdateCategory(category *data.Category) error
+	IsCategoryDescendant(categoryID, ancestorID int64) (bool, error)
+	DeleteCategory(categoryID, moveToID int64) error
+	MergeCategories(categoryID int64, sourceIDs []int64) error
+	SetCategoriesForBook(bookID int64, categoryIDs []int64) error
+	GetAllBooksForCategory(categoryID int64, includeSubcategories bool, filters data.Filters) ([]*data.Book, data.Metadata, error)
+	FollowCategory(userID, categoryID int64) error
+	UnfollowCategory(userID, categoryID int64) error
+}
+
+// CreateCategory creates a new category record.
+func (r *repository) CreateCategory(category *data.Category) error {
+	query := `
+		INSERT INTO categories (parent_id, name, slug)
+		VALUES (NULLIF($1, 0), $2, $3)
+		RETURNING id, version`
+	args := []interface{}{category.ParentID, category.Name, category.Slug}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&category.ID, &category.Version)
+	if err != nil {
+		switch {
+		case err.Error() == `pq: duplicate key value violates unique constraint "categories_slug_key"`:
+			return ErrDuplicateRecord
+		case err.Error() == `pq: duplicate key value violates unique constraint "categories_name_idx"`:
+			return ErrDuplicateRecord
+		default:
+			return err
+		}
+	}
+	return nil
 }
 
 // GetCategory retrieves a category record.
@@ -26,15 +57,42 @@ func (r *repository) GetCategory(categoryID int64) (*data.Category, error) {
 		return nil, ErrRecordNotFound
 	}
 	query := `
-		SELECT id, name
+		SELECT id, COALESCE(parent_id, 0), name, slug, books_count, version
 		FROM categories
 		WHERE id = $1`
+	return r.getCategory(query, categoryID)
+}
+
+// GetCategoryBySlug retrieves a category record by its slug.
+func (r *repository) GetCategoryBySlug(slug string) (*data.Category, error) {
+	query := `
+		SELECT id, COALESCE(parent_id, 0), name, slug, books_count, version
+		FROM categories
+		WHERE slug = $1`
+	return r.getCategory(query, slug)
+}
+
+// GetCategoryByName retrieves a category record by its case-insensitive name.
+func (r *repository) GetCategoryByName(name string) (*data.Category, error) {
+	query := `
+		SELECT id, COALESCE(parent_id, 0), name, slug, books_count, version
+		FROM categories
+		WHERE lower(name) = lower($1)`
+	return r.getCategory(query, name)
+}
+
+// getCategory runs a query that selects a single category record.
+func (r *repository) getCategory(query string, args ...interface{}) (*data.Category, error) {
 	var category data.Category
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	err := r.db.QueryRowContext(ctx, query, categoryID).Scan(
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(
 		&category.ID,
+		&category.ParentID,
 		&category.Name,
+		&category.Slug,
+		&category.BooksCount,
+		&category.Version,
 	)
 	if err != nil {
 		switch {
@@ -47,14 +105,12 @@ func (r *repository) GetCategory(categoryID int64) (*data.Category, error) {
 	return &category, nil
 }
 
-// GetAllCategories retrieves all category records.
+// GetAllCategories retrieves all category records ordered by name.
 func (r *repository) GetAllCategories() ([]*data.Category, error) {
 	query := `
-		SELECT categories.id, categories.name, count(books_categories.book_id)
+		SELECT id, COALESCE(parent_id, 0), name, slug, books_count, version
 		FROM categories
-		LEFT JOIN books_categories ON books_categories.category_id = categories.id
-		GROUP BY categories.id
-		ORDER by categories.id ASC`
+		ORDER by name ASC, id ASC`
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	rows, err := r.db.QueryContext(ctx, query)
@@ -67,8 +123,11 @@ func (r *repository) GetAllCategories() ([]*data.Category, error) {
 		var category data.Category
 		err := rows.Scan(
 			&category.ID,
+			&category.ParentID,
 			&category.Name,
+			&category.Slug,
 			&category.BooksCount,
+			&category.Version,
 		)
 		if err != nil {
 			return nil, err
@@ -81,66 +140,100 @@ func (r *repository) GetAllCategories() ([]*data.Category, error) {
 	return categories, nil
 }
 
-// AddCategoryForBook associates a book record with a category.
-func (r *repository) AddCategoryForBook(bookID int64, category string) error {
-	query := `
-		INSERT INTO books_categories
-		SELECT $1, categories.id
-		FROM categories
-		WHERE categories.name = $2`
-	args := []interface{}{bookID, category}
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+// UpdateCategory updates a category record. When the name changes, the category name stored
+// on the book records that have it as their main category is updated too.
+func (r *repository) UpdateCategory(category *data.Category) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	_, err := r.db.ExecContext(ctx, query, args...)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	var previousName string
+	err = tx.QueryRowContext(ctx, `SELECT name FROM categories WHERE id = $1`, category.ID).Scan(&previousName)
 	if err != nil {
 		switch {
-		case err.Error() == `pq: duplicate key value violates unique constraint "books_categories_pkey"`:
-			return ErrDuplicateRecord
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrEditConflict
 		default:
 			return err
 		}
 	}
-	return nil
-}
-
-// GetCategoryForBook retrieves the category associated with a book record.
-func (r *repository) GetCategoryForBook(bookID int64) (*data.Category, error) {
-	if bookID < 1 {
-		return nil, ErrRecordNotFound
-	}
 	query := `
-		SELECT categories.id, categories.name
-		FROM categories 
-		INNER JOIN books_categories ON books_categories.category_id = categories.id
-		INNER JOIN books ON books_categories.book_id = books.id
-		WHERE books.id = $1`
-	var category data.Category
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-	err := r.db.QueryRowContext(ctx, query, bookID).Scan(
-		&category.ID,
-		&category.Name,
-	)
+		UPDATE categories
+		SET parent_id = NULLIF($1, 0), name = $2, slug = $3, version = version + 1
+		WHERE id = $4 AND version = $5
+		RETURNING version`
+	args := []interface{}{category.ParentID, category.Name, category.Slug, category.ID, category.Version}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&category.Version)
 	if err != nil {
 		switch {
 		case errors.Is(err, sql.ErrNoRows):
-			return nil, ErrRecordNotFound
+			return ErrEditConflict
+		case err.Error() == `pq: duplicate key value violates unique constraint "categories_slug_key"`:
+			return ErrDuplicateRecord
+		case err.Error() == `pq: duplicate key value violates unique constraint "categories_name_idx"`:
+			return ErrDuplicateRecord
 		default:
-			return nil, err
+			return err
 		}
 	}
-	return &category, nil
+	if previousName != category.Name {
+		query = `
+			UPDATE books
+			SET category = $1, version = version + 1
+			WHERE id IN (SELECT book_id FROM books_categories WHERE category_id = $2) AND lower(category) = lower($3)`
+		_, err = tx.ExecContext(ctx, query, category.Name, category.ID, previousName)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
 }
 
-// DeleteCategoryForBook deletes a category assciated with a book record.
-func (r *repository) DeleteCategoryForBook(bookID, categoryID int64) error {
+// IsCategoryDescendant reports whether a category is a subcategory, at any depth, of another category.
+func (r *repository) IsCategoryDescendant(categoryID, ancestorID int64) (bool, error) {
 	query := `
-		DELETE FROM books_categories
-		WHERE book_id = $1 AND category_id = $2`
-	args := []interface{}{bookID, categoryID}
+		WITH RECURSIVE ancestors AS (
+			SELECT parent_id FROM categories WHERE id = $1
+			UNION
+			SELECT categories.parent_id
+			FROM categories
+			INNER JOIN ancestors ON categories.id = ancestors.parent_id
+		)
+		SELECT EXISTS (SELECT 1 FROM ancestors WHERE parent_id = $2)`
+	var isDescendant bool
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	result, err := r.db.ExecContext(ctx, query, args...)
+	err := r.db.QueryRowContext(ctx, query, categoryID, ancestorID).Scan(&isDescendant)
+	return isDescendant, err
+}
+
+// DeleteCategory deletes a category record. Its books are moved to the category with an ID
+// of moveToID, or lose their category when moveToID is 0, and its subcategories are moved
+// to its parent. All changes are made in a single transaction.
+func (r *repository) DeleteCategory(categoryID, moveToID int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	err = r.moveCategoryBooks(ctx, tx, []int64{categoryID}, moveToID)
+	if err != nil {
+		return err
+	}
+	query := `
+		UPDATE categories
+		SET parent_id = (SELECT parent_id FROM categories WHERE id = $1), version = version + 1
+		WHERE parent_id = $1`
+	_, err = tx.ExecContext(ctx, query, categoryID)
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM categories WHERE id = $1`, categoryID)
 	if err != nil {
 		return err
 	}
@@ -151,22 +244,125 @@ func (r *repository) DeleteCategoryForBook(bookID, categoryID int64) error {
 	if rowsAffected == 0 {
 		return ErrRecordNotFound
 	}
-	return nil
+	return tx.Commit()
+}
+
+// MergeCategories moves the books and subcategories of the source categories to a category
+// and deletes the sources. All changes are made in a single transaction.
+func (r *repository) MergeCategories(categoryID int64, sourceIDs []int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	err = r.moveCategoryBooks(ctx, tx, sourceIDs, categoryID)
+	if err != nil {
+		return err
+	}
+	query := `
+		UPDATE categories
+		SET parent_id = $1, version = version + 1
+		WHERE parent_id = ANY($2) AND id <> ALL($2)`
+	_, err = tx.ExecContext(ctx, query, categoryID, pq.Array(sourceIDs))
+	if err != nil {
+		return err
+	}
+	// Sources may be subcategories of each other, so unlink them before deleting
+	_, err = tx.ExecContext(ctx, `UPDATE categories SET parent_id = NULL WHERE id = ANY($1)`, pq.Array(sourceIDs))
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM categories WHERE id = ANY($1)`, pq.Array(sourceIDs))
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != int64(len(sourceIDs)) {
+		return ErrRecordNotFound
+	}
+	return tx.Commit()
+}
+
+// moveCategoryBooks associates the books of the source categories with the category with an ID
+// of targetID and updates the category name stored on the book records whose main category is a
+// source. When targetID is 0 those books lose their main category. The source categories keep
+// their links until deleted.
+func (r *repository) moveCategoryBooks(ctx context.Context, tx *sql.Tx, sourceIDs []int64, targetID int64) error {
+	if targetID != 0 {
+		query := `
+			INSERT INTO books_categories (book_id, category_id)
+			SELECT DISTINCT book_id, $1::bigint
+			FROM books_categories
+			WHERE category_id = ANY($2)
+			ON CONFLICT DO NOTHING`
+		_, err := tx.ExecContext(ctx, query, targetID, pq.Array(sourceIDs))
+		if err != nil {
+			return err
+		}
+	}
+	query := `
+		UPDATE books
+		SET category = COALESCE((SELECT name FROM categories WHERE id = $1), ''), version = version + 1
+		WHERE id IN (SELECT book_id FROM books_categories WHERE category_id = ANY($2))
+			AND lower(category) IN (SELECT lower(name) FROM categories WHERE id = ANY($2))`
+	_, err := tx.ExecContext(ctx, query, targetID, pq.Array(sourceIDs))
+	return err
+}
+
+// SetCategoriesForBook replaces the categories associated with a book record.
+func (r *repository) SetCategoriesForBook(bookID int64, categoryIDs []int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx, `DELETE FROM books_categories WHERE book_id = $1`, bookID)
+	if err != nil {
+		return err
+	}
+	query := `
+		INSERT INTO books_categories (book_id, category_id)
+		SELECT $1, unnest($2::bigint[])
+		ON CONFLICT DO NOTHING`
+	_, err = tx.ExecContext(ctx, query, bookID, pq.Array(categoryIDs))
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // GetAllBooksForCategory retrieves a paginated record of all books for a specific category.
-func (r *repository) GetAllBooksForCategory(categoryID int64, filters data.Filters) ([]*data.Book, data.Metadata, error) {
+// When includeSubcategories is true, books in its subcategories at any depth are included.
+func (r *repository) GetAllBooksForCategory(categoryID int64, includeSubcategories bool, filters data.Filters) ([]*data.Book, data.Metadata, error) {
 	query := fmt.Sprintf(`
+		WITH RECURSIVE tree AS (
+			SELECT id FROM categories WHERE id = $1
+			UNION
+			SELECT categories.id
+			FROM categories
+			INNER JOIN tree ON categories.parent_id = tree.id
+			WHERE $4
+		), books_in_tree AS (
+			SELECT book_id, max(datetime) AS datetime
+			FROM books_categories
+			WHERE category_id IN (SELECT id FROM tree)
+			GROUP BY book_id
+		)
 		SELECT count (*) OVER(), books.id, books.user_id, books.created_at, books.title, books.description, books.author, books.category, books.publisher, books.language, books.series, books.volume, books.edition, books.year, books.page_count, books.isbn_10, books.isbn_13, books.cover_path, books.s3_file_key, books.fname, books.extension, books.size, books.popularity, books.version
 		FROM books
-		INNER JOIN books_categories ON books_categories.book_id = books.id
-		INNER JOIN categories ON books_categories.category_id = categories.id
-		WHERE categories.id = $1
-		ORDER BY %s %s, datetime DESC
+		INNER JOIN books_in_tree ON books_in_tree.book_id = books.id
+		ORDER BY %s %s, datetime DESC, books.id ASC
 		LIMIT $2 OFFSET $3`,
 		filters.SortColumn(), filters.SortDirection(),
 	)
-	args := []interface{}{categoryID, filters.Limit(), filters.Offset()}
+	args := []interface{}{categoryID, filters.Limit(), filters.Offset(), includeSubcategories}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	rows, err := r.db.QueryContext(ctx, query, args...)
@@ -207,6 +403,7 @@ func (r *repository) GetAllBooksForCategory(categoryID int64, filters data.Filte
 		if err != nil {
 			return nil, data.Metadata{}, err
 		}
+		book.LanguageName = iso639.Name(book.Language)
 		books = append(books, &book)
 	}
 	if err = rows.Err(); err != nil {
@@ -215,3 +412,48 @@ func (r *repository) GetAllBooksForCategory(categoryID int64, filters data.Filte
 	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
 	return books, metadata, nil
 }
+
+// FollowCategory records that a user follows a category.
+func (r *repository) FollowCategory(userID, categoryID int64) error {
+	query := `
+		INSERT INTO users_followed_categories (user_id, category_id)
+		VALUES ($1, $2)`
+	args := []interface{}{userID, categoryID}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	_, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		switch {
+		case err.Error() == `pq: duplicate key value violates unique constraint "users_followed_categories_pkey"`:
+			return ErrDuplicateRecord
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// UnfollowCategory deletes the record of a user following a category.
+func (r *repository) UnfollowCategory(userID, categoryID int64) error {
+	if userID < 1 || categoryID < 1 {
+		return ErrRecordNotFound
+	}
+	query := `
+		DELETE FROM users_followed_categories
+		WHERE user_id = $1 AND category_id = $2`
+	args := []interface{}{userID, categoryID}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/emzola/bibliotheca/data"
+	"github.com/lib/pq"
 )
 
 type comments interface {
@@ -14,7 +16,8 @@ type comments interface {
 	GetComment(commentID int64) (*data.Comment, error)
 	UpdateComment(comment *data.Comment) error
 	DeleteComment(commentID int64) error
-	GetAllComments(booklistID int64) ([]*data.Comment, error)
+	GetAllComments(booklistID int64, filters data.Filters) ([]*data.Comment, data.Metadata, error)
+	GetCommentReplies(parentIDs []int64, depth int) ([]*data.Comment, error)
 	CreateReply(comment *data.Comment) error
 }
 
@@ -30,13 +33,15 @@ func (r *repository) CreateComment(comment *data.Comment) error {
 	return r.db.QueryRowContext(ctx, query, args...).Scan(&comment.ID, &comment.CreatedAt, &comment.Version)
 }
 
-// GetComment retrieves a comment record.
+// GetComment retrieves a comment record with its number of replies. The author and content
+// of a deleted comment are hidden.
 func (r *repository) GetComment(commentID int64) (*data.Comment, error) {
 	if commentID < 1 {
 		return nil, ErrRecordNotFound
 	}
 	query := `
-		SELECT comments.id, comments.parent_id, comments.booklist_id, comments.user_id, users.name, comments.created_at, comments.content, comments.version
+		SELECT comments.id, COALESCE(comments.parent_id, 0), comments.booklist_id, comments.user_id, users.name, comments.created_at, comments.content, comments.deleted_at IS NOT NULL,
+		(SELECT count(*) FROM comments AS replies WHERE replies.parent_id = comments.id), comments.version
 		FROM comments
 		INNER JOIN users on comments.user_id = users.id
 		WHERE comments.id = $1`
@@ -51,6 +56,8 @@ func (r *repository) GetComment(commentID int64) (*data.Comment, error) {
 		&comment.UserName,
 		&comment.CreatedAt,
 		&comment.Content,
+		&comment.Deleted,
+		&comment.ReplyCount,
 		&comment.Version,
 	)
 	if err != nil {
@@ -61,17 +68,20 @@ func (r *repository) GetComment(commentID int64) (*data.Comment, error) {
 			return nil, err
 		}
 	}
+	if comment.Deleted {
+		comment.Redact()
+	}
 	return &comment, nil
 }
 
-// UpdateComment updates a comment record.
+// UpdateComment updates a comment record. Deleted comments can't be updated.
 func (r *repository) UpdateComment(comment *data.Comment) error {
 	query := `
 		UPDATE comments
 		SET content = $1, version = version + 1
-		WHERE id = $2 AND version = $3
+		WHERE id = $2 AND version = $3 AND deleted_at IS NULL
 		RETURNING version`
-	args := []interface{}{comment.Content, comment.Version}
+	args := []interface{}{comment.Content, comment.ID, comment.Version}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	err := r.db.QueryRowContext(ctx, query, args...).Scan(&comment.Version)
@@ -86,17 +96,26 @@ func (r *repository) UpdateComment(comment *data.Comment) error {
 	return nil
 }
 
-// DeleteComment deletes a comment record.
+// DeleteComment deletes a comment record. A comment with replies is kept as a placeholder,
+// with its content cleared, so that its replies stay in the conversation. Deleting the last
+// reply of a placeholder also deletes the placeholder, and so on up the thread.
 func (r *repository) DeleteComment(commentID int64) error {
 	if commentID < 1 {
 		return ErrRecordNotFound
 	}
-	query := `
-		DELETE FROM comments
-		WHERE id = $1`
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	result, err := r.db.ExecContext(ctx, query, commentID)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	query := `
+		UPDATE comments
+		SET content = '', deleted_at = NOW(), version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL
+		AND EXISTS (SELECT 1 FROM comments AS replies WHERE replies.parent_id = comments.id)`
+	result, err := tx.ExecContext(ctx, query, commentID)
 	if err != nil {
 		return err
 	}
@@ -104,23 +123,116 @@ func (r *repository) DeleteComment(commentID int64) error {
 	if err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return ErrRecordNotFound
+	if rowsAffected == 1 {
+		return tx.Commit()
 	}
-	return nil
+	// Comments without replies are deleted outright, as are the placeholders they leave empty
+	query = `
+		DELETE FROM comments
+		WHERE id = $1 AND ($2 OR deleted_at IS NOT NULL)
+		AND NOT EXISTS (SELECT 1 FROM comments AS replies WHERE replies.parent_id = comments.id)
+		RETURNING COALESCE(parent_id, 0)`
+	var parentID int64
+	err = tx.QueryRowContext(ctx, query, commentID, true).Scan(&parentID)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	for parentID != 0 {
+		err = tx.QueryRowContext(ctx, query, parentID, false).Scan(&parentID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				break
+			}
+			return err
+		}
+	}
+	return tx.Commit()
 }
 
-// GetAllComments retrievs all comment records for a booklist.
-func (r *repository) GetAllComments(booklistID int64) ([]*data.Comment, error) {
+// GetAllComments retrieves a paginated list of the top-level comment records of a booklist,
+// with their number of replies. The author and content of deleted comments are hidden.
+func (r *repository) GetAllComments(booklistID int64, filters data.Filters) ([]*data.Comment, data.Metadata, error) {
+	query := fmt.Sprintf(`
+		SELECT count(*) OVER(), comments.id, comments.booklist_id, comments.user_id, users.name, comments.created_at, comments.content, comments.deleted_at IS NOT NULL,
+		(SELECT count(*) FROM comments AS replies WHERE replies.parent_id = comments.id), comments.version
+		FROM comments
+		INNER JOIN users on comments.user_id = users.id
+		WHERE comments.booklist_id = $1 AND comments.parent_id IS NULL
+		ORDER BY comments.%s %s, comments.id ASC
+		LIMIT $2 OFFSET $3`,
+		filters.SortColumn(), filters.SortDirection(),
+	)
+	args := []interface{}{booklistID, filters.Limit(), filters.Offset()}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	defer rows.Close()
+	totalRecords := 0
+	comments := []*data.Comment{}
+	for rows.Next() {
+		var comment data.Comment
+		err := rows.Scan(
+			&totalRecords,
+			&comment.ID,
+			&comment.BooklistID,
+			&comment.UserID,
+			&comment.UserName,
+			&comment.CreatedAt,
+			&comment.Content,
+			&comment.Deleted,
+			&comment.ReplyCount,
+			&comment.Version,
+		)
+		if err != nil {
+			return nil, data.Metadata{}, err
+		}
+		if comment.Deleted {
+			comment.Redact()
+		}
+		comments = append(comments, &comment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, data.Metadata{}, err
+	}
+	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
+	return comments, metadata, nil
+}
+
+// GetCommentReplies retrieves the replies to a set of comments and their replies in turn, down
+// to depth levels below the comments, oldest first. The author and content of deleted replies
+// are hidden.
+func (r *repository) GetCommentReplies(parentIDs []int64, depth int) ([]*data.Comment, error) {
+	if len(parentIDs) == 0 || depth < 1 {
+		return []*data.Comment{}, nil
+	}
 	query := `
-	SELECT comments.id, COALESCE(comments.parent_id, 0), comments.booklist_id, comments.user_id, users.name, comments.created_at, comments.content, comments.version
-	FROM comments 
-	INNER JOIN users on comments.user_id = users.id
-	WHERE comments.booklist_id = $1
-	ORDER BY id DESC`
+		WITH RECURSIVE thread AS (
+			SELECT id, 1 AS depth
+			FROM comments
+			WHERE parent_id = ANY($1)
+			UNION ALL
+			SELECT comments.id, thread.depth + 1
+			FROM comments
+			INNER JOIN thread ON comments.parent_id = thread.id
+			WHERE thread.depth < $2
+		)
+		SELECT comments.id, comments.parent_id, comments.booklist_id, comments.user_id, users.name, comments.created_at, comments.content, comments.deleted_at IS NOT NULL,
+		(SELECT count(*) FROM comments AS replies WHERE replies.parent_id = comments.id), comments.version
+		FROM thread
+		INNER JOIN comments ON comments.id = thread.id
+		INNER JOIN users on comments.user_id = users.id
+		ORDER BY comments.created_at ASC, comments.id ASC`
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	rows, err := r.db.QueryContext(ctx, query, booklistID)
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(parentIDs), depth)
 	if err != nil {
 		return nil, err
 	}
@@ -136,11 +248,16 @@ func (r *repository) GetAllComments(booklistID int64) ([]*data.Comment, error) {
 			&comment.UserName,
 			&comment.CreatedAt,
 			&comment.Content,
+			&comment.Deleted,
+			&comment.ReplyCount,
 			&comment.Version,
 		)
 		if err != nil {
 			return nil, err
 		}
+		if comment.Deleted {
+			comment.Redact()
+		}
 		comments = append(comments, &comment)
 	}
 	if err = rows.Err(); err != nil {
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+)
+
+type covers interface {
+	GetBooksMissingCover(afterID int64, limit int) ([]*data.Book, error)
+	SetFetchedBookCover(bookID int64, coverPath, source string) error
+	GetRequestsMissingCover(afterID int64, limit int) ([]*data.Request, error)
+	SetRequestCover(requestID int64, coverPath string) error
+}
+
+// GetBooksMissingCover retrieves the ID and ISBNs of up to limit book records with an ISBN
+// and no cover, with IDs greater than afterID in ascending order.
+func (r *repository) GetBooksMissingCover(afterID int64, limit int) ([]*data.Book, error) {
+	query := `
+		SELECT id, isbn_10, isbn_13
+		FROM books
+		WHERE id > $1 AND cover_path = '' AND (isbn_10 <> '' OR isbn_13 <> '')
+		ORDER BY id ASC
+		LIMIT $2`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	books := []*data.Book{}
+	for rows.Next() {
+		var book data.Book
+		err := rows.Scan(&book.ID, &book.Isbn10, &book.Isbn13)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, &book)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return books, nil
+}
+
+// SetFetchedBookCover sets the cover of a book record that has no cover. It returns
+// ErrEditConflict when the book has been given a cover in the meantime, so that fetched
+// covers never replace covers uploaded by the book's owner.
+func (r *repository) SetFetchedBookCover(bookID int64, coverPath, source string) error {
+	query := `
+		UPDATE books
+		SET cover_path = $2, cover_source = $3, version = version + 1
+		WHERE id = $1 AND cover_path = ''`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, bookID, coverPath, source)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrEditConflict
+	}
+	return nil
+}
+
+// GetRequestsMissingCover retrieves the ID and ISBN of up to limit request records with an
+// ISBN and no cover, with IDs greater than afterID in ascending order.
+func (r *repository) GetRequestsMissingCover(afterID int64, limit int) ([]*data.Request, error) {
+	query := `
+		SELECT id, isbn
+		FROM requests
+		WHERE id > $1 AND cover_path = '' AND isbn <> ''
+		ORDER BY id ASC
+		LIMIT $2`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	requests := []*data.Request{}
+	for rows.Next() {
+		var request data.Request
+		err := rows.Scan(&request.ID, &request.Isbn)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, &request)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// SetRequestCover sets the cover of a request record that has no cover.
+func (r *repository) SetRequestCover(requestID int64, coverPath string) error {
+	query := `
+		UPDATE requests
+		SET cover_path = $2
+		WHERE id = $1 AND cover_path = ''`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, requestID, coverPath)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrEditConflict
+	}
+	return nil
+}
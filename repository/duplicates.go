@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/lib/pq"
+)
+
+type duplicates interface {
+	RefreshDuplicatesForBook(bookID int64) error
+	GetAllBookDuplicates(ownerID int64, filters data.Filters) ([]*data.BookDuplicate, data.Metadata, error)
+	MergeBooks(bookID int64, sourceIDs []int64) error
+}
+
+// RefreshDuplicatesForBook replaces the likely duplicates recorded for a book record. Books are
+// likely duplicates when their files are byte-identical, when they share an ISBN, or when their
+// normalized titles, authors and years are the same. Each pair is stored once, lowest ID first.
+func (r *repository) RefreshDuplicatesForBook(bookID int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx, `DELETE FROM book_duplicates WHERE book_id = $1 OR duplicate_book_id = $1`, bookID)
+	if err != nil {
+		return err
+	}
+	query := `
+		WITH target AS (
+			SELECT id, checksum, isbn_10, isbn_13, year,
+				lower(regexp_replace(title, '[^[:alnum:]]+', '', 'g')) AS title_key,
+				ARRAY(SELECT author_id FROM books_authors WHERE book_id = books.id ORDER BY author_id) AS author_ids
+			FROM books
+			WHERE id = $1
+		)
+		INSERT INTO book_duplicates (book_id, duplicate_book_id, reason)
+		SELECT LEAST(target.id, books.id), GREATEST(target.id, books.id),
+			CASE
+				WHEN target.checksum <> '' AND books.checksum = target.checksum THEN $2::text
+				WHEN (target.isbn_13 <> '' AND books.isbn_13 = target.isbn_13) OR (target.isbn_10 <> '' AND books.isbn_10 = target.isbn_10) THEN $3::text
+				ELSE $4::text
+			END
+		FROM target, books
+		WHERE books.id <> target.id AND (
+			(target.checksum <> '' AND books.checksum = target.checksum)
+			OR (target.isbn_13 <> '' AND books.isbn_13 = target.isbn_13)
+			OR (target.isbn_10 <> '' AND books.isbn_10 = target.isbn_10)
+			OR (
+				target.year <> 0 AND books.year = target.year
+				AND target.title_key <> '' AND lower(regexp_replace(books.title, '[^[:alnum:]]+', '', 'g')) = target.title_key
+				AND cardinality(target.author_ids) > 0
+				AND ARRAY(SELECT author_id FROM books_authors WHERE book_id = books.id ORDER BY author_id) = target.author_ids
+			)
+		)`
+	args := []interface{}{bookID, data.DuplicateReasonSameFile, data.DuplicateReasonSameIsbn, data.DuplicateReasonSameTitleAuthorYear}
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetAllBookDuplicates retrieves a paginated list of likely duplicate books where either book
+// was uploaded by ownerID, or of all likely duplicates when ownerID is 0.
+func (r *repository) GetAllBookDuplicates(ownerID int64, filters data.Filters) ([]*data.BookDuplicate, data.Metadata, error) {
+	query := fmt.Sprintf(`
+		SELECT count(*) OVER(), book_duplicates.book_id, books.title, book_duplicates.duplicate_book_id, duplicates.title, book_duplicates.reason, book_duplicates.created_at
+		FROM book_duplicates
+		INNER JOIN books ON books.id = book_duplicates.book_id
+		INNER JOIN books AS duplicates ON duplicates.id = book_duplicates.duplicate_book_id
+		WHERE books.user_id = $1 OR duplicates.user_id = $1 OR $1 = 0
+		ORDER BY book_duplicates.%s %s, book_duplicates.book_id ASC, book_duplicates.duplicate_book_id ASC
+		LIMIT $2 OFFSET $3`,
+		filters.SortColumn(), filters.SortDirection(),
+	)
+	args := []interface{}{ownerID, filters.Limit(), filters.Offset()}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	defer rows.Close()
+	totalRecords := 0
+	bookDuplicates := []*data.BookDuplicate{}
+	for rows.Next() {
+		var bookDuplicate data.BookDuplicate
+		err := rows.Scan(
+			&totalRecords,
+			&bookDuplicate.BookID,
+			&bookDuplicate.BookTitle,
+			&bookDuplicate.DuplicateBookID,
+			&bookDuplicate.DuplicateBookTitle,
+			&bookDuplicate.Reason,
+			&bookDuplicate.CreatedAt,
+		)
+		if err != nil {
+			return nil, data.Metadata{}, err
+		}
+		bookDuplicates = append(bookDuplicates, &bookDuplicate)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, data.Metadata{}, err
+	}
+	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
+	return bookDuplicates, metadata, nil
+}
+
+// MergeBooks moves the reviews, favourites, downloads, booklist memberships, categories, tags
+// and fulfilled requests of the source books to a book and deletes the sources, in a single
+// transaction. Users keep one review of the book: their review of it if they have one, or
+// else their latest review of a source. The book's ratings follow its reviews.
+func (r *repository) MergeBooks(bookID int64, sourceIDs []int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	queries := []string{
+		`DELETE FROM reviews
+		WHERE book_id = ANY($2) AND id NOT IN (
+			SELECT DISTINCT ON (user_id) id FROM reviews WHERE book_id = $1 OR book_id = ANY($2)
+			ORDER BY user_id, book_id = $1 DESC, created_at DESC, id DESC
+		)`,
+		`UPDATE reviews SET book_id = $1 WHERE book_id = ANY($2)`,
+		`UPDATE requests SET book_id = $1 WHERE book_id = ANY($2)`,
+		`INSERT INTO users_favourite_books (user_id, book_id, datetime)
+		SELECT user_id, $1, min(datetime) FROM users_favourite_books WHERE book_id = ANY($2) GROUP BY user_id
+		ON CONFLICT DO NOTHING`,
+		`INSERT INTO users_downloads (user_id, book_id, datetime)
+		SELECT user_id, $1, min(datetime) FROM users_downloads WHERE book_id = ANY($2) GROUP BY user_id
+		ON CONFLICT DO NOTHING`,
+		`INSERT INTO booklists_books (booklist_id, book_id, datetime)
+		SELECT booklist_id, $1, min(datetime) FROM booklists_books WHERE book_id = ANY($2) GROUP BY booklist_id
+		ON CONFLICT DO NOTHING`,
+		`INSERT INTO books_categories (book_id, category_id, datetime)
+		SELECT $1, category_id, min(datetime) FROM books_categories WHERE book_id = ANY($2) GROUP BY category_id
+		ON CONFLICT DO NOTHING`,
+		`INSERT INTO books_tags (book_id, tag_id, user_id, status, datetime)
+		SELECT DISTINCT ON (tag_id) $1, tag_id, user_id, status, datetime FROM books_tags WHERE book_id = ANY($2)
+		ORDER BY tag_id, status = 'approved' DESC, datetime ASC
+		ON CONFLICT DO NOTHING`,
+	}
+	for _, query := range queries {
+		_, err = tx.ExecContext(ctx, query, bookID, pq.Array(sourceIDs))
+		if err != nil {
+			return err
+		}
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM books WHERE id = ANY($1)`, pq.Array(sourceIDs))
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != int64(len(sourceIDs)) {
+		return ErrRecordNotFound
+	}
+	return tx.Commit()
+}
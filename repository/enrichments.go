@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+)
+
+type enrichments interface {
+	SetBookEnrichment(enrichment *data.BookEnrichment) error
+	GetBookEnrichment(bookID int64) (*data.BookEnrichment, error)
+	DeleteBookEnrichment(bookID int64) error
+}
+
+// SetBookEnrichment saves the metadata proposed for a book record, replacing any earlier proposal.
+func (r *repository) SetBookEnrichment(enrichment *data.BookEnrichment) error {
+	metadata, err := json.Marshal(enrichment.Metadata)
+	if err != nil {
+		return err
+	}
+	query := `
+		INSERT INTO book_enrichments (book_id, source, metadata)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (book_id) DO UPDATE
+		SET source = EXCLUDED.source, metadata = EXCLUDED.metadata, created_at = NOW()
+		RETURNING created_at`
+	args := []interface{}{enrichment.BookID, enrichment.Source, metadata}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(&enrichment.CreatedAt)
+	if err != nil {
+		switch {
+		case err.Error() == `pq: insert or update on table "book_enrichments" violates foreign key constraint "book_enrichments_book_id_fkey"`:
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBookEnrichment retrieves the metadata proposed for a book record.
+func (r *repository) GetBookEnrichment(bookID int64) (*data.BookEnrichment, error) {
+	query := `
+		SELECT book_id, source, metadata, created_at
+		FROM book_enrichments
+		WHERE book_id = $1`
+	var enrichment data.BookEnrichment
+	var metadata []byte
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, bookID).Scan(
+		&enrichment.BookID,
+		&enrichment.Source,
+		&metadata,
+		&enrichment.CreatedAt,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	err = json.Unmarshal(metadata, &enrichment.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &enrichment, nil
+}
+
+// DeleteBookEnrichment deletes the metadata proposed for a book record.
+func (r *repository) DeleteBookEnrichment(bookID int64) error {
+	query := `
+		DELETE FROM book_enrichments
+		WHERE book_id = $1`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, bookID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
@@ -3,10 +3,11 @@ package repository
 import "errors"
 
 var (
-	ErrRecordNotFound   = errors.New("record not found")
-	ErrFailedValidation = errors.New("failed validation")
-	ErrEditConflict     = errors.New("edit conflict")
-	ErrDuplicateRecord  = errors.New("duplicate record")
-	ErrNotPermitted     = errors.New("not permitted")
-	ErrBadRequest       = errors.New("bad request")
+	ErrRecordNotFound    = errors.New("record not found")
+	ErrFailedValidation  = errors.New("failed validation")
+	ErrEditConflict      = errors.New("edit conflict")
+	ErrDuplicateRecord   = errors.New("duplicate record")
+	ErrDuplicateUsername = errors.New("duplicate username")
+	ErrNotPermitted      = errors.New("not permitted")
+	ErrBadRequest        = errors.New("bad request")
 )
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+)
+
+type jobs interface {
+	TryJobLock(name string) (func(), bool, error)
+	StartJobRun(run *data.JobRun) (bool, error)
+	FinishJobRun(run *data.JobRun) error
+	GetAllJobRuns(name string, filters data.Filters) ([]*data.JobRun, data.Metadata, error)
+	GetLatestJobRuns() (map[string]*data.JobRun, error)
+}
+
+// TryJobLock acquires a Postgres advisory lock for a job without waiting, so that only one
+// replica of the server runs the job at a time. Advisory locks belong to a database session,
+// so the lock holds on to a connection of the pool until the returned function releases it.
+func (r *repository) TryJobLock(name string) (func(), bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	var locked bool
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, "job:"+name).Scan(&locked)
+	if err != nil || !locked {
+		conn.Close()
+		return nil, false, err
+	}
+	unlock := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		conn.ExecContext(ctx, `SELECT pg_advisory_unlock(hashtext($1))`, "job:"+name)
+		// Closing the connection returns it to the pool. Should the unlock have failed, the
+		// lock is released when the session ends.
+		conn.Close()
+	}
+	return unlock, true, nil
+}
+
+// StartJobRun creates a job run record. It returns false without creating the record when a
+// run of the job was already recorded for the same scheduled time.
+func (r *repository) StartJobRun(run *data.JobRun) (bool, error) {
+	query := `
+		INSERT INTO job_runs (job_name, trigger, user_id, status, scheduled_at)
+		VALUES ($1, $2, NULLIF($3, 0), $4, $5)
+		ON CONFLICT (job_name, scheduled_at) DO NOTHING
+		RETURNING id, started_at`
+	args := []interface{}{run.JobName, run.Trigger, run.UserID, run.Status, run.ScheduledAt}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&run.ID, &run.StartedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return false, nil
+		default:
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// FinishJobRun records the status, error and finish time of a job run.
+func (r *repository) FinishJobRun(run *data.JobRun) error {
+	query := `
+		UPDATE job_runs
+		SET status = $1, error = $2, finished_at = NOW()
+		WHERE id = $3
+		RETURNING finished_at`
+	args := []interface{}{run.Status, run.Error, run.ID}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&run.FinishedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAllJobRuns retrieves a paginated list of the runs of a job.
+func (r *repository) GetAllJobRuns(name string, filters data.Filters) ([]*data.JobRun, data.Metadata, error) {
+	query := fmt.Sprintf(`
+		SELECT count(*) OVER(), id, job_name, trigger, COALESCE(user_id, 0), status, error, scheduled_at, started_at, finished_at
+		FROM job_runs
+		WHERE job_name = $1
+		ORDER BY %s %s, id DESC
+		LIMIT $2 OFFSET $3`,
+		filters.SortColumn(), filters.SortDirection(),
+	)
+	args := []interface{}{name, filters.Limit(), filters.Offset()}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	defer rows.Close()
+	totalRecords := 0
+	runs := []*data.JobRun{}
+	for rows.Next() {
+		var run data.JobRun
+		err := rows.Scan(
+			&totalRecords,
+			&run.ID,
+			&run.JobName,
+			&run.Trigger,
+			&run.UserID,
+			&run.Status,
+			&run.Error,
+			&run.ScheduledAt,
+			&run.StartedAt,
+			&run.FinishedAt,
+		)
+		if err != nil {
+			return nil, data.Metadata{}, err
+		}
+		runs = append(runs, &run)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, data.Metadata{}, err
+	}
+	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
+	return runs, metadata, nil
+}
+
+// GetLatestJobRuns retrieves the latest run of each job, keyed by job name.
+func (r *repository) GetLatestJobRuns() (map[string]*data.JobRun, error) {
+	query := `
+		SELECT DISTINCT ON (job_name) id, job_name, trigger, COALESCE(user_id, 0), status, error, scheduled_at, started_at, finished_at
+		FROM job_runs
+		ORDER BY job_name, started_at DESC, id DESC`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	runs := make(map[string]*data.JobRun)
+	for rows.Next() {
+		var run data.JobRun
+		err := rows.Scan(
+			&run.ID,
+			&run.JobName,
+			&run.Trigger,
+			&run.UserID,
+			&run.Status,
+			&run.Error,
+			&run.ScheduledAt,
+			&run.StartedAt,
+			&run.FinishedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		runs[run.JobName] = &run
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/lib/pq"
+)
+
+type mentions interface {
+	SetCommentMentions(commentID int64, users []*data.MentionedUser) ([]int64, error)
+	GetCommentMentions(commentIDs []int64) (map[int64][]*data.MentionedUser, error)
+	SetReviewMentions(reviewID int64, users []*data.MentionedUser) ([]int64, error)
+	GetReviewMentions(reviewIDs []int64) (map[int64][]*data.MentionedUser, error)
+}
+
+// SetCommentMentions replaces the users a comment mentions, and returns the IDs of the users
+// who weren't mentioned by it before.
+func (r *repository) SetCommentMentions(commentID int64, users []*data.MentionedUser) ([]int64, error) {
+	return r.setMentions("comments_mentions", "comment_id", commentID, users)
+}
+
+// GetCommentMentions retrieves the users mentioned by a set of comments, keyed by comment ID.
+func (r *repository) GetCommentMentions(commentIDs []int64) (map[int64][]*data.MentionedUser, error) {
+	return r.getMentions("comments_mentions", "comment_id", commentIDs)
+}
+
+// SetReviewMentions replaces the users a review mentions, and returns the IDs of the users
+// who weren't mentioned by it before.
+func (r *repository) SetReviewMentions(reviewID int64, users []*data.MentionedUser) ([]int64, error) {
+	return r.setMentions("reviews_mentions", "review_id", reviewID, users)
+}
+
+// GetReviewMentions retrieves the users mentioned by a set of reviews, keyed by review ID.
+func (r *repository) GetReviewMentions(reviewIDs []int64) (map[int64][]*data.MentionedUser, error) {
+	return r.getMentions("reviews_mentions", "review_id", reviewIDs)
+}
+
+// setMentions replaces the users mentioned by the record with ID id in a mentions table, in a
+// single transaction, and returns the IDs of the users who weren't mentioned by it before.
+func (r *repository) setMentions(table, column string, id int64, users []*data.MentionedUser) ([]int64, error) {
+	userIDs := make([]int64, len(users))
+	for i, user := range users {
+		userIDs[i] = user.UserID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE %s = $1 AND NOT user_id = ANY($2)`,
+		table, column,
+	)
+	_, err = tx.ExecContext(ctx, query, id, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	query = fmt.Sprintf(`
+		INSERT INTO %s (%s, user_id, handle)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (%s, user_id) DO UPDATE
+		SET handle = EXCLUDED.handle
+		RETURNING xmax = 0`,
+		table, column, column,
+	)
+	added := []int64{}
+	for _, user := range users {
+		var inserted bool
+		err = tx.QueryRowContext(ctx, query, id, user.UserID, user.Handle).Scan(&inserted)
+		if err != nil {
+			return nil, err
+		}
+		if inserted {
+			added = append(added, user.UserID)
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
+// getMentions retrieves the users mentioned by a set of records in a mentions table, with their
+// current usernames.
+func (r *repository) getMentions(table, column string, ids []int64) (map[int64][]*data.MentionedUser, error) {
+	mentions := make(map[int64][]*data.MentionedUser)
+	if len(ids) == 0 {
+		return mentions, nil
+	}
+	query := fmt.Sprintf(`
+		SELECT %s.%s, users.id, users.username, %s.handle
+		FROM %s
+		INNER JOIN users ON users.id = %s.user_id
+		WHERE %s.%s = ANY($1)`,
+		table, column, table, table, table, table, column,
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int64
+		var user data.MentionedUser
+		err := rows.Scan(&id, &user.UserID, &user.Username, &user.Handle)
+		if err != nil {
+			return nil, err
+		}
+		mentions[id] = append(mentions[id], &user)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return mentions, nil
+}
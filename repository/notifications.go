@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/lib/pq"
+)
+
+type notifications interface {
+	CreateNotification(notification *data.Notification) error
+	GetNotification(notificationID, userID int64) (*data.Notification, error)
+	GetAllNotifications(userID int64, unread bool, filters data.Filters) ([]*data.Notification, data.Metadata, error)
+	GetUnreadNotificationCount(userID int64) (int, error)
+	MarkNotificationRead(notificationID, userID int64, read bool) error
+	MarkAllNotificationsRead(userID int64) (int64, error)
+	GetNotificationPreferences(userID int64) ([]*data.NotificationPreference, error)
+	UpdateNotificationPreferences(userID int64, preferences []*data.NotificationPreference) error
+	GetNotificationRecipients(userIDs []int64, defaults *data.NotificationPreference) ([]*data.NotificationRecipient, error)
+}
+
+// notificationColumns are the columns of a notification, with the current name of its actor.
+const notificationColumns = `
+	notifications.id, notifications.user_id, notifications.event, COALESCE(notifications.actor_id, 0), COALESCE(users.name, ''),
+	notifications.message, COALESCE(notifications.book_id, 0), COALESCE(notifications.booklist_id, 0),
+	COALESCE(notifications.comment_id, 0), COALESCE(notifications.review_id, 0), COALESCE(notifications.request_id, 0),
+	notifications.read_at IS NOT NULL, notifications.created_at`
+
+// CreateNotification creates a new notification record.
+func (r *repository) CreateNotification(notification *data.Notification) error {
+	query := `
+		INSERT INTO notifications (user_id, event, actor_id, message, book_id, booklist_id, comment_id, review_id, request_id)
+		VALUES ($1, $2, NULLIF($3, 0), $4, NULLIF($5, 0), NULLIF($6, 0), NULLIF($7, 0), NULLIF($8, 0), NULLIF($9, 0))
+		RETURNING id, created_at`
+	args := []interface{}{
+		notification.UserID,
+		notification.Event,
+		notification.ActorID,
+		notification.Message,
+		notification.BookID,
+		notification.BooklistID,
+		notification.CommentID,
+		notification.ReviewID,
+		notification.RequestID,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return r.db.QueryRowContext(ctx, query, args...).Scan(&notification.ID, &notification.CreatedAt)
+}
+
+// GetNotification retrieves a notification record of a user.
+func (r *repository) GetNotification(notificationID, userID int64) (*data.Notification, error) {
+	if notificationID < 1 {
+		return nil, ErrRecordNotFound
+	}
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM notifications
+		LEFT JOIN users ON notifications.actor_id = users.id
+		WHERE notifications.id = $1 AND notifications.user_id = $2`,
+		notificationColumns)
+	var notification data.Notification
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, notificationID, userID).Scan(
+		&notification.ID,
+		&notification.UserID,
+		&notification.Event,
+		&notification.ActorID,
+		&notification.ActorName,
+		&notification.Message,
+		&notification.BookID,
+		&notification.BooklistID,
+		&notification.CommentID,
+		&notification.ReviewID,
+		&notification.RequestID,
+		&notification.Read,
+		&notification.CreatedAt,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return &notification, nil
+}
+
+// GetAllNotifications retrieves a paginated list of the notification records of a user, newest
+// first. If unread is true, only unread notifications are retrieved.
+func (r *repository) GetAllNotifications(userID int64, unread bool, filters data.Filters) ([]*data.Notification, data.Metadata, error) {
+	query := fmt.Sprintf(`
+		SELECT count(*) OVER(), %s
+		FROM notifications
+		LEFT JOIN users ON notifications.actor_id = users.id
+		WHERE notifications.user_id = $1 AND (notifications.read_at IS NULL OR NOT $2)
+		ORDER BY notifications.created_at DESC, notifications.id DESC
+		LIMIT $3 OFFSET $4`,
+		notificationColumns)
+	args := []interface{}{userID, unread, filters.Limit(), filters.Offset()}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	defer rows.Close()
+	totalRecords := 0
+	notifications := []*data.Notification{}
+	for rows.Next() {
+		var notification data.Notification
+		err := rows.Scan(
+			&totalRecords,
+			&notification.ID,
+			&notification.UserID,
+			&notification.Event,
+			&notification.ActorID,
+			&notification.ActorName,
+			&notification.Message,
+			&notification.BookID,
+			&notification.BooklistID,
+			&notification.CommentID,
+			&notification.ReviewID,
+			&notification.RequestID,
+			&notification.Read,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, data.Metadata{}, err
+		}
+		notifications = append(notifications, &notification)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, data.Metadata{}, err
+	}
+	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
+	return notifications, metadata, nil
+}
+
+// GetUnreadNotificationCount retrieves the number of unread notification records of a user.
+func (r *repository) GetUnreadNotificationCount(userID int64) (int, error) {
+	query := `
+		SELECT count(*)
+		FROM notifications
+		WHERE user_id = $1 AND read_at IS NULL`
+	var count int
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// MarkNotificationRead marks a notification record of a user as read or unread. A notification
+// that is already read keeps the time it was first read.
+func (r *repository) MarkNotificationRead(notificationID, userID int64, read bool) error {
+	if notificationID < 1 {
+		return ErrRecordNotFound
+	}
+	query := `
+		UPDATE notifications
+		SET read_at = CASE WHEN $3 THEN COALESCE(read_at, NOW()) END
+		WHERE id = $1 AND user_id = $2`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, notificationID, userID, read)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+// MarkAllNotificationsRead marks all unread notification records of a user as read, and returns
+// the number of notifications marked.
+func (r *repository) MarkAllNotificationsRead(userID int64) (int64, error) {
+	query := `
+		UPDATE notifications
+		SET read_at = NOW()
+		WHERE user_id = $1 AND read_at IS NULL`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// GetNotificationPreferences retrieves the notification preferences a user has set.
+func (r *repository) GetNotificationPreferences(userID int64) ([]*data.NotificationPreference, error) {
+	query := `
+		SELECT event, in_app, email
+		FROM notification_preferences
+		WHERE user_id = $1`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	preferences := []*data.NotificationPreference{}
+	for rows.Next() {
+		var preference data.NotificationPreference
+		err := rows.Scan(&preference.Event, &preference.InApp, &preference.Email)
+		if err != nil {
+			return nil, err
+		}
+		preferences = append(preferences, &preference)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// UpdateNotificationPreferences sets the notification preferences of a user for each event, in
+// a single transaction.
+func (r *repository) UpdateNotificationPreferences(userID int64, preferences []*data.NotificationPreference) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, event, in_app, email)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, event) DO UPDATE
+		SET in_app = EXCLUDED.in_app, email = EXCLUDED.email`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, preference := range preferences {
+		_, err = tx.ExecContext(ctx, query, userID, preference.Event, preference.InApp, preference.Email)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetNotificationRecipients retrieves the activated users among userIDs along with how each wants
+// to be notified of the event of defaults. Users who haven't set a preference for the event get
+// defaults.
+func (r *repository) GetNotificationRecipients(userIDs []int64, defaults *data.NotificationPreference) ([]*data.NotificationRecipient, error) {
+	query := `
+		SELECT users.id, users.name, users.email, COALESCE(notification_preferences.in_app, $3), COALESCE(notification_preferences.email, $4)
+		FROM users
+		LEFT JOIN notification_preferences ON notification_preferences.user_id = users.id AND notification_preferences.event = $2
+		WHERE users.id = ANY($1) AND users.activated
+		ORDER BY users.id`
+	args := []interface{}{pq.Array(userIDs), defaults.Event, defaults.InApp, defaults.Email}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	recipients := []*data.NotificationRecipient{}
+	for rows.Next() {
+		var recipient data.NotificationRecipient
+		err := rows.Scan(
+			&recipient.UserID,
+			&recipient.UserName,
+			&recipient.UserEmail,
+			&recipient.InApp,
+			&recipient.Email,
+		)
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, &recipient)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return recipients, nil
+}
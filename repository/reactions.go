@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/lib/pq"
+)
+
+type reactions interface {
+	AddCommentReaction(commentID, userID int64, reaction string) error
+	DeleteCommentReaction(commentID, userID int64, reaction string) error
+	GetCommentReactions(commentIDs []int64, userID int64) (map[int64][]*data.ReactionCount, error)
+	AddReviewReaction(reviewID, userID int64, reaction string) error
+	DeleteReviewReaction(reviewID, userID int64, reaction string) error
+	GetReviewReactions(reviewIDs []int64, userID int64) (map[int64][]*data.ReactionCount, error)
+}
+
+// AddCommentReaction adds a user's reaction to a comment.
+func (r *repository) AddCommentReaction(commentID, userID int64, reaction string) error {
+	return r.addReaction("comments_reactions", "comment_id", commentID, userID, reaction)
+}
+
+// DeleteCommentReaction removes a user's reaction from a comment.
+func (r *repository) DeleteCommentReaction(commentID, userID int64, reaction string) error {
+	return r.deleteReaction("comments_reactions", "comment_id", commentID, userID, reaction)
+}
+
+// GetCommentReactions retrieves the reaction counts of a set of comments, keyed by comment ID.
+func (r *repository) GetCommentReactions(commentIDs []int64, userID int64) (map[int64][]*data.ReactionCount, error) {
+	return r.getReactions("comments_reactions", "comment_id", commentIDs, userID)
+}
+
+// AddReviewReaction adds a user's reaction to a review.
+func (r *repository) AddReviewReaction(reviewID, userID int64, reaction string) error {
+	return r.addReaction("reviews_reactions", "review_id", reviewID, userID, reaction)
+}
+
+// DeleteReviewReaction removes a user's reaction from a review.
+func (r *repository) DeleteReviewReaction(reviewID, userID int64, reaction string) error {
+	return r.deleteReaction("reviews_reactions", "review_id", reviewID, userID, reaction)
+}
+
+// GetReviewReactions retrieves the reaction counts of a set of reviews, keyed by review ID.
+func (r *repository) GetReviewReactions(reviewIDs []int64, userID int64) (map[int64][]*data.ReactionCount, error) {
+	return r.getReactions("reviews_reactions", "review_id", reviewIDs, userID)
+}
+
+// addReaction adds a user's reaction to the record with ID id in a reactions table. A user can
+// only react once with each reaction.
+func (r *repository) addReaction(table, column string, id, userID int64, reaction string) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s, user_id, reaction)
+		VALUES ($1, $2, $3)`,
+		table, column,
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	_, err := r.db.ExecContext(ctx, query, id, userID, reaction)
+	if err != nil {
+		switch {
+		case err.Error() == fmt.Sprintf(`pq: duplicate key value violates unique constraint "%s_pkey"`, table):
+			return ErrDuplicateRecord
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteReaction removes a user's reaction from the record with ID id in a reactions table.
+func (r *repository) deleteReaction(table, column string, id, userID int64, reaction string) error {
+	query := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE %s = $1 AND user_id = $2 AND reaction = $3`,
+		table, column,
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, id, userID, reaction)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+// getReactions counts the reactions to a set of records in a reactions table, and whether
+// userID made each of them. Reactions are listed in the order of data.Reactions, and only
+// reactions that were made are included.
+func (r *repository) getReactions(table, column string, ids []int64, userID int64) (map[int64][]*data.ReactionCount, error) {
+	reactions := make(map[int64][]*data.ReactionCount)
+	if len(ids) == 0 {
+		return reactions, nil
+	}
+	query := fmt.Sprintf(`
+		SELECT %s, reaction, count(*), bool_or(user_id = $2)
+		FROM %s
+		WHERE %s = ANY($1)
+		GROUP BY %s, reaction
+		ORDER BY %s, array_position($3, reaction)`,
+		column, table, column, column, column,
+	)
+	args := []interface{}{pq.Array(ids), userID, pq.Array(data.Reactions)}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int64
+		var reaction data.ReactionCount
+		err := rows.Scan(&id, &reaction.Reaction, &reaction.Count, &reaction.Reacted)
+		if err != nil {
+			return nil, err
+		}
+		reactions[id] = append(reactions[id], &reaction)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return reactions, nil
+}
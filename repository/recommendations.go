@@ -0,0 +1,603 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/iso639"
+	"github.com/lib/pq"
+)
+
+type recommendations interface {
+	RefreshBookSimilarities(minCooccurrence, maxPerBook int) error
+	GetSimilarBooks(bookID int64, limit int) ([]*data.RelatedBook, error)
+	GetBooksBySameAuthors(bookID int64, excludeIDs []int64, limit int) ([]*data.RelatedBook, error)
+	GetBooksInSameCategory(bookID int64, excludeIDs []int64, limit int) ([]*data.RelatedBook, error)
+	GetRecommendationsFromSimilarBooks(userID int64, limit int) ([]*data.Recommendation, error)
+	GetPopularBooksInFollowedCategories(userID int64, excludeIDs []int64, limit int) ([]*data.Recommendation, error)
+	GetPopularBooksForUser(userID int64, excludeIDs []int64, limit int) ([]*data.Recommendation, error)
+	RefreshTrendingBooks(window data.TrendingWindow) error
+	GetAllTrendingBooks(window string, categoryID int64, filters data.Filters) ([]*data.TrendingBook, data.Metadata, error)
+	SetTermsForBook(bookID int64, terms map[string]float64) error
+	RefreshContentSimilaritiesForBook(bookID int64, maxPerBook int) error
+	GetContentSimilarBooks(bookID int64, limit int) ([]*data.RelatedBook, error)
+	GetBooksWithoutTerms(afterID int64, limit int) ([]*data.Book, error)
+}
+
+// seenBooks selects the IDs of the books user $1 has downloaded, favourited, reviewed or uploaded.
+const seenBooks = `
+	SELECT book_id FROM users_downloads WHERE user_id = $1
+	UNION
+	SELECT book_id FROM users_favourite_books WHERE user_id = $1
+	UNION
+	SELECT book_id FROM reviews WHERE user_id = $1
+	UNION
+	SELECT id FROM books WHERE user_id = $1`
+
+// RefreshBookSimilarities recomputes the book_similarities table from the books that users
+// downloaded or favourited. Two books are similar when the same users interacted with both,
+// scored with the cosine similarity of their sets of users. Pairs shared by fewer than
+// minCooccurrence users are discarded and only the maxPerBook best pairs of each book are kept.
+func (r *repository) RefreshBookSimilarities(minCooccurrence, maxPerBook int) error {
+	query := `
+		WITH interactions AS (
+			SELECT user_id, book_id FROM users_downloads
+			UNION
+			SELECT user_id, book_id FROM users_favourite_books
+		), book_counts AS (
+			SELECT book_id, count(*) AS readers
+			FROM interactions
+			GROUP BY book_id
+		), pairs AS (
+			SELECT a.book_id, b.book_id AS similar_book_id, count(*) AS co_occurrences
+			FROM interactions a
+			INNER JOIN interactions b ON a.user_id = b.user_id AND a.book_id <> b.book_id
+			GROUP BY a.book_id, b.book_id
+			HAVING count(*) >= $1
+		), ranked AS (
+			SELECT pairs.book_id, pairs.similar_book_id, pairs.co_occurrences,
+				pairs.co_occurrences / sqrt(ca.readers * cb.readers) AS score,
+				row_number() OVER (PARTITION BY pairs.book_id ORDER BY pairs.co_occurrences / sqrt(ca.readers * cb.readers) DESC, pairs.similar_book_id) AS rank
+			FROM pairs
+			INNER JOIN book_counts ca ON ca.book_id = pairs.book_id
+			INNER JOIN book_counts cb ON cb.book_id = pairs.similar_book_id
+		)
+		INSERT INTO book_similarities (book_id, similar_book_id, score, co_occurrences)
+		SELECT book_id, similar_book_id, score, co_occurrences
+		FROM ranked
+		WHERE rank <= $2`
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx, `DELETE FROM book_similarities`)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, query, minCooccurrence, maxPerBook)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetSimilarBooks retrieves the books most often downloaded or favourited by the readers of a book.
+func (r *repository) GetSimilarBooks(bookID int64, limit int) ([]*data.RelatedBook, error) {
+	query := `
+		SELECT books.id, books.user_id, books.created_at, books.title, books.description, books.author, books.category, books.publisher, books.language, books.series, books.volume, books.edition, books.year, books.page_count, books.isbn_10, books.isbn_13, books.cover_path, books.s3_file_key, books.fname, books.extension, books.size, books.popularity, books.version, book_similarities.score
+		FROM book_similarities
+		INNER JOIN books ON books.id = book_similarities.similar_book_id
+		WHERE book_similarities.book_id = $1
+		ORDER BY book_similarities.score DESC, books.id ASC
+		LIMIT $2`
+	return r.queryRelatedBooks(data.ReasonAlsoDownloaded, query, bookID, limit)
+}
+
+// GetBooksBySameAuthors retrieves books that share at least one author with a book,
+// most popular first. Books in excludeIDs are left out.
+func (r *repository) GetBooksBySameAuthors(bookID int64, excludeIDs []int64, limit int) ([]*data.RelatedBook, error) {
+	query := `
+		SELECT books.id, books.user_id, books.created_at, books.title, books.description, books.author, books.category, books.publisher, books.language, books.series, books.volume, books.edition, books.year, books.page_count, books.isbn_10, books.isbn_13, books.cover_path, books.s3_file_key, books.fname, books.extension, books.size, books.popularity, books.version, 0
+		FROM books
+		WHERE books.id <> $1
+		AND books.id <> ALL($3)
+		AND books.id IN (
+			SELECT other.book_id
+			FROM books_authors
+			INNER JOIN books_authors other ON other.author_id = books_authors.author_id
+			WHERE books_authors.book_id = $1
+		)
+		ORDER BY books.popularity DESC, books.id ASC
+		LIMIT $2`
+	return r.queryRelatedBooks(data.ReasonSameAuthor, query, bookID, limit, pq.Array(excludeIDs))
+}
+
+// GetBooksInSameCategory retrieves books in the same category as a book, most popular first.
+// Books in excludeIDs are left out.
+func (r *repository) GetBooksInSameCategory(bookID int64, excludeIDs []int64, limit int) ([]*data.RelatedBook, error) {
+	query := `
+		SELECT books.id, books.user_id, books.created_at, books.title, books.description, books.author, books.category, books.publisher, books.language, books.series, books.volume, books.edition, books.year, books.page_count, books.isbn_10, books.isbn_13, books.cover_path, books.s3_file_key, books.fname, books.extension, books.size, books.popularity, books.version, 0
+		FROM books
+		WHERE books.id <> $1
+		AND books.id <> ALL($3)
+		AND books.id IN (
+			SELECT other.book_id
+			FROM books_categories
+			INNER JOIN books_categories other ON other.category_id = books_categories.category_id
+			WHERE books_categories.book_id = $1
+		)
+		ORDER BY books.popularity DESC, books.id ASC
+		LIMIT $2`
+	return r.queryRelatedBooks(data.ReasonSameCategory, query, bookID, limit, pq.Array(excludeIDs))
+}
+
+// GetRecommendationsFromSimilarBooks retrieves unseen books related to the books a user liked.
+// Favourites weigh 1, downloads 0.5 and reviews rated 4 or 5 weigh 0.5 or 1. A candidate's score
+// is the sum of its similarity to each liked book times that book's weight, and its source is
+// the liked book that contributed the most.
+func (r *repository) GetRecommendationsFromSimilarBooks(userID int64, limit int) ([]*data.Recommendation, error) {
+	query := fmt.Sprintf(`
+		WITH liked AS (
+			SELECT book_id, max(weight) AS weight
+			FROM (
+				SELECT book_id, 1.0 AS weight FROM users_favourite_books WHERE user_id = $1
+				UNION ALL
+				SELECT book_id, 0.5 FROM users_downloads WHERE user_id = $1
+				UNION ALL
+				SELECT book_id, (rating - 3) / 2.0 FROM reviews WHERE user_id = $1 AND rating >= 4
+			) AS signals
+			GROUP BY book_id
+		), contributions AS (
+			SELECT book_similarities.similar_book_id AS book_id, book_similarities.book_id AS source_id, liked.weight * book_similarities.score AS score
+			FROM liked
+			INNER JOIN book_similarities ON book_similarities.book_id = liked.book_id
+			WHERE book_similarities.similar_book_id NOT IN (%s)
+		), ranked AS (
+			SELECT book_id, source_id,
+				sum(score) OVER (PARTITION BY book_id) AS score,
+				row_number() OVER (PARTITION BY book_id ORDER BY score DESC, source_id ASC) AS rank
+			FROM contributions
+		)
+		SELECT books.id, books.user_id, books.created_at, books.title, books.description, books.author, books.category, books.publisher, books.language, books.series, books.volume, books.edition, books.year, books.page_count, books.isbn_10, books.isbn_13, books.cover_path, books.s3_file_key, books.fname, books.extension, books.size, books.popularity, books.version, ranked.score, sources.title
+		FROM ranked
+		INNER JOIN books ON books.id = ranked.book_id
+		INNER JOIN books sources ON sources.id = ranked.source_id
+		WHERE ranked.rank = 1
+		ORDER BY ranked.score DESC, books.id ASC
+		LIMIT $2`, seenBooks)
+	return r.queryRecommendations(data.ReasonBecauseYouLiked, query, userID, limit)
+}
+
+// GetPopularBooksInFollowedCategories retrieves the most popular unseen books in the categories
+// a user follows. A book in several followed categories is sourced from the first of them.
+// Books in excludeIDs are left out.
+func (r *repository) GetPopularBooksInFollowedCategories(userID int64, excludeIDs []int64, limit int) ([]*data.Recommendation, error) {
+	query := fmt.Sprintf(`
+		SELECT * FROM (
+			SELECT DISTINCT ON (books.id) books.id, books.user_id, books.created_at, books.title, books.description, books.author, books.category, books.publisher, books.language, books.series, books.volume, books.edition, books.year, books.page_count, books.isbn_10, books.isbn_13, books.cover_path, books.s3_file_key, books.fname, books.extension, books.size, books.popularity, books.version, books.popularity AS score, categories.name
+			FROM users_followed_categories
+			INNER JOIN books_categories ON books_categories.category_id = users_followed_categories.category_id
+			INNER JOIN categories ON categories.id = books_categories.category_id
+			INNER JOIN books ON books.id = books_categories.book_id
+			WHERE users_followed_categories.user_id = $1
+			AND books.id <> ALL($3)
+			AND books.id NOT IN (%s)
+			ORDER BY books.id ASC, categories.id ASC
+		) AS candidates
+		ORDER BY score DESC, id ASC
+		LIMIT $2`, seenBooks)
+	return r.queryRecommendations(data.ReasonPopularInCategory, query, userID, limit, pq.Array(excludeIDs))
+}
+
+// GetPopularBooksForUser retrieves the most popular books a user hasn't seen.
+// Books in excludeIDs are left out.
+func (r *repository) GetPopularBooksForUser(userID int64, excludeIDs []int64, limit int) ([]*data.Recommendation, error) {
+	query := fmt.Sprintf(`
+		SELECT books.id, books.user_id, books.created_at, books.title, books.description, books.author, books.category, books.publisher, books.language, books.series, books.volume, books.edition, books.year, books.page_count, books.isbn_10, books.isbn_13, books.cover_path, books.s3_file_key, books.fname, books.extension, books.size, books.popularity, books.version, books.popularity, ''
+		FROM books
+		WHERE books.id <> ALL($3)
+		AND books.id NOT IN (%s)
+		ORDER BY books.popularity DESC, books.id ASC
+		LIMIT $2`, seenBooks)
+	return r.queryRecommendations(data.ReasonPopular, query, userID, limit, pq.Array(excludeIDs))
+}
+
+// RefreshTrendingBooks recomputes the trending scores of a window from downloads, favourites and
+// reviews made within it. Favourites weigh 2, reviews 1.5 and downloads 1, and each loses half of
+// its weight every half-life of the window.
+func (r *repository) RefreshTrendingBooks(window data.TrendingWindow) error {
+	query := `
+		WITH events AS (
+			SELECT book_id, datetime, 1.0 AS weight, 'download' AS kind FROM users_downloads
+			UNION ALL
+			SELECT book_id, datetime, 2.0, 'favourite' FROM users_favourite_books
+			UNION ALL
+			SELECT book_id, created_at, 1.5, 'review' FROM reviews
+		)
+		INSERT INTO book_trending_scores (time_window, book_id, score, downloads, favourites, reviews)
+		SELECT $1, book_id,
+			sum(weight * power(0.5, extract(epoch FROM NOW() - datetime) / $3)),
+			count(*) FILTER (WHERE kind = 'download'),
+			count(*) FILTER (WHERE kind = 'favourite'),
+			count(*) FILTER (WHERE kind = 'review')
+		FROM events
+		WHERE $2 = '' OR datetime >= NOW() - NULLIF($2, '')::interval
+		GROUP BY book_id`
+	args := []interface{}{window.Name, window.Period, window.HalfLife.Seconds()}
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx, `DELETE FROM book_trending_scores WHERE time_window = $1`, window.Name)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetAllTrendingBooks retrieves a paginated list of the precomputed trending books of a window.
+// When categoryID isn't 0, only books in that category are listed.
+func (r *repository) GetAllTrendingBooks(window string, categoryID int64, filters data.Filters) ([]*data.TrendingBook, data.Metadata, error) {
+	query := fmt.Sprintf(`
+		SELECT count(*) OVER(), books.id, books.user_id, books.created_at, books.title, books.description, books.author, books.category, books.publisher, books.language, books.series, books.volume, books.edition, books.year, books.page_count, books.isbn_10, books.isbn_13, books.cover_path, books.s3_file_key, books.fname, books.extension, books.size, books.popularity, books.version, book_trending_scores.score, book_trending_scores.downloads, book_trending_scores.favourites, book_trending_scores.reviews
+		FROM book_trending_scores
+		INNER JOIN books ON books.id = book_trending_scores.book_id
+		WHERE book_trending_scores.time_window = $1
+		AND ($2 = 0 OR books.id IN (SELECT book_id FROM books_categories WHERE category_id = $2))
+		ORDER BY book_trending_scores.%s %s, books.id ASC
+		LIMIT $3 OFFSET $4`,
+		filters.SortColumn(), filters.SortDirection(),
+	)
+	args := []interface{}{window, categoryID, filters.Limit(), filters.Offset()}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	defer rows.Close()
+	totalRecords := 0
+	books := []*data.TrendingBook{}
+	for rows.Next() {
+		book := data.TrendingBook{Book: &data.Book{}}
+		err := rows.Scan(
+			&totalRecords,
+			&book.ID,
+			&book.UserID,
+			&book.CreatedAt,
+			&book.Title,
+			&book.Description,
+			pq.Array(&book.Author),
+			&book.Category,
+			&book.Publisher,
+			&book.Language,
+			&book.Series,
+			&book.Volume,
+			&book.Edition,
+			&book.Year,
+			&book.PageCount,
+			&book.Isbn10,
+			&book.Isbn13,
+			&book.CoverPath,
+			&book.S3FileKey,
+			&book.Filename,
+			&book.Extension,
+			&book.Size,
+			&book.Popularity,
+			&book.Version,
+			&book.Score,
+			&book.Downloads,
+			&book.Favourites,
+			&book.Reviews,
+		)
+		if err != nil {
+			return nil, data.Metadata{}, err
+		}
+		book.LanguageName = iso639.Name(book.Language)
+		books = append(books, &book)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, data.Metadata{}, err
+	}
+	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
+	return books, metadata, nil
+}
+
+// SetTermsForBook replaces the weighted title and description terms of a book record.
+func (r *repository) SetTermsForBook(bookID int64, terms map[string]float64) error {
+	words := make([]string, 0, len(terms))
+	weights := make([]float64, 0, len(terms))
+	for term, weight := range terms {
+		words = append(words, term)
+		weights = append(weights, weight)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx, `DELETE FROM book_terms WHERE book_id = $1`, bookID)
+	if err != nil {
+		return err
+	}
+	query := `
+		INSERT INTO book_terms (book_id, term, weight)
+		SELECT $1, t.term, t.weight
+		FROM unnest($2::text[], $3::double precision[]) AS t(term, weight)`
+	_, err = tx.ExecContext(ctx, query, bookID, pq.Array(words), pq.Array(weights))
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// RefreshContentSimilaritiesForBook recomputes the books most like a book from their metadata.
+// Shared authors weigh 0.35, the same series 0.2, a shared category 0.15, the same publisher and
+// language 0.05 each and the TF-IDF similarity of title and description terms up to 0.2. Only the
+// maxPerBook best matches are kept, and each match is also stored the other way round.
+func (r *repository) RefreshContentSimilaritiesForBook(bookID int64, maxPerBook int) error {
+	query := `
+		WITH target AS (
+			SELECT id, lower(series) AS series, lower(publisher) AS publisher, lower(language) AS language
+			FROM books
+			WHERE id = $1
+		), total AS (
+			SELECT count(DISTINCT book_id) AS n FROM book_terms
+		), target_terms AS (
+			SELECT term, weight FROM book_terms WHERE book_id = $1
+		), idf AS (
+			SELECT book_terms.term, ln(1 + total.n::double precision / count(*)) / ln(1 + total.n) AS idf
+			FROM book_terms
+			CROSS JOIN total
+			WHERE book_terms.term IN (SELECT term FROM target_terms)
+			GROUP BY book_terms.term, total.n
+		), text_scores AS (
+			SELECT book_terms.book_id, sum(book_terms.weight * target_terms.weight * idf.idf) AS score
+			FROM book_terms
+			INNER JOIN target_terms ON target_terms.term = book_terms.term
+			INNER JOIN idf ON idf.term = book_terms.term
+			WHERE book_terms.book_id <> $1
+			GROUP BY book_terms.book_id
+		), book_authors AS (
+			SELECT other.book_id
+			FROM books_authors
+			INNER JOIN books_authors other ON other.author_id = books_authors.author_id
+			WHERE books_authors.book_id = $1
+		), book_categories AS (
+			SELECT other.book_id
+			FROM books_categories
+			INNER JOIN books_categories other ON other.category_id = books_categories.category_id
+			WHERE books_categories.book_id = $1
+		), candidates AS (
+			SELECT book_id FROM text_scores
+			UNION
+			SELECT book_id FROM book_authors
+			UNION
+			SELECT book_id FROM book_categories
+			UNION
+			SELECT books.id FROM books INNER JOIN target ON target.series <> '' AND lower(books.series) = target.series
+		), scored AS (
+			SELECT books.id,
+				0.35 * (books.id IN (SELECT book_id FROM book_authors))::int
+				+ 0.2 * (target.series <> '' AND lower(books.series) = target.series)::int
+				+ 0.15 * (books.id IN (SELECT book_id FROM book_categories))::int
+				+ 0.05 * (target.publisher <> '' AND lower(books.publisher) = target.publisher)::int
+				+ 0.05 * (target.language <> '' AND lower(books.language) = target.language)::int
+				+ 0.2 * coalesce(text_scores.score, 0) AS score
+			FROM candidates
+			INNER JOIN books ON books.id = candidates.book_id
+			CROSS JOIN target
+			LEFT JOIN text_scores ON text_scores.book_id = books.id
+			WHERE books.id <> $1
+		)
+		INSERT INTO book_content_similarities (book_id, similar_book_id, score)
+		SELECT $1, id, score
+		FROM scored
+		WHERE score > 0
+		ORDER BY score DESC, id ASC
+		LIMIT $2`
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx, `DELETE FROM book_content_similarities WHERE book_id = $1 OR similar_book_id = $1`, bookID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, query, bookID, maxPerBook)
+	if err != nil {
+		return err
+	}
+	mirror := `
+		INSERT INTO book_content_similarities (book_id, similar_book_id, score)
+		SELECT similar_book_id, book_id, score
+		FROM book_content_similarities
+		WHERE book_id = $1
+		ON CONFLICT (book_id, similar_book_id) DO UPDATE SET score = EXCLUDED.score, updated_at = NOW()`
+	_, err = tx.ExecContext(ctx, mirror, bookID)
+	if err != nil {
+		return err
+	}
+	// Mirrored rows can push the neighbours past maxPerBook, so their lists are re-ranked and trimmed
+	trim := `
+		DELETE FROM book_content_similarities
+		WHERE (book_id, similar_book_id) IN (
+			SELECT book_id, similar_book_id
+			FROM (
+				SELECT book_id, similar_book_id, row_number() OVER (PARTITION BY book_id ORDER BY score DESC, similar_book_id ASC) AS rank
+				FROM book_content_similarities
+				WHERE book_id IN (SELECT similar_book_id FROM book_content_similarities WHERE book_id = $1)
+			) ranked
+			WHERE rank > $2
+		)`
+	_, err = tx.ExecContext(ctx, trim, bookID, maxPerBook)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetContentSimilarBooks retrieves the books whose metadata is most like a book's.
+func (r *repository) GetContentSimilarBooks(bookID int64, limit int) ([]*data.RelatedBook, error) {
+	query := `
+		SELECT books.id, books.user_id, books.created_at, books.title, books.description, books.author, books.category, books.publisher, books.language, books.series, books.volume, books.edition, books.year, books.page_count, books.isbn_10, books.isbn_13, books.cover_path, books.s3_file_key, books.fname, books.extension, books.size, books.popularity, books.version, book_content_similarities.score
+		FROM book_content_similarities
+		INNER JOIN books ON books.id = book_content_similarities.similar_book_id
+		WHERE book_content_similarities.book_id = $1
+		ORDER BY book_content_similarities.score DESC, books.id ASC
+		LIMIT $2`
+	return r.queryRelatedBooks(data.ReasonSimilarContent, query, bookID, limit)
+}
+
+// GetBooksWithoutTerms retrieves up to limit book records after afterID whose terms haven't been
+// extracted yet. Books without any terms keep matching, so callers page through them by ID.
+func (r *repository) GetBooksWithoutTerms(afterID int64, limit int) ([]*data.Book, error) {
+	query := `
+		SELECT id, title, description
+		FROM books
+		WHERE NOT EXISTS (SELECT 1 FROM book_terms WHERE book_terms.book_id = books.id) AND id > $1
+		ORDER BY id ASC
+		LIMIT $2`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	books := []*data.Book{}
+	for rows.Next() {
+		var book data.Book
+		err := rows.Scan(&book.ID, &book.Title, &book.Description)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, &book)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return books, nil
+}
+
+// queryRecommendations runs a query that selects book columns followed by a score and a
+// source and returns the rows as recommendations with the given reason.
+func (r *repository) queryRecommendations(reason, query string, userID int64, limit int, extraArgs ...interface{}) ([]*data.Recommendation, error) {
+	args := append([]interface{}{userID, limit}, extraArgs...)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	recommendations := []*data.Recommendation{}
+	for rows.Next() {
+		recommendation := data.Recommendation{Book: &data.Book{}, Reason: reason}
+		err := rows.Scan(
+			&recommendation.ID,
+			&recommendation.UserID,
+			&recommendation.CreatedAt,
+			&recommendation.Title,
+			&recommendation.Description,
+			pq.Array(&recommendation.Author),
+			&recommendation.Category,
+			&recommendation.Publisher,
+			&recommendation.Language,
+			&recommendation.Series,
+			&recommendation.Volume,
+			&recommendation.Edition,
+			&recommendation.Year,
+			&recommendation.PageCount,
+			&recommendation.Isbn10,
+			&recommendation.Isbn13,
+			&recommendation.CoverPath,
+			&recommendation.S3FileKey,
+			&recommendation.Filename,
+			&recommendation.Extension,
+			&recommendation.Size,
+			&recommendation.Popularity,
+			&recommendation.Version,
+			&recommendation.Score,
+			&recommendation.Source,
+		)
+		if err != nil {
+			return nil, err
+		}
+		recommendation.LanguageName = iso639.Name(recommendation.Language)
+		recommendations = append(recommendations, &recommendation)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return recommendations, nil
+}
+
+// queryRelatedBooks runs a query that selects book columns followed by a score
+// and returns the rows as related books with the given reason.
+func (r *repository) queryRelatedBooks(reason, query string, bookID int64, limit int, extraArgs ...interface{}) ([]*data.RelatedBook, error) {
+	args := append([]interface{}{bookID, limit}, extraArgs...)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	books := []*data.RelatedBook{}
+	for rows.Next() {
+		book := data.RelatedBook{Book: &data.Book{}, Reason: reason}
+		err := rows.Scan(
+			&book.ID,
+			&book.UserID,
+			&book.CreatedAt,
+			&book.Title,
+			&book.Description,
+			pq.Array(&book.Author),
+			&book.Category,
+			&book.Publisher,
+			&book.Language,
+			&book.Series,
+			&book.Volume,
+			&book.Edition,
+			&book.Year,
+			&book.PageCount,
+			&book.Isbn10,
+			&book.Isbn13,
+			&book.CoverPath,
+			&book.S3FileKey,
+			&book.Filename,
+			&book.Extension,
+			&book.Size,
+			&book.Popularity,
+			&book.Version,
+			&book.Score,
+		)
+		if err != nil {
+			return nil, err
+		}
+		book.LanguageName = iso639.Name(book.Language)
+		books = append(books, &book)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return books, nil
+}
@@ -6,11 +6,22 @@ import (
 
 type Repository interface {
 	books
+	authors
+	recommendations
+	savedSearches
+	tags
+	duplicates
+	enrichments
+	covers
+	jobs
 	reviews
 	categories
 	requests
 	booklists
 	comments
+	reactions
+	notifications
+	mentions
 	users
 	tokens
 }
@@ -8,35 +8,63 @@ import (
 	"time"
 
 	"github.com/emzola/bibliotheca/data"
+	"github.com/lib/pq"
 )
 
 type requests interface {
 	CreateRequest(request *data.Request) error
 	UpdateRequest(request *data.Request) error
 	GetRequest(requestID int64) (*data.Request, error)
-	GetAllRequests(search, status string, filters data.Filters) ([]*data.Request, data.Metadata, error)
+	GetActiveRequestByIsbn(isbn string) (*data.Request, error)
+	GetAllRequests(search string, statuses []string, filters data.Filters) ([]*data.Request, data.Metadata, error)
 	AddRequestForUser(userID, requestID int64, expiry time.Time) error
 	DeleteRequestForUser(userID int64, requestID int64) error
+	FulfilRequestsForBook(bookID int64) ([]*data.RequestFulfilment, error)
+	GetRequestFulfilments(requestID int64) ([]*data.RequestFulfilment, error)
+	TransitionRequest(request *data.Request, event *data.RequestEvent) error
+	GetRequestEvents(requestID int64) ([]*data.RequestEvent, error)
+	ExpireRequests() (int64, error)
+	GetTopRequests(categoryID int64, halfLife time.Duration, filters data.Filters) ([]*data.TopRequest, data.Metadata, error)
+	GetRequestCategoryDemand(halfLife time.Duration) ([]*data.RequestCategoryDemand, error)
 }
 
-// CreateRequest creates a new book request record.
+// CreateRequest creates a new book request record, and starts its history with its creation.
+// There can only be one active request for an ISBN.
 func (r *repository) CreateRequest(request *data.Request) error {
 	query := `
-		INSERT INTO requests (user_id, title, publisher, isbn, year, expiry, status)	
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-	  	RETURNING id, created_at, version`
+		WITH request AS (
+			INSERT INTO requests (user_id, title, author, publisher, isbn, year, expiry, status, source, category_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, 0))
+			RETURNING id, user_id, status, created_at, version
+		), event AS (
+			INSERT INTO request_events (request_id, user_id, to_status, created_at)
+			SELECT id, user_id, status, created_at FROM request
+		)
+		SELECT id, created_at, version FROM request`
 	args := []interface{}{
 		request.UserID,
 		request.Title,
+		pq.Array(request.Author),
 		request.Publisher,
 		request.Isbn,
 		request.Year,
 		request.Expiry,
 		request.Status,
+		request.Source,
+		request.CategoryID,
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	return r.db.QueryRowContext(ctx, query, args...).Scan(&request.ID, &request.CreatedAt, &request.Version)
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&request.ID, &request.CreatedAt, &request.Version)
+	if err != nil {
+		switch {
+		case err.Error() == `pq: duplicate key value violates unique constraint "requests_open_isbn_idx"`:
+			return ErrDuplicateRecord
+		default:
+			return err
+		}
+	}
+	return nil
 }
 
 // AddRequestForUser adds a book request subscribe record for user.
@@ -88,11 +116,12 @@ func (r *repository) DeleteRequestForUser(userID int64, requestID int64) error {
 func (r *repository) UpdateRequest(request *data.Request) error {
 	query := `
 		UPDATE requests
-		SET title = $1, publisher = $2, isbn = $3, year = $4, expiry = $5, status = $6, waitlist = $7, version = version + 1
-		WHERE id = $8 AND version = $9
+		SET title = $1, author = $2, publisher = $3, isbn = $4, year = $5, expiry = $6, status = $7, waitlist = $8, version = version + 1
+		WHERE id = $9 AND version = $10
 		RETURNING version`
 	args := []interface{}{
 		request.Title,
+		pq.Array(request.Author),
 		request.Publisher,
 		request.Isbn,
 		request.Year,
@@ -122,7 +151,7 @@ func (r *repository) GetRequest(requestID int64) (*data.Request, error) {
 		return nil, ErrRecordNotFound
 	}
 	query := `
-		SELECT id, user_id, title, publisher, isbn, year, expiry, status, waitlist, created_at, version
+		SELECT id, user_id, title, author, publisher, isbn, year, cover_path, expiry, status, waitlist, COALESCE(book_id, 0), COALESCE(claimed_by, 0), COALESCE(category_id, 0), source, created_at, version
 		FROM requests 
 		WHERE id = $1`
 	var request data.Request
@@ -132,12 +161,18 @@ func (r *repository) GetRequest(requestID int64) (*data.Request, error) {
 		&request.ID,
 		&request.UserID,
 		&request.Title,
+		pq.Array(&request.Author),
 		&request.Publisher,
 		&request.Isbn,
 		&request.Year,
+		&request.CoverPath,
 		&request.Expiry,
 		&request.Status,
 		&request.Waitlist,
+		&request.BookID,
+		&request.ClaimedBy,
+		&request.CategoryID,
+		&request.Source,
 		&request.CreatedAt,
 		&request.Version,
 	)
@@ -152,26 +187,53 @@ func (r *repository) GetRequest(requestID int64) (*data.Request, error) {
 	return &request, nil
 }
 
+// GetActiveRequestByIsbn retrieves the active request record for an ISBN-13.
+func (r *repository) GetActiveRequestByIsbn(isbn string) (*data.Request, error) {
+	if isbn == "" {
+		return nil, ErrRecordNotFound
+	}
+	query := `
+		SELECT id
+		FROM requests
+		WHERE isbn = $1 AND status = ANY($2)
+		ORDER BY id ASC
+		LIMIT 1`
+	var requestID int64
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, isbn, pq.Array(data.RequestActiveStatuses)).Scan(&requestID)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return r.GetRequest(requestID)
+}
+
 // GetAllRequests retrieves a paginated list of all request records.
-// Records can be filtered and sorted.
-func (r *repository) GetAllRequests(search, status string, filters data.Filters) ([]*data.Request, data.Metadata, error) {
+// Records can be filtered and sorted. An empty list of statuses matches requests in any status.
+func (r *repository) GetAllRequests(search string, statuses []string, filters data.Filters) ([]*data.Request, data.Metadata, error) {
 	query := fmt.Sprintf(`
-		SELECT count(*) OVER(), id, user_id, title, publisher, isbn, year, expiry, status, waitlist, created_at, version
+		SELECT count(*) OVER(), id, user_id, title, author, publisher, isbn, year, cover_path, expiry, status, waitlist, COALESCE(book_id, 0), COALESCE(claimed_by, 0), COALESCE(category_id, 0), source, created_at, version
 		FROM requests
 		WHERE (
 			to_tsvector('simple', title) || 
+			to_tsvector('simple', array_to_string(author, ' ')) || 
 			to_tsvector('simple', isbn) || 
 			to_tsvector('simple', publisher) 
 			@@ plainto_tsquery('simple', $1) OR $1 = ''
 		) 
-		AND (LOWER(status) = LOWER($2) OR $2 = '') 
+		AND (status = ANY($2) OR cardinality($2::text[]) = 0)
 		ORDER BY %s %s, id ASC
 		LIMIT $3 OFFSET $4`,
 		filters.SortColumn(), filters.SortDirection(),
 	)
 	args := []interface{}{
 		search,
-		status,
+		pq.Array(statuses),
 		filters.Limit(),
 		filters.Offset(),
 	}
@@ -191,12 +253,18 @@ func (r *repository) GetAllRequests(search, status string, filters data.Filters)
 			&request.ID,
 			&request.UserID,
 			&request.Title,
+			pq.Array(&request.Author),
 			&request.Publisher,
 			&request.Isbn,
 			&request.Year,
+			&request.CoverPath,
 			&request.Expiry,
 			&request.Status,
 			&request.Waitlist,
+			&request.BookID,
+			&request.ClaimedBy,
+			&request.CategoryID,
+			&request.Source,
 			&request.CreatedAt,
 			&request.Version,
 		)
@@ -211,3 +279,329 @@ func (r *repository) GetAllRequests(search, status string, filters data.Filters)
 	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
 	return requests, metadata, nil
 }
+
+// FulfilRequestsForBook marks the active requests that a book matches as fulfilled by the book,
+// and returns the subscribers of each fulfilled request. Requests match books with the same
+// ISBN, or with the same title and an author in common. Requests without authors match on
+// title and year instead.
+func (r *repository) FulfilRequestsForBook(bookID int64) ([]*data.RequestFulfilment, error) {
+	query := `
+		WITH fulfilled AS (
+			UPDATE requests
+			SET status = $2, book_id = books.id, version = requests.version + 1
+			FROM books, requests AS previous
+			WHERE books.id = $1 AND previous.id = requests.id AND requests.status = ANY($3) AND (
+				(requests.isbn <> '' AND (requests.isbn = books.isbn_13 OR requests.isbn = books.isbn_10))
+				OR (
+					lower(regexp_replace(requests.title, '[^[:alnum:]]+', '', 'g')) <> ''
+					AND lower(regexp_replace(requests.title, '[^[:alnum:]]+', '', 'g')) = lower(regexp_replace(books.title, '[^[:alnum:]]+', '', 'g'))
+					AND (
+						EXISTS (
+							SELECT 1
+							FROM unnest(requests.author) AS request_authors(name)
+							INNER JOIN books_authors ON books_authors.book_id = books.id
+							INNER JOIN authors ON authors.id = books_authors.author_id
+							WHERE lower(regexp_replace(
+								CASE
+									WHEN request_authors.name ~ '^[^,]+,[^,]+$' THEN btrim(split_part(request_authors.name, ',', 2)) || ' ' || btrim(split_part(request_authors.name, ',', 1))
+									ELSE request_authors.name
+								END, '[^[:alnum:]]', '', 'g')) = ANY(authors.name_keys)
+						)
+						OR (cardinality(requests.author) = 0 AND requests.year <> 0 AND requests.year = books.year)
+					)
+				)
+			)
+			RETURNING requests.id, requests.title, previous.status AS previous_status, books.id AS book_id, books.title AS book_title, books.author AS book_author
+		), event AS (
+			INSERT INTO request_events (request_id, from_status, to_status, reason)
+			SELECT id, previous_status, $2, 'a matching book was uploaded' FROM fulfilled
+		)
+		SELECT fulfilled.id, fulfilled.title, users.id, users.name, users.email, fulfilled.book_id, fulfilled.book_title, fulfilled.book_author
+		FROM fulfilled
+		INNER JOIN users_requests ON users_requests.request_id = fulfilled.id
+		INNER JOIN users ON users.id = users_requests.user_id
+		ORDER BY users.id ASC, fulfilled.id ASC`
+	args := []interface{}{bookID, data.RequestStatusFulfilled, pq.Array(data.RequestActiveStatuses)}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	fulfilments := []*data.RequestFulfilment{}
+	for rows.Next() {
+		var fulfilment data.RequestFulfilment
+		err := rows.Scan(
+			&fulfilment.RequestID,
+			&fulfilment.RequestTitle,
+			&fulfilment.UserID,
+			&fulfilment.UserName,
+			&fulfilment.UserEmail,
+			&fulfilment.BookID,
+			&fulfilment.BookTitle,
+			pq.Array(&fulfilment.BookAuthor),
+		)
+		if err != nil {
+			return nil, err
+		}
+		fulfilments = append(fulfilments, &fulfilment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return fulfilments, nil
+}
+
+// GetRequestFulfilments returns the subscribers of a fulfilled request along with the book that
+// fulfilled it.
+func (r *repository) GetRequestFulfilments(requestID int64) ([]*data.RequestFulfilment, error) {
+	query := `
+		SELECT requests.id, requests.title, users.id, users.name, users.email, books.id, books.title, books.author
+		FROM requests
+		INNER JOIN books ON books.id = requests.book_id
+		INNER JOIN users_requests ON users_requests.request_id = requests.id
+		INNER JOIN users ON users.id = users_requests.user_id
+		WHERE requests.id = $1 AND requests.status = $2
+		ORDER BY users.id ASC`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, requestID, data.RequestStatusFulfilled)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	fulfilments := []*data.RequestFulfilment{}
+	for rows.Next() {
+		var fulfilment data.RequestFulfilment
+		err := rows.Scan(
+			&fulfilment.RequestID,
+			&fulfilment.RequestTitle,
+			&fulfilment.UserID,
+			&fulfilment.UserName,
+			&fulfilment.UserEmail,
+			&fulfilment.BookID,
+			&fulfilment.BookTitle,
+			pq.Array(&fulfilment.BookAuthor),
+		)
+		if err != nil {
+			return nil, err
+		}
+		fulfilments = append(fulfilments, &fulfilment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return fulfilments, nil
+}
+
+// TransitionRequest changes the status, claimant and fulfilling book of a request record, and
+// records the change in its history, in a single transaction.
+func (r *repository) TransitionRequest(request *data.Request, event *data.RequestEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	query := `
+		UPDATE requests
+		SET status = $1, claimed_by = NULLIF($2, 0), book_id = NULLIF($3, 0), version = version + 1
+		WHERE id = $4 AND version = $5
+		RETURNING version`
+	args := []interface{}{request.Status, request.ClaimedBy, request.BookID, request.ID, request.Version}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&request.Version)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrEditConflict
+		case err.Error() == `pq: duplicate key value violates unique constraint "requests_open_isbn_idx"`:
+			return ErrDuplicateRecord
+		default:
+			return err
+		}
+	}
+	query = `
+		INSERT INTO request_events (request_id, user_id, from_status, to_status, reason)
+		VALUES ($1, NULLIF($2, 0), $3, $4, $5)
+		RETURNING id, created_at`
+	args = []interface{}{event.RequestID, event.UserID, event.FromStatus, event.ToStatus, event.Reason}
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetRequestEvents retrieves the history of a request record, oldest first.
+func (r *repository) GetRequestEvents(requestID int64) ([]*data.RequestEvent, error) {
+	query := `
+		SELECT id, request_id, COALESCE(user_id, 0), from_status, to_status, reason, created_at
+		FROM request_events
+		WHERE request_id = $1
+		ORDER BY created_at ASC, id ASC`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	events := []*data.RequestEvent{}
+	for rows.Next() {
+		var event data.RequestEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.RequestID,
+			&event.UserID,
+			&event.FromStatus,
+			&event.ToStatus,
+			&event.Reason,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// ExpireRequests marks the active requests past their expiry as expired, recording the change
+// in their history, and returns the number of expired requests.
+func (r *repository) ExpireRequests() (int64, error) {
+	query := `
+		WITH expired AS (
+			UPDATE requests
+			SET status = $1, claimed_by = NULL, version = requests.version + 1
+			FROM requests AS previous
+			WHERE previous.id = requests.id AND requests.status = ANY($2) AND requests.expiry < NOW()
+			RETURNING requests.id, previous.status AS previous_status
+		), event AS (
+			INSERT INTO request_events (request_id, from_status, to_status, reason)
+			SELECT id, previous_status, $1, 'the request expired' FROM expired
+		)
+		SELECT count(*) FROM expired`
+	args := []interface{}{data.RequestStatusExpired, pq.Array(data.RequestActiveStatuses)}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var expired int64
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&expired)
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+// requestScores scores each request with its subscriptions, each losing half of its weight
+// every $2 seconds.
+const requestScores = `
+	SELECT request_id, sum(power(0.5, extract(epoch FROM NOW() - datetime) / $2)) AS weight
+	FROM users_requests
+	GROUP BY request_id`
+
+// GetTopRequests retrieves a paginated list of the active requests ranked by their recency
+// weighted subscribers. When categoryID isn't 0, only requests in that category are listed.
+func (r *repository) GetTopRequests(categoryID int64, halfLife time.Duration, filters data.Filters) ([]*data.TopRequest, data.Metadata, error) {
+	query := fmt.Sprintf(`
+		WITH scores AS (%s)
+		SELECT count(*) OVER(), requests.id, requests.user_id, requests.title, requests.author, requests.publisher, requests.isbn, requests.year, requests.cover_path, requests.expiry, requests.status, requests.waitlist, COALESCE(requests.book_id, 0), COALESCE(requests.claimed_by, 0), COALESCE(requests.category_id, 0), requests.source, requests.created_at, requests.version, COALESCE(scores.weight, 0) AS score
+		FROM requests
+		LEFT JOIN scores ON scores.request_id = requests.id
+		WHERE requests.status = ANY($1)
+		AND ($3 = 0 OR requests.category_id = $3)
+		ORDER BY %s %s, requests.id ASC
+		LIMIT $4 OFFSET $5`,
+		requestScores, filters.SortColumn(), filters.SortDirection(),
+	)
+	args := []interface{}{pq.Array(data.RequestActiveStatuses), halfLife.Seconds(), categoryID, filters.Limit(), filters.Offset()}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	defer rows.Close()
+	totalRecords := 0
+	requests := []*data.TopRequest{}
+	for rows.Next() {
+		request := data.TopRequest{Request: &data.Request{}}
+		err := rows.Scan(
+			&totalRecords,
+			&request.ID,
+			&request.UserID,
+			&request.Title,
+			pq.Array(&request.Author),
+			&request.Publisher,
+			&request.Isbn,
+			&request.Year,
+			&request.CoverPath,
+			&request.Expiry,
+			&request.Status,
+			&request.Waitlist,
+			&request.BookID,
+			&request.ClaimedBy,
+			&request.CategoryID,
+			&request.Source,
+			&request.CreatedAt,
+			&request.Version,
+			&request.Score,
+		)
+		if err != nil {
+			return nil, data.Metadata{}, err
+		}
+		requests = append(requests, &request)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, data.Metadata{}, err
+	}
+	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
+	return requests, metadata, nil
+}
+
+// GetRequestCategoryDemand retrieves the number of active requests in each category with their
+// subscribers and recency weighted score, most wanted category first.
+func (r *repository) GetRequestCategoryDemand(halfLife time.Duration) ([]*data.RequestCategoryDemand, error) {
+	query := fmt.Sprintf(`
+		WITH scores AS (%s)
+		SELECT COALESCE(categories.id, 0), COALESCE(categories.name, ''), count(*), COALESCE(sum(requests.waitlist), 0), COALESCE(sum(scores.weight), 0) AS score
+		FROM requests
+		LEFT JOIN categories ON categories.id = requests.category_id
+		LEFT JOIN scores ON scores.request_id = requests.id
+		WHERE requests.status = ANY($1)
+		GROUP BY categories.id, categories.name
+		ORDER BY score DESC, categories.id ASC NULLS LAST`,
+		requestScores,
+	)
+	args := []interface{}{pq.Array(data.RequestActiveStatuses), halfLife.Seconds()}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	demand := []*data.RequestCategoryDemand{}
+	for rows.Next() {
+		var category data.RequestCategoryDemand
+		err := rows.Scan(
+			&category.CategoryID,
+			&category.CategoryName,
+			&category.Requests,
+			&category.Subscribers,
+			&category.Score,
+		)
+		if err != nil {
+			return nil, err
+		}
+		demand = append(demand, &category)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return demand, nil
+}
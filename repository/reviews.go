@@ -5,8 +5,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"math"
-	"strconv"
 	"time"
 
 	"github.com/emzola/bibliotheca/data"
@@ -18,8 +16,11 @@ type reviews interface {
 	UpdateReview(review *data.Review) error
 	DeleteReview(reviewID int64) error
 	ReviewExistsForUser(userID int64, bookID int64) bool
-	GetReviewRatings() (data.Rating, error)
-	GetAllReviews(filters data.Filters) (data.Rating, []*data.Review, data.Metadata, error)
+	GetBookRatings(bookID int64) (data.Rating, error)
+	RefreshBookPopularity() (int64, error)
+	GetAllReviews(bookID int64, filters data.Filters) ([]*data.Review, data.Metadata, error)
+	VoteReview(reviewID, userID int64, helpful bool) error
+	DeleteReviewVote(reviewID, userID int64) error
 }
 
 // CreateReview creates a review record for book.
@@ -62,7 +63,8 @@ func (r *repository) GetReview(reviewID int64) (*data.Review, error) {
 		return nil, ErrRecordNotFound
 	}
 	query := `
-		SELECT reviews.id, reviews.book_id, reviews.user_id, users.name, reviews.created_at, reviews.rating, reviews.comment, reviews.version
+		SELECT reviews.id, reviews.book_id, reviews.user_id, users.name, reviews.created_at, reviews.rating, reviews.comment,
+			reviews.helpful_votes, reviews.unhelpful_votes, reviews.version
 		FROM reviews
 		INNER JOIN users ON reviews.user_id = users.id
 		WHERE reviews.id = $1`
@@ -77,6 +79,8 @@ func (r *repository) GetReview(reviewID int64) (*data.Review, error) {
 		&review.CreatedAt,
 		&review.Rating,
 		&review.Comment,
+		&review.HelpfulVotes,
+		&review.UnhelpfulVotes,
 		&review.Version,
 	)
 	if err != nil {
@@ -136,88 +140,101 @@ func (r *repository) DeleteReview(reviewID int64) error {
 	return nil
 }
 
-// GetReviewRatings retrieves the ratings for a review record.
-func (r *repository) GetReviewRatings() (data.Rating, error) {
+// GetBookRatings retrieves the ratings of a book.
+func (r *repository) GetBookRatings(bookID int64) (data.Rating, error) {
+	if bookID < 1 {
+		return data.Rating{}, ErrRecordNotFound
+	}
 	query := `
-		SELECT id, rating
-		FROM reviews  
-		ORDER BY id ASC`
+		SELECT COALESCE(book_ratings.five_stars, 0), COALESCE(book_ratings.four_stars, 0), COALESCE(book_ratings.three_stars, 0),
+			COALESCE(book_ratings.two_stars, 0), COALESCE(book_ratings.one_star, 0), COALESCE(book_ratings.total, 0),
+			COALESCE(round(book_ratings.sum::numeric / NULLIF(book_ratings.total, 0), 1), 0), books.popularity
+		FROM books
+		LEFT JOIN book_ratings ON books.id = book_ratings.book_id
+		WHERE books.id = $1`
+	var ratings data.Rating
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	rows, err := r.db.QueryContext(ctx, query)
+	err := r.db.QueryRowContext(ctx, query, bookID).Scan(
+		&ratings.FiveStars,
+		&ratings.FourStars,
+		&ratings.ThreeStars,
+		&ratings.TwoStars,
+		&ratings.OneStar,
+		&ratings.Total,
+		&ratings.Average,
+		&ratings.Weighted,
+	)
 	if err != nil {
-		return data.Rating{}, err
-	}
-	defer rows.Close()
-	ratings := data.Rating{}
-	sumRatings := int64(0)
-	totalRecords := 0
-	for rows.Next() {
-		var review data.Review
-		err := rows.Scan(
-			&review.ID,
-			&review.Rating,
-		)
-		if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return data.Rating{}, ErrRecordNotFound
+		default:
 			return data.Rating{}, err
 		}
-		switch review.Rating {
-		case 5:
-			ratings.FiveStars++
-		case 4:
-			ratings.FourStars++
-		case 3:
-			ratings.ThreeStars++
-		case 2:
-			ratings.TwoStars++
-		case 1:
-			ratings.OneStar++
-		}
-		sumRatings += int64(review.Rating)
-		totalRecords++
-	}
-	if err = rows.Err(); err != nil {
-		return data.Rating{}, err
 	}
-	avgRatingString := fmt.Sprintf("%.1f", float64(sumRatings)/float64(totalRecords))
-	avgRating, err := strconv.ParseFloat(avgRatingString, 64)
+	return ratings, nil
+}
+
+// RefreshBookPopularity recomputes the popularity of every book whose popularity has drifted as
+// the average of all ratings moved, and returns the number of books updated.
+func (r *repository) RefreshBookPopularity() (int64, error) {
+	query := `
+		UPDATE books
+		SET popularity = ratings.popularity
+		FROM (
+			SELECT book_id, book_popularity(total, sum) AS popularity
+			FROM book_ratings
+		) ratings
+		WHERE books.id = ratings.book_id AND books.popularity <> ratings.popularity`
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query)
 	if err != nil {
-		return data.Rating{}, err
-	}
-	// Because averageRating calculation could result in NAN,
-	// check that it isn't NAN before updating rating's average.
-	// This ensures that JSON encoding works without NAN error
-	if !math.IsNaN(avgRating) {
-		ratings.Average = avgRating
+		return 0, err
 	}
-	ratings.Total = int64(totalRecords)
-	return ratings, nil
+	return result.RowsAffected()
 }
 
-// GetAllReviews retrieves a paginated list of all review records (including it's ratings).
-// Records can be filtered and sorted.
-func (r *repository) GetAllReviews(filters data.Filters) (data.Rating, []*data.Review, data.Metadata, error) {
+// reviewHelpfulness ranks reviews by the lower bound of the Wilson score interval of their
+// helpful votes, so that a review needs both a high share of helpful votes and enough votes
+// to rank above others.
+const reviewHelpfulness = `
+	CASE WHEN reviews.helpful_votes + reviews.unhelpful_votes = 0 THEN 0 ELSE
+		((reviews.helpful_votes + 1.9208) / (reviews.helpful_votes + reviews.unhelpful_votes)
+		- 1.96 * sqrt(reviews.helpful_votes::numeric * reviews.unhelpful_votes / (reviews.helpful_votes + reviews.unhelpful_votes) + 0.9604)
+		/ (reviews.helpful_votes + reviews.unhelpful_votes))
+		/ (1 + 3.8416 / (reviews.helpful_votes + reviews.unhelpful_votes))
+	END`
+
+// GetAllReviews retrieves a paginated list of the review records of a book. Records can be
+// sorted, including by their number of likes and by how helpful users found them.
+func (r *repository) GetAllReviews(bookID int64, filters data.Filters) ([]*data.Review, data.Metadata, error) {
 	query := fmt.Sprintf(`
-		SELECT count(*) OVER(), reviews.id, reviews.book_id, reviews.user_id, users.name, reviews.created_at, reviews.rating, reviews.comment, reviews.version
-		FROM reviews  
+		SELECT count(*) OVER(), reviews.id, reviews.book_id, reviews.user_id, users.name, reviews.created_at, reviews.rating, reviews.comment,
+			reviews.helpful_votes, reviews.unhelpful_votes, reviews.version,
+			(SELECT count(*) FROM reviews_reactions WHERE review_id = reviews.id AND reaction = 'like') AS likes,
+			%s AS helpfulness
+		FROM reviews
 		INNER JOIN users ON reviews.user_id = users.id
+		WHERE reviews.book_id = $1
 		ORDER BY %s %s, id ASC
-		LIMIT $1 OFFSET $2`,
-		filters.SortColumn(), filters.SortDirection())
-	args := []interface{}{filters.Limit(), filters.Offset()}
+		LIMIT $2 OFFSET $3`,
+		reviewHelpfulness, filters.SortColumn(), filters.SortDirection())
+	args := []interface{}{bookID, filters.Limit(), filters.Offset()}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return data.Rating{}, nil, data.Metadata{}, err
+		return nil, data.Metadata{}, err
 	}
 	defer rows.Close()
-	ratings := data.Rating{}
-	sumRatings := int64(0)
 	totalRecords := 0
 	reviews := []*data.Review{}
 	for rows.Next() {
 		var review data.Review
+		var likes int64
+		var helpfulness float64
 		err := rows.Scan(
 			&totalRecords,
 			&review.ID,
@@ -227,41 +244,54 @@ func (r *repository) GetAllReviews(filters data.Filters) (data.Rating, []*data.R
 			&review.CreatedAt,
 			&review.Rating,
 			&review.Comment,
+			&review.HelpfulVotes,
+			&review.UnhelpfulVotes,
 			&review.Version,
+			&likes,
+			&helpfulness,
 		)
 		if err != nil {
-			return data.Rating{}, nil, data.Metadata{}, err
+			return nil, data.Metadata{}, err
 		}
-		switch review.Rating {
-		case 5:
-			ratings.FiveStars++
-		case 4:
-			ratings.FourStars++
-		case 3:
-			ratings.ThreeStars++
-		case 2:
-			ratings.TwoStars++
-		case 1:
-			ratings.OneStar++
-		}
-		sumRatings += int64(review.Rating)
 		reviews = append(reviews, &review)
 	}
 	if err = rows.Err(); err != nil {
-		return data.Rating{}, nil, data.Metadata{}, err
+		return nil, data.Metadata{}, err
+	}
+	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
+	return reviews, metadata, nil
+}
+
+// VoteReview records whether a user found a review helpful, replacing the user's previous vote.
+func (r *repository) VoteReview(reviewID, userID int64, helpful bool) error {
+	query := `
+		INSERT INTO reviews_votes (review_id, user_id, helpful)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (review_id, user_id) DO UPDATE
+		SET helpful = EXCLUDED.helpful, datetime = NOW()`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	_, err := r.db.ExecContext(ctx, query, reviewID, userID, helpful)
+	return err
+}
+
+// DeleteReviewVote removes a user's vote on a review.
+func (r *repository) DeleteReviewVote(reviewID, userID int64) error {
+	query := `
+		DELETE FROM reviews_votes
+		WHERE review_id = $1 AND user_id = $2`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, reviewID, userID)
+	if err != nil {
+		return err
 	}
-	avgRatingString := fmt.Sprintf("%.1f", float64(sumRatings)/float64(totalRecords))
-	avgRating, err := strconv.ParseFloat(avgRatingString, 64)
+	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return data.Rating{}, nil, data.Metadata{}, err
+		return err
 	}
-	// Because averageRating calculation could result in NAN,
-	// check that it isn't NAN before updating rating's average.
-	// This ensures that JSON encoding works without NAN error
-	if !math.IsNaN(avgRating) {
-		ratings.Average = avgRating
+	if rowsAffected == 0 {
+		return ErrRecordNotFound
 	}
-	ratings.Total = int64(totalRecords)
-	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
-	return ratings, reviews, metadata, nil
+	return nil
 }
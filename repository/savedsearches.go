@@ -0,0 +1,270 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/lib/pq"
+)
+
+type savedSearches interface {
+	CreateSavedSearch(savedSearch *data.SavedSearch) error
+	GetSavedSearch(savedSearchID, userID int64) (*data.SavedSearch, error)
+	GetAllSavedSearchesForUser(userID int64) ([]*data.SavedSearch, error)
+	UpdateSavedSearch(savedSearch *data.SavedSearch) error
+	DeleteSavedSearch(savedSearchID, userID int64) error
+	AddSavedSearchMatchesForBook(bookID int64) error
+	GetPendingSavedSearchMatches(before time.Time) ([]*data.SavedSearchMatch, error)
+	MarkSavedSearchMatchesNotified(userID int64, before time.Time) error
+}
+
+// CreateSavedSearch creates a new saved search record.
+func (r *repository) CreateSavedSearch(savedSearch *data.SavedSearch) error {
+	query := `
+		INSERT INTO saved_searches (user_id, name, search, from_year, to_year, language, extension, tags, isbns, notify)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at, version`
+	args := []interface{}{
+		savedSearch.UserID,
+		savedSearch.Name,
+		savedSearch.Search,
+		savedSearch.FromYear,
+		savedSearch.ToYear,
+		pq.Array(savedSearch.Language),
+		pq.Array(savedSearch.Extension),
+		pq.Array(savedSearch.Tags),
+		pq.Array(savedSearch.Isbns),
+		savedSearch.Notify,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&savedSearch.ID, &savedSearch.CreatedAt, &savedSearch.Version)
+	if err != nil {
+		switch {
+		case err.Error() == `pq: duplicate key value violates unique constraint "saved_searches_user_id_name_key"`:
+			return ErrDuplicateRecord
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSavedSearch retrieves a saved search record of a user.
+func (r *repository) GetSavedSearch(savedSearchID, userID int64) (*data.SavedSearch, error) {
+	if savedSearchID < 1 {
+		return nil, ErrRecordNotFound
+	}
+	query := `
+		SELECT id, user_id, created_at, name, search, from_year, to_year, language, extension, tags, isbns, notify, version
+		FROM saved_searches
+		WHERE id = $1 AND user_id = $2`
+	var savedSearch data.SavedSearch
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, savedSearchID, userID).Scan(
+		&savedSearch.ID,
+		&savedSearch.UserID,
+		&savedSearch.CreatedAt,
+		&savedSearch.Name,
+		&savedSearch.Search,
+		&savedSearch.FromYear,
+		&savedSearch.ToYear,
+		pq.Array(&savedSearch.Language),
+		pq.Array(&savedSearch.Extension),
+		pq.Array(&savedSearch.Tags),
+		pq.Array(&savedSearch.Isbns),
+		&savedSearch.Notify,
+		&savedSearch.Version,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return &savedSearch, nil
+}
+
+// GetAllSavedSearchesForUser retrieves all saved search records of a user.
+func (r *repository) GetAllSavedSearchesForUser(userID int64) ([]*data.SavedSearch, error) {
+	query := `
+		SELECT id, user_id, created_at, name, search, from_year, to_year, language, extension, tags, isbns, notify, version
+		FROM saved_searches
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id DESC`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	savedSearches := []*data.SavedSearch{}
+	for rows.Next() {
+		var savedSearch data.SavedSearch
+		err := rows.Scan(
+			&savedSearch.ID,
+			&savedSearch.UserID,
+			&savedSearch.CreatedAt,
+			&savedSearch.Name,
+			&savedSearch.Search,
+			&savedSearch.FromYear,
+			&savedSearch.ToYear,
+			pq.Array(&savedSearch.Language),
+			pq.Array(&savedSearch.Extension),
+			pq.Array(&savedSearch.Tags),
+			pq.Array(&savedSearch.Isbns),
+			&savedSearch.Notify,
+			&savedSearch.Version,
+		)
+		if err != nil {
+			return nil, err
+		}
+		savedSearches = append(savedSearches, &savedSearch)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return savedSearches, nil
+}
+
+// UpdateSavedSearch updates the name and notification setting of a saved search record.
+func (r *repository) UpdateSavedSearch(savedSearch *data.SavedSearch) error {
+	query := `
+		UPDATE saved_searches
+		SET name = $1, notify = $2, version = version + 1
+		WHERE id = $3 AND version = $4
+		RETURNING version`
+	args := []interface{}{savedSearch.Name, savedSearch.Notify, savedSearch.ID, savedSearch.Version}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&savedSearch.Version)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrEditConflict
+		case err.Error() == `pq: duplicate key value violates unique constraint "saved_searches_user_id_name_key"`:
+			return ErrDuplicateRecord
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteSavedSearch deletes a saved search record of a user.
+func (r *repository) DeleteSavedSearch(savedSearchID, userID int64) error {
+	if savedSearchID < 1 {
+		return ErrRecordNotFound
+	}
+	query := `
+		DELETE FROM saved_searches
+		WHERE id = $1 AND user_id = $2`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, savedSearchID, userID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+// AddSavedSearchMatchesForBook records a match for every saved search with notifications
+// turned on that a book matches. Searches match books the same way GetAllBooks does, users
+// aren't notified about their own uploads and a book matches each search at most once.
+func (r *repository) AddSavedSearchMatchesForBook(bookID int64) error {
+	query := `
+		INSERT INTO saved_search_matches (saved_search_id, book_id)
+		SELECT saved_searches.id, books.id
+		FROM saved_searches
+		INNER JOIN books ON books.id = $1
+		WHERE saved_searches.notify
+		AND saved_searches.user_id <> books.user_id
+		AND ` + bookSearchConditions(
+		"saved_searches.search",
+		"saved_searches.from_year",
+		"saved_searches.to_year",
+		"saved_searches.language",
+		"saved_searches.extension",
+		"saved_searches.tags",
+		"saved_searches.isbns",
+	) + `
+		ON CONFLICT DO NOTHING`
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := r.db.ExecContext(ctx, query, bookID)
+	return err
+}
+
+// GetPendingSavedSearchMatches retrieves the matches recorded before a time that users haven't
+// been notified about yet, ordered by user and saved search.
+func (r *repository) GetPendingSavedSearchMatches(before time.Time) ([]*data.SavedSearchMatch, error) {
+	query := `
+		SELECT users.id, users.name, users.email, saved_searches.id, saved_searches.name, books.id, books.title, books.author
+		FROM saved_search_matches
+		INNER JOIN saved_searches ON saved_searches.id = saved_search_matches.saved_search_id
+		INNER JOIN users ON users.id = saved_searches.user_id
+		INNER JOIN books ON books.id = saved_search_matches.book_id
+		WHERE saved_search_matches.notified_at IS NULL
+		AND saved_search_matches.created_at <= $1
+		AND saved_searches.notify
+		AND users.activated
+		ORDER BY users.id ASC, saved_searches.id ASC, books.id ASC`
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	matches := []*data.SavedSearchMatch{}
+	for rows.Next() {
+		var match data.SavedSearchMatch
+		err := rows.Scan(
+			&match.UserID,
+			&match.UserName,
+			&match.UserEmail,
+			&match.SavedSearchID,
+			&match.SavedSearchName,
+			&match.BookID,
+			&match.BookTitle,
+			pq.Array(&match.BookAuthor),
+		)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, &match)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// MarkSavedSearchMatchesNotified marks the pending matches of a user's saved searches recorded
+// before a time as notified.
+func (r *repository) MarkSavedSearchMatchesNotified(userID int64, before time.Time) error {
+	query := `
+		UPDATE saved_search_matches
+		SET notified_at = NOW()
+		WHERE notified_at IS NULL
+		AND created_at <= $2
+		AND saved_search_id IN (SELECT id FROM saved_searches WHERE user_id = $1)`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	_, err := r.db.ExecContext(ctx, query, userID, before)
+	return err
+}
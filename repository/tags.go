@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/lib/pq"
+)
+
+type tags interface {
+	AddTagsForBook(bookID, userID int64, tags []string, status string) error
+	GetBookTag(bookID int64, tag string) (*data.BookTag, error)
+	UpdateBookTagStatus(bookID int64, tag, status string) error
+	DeleteTagForBook(bookID int64, tag string) error
+	GetTagsByPrefix(prefix string, limit int) ([]*data.Tag, error)
+	GetPopularTags(limit int) ([]*data.Tag, error)
+	GetAllPendingBookTags(ownerID int64, filters data.Filters) ([]*data.BookTag, data.Metadata, error)
+}
+
+// AddTagsForBook adds tags to a book record with the given status, creating the tags that
+// don't exist yet. Tags the book already has keep their status unless they are approved.
+func (r *repository) AddTagsForBook(bookID, userID int64, tags []string, status string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	query := `
+		INSERT INTO tags (name)
+		SELECT unnest($1::text[])
+		ON CONFLICT (name) DO NOTHING`
+	_, err = tx.ExecContext(ctx, query, pq.Array(tags))
+	if err != nil {
+		return err
+	}
+	query = `
+		INSERT INTO books_tags (book_id, tag_id, user_id, status)
+		SELECT $1, tags.id, $2, $3
+		FROM tags
+		WHERE tags.name = ANY($4)
+		ON CONFLICT (book_id, tag_id) DO UPDATE
+		SET status = EXCLUDED.status
+		WHERE EXCLUDED.status = 'approved' AND books_tags.status <> 'approved'`
+	_, err = tx.ExecContext(ctx, query, bookID, userID, status, pq.Array(tags))
+	if err != nil {
+		switch {
+		case err.Error() == `pq: insert or update on table "books_tags" violates foreign key constraint "books_tags_book_id_fkey"`:
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetBookTag retrieves a tag of a book record.
+func (r *repository) GetBookTag(bookID int64, tag string) (*data.BookTag, error) {
+	query := `
+		SELECT books_tags.book_id, tags.name, COALESCE(books_tags.user_id, 0), books_tags.status, books_tags.datetime
+		FROM books_tags
+		INNER JOIN tags ON tags.id = books_tags.tag_id
+		WHERE books_tags.book_id = $1 AND tags.name = $2`
+	var bookTag data.BookTag
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, bookID, tag).Scan(
+		&bookTag.BookID,
+		&bookTag.Tag,
+		&bookTag.UserID,
+		&bookTag.Status,
+		&bookTag.CreatedAt,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return &bookTag, nil
+}
+
+// UpdateBookTagStatus approves or rejects a tag of a book record.
+func (r *repository) UpdateBookTagStatus(bookID int64, tag, status string) error {
+	query := `
+		UPDATE books_tags
+		SET status = $3
+		FROM tags
+		WHERE tags.id = books_tags.tag_id AND books_tags.book_id = $1 AND tags.name = $2`
+	args := []interface{}{bookID, tag, status}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteTagForBook removes a tag from a book record.
+func (r *repository) DeleteTagForBook(bookID int64, tag string) error {
+	query := `
+		DELETE FROM books_tags
+		USING tags
+		WHERE tags.id = books_tags.tag_id AND books_tags.book_id = $1 AND tags.name = $2`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query, bookID, tag)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetTagsByPrefix retrieves the most used tags starting with prefix. Tags that
+// aren't approved on any book are left out.
+func (r *repository) GetTagsByPrefix(prefix string, limit int) ([]*data.Tag, error) {
+	query := `
+		SELECT id, name, usage_count
+		FROM tags
+		WHERE name LIKE $1 || '%' AND usage_count > 0
+		ORDER BY usage_count DESC, name ASC
+		LIMIT $2`
+	return r.queryTags(query, prefix, limit)
+}
+
+// GetPopularTags retrieves the most used tags.
+func (r *repository) GetPopularTags(limit int) ([]*data.Tag, error) {
+	query := `
+		SELECT id, name, usage_count
+		FROM tags
+		WHERE usage_count > 0
+		ORDER BY usage_count DESC, name ASC
+		LIMIT $1`
+	return r.queryTags(query, limit)
+}
+
+// queryTags runs a query that selects tag records.
+func (r *repository) queryTags(query string, args ...interface{}) ([]*data.Tag, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	tags := []*data.Tag{}
+	for rows.Next() {
+		var tag data.Tag
+		err := rows.Scan(&tag.ID, &tag.Name, &tag.UsageCount)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, &tag)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// GetAllPendingBookTags retrieves a paginated list of tags awaiting moderation on the books
+// uploaded by ownerID, or on all books when ownerID is 0.
+func (r *repository) GetAllPendingBookTags(ownerID int64, filters data.Filters) ([]*data.BookTag, data.Metadata, error) {
+	query := fmt.Sprintf(`
+		SELECT count(*) OVER(), books_tags.book_id, books.title, tags.name, COALESCE(books_tags.user_id, 0), books_tags.status, books_tags.datetime
+		FROM books_tags
+		INNER JOIN tags ON tags.id = books_tags.tag_id
+		INNER JOIN books ON books.id = books_tags.book_id
+		WHERE books_tags.status = 'pending' AND (books.user_id = $1 OR $1 = 0)
+		ORDER BY books_tags.%s %s, books_tags.book_id ASC, tags.name ASC
+		LIMIT $2 OFFSET $3`,
+		filters.SortColumn(), filters.SortDirection(),
+	)
+	args := []interface{}{ownerID, filters.Limit(), filters.Offset()}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	defer rows.Close()
+	totalRecords := 0
+	bookTags := []*data.BookTag{}
+	for rows.Next() {
+		var bookTag data.BookTag
+		err := rows.Scan(
+			&totalRecords,
+			&bookTag.BookID,
+			&bookTag.BookTitle,
+			&bookTag.Tag,
+			&bookTag.UserID,
+			&bookTag.Status,
+			&bookTag.CreatedAt,
+		)
+		if err != nil {
+			return nil, data.Metadata{}, err
+		}
+		bookTags = append(bookTags, &bookTag)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, data.Metadata{}, err
+	}
+	metadata := data.CalculateMetadata(totalRecords, filters.Page, filters.PageSize)
+	return bookTags, metadata, nil
+}
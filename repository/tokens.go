@@ -13,6 +13,7 @@ import (
 type tokens interface {
 	CreateNewToken(userID int64, ttl time.Duration, scope string) (*data.Token, error)
 	DeleteAllTokensForUser(scope string, userID int64) error
+	DeleteExpiredTokens() (int64, error)
 }
 
 // generateToken generates a new user token.
@@ -68,3 +69,17 @@ func (r *repository) DeleteAllTokensForUser(scope string, userID int64) error {
 	_, err := r.db.ExecContext(ctx, query, scope, userID)
 	return err
 }
+
+// DeleteExpiredTokens deletes all expired tokens and returns the number of deleted tokens.
+func (r *repository) DeleteExpiredTokens() (int64, error) {
+	query := `
+		DELETE FROM tokens
+		WHERE expiry < NOW()`
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
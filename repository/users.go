@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/iso639"
 	"github.com/lib/pq"
 )
 
@@ -16,24 +17,27 @@ type users interface {
 	RegisterUser(user *data.User) error
 	GetUserByID(ID int64) (*data.User, error)
 	GetUserByEmail(email string) (*data.User, error)
+	GetUserByUsername(username string) (*data.PublicUser, error)
+	GetUsersByUsernames(usernames []string) ([]*data.PublicUser, error)
 	UpdateUser(user *data.User) error
 	DeleteUser(ID int64) error
 	GetUserForToken(tokenScope string, tokenPlaintext string) (*data.User, error)
 	GetAllFavouriteBooklistsForUser(userID int64, filters data.Filters) ([]*data.Booklist, data.Metadata, error)
 	GetAllBooklistsForUser(userID int64, filters data.Filters) ([]*data.Booklist, data.Metadata, error)
-	GetAllRequestsForUser(userID int64, status string, filters data.Filters) ([]*data.Request, data.Metadata, error)
+	GetAllRequestsForUser(userID int64, statuses []string, filters data.Filters) ([]*data.Request, data.Metadata, error)
 	GetAllBooksForUser(userID int64, filters data.Filters) ([]*data.Book, data.Metadata, error)
 	GetAllFavouriteBooksForUser(userID int64, filters data.Filters) ([]*data.Book, data.Metadata, error)
 	GetAllDownloadsForUser(userID int64, fromDate, toDate string, filters data.Filters) ([]*data.Book, data.Metadata, error)
+	ResetDownloadCounts() (int64, error)
 }
 
 // RegisterUser registers a new user.
 func (r *repository) RegisterUser(user *data.User) error {
 	query := `
-		INSERT INTO users (name, email, password_hash, activated)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO users (name, username, email, password_hash, activated)
+		VALUES ($1, $2, $3, $4, $5)
 		RETURNING id, created_at, version`
-	args := []interface{}{user.Name, user.Email, user.Password.Hash, user.Activated}
+	args := []interface{}{user.Name, user.Username, user.Email, user.Password.Hash, user.Activated}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	err := r.db.QueryRowContext(ctx, query, args...).Scan(
@@ -45,6 +49,8 @@ func (r *repository) RegisterUser(user *data.User) error {
 		switch {
 		case err.Error() == `pq: duplicate key value violates unique constraint "users_email_key"`:
 			return ErrDuplicateRecord
+		case err.Error() == `pq: duplicate key value violates unique constraint "users_username_key"`:
+			return ErrDuplicateUsername
 		default:
 			return err
 		}
@@ -55,7 +61,7 @@ func (r *repository) RegisterUser(user *data.User) error {
 // GetUserByID retrieves a user record by its ID.
 func (r *repository) GetUserByID(ID int64) (*data.User, error) {
 	query := `
-		SELECT id, created_at, name, email, password_hash, activated, download_count, version
+		SELECT id, created_at, name, username, email, password_hash, activated, download_count, role, version
 		FROM users
 		WHERE id = $1`
 	var user data.User
@@ -65,10 +71,12 @@ func (r *repository) GetUserByID(ID int64) (*data.User, error) {
 		&user.ID,
 		&user.CreatedAt,
 		&user.Name,
+		&user.Username,
 		&user.Email,
 		&user.Password.Hash,
 		&user.Activated,
 		&user.DownloadCount,
+		&user.Role,
 		&user.Version,
 	)
 	if err != nil {
@@ -85,7 +93,7 @@ func (r *repository) GetUserByID(ID int64) (*data.User, error) {
 // GetUserByID retrieves a user record by its email.
 func (r *repository) GetUserByEmail(email string) (*data.User, error) {
 	query := `
-		SELECT id, created_at, name, email, password_hash, activated, download_count, version
+		SELECT id, created_at, name, username, email, password_hash, activated, download_count, role, version
 		FROM users
 		WHERE email = $1`
 	var user data.User
@@ -95,10 +103,12 @@ func (r *repository) GetUserByEmail(email string) (*data.User, error) {
 		&user.ID,
 		&user.CreatedAt,
 		&user.Name,
+		&user.Username,
 		&user.Email,
 		&user.Password.Hash,
 		&user.Activated,
 		&user.DownloadCount,
+		&user.Role,
 		&user.Version,
 	)
 	if err != nil {
@@ -112,15 +122,66 @@ func (r *repository) GetUserByEmail(email string) (*data.User, error) {
 	return &user, nil
 }
 
+// GetUserByUsername retrieves the public details of a user by their username, regardless of case.
+func (r *repository) GetUserByUsername(username string) (*data.PublicUser, error) {
+	query := `
+		SELECT id, username, name
+		FROM users
+		WHERE username = $1::citext AND activated`
+	var user data.PublicUser
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	err := r.db.QueryRowContext(ctx, query, username).Scan(&user.ID, &user.Username, &user.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return &user, nil
+}
+
+// GetUsersByUsernames retrieves the public details of the activated users with usernames,
+// regardless of case. Usernames that don't belong to a user are skipped.
+func (r *repository) GetUsersByUsernames(usernames []string) ([]*data.PublicUser, error) {
+	query := `
+		SELECT id, username, name
+		FROM users
+		WHERE username = ANY($1::citext[]) AND activated`
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(usernames))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	users := []*data.PublicUser{}
+	for rows.Next() {
+		var user data.PublicUser
+		err := rows.Scan(&user.ID, &user.Username, &user.Name)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 // UpdateUser updates a user record.
 func (r *repository) UpdateUser(user *data.User) error {
 	query := `
 		UPDATE users
-		SET name = $1, email = $2, password_hash = $3, activated = $4, download_count = $5, version = version + 1
-		WHERE id = $6 AND version = $7
+		SET name = $1, username = $2, email = $3, password_hash = $4, activated = $5, download_count = $6, version = version + 1
+		WHERE id = $7 AND version = $8
 		RETURNING version`
 	args := []interface{}{
 		user.Name,
+		user.Username,
 		user.Email,
 		user.Password.Hash,
 		user.Activated,
@@ -135,6 +196,8 @@ func (r *repository) UpdateUser(user *data.User) error {
 		switch {
 		case err.Error() == `pq: duplicate key value violates unique constraint "users_email_key"`:
 			return ErrDuplicateRecord
+		case err.Error() == `pq: duplicate key value violates unique constraint "users_username_key"`:
+			return ErrDuplicateUsername
 		case errors.Is(err, sql.ErrNoRows):
 			return ErrEditConflict
 		default:
@@ -172,7 +235,7 @@ func (r *repository) DeleteUser(ID int64) error {
 func (r *repository) GetUserForToken(tokenScope string, tokenPlaintext string) (*data.User, error) {
 	tokenHash := sha256.Sum256([]byte(tokenPlaintext))
 	query := `
-		SELECT users.id, users.created_at, users.name, users.email, users.password_hash, users.activated, users.version
+		SELECT users.id, users.created_at, users.name, users.username, users.email, users.password_hash, users.activated, users.role, users.version
 		FROM users
 		INNER JOIN tokens
 		ON users.id = tokens.user_id
@@ -187,9 +250,11 @@ func (r *repository) GetUserForToken(tokenScope string, tokenPlaintext string) (
 		&user.ID,
 		&user.CreatedAt,
 		&user.Name,
+		&user.Username,
 		&user.Email,
 		&user.Password.Hash,
 		&user.Activated,
+		&user.Role,
 		&user.Version,
 	)
 	if err != nil {
@@ -299,18 +364,18 @@ func (r *repository) GetAllBooklistsForUser(userID int64, filters data.Filters)
 
 // GetAllRequestsForUser retrieves a paginated record of all user's requests.
 // Records can be filtered and sorted.
-func (r *repository) GetAllRequestsForUser(userID int64, status string, filters data.Filters) ([]*data.Request, data.Metadata, error) {
+func (r *repository) GetAllRequestsForUser(userID int64, statuses []string, filters data.Filters) ([]*data.Request, data.Metadata, error) {
 	query := fmt.Sprintf(`
-		SELECT count(*) OVER(), requests.id, requests.user_id, requests.title, requests.publisher, requests.isbn, requests.year, requests.expiry, requests.status, requests.waitlist, requests.created_at, requests.version
+		SELECT count(*) OVER(), requests.id, requests.user_id, requests.title, requests.author, requests.publisher, requests.isbn, requests.year, requests.cover_path, requests.expiry, requests.status, requests.waitlist, COALESCE(requests.book_id, 0), COALESCE(requests.claimed_by, 0), COALESCE(requests.category_id, 0), requests.source, requests.created_at, requests.version
 		FROM requests
 		INNER JOIN users_requests ON users_requests.request_id = requests.id
 		INNER JOIN users ON users_requests.user_id = users.id
-		WHERE users.id = $1 AND (LOWER(requests.status) = LOWER($2) OR $2 = '') 
+		WHERE users.id = $1 AND (requests.status = ANY($2) OR cardinality($2::text[]) = 0)
 		ORDER BY %s %s, datetime DESC
 		LIMIT $3 OFFSET $4`,
 		filters.SortColumn(), filters.SortDirection(),
 	)
-	args := []interface{}{userID, status, filters.Limit(), filters.Offset()}
+	args := []interface{}{userID, pq.Array(statuses), filters.Limit(), filters.Offset()}
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	rows, err := r.db.QueryContext(ctx, query, args...)
@@ -327,12 +392,18 @@ func (r *repository) GetAllRequestsForUser(userID int64, status string, filters
 			&request.ID,
 			&request.UserID,
 			&request.Title,
+			pq.Array(&request.Author),
 			&request.Publisher,
 			&request.Isbn,
 			&request.Year,
+			&request.CoverPath,
 			&request.Expiry,
 			&request.Status,
 			&request.Waitlist,
+			&request.BookID,
+			&request.ClaimedBy,
+			&request.CategoryID,
+			&request.Source,
 			&request.CreatedAt,
 			&request.Version,
 		)
@@ -400,6 +471,7 @@ func (r *repository) GetAllBooksForUser(userID int64, filters data.Filters) ([]*
 		if err != nil {
 			return nil, data.Metadata{}, err
 		}
+		book.LanguageName = iso639.Name(book.Language)
 		books = append(books, &book)
 	}
 	if err = rows.Err(); err != nil {
@@ -464,6 +536,7 @@ func (r *repository) GetAllFavouriteBooksForUser(userID int64, filters data.Filt
 		if err != nil {
 			return nil, data.Metadata{}, err
 		}
+		book.LanguageName = iso639.Name(book.Language)
 		books = append(books, &book)
 	}
 	if err = rows.Err(); err != nil {
@@ -527,6 +600,7 @@ func (r *repository) GetAllDownloadsForUser(userID int64, fromDate, toDate strin
 		if err != nil {
 			return nil, data.Metadata{}, err
 		}
+		book.LanguageName = iso639.Name(book.Language)
 		books = append(books, &book)
 	}
 	if err = rows.Err(); err != nil {
@@ -581,3 +655,19 @@ func (r *repository) GetAllDownloadsForUser(userID int64, fromDate, toDate strin
 // 	}
 // 	return &book, nil
 // }
+
+// ResetDownloadCounts resets the daily download count of all users to 0 and returns the
+// number of users whose count was reset.
+func (r *repository) ResetDownloadCounts() (int64, error) {
+	query := `
+		UPDATE users
+		SET download_count = 0, version = version + 1
+		WHERE download_count <> 0`
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	result, err := r.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/repository"
+)
+
+type authors interface {
+	GetAuthor(authorID int64) (*data.Author, error)
+	ListAuthors(search string, filters data.Filters) ([]*data.Author, data.Metadata, error)
+	ListAuthorBooks(authorID int64, filters data.Filters) ([]*data.Book, data.Metadata, error)
+	UpdateAuthor(authorID int64, requestBody dto.UpdateAuthorRequestBody) (*data.Author, error)
+	MergeAuthors(authorID int64, duplicateIDs []int64) (*data.Author, error)
+}
+
+// GetAuthor service retrieves the details of an author.
+func (s *service) GetAuthor(authorID int64) (*data.Author, error) {
+	author, err := s.repo.GetAuthor(authorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return author, nil
+}
+
+// ListAuthors service retrieves a paginated list of authors. The list can be searched and sorted.
+func (s *service) ListAuthors(search string, filters data.Filters) ([]*data.Author, data.Metadata, error) {
+	v := validator.New()
+	if data.ValidateFilters(v, filters); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, data.Metadata{}, ErrFailedValidation
+	}
+	authors, metadata, err := s.repo.GetAllAuthors(search, filters)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	return authors, metadata, nil
+}
+
+// ListAuthorBooks service retrieves a paginated list of an author's books.
+func (s *service) ListAuthorBooks(authorID int64, filters data.Filters) ([]*data.Book, data.Metadata, error) {
+	v := validator.New()
+	if data.ValidateFilters(v, filters); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, data.Metadata{}, ErrFailedValidation
+	}
+	_, err := s.repo.GetAuthor(authorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, data.Metadata{}, ErrRecordNotFound
+		default:
+			return nil, data.Metadata{}, err
+		}
+	}
+	books, metadata, err := s.repo.GetAllBooksForAuthor(authorID, filters)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	return books, metadata, nil
+}
+
+// UpdateAuthor service updates the details of an author. When the canonical name changes,
+// the previous name is kept as an alternate name so that it still resolves to the author.
+func (s *service) UpdateAuthor(authorID int64, requestBody dto.UpdateAuthorRequestBody) (*data.Author, error) {
+	author, err := s.repo.GetAuthor(authorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	previousName := author.Name
+	if requestBody.AlternateNames != nil {
+		author.AlternateNames = []string{}
+		for _, name := range requestBody.AlternateNames {
+			author.AlternateNames = append(author.AlternateNames, data.NormalizeAuthorName(name))
+		}
+	}
+	if requestBody.Name != nil {
+		author.Name = data.NormalizeAuthorName(*requestBody.Name)
+		author.SortName = data.AuthorSortName(author.Name)
+		if data.AuthorNameKey(previousName) != data.AuthorNameKey(author.Name) && !validator.In(previousName, author.AlternateNames...) {
+			author.AlternateNames = append(author.AlternateNames, previousName)
+		}
+	}
+	if requestBody.SortName != nil {
+		author.SortName = *requestBody.SortName
+	}
+	if requestBody.Bio != nil {
+		author.Bio = *requestBody.Bio
+	}
+	if requestBody.BirthYear != nil {
+		author.BirthYear = *requestBody.BirthYear
+	}
+	if requestBody.DeathYear != nil {
+		author.DeathYear = *requestBody.DeathYear
+	}
+	v := validator.New()
+	if data.ValidateAuthor(v, author); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	// Names must not resolve to a different author, otherwise lookups become ambiguous
+	for _, name := range append([]string{author.Name}, author.AlternateNames...) {
+		other, err := s.repo.GetAuthorByName(name)
+		if err != nil {
+			if errors.Is(err, repository.ErrRecordNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		if other.ID != author.ID {
+			v.AddError("name", "the name "+name+" already belongs to another author, merge the authors instead")
+			ErrFailedValidation = s.failedValidation(v.Errors)
+			return nil, ErrFailedValidation
+		}
+	}
+	err = s.repo.UpdateAuthor(author)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrEditConflict):
+			return nil, ErrEditConflict
+		default:
+			return nil, err
+		}
+	}
+	if author.Name != previousName {
+		err = s.repo.RefreshAuthorNamesForBooks(author.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return author, nil
+}
+
+// MergeAuthors service merges duplicate authors into an author. Books of the duplicates are
+// re-pointed to the author and the duplicates' names are kept as alternate names.
+func (s *service) MergeAuthors(authorID int64, duplicateIDs []int64) (*data.Author, error) {
+	v := validator.New()
+	v.Check(len(duplicateIDs) > 0, "duplicate_ids", "must contain at least 1 author")
+	v.Check(len(duplicateIDs) <= 50, "duplicate_ids", "must not contain more than 50 authors")
+	v.Check(validator.UniqueInt64(duplicateIDs), "duplicate_ids", "must not contain duplicate values")
+	for _, id := range duplicateIDs {
+		v.Check(id != authorID, "duplicate_ids", "must not contain the author being merged into")
+	}
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	author, err := s.repo.GetAuthor(authorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	for _, id := range duplicateIDs {
+		duplicate, err := s.repo.GetAuthor(id)
+		if err != nil {
+			switch {
+			case errors.Is(err, repository.ErrRecordNotFound):
+				return nil, ErrRecordNotFound
+			default:
+				return nil, err
+			}
+		}
+		for _, name := range append([]string{duplicate.Name}, duplicate.AlternateNames...) {
+			if data.AuthorNameKey(name) != data.AuthorNameKey(author.Name) && !validator.In(name, author.AlternateNames...) {
+				author.AlternateNames = append(author.AlternateNames, name)
+			}
+		}
+		// Keep biographical details the author is missing
+		if author.Bio == "" {
+			author.Bio = duplicate.Bio
+		}
+		if author.BirthYear == 0 {
+			author.BirthYear = duplicate.BirthYear
+		}
+		if author.DeathYear == 0 {
+			author.DeathYear = duplicate.DeathYear
+		}
+	}
+	err = s.repo.MergeAuthors(author, duplicateIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrEditConflict):
+			return nil, ErrEditConflict
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return s.GetAuthor(author.ID)
+}
+
+// resolveAuthors looks up the author record for each name. Names that do not match an
+// existing author resolve to a new, unsaved author record with an ID of 0. Names that
+// refer to the same author resolve to the same record.
+func (s *service) resolveAuthors(names []string) ([]*data.Author, error) {
+	authors := make([]*data.Author, len(names))
+	resolved := make(map[string]*data.Author)
+	for i, name := range names {
+		name = data.NormalizeAuthorName(name)
+		key := data.AuthorNameKey(name)
+		if author, ok := resolved[key]; ok {
+			authors[i] = author
+			continue
+		}
+		author, err := s.repo.GetAuthorByName(name)
+		if err != nil {
+			switch {
+			case errors.Is(err, repository.ErrRecordNotFound):
+				author = &data.Author{Name: name, SortName: data.AuthorSortName(name)}
+			default:
+				return nil, err
+			}
+		}
+		resolved[key] = author
+		authors[i] = author
+	}
+	return authors, nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/emzola/bibliotheca/data"
 	"github.com/emzola/bibliotheca/internal/validator"
@@ -165,7 +166,7 @@ func (s *service) DeleteFavouriteBooklist(userID int64, booklistID int64) error
 	return nil
 }
 
-// AddBookToBooklist service adds a book to a booklist.
+// AddBookToBooklist service adds a book to a booklist and notifies the book's owner.
 func (s *service) AddBookToBooklist(bookID int64, booklistID int64) error {
 	booklist, err := s.repo.GetBooklist(booklistID)
 	if err != nil {
@@ -176,7 +177,16 @@ func (s *service) AddBookToBooklist(bookID int64, booklistID int64) error {
 			return err
 		}
 	}
-	err = s.repo.AddBookToBooklist(booklist.ID, bookID)
+	book, err := s.repo.GetBook(bookID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	err = s.repo.AddBookToBooklist(booklist.ID, book.ID)
 	if err != nil {
 		return err
 	}
@@ -189,6 +199,18 @@ func (s *service) AddBookToBooklist(bookID int64, booklistID int64) error {
 			return err
 		}
 	}
+	// Books added to private booklists are not notified, so as not to reveal the booklists
+	if booklist.Private {
+		return nil
+	}
+	notification := data.Notification{
+		Event:      data.NotificationBooklistBook,
+		ActorID:    booklist.UserID,
+		Message:    fmt.Sprintf("added your book \"%s\" to the booklist \"%s\"", book.Title, booklist.Name),
+		BookID:     book.ID,
+		BooklistID: booklist.ID,
+	}
+	s.notifyInBackground(notification, []int64{book.UserID}, fmt.Sprintf("/booklists/%d", booklist.ID))
 	return nil
 }
 
@@ -1,7 +1,9 @@
 package service
 
 import (
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"net/http"
 	"path/filepath"
 	"strings"
@@ -9,6 +11,7 @@ import (
 	"github.com/emzola/bibliotheca/clients"
 	"github.com/emzola/bibliotheca/data"
 	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/iso639"
 	"github.com/emzola/bibliotheca/internal/validator"
 	"github.com/emzola/bibliotheca/repository"
 )
@@ -16,7 +19,8 @@ import (
 type books interface {
 	CreateBook(userID int64, r *http.Request) (*data.Book, error)
 	GetBook(bookID int64) (*data.Book, error)
-	ListBooks(search string, fromYear int, toYear int, language []string, extension []string, filters data.Filters) ([]*data.Book, data.Metadata, error)
+	ListBooks(search string, fromYear int, toYear int, language []string, extension []string, tags []string, isbn string, filters data.Filters) ([]*data.Book, data.Metadata, error)
+	ListLanguages() ([]*data.LanguageCount, error)
 	UpdateBook(bookID int64, requestBody dto.UpdateBookRequestBody) (*data.Book, error)
 	UpdateBookCover(bookID int64, r *http.Request) (*data.Book, error)
 	DeleteBook(bookID int64) error
@@ -67,7 +71,7 @@ func (s *service) CreateBook(userID int64, r *http.Request) (*data.Book, error)
 	if err != nil {
 		return nil, err
 	}
-	s3FileKey, err := s.uploadFileToS3(s3Client, buffer, mtype, fileHeader, data.ScopeBook)
+	s3FileKey, err := s.uploadFileToS3(s3Client, buffer, mtype, fileHeader.Filename, data.ScopeBook)
 	if err != nil {
 		return nil, err
 	}
@@ -78,12 +82,17 @@ func (s *service) CreateBook(userID int64, r *http.Request) (*data.Book, error)
 		Filename:  fileHeader.Filename,
 		Extension: strings.ToUpper(strings.TrimPrefix(filepath.Ext(fileHeader.Filename), ".")),
 		Size:      fileHeader.Size,
+		Checksum:  fmt.Sprintf("%x", sha256.Sum256(buffer)),
 	}
 	// Create record
 	err = s.repo.CreateBook(book)
 	if err != nil {
 		return nil, err
 	}
+	s.refreshBookContentInBackground(book)
+	s.addSavedSearchMatchesInBackground(book.ID)
+	s.refreshBookDuplicatesInBackground(book.ID)
+	s.fulfilRequestsInBackground(book.ID)
 	return book, nil
 }
 
@@ -102,19 +111,57 @@ func (s *service) GetBook(bookID int64) (*data.Book, error) {
 }
 
 // ListBooks service retrieves a list of paginated books. The list can be filtered and sorted.
-func (s *service) ListBooks(search string, fromYear int, toYear int, language []string, extension []string, filters data.Filters) ([]*data.Book, data.Metadata, error) {
+func (s *service) ListBooks(search string, fromYear int, toYear int, language []string, extension []string, tags []string, isbn string, filters data.Filters) ([]*data.Book, data.Metadata, error) {
 	v := validator.New()
+	language, tags, isbns := normalizeBookSearch(v, language, tags, isbn)
 	if data.ValidateFilters(v, filters); !v.Valid() {
 		ErrFailedValidation = s.failedValidation(v.Errors)
 		return nil, data.Metadata{}, ErrFailedValidation
 	}
-	books, metadata, err := s.repo.GetAllBooks(search, fromYear, toYear, language, extension, filters)
+	books, metadata, err := s.repo.GetAllBooks(search, fromYear, toYear, language, extension, tags, isbns, filters)
 	if err != nil {
 		return nil, data.Metadata{}, err
 	}
 	return books, metadata, nil
 }
 
+// normalizeBookSearch normalizes the languages, tags and ISBN that books are searched by, and
+// checks the languages and ISBN. A book is found by either form of its ISBN, so the ISBN is
+// returned in both forms.
+func normalizeBookSearch(v *validator.Validator, language []string, tags []string, isbn string) ([]string, []string, []string) {
+	isbns := []string{}
+	if isbn != "" {
+		isbn10, isbn13, ok := validator.ParseISBN(isbn)
+		v.Check(ok, "isbn", "must be a valid ISBN-10 or ISBN-13")
+		for _, isbn := range []string{isbn10, isbn13} {
+			if isbn != "" {
+				isbns = append(isbns, isbn)
+			}
+		}
+	}
+	language = data.NormalizeLanguages(language)
+	if language == nil {
+		language = []string{}
+	}
+	for _, code := range language {
+		data.ValidateLanguage(v, "language", code)
+	}
+	normalizedTags := make([]string, len(tags))
+	for i, tag := range tags {
+		normalizedTags[i] = data.NormalizeTag(tag)
+	}
+	return language, normalizedTags, isbns
+}
+
+// ListLanguages service retrieves the languages of books with the number of books in each.
+func (s *service) ListLanguages() ([]*data.LanguageCount, error) {
+	languages, err := s.repo.GetLanguageCounts()
+	if err != nil {
+		return nil, err
+	}
+	return languages, nil
+}
+
 // UpdateBook service updates the details of a specific book.
 func (s *service) UpdateBook(bookID int64, requestBody dto.UpdateBookRequestBody) (*data.Book, error) {
 	// Retrieve the book by its ID
@@ -127,6 +174,13 @@ func (s *service) UpdateBook(bookID int64, requestBody dto.UpdateBookRequestBody
 			return nil, err
 		}
 	}
+	return s.updateBook(book, requestBody, true)
+}
+
+// updateBook updates the fields of a book record that have new data in requestBody. When
+// enrich is true, metadata is proposed for the book if its ISBN changes.
+func (s *service) updateBook(book *data.Book, requestBody dto.UpdateBookRequestBody, enrich bool) (*data.Book, error) {
+	var err error
 	// Update only fields with new data
 	if requestBody.Title != nil {
 		book.Title = *requestBody.Title
@@ -137,15 +191,15 @@ func (s *service) UpdateBook(bookID int64, requestBody dto.UpdateBookRequestBody
 	if requestBody.Author != nil {
 		book.Author = requestBody.Author
 	}
-	if requestBody.Category != nil {
-		book.Category = *requestBody.Category
-	}
 	if requestBody.Publisher != nil {
 		book.Publisher = *requestBody.Publisher
 	}
 	if requestBody.Language != nil {
 		book.Language = *requestBody.Language
 	}
+	// Languages are stored by their ISO 639 code
+	book.Language = data.NormalizeLanguage(book.Language)
+	book.LanguageName = iso639.Name(book.Language)
 	if requestBody.Series != nil {
 		book.Series = *requestBody.Series
 	}
@@ -161,21 +215,76 @@ func (s *service) UpdateBook(bookID int64, requestBody dto.UpdateBookRequestBody
 	if requestBody.PageCount != nil {
 		book.PageCount = *requestBody.PageCount
 	}
-	if requestBody.Isbn10 != nil {
-		book.Isbn10 = *requestBody.Isbn10
+	if requestBody.Isbn10 != nil || requestBody.Isbn13 != nil {
+		// Store ISBNs without hyphens or spaces and derive the form that isn't given
+		book.Isbn10, book.Isbn13 = "", ""
+		if requestBody.Isbn10 != nil {
+			book.Isbn10 = validator.CleanISBN(*requestBody.Isbn10)
+		}
+		if requestBody.Isbn13 != nil {
+			book.Isbn13 = validator.CleanISBN(*requestBody.Isbn13)
+		}
+		if book.Isbn13 == "" && validator.ISBN10(book.Isbn10) {
+			book.Isbn13 = validator.ISBN10To13(book.Isbn10)
+		}
+		if book.Isbn10 == "" && validator.ISBN13(book.Isbn13) {
+			book.Isbn10, _ = validator.ISBN13To10(book.Isbn13)
+		}
 	}
-	if requestBody.Isbn13 != nil {
-		book.Isbn13 = *requestBody.Isbn13
+	// Resolve author names to author records so that different spellings of
+	// the same name point to the same author
+	var authors []*data.Author
+	if requestBody.Author != nil || len(book.AuthorIDs) != len(book.Author) {
+		authors, err = s.resolveAuthors(book.Author)
+		if err != nil {
+			return nil, err
+		}
+		book.Author = make([]string, len(authors))
+		book.AuthorIDs = make([]int64, len(authors))
+		for i, author := range authors {
+			book.Author[i] = author.Name
+			book.AuthorIDs[i] = author.ID
+		}
 	}
-	if requestBody.Popularity != nil {
-		book.Popularity = *requestBody.Popularity
+	// Resolve category names or slugs to category records. The first category is the
+	// book's main category
+	var categories []*data.Category
+	if requestBody.Categories != nil || requestBody.Category != nil {
+		names := requestBody.Categories
+		if names == nil {
+			// Only the main category changes, so keep the book's other categories
+			names = []string{*requestBody.Category}
+			for _, name := range book.Categories {
+				if !strings.EqualFold(name, book.Category) && !strings.EqualFold(name, *requestBody.Category) {
+					names = append(names, name)
+				}
+			}
+		}
+		categories, err = s.resolveCategories(names)
+		if err != nil {
+			return nil, err
+		}
+		book.Categories = make([]string, len(categories))
+		for i, category := range categories {
+			book.Categories[i] = names[i]
+			if category != nil {
+				book.Categories[i] = category.Name
+			}
+		}
+		book.Category = ""
+		if len(book.Categories) > 0 {
+			book.Category = book.Categories[0]
+		}
 	}
 	v := validator.New()
+	for _, category := range categories {
+		v.Check(category != nil, "categories", "must only contain existing categories")
+	}
 	if data.ValidateBook(v, book); !v.Valid() {
 		ErrFailedValidation = s.failedValidation(v.Errors)
 		return nil, ErrFailedValidation
 	}
-	err = s.repo.UpdateBook(book)
+	err = s.repo.UpdateBook(book, authors)
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrEditConflict):
@@ -184,50 +293,35 @@ func (s *service) UpdateBook(bookID int64, requestBody dto.UpdateBookRequestBody
 			return nil, err
 		}
 	}
-	// Also update books_categories table to detect the right book associated with a category
-	if requestBody.Category != nil {
-		// First check if a category for a book exists in books_categories table
-		category, err := s.repo.GetCategoryForBook(book.ID)
+	if categories != nil {
+		categoryIDs := make([]int64, len(categories))
+		for i, category := range categories {
+			categoryIDs[i] = category.ID
+		}
+		err = s.repo.SetCategoriesForBook(book.ID, categoryIDs)
 		if err != nil {
-			switch {
-			// If ErrRecordNotFound is returned, it means no category was found,
-			// so add a category for the book in the books_categories table
-			case errors.Is(err, repository.ErrRecordNotFound):
-				err := s.repo.AddCategoryForBook(book.ID, book.Category)
-				if err != nil {
-					switch {
-					case errors.Is(err, repository.ErrDuplicateRecord):
-						return nil, ErrDuplicateRecord
-					default:
-						return nil, err
-					}
-				}
-			default:
-				return nil, err
-			}
-		} else {
-			// At this point, a category already exists in the books_categories table.
-			// So, delete the record and add a new record with updated info
-			err := s.repo.DeleteCategoryForBook(book.ID, category.ID)
-			if err != nil {
-				switch {
-				case errors.Is(err, repository.ErrRecordNotFound):
-					return nil, ErrRecordNotFound
-				default:
-					return nil, err
-				}
-			}
-			err = s.repo.AddCategoryForBook(book.ID, book.Category)
-			if err != nil {
-				switch {
-				case errors.Is(err, repository.ErrDuplicateRecord):
-					return nil, ErrDuplicateRecord
-				default:
-					return nil, err
-				}
-			}
+			return nil, err
+		}
+	}
+	// Similar books depend on the book's metadata, so refresh them when it changes
+	if requestBody.Title != nil || requestBody.Description != nil || requestBody.Author != nil || categories != nil ||
+		requestBody.Publisher != nil || requestBody.Language != nil || requestBody.Series != nil {
+		s.refreshBookContentInBackground(book)
+	}
+	// Duplicates are detected, and requests fulfilled, by ISBN or by title, authors and year
+	if requestBody.Title != nil || requestBody.Author != nil || requestBody.Year != nil ||
+		requestBody.Isbn10 != nil || requestBody.Isbn13 != nil {
+		s.refreshBookDuplicatesInBackground(book.ID)
+		s.fulfilRequestsInBackground(book.ID)
+	}
+	// Propose metadata and fetch a cover as soon as the book's ISBN is known
+	if requestBody.Isbn10 != nil || requestBody.Isbn13 != nil {
+		if enrich {
+			s.enrichBookInBackground(book)
 		}
+		s.fetchBookCoverInBackground(book)
 	}
+	s.addSavedSearchMatchesInBackground(book.ID)
 	return book, nil
 }
 
@@ -274,13 +368,14 @@ func (s *service) UpdateBookCover(bookID int64, r *http.Request) (*data.Book, er
 	if err != nil {
 		return nil, err
 	}
-	s3CoverPath, err := s.uploadFileToS3(s3Client, buffer, mtype, fileHeader, data.ScopeCover)
+	s3CoverPath, err := s.uploadFileToS3(s3Client, buffer, mtype, fileHeader.Filename, data.ScopeCover)
 	if err != nil {
 		return nil, err
 	}
 	book.CoverPath = s3CoverPath
+	book.CoverSource = data.CoverSourceOwner
 	// Update book record
-	err = s.repo.UpdateBook(book)
+	err = s.repo.UpdateBook(book, nil)
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrEditConflict):
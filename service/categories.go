@@ -2,24 +2,37 @@ package service
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/data/dto"
 	"github.com/emzola/bibliotheca/internal/validator"
 	"github.com/emzola/bibliotheca/repository"
 )
 
 type categories interface {
 	GetCategory(categoryID int64) (*data.Category, error)
-	ShowCategory(categoryID int64, filters data.Filters) ([]*data.Book, data.Metadata, error)
-	ListCategories() ([]*data.Category, error)
+	GetCategoryBySlug(slug string) (*data.Category, error)
+	ShowCategory(categoryID int64, includeSubcategories bool, filters data.Filters) ([]*data.Book, data.Metadata, error)
+	ListCategories(tree bool) ([]*data.Category, error)
+	CreateCategory(requestBody dto.CreateCategoryRequestBody) (*data.Category, error)
+	UpdateCategory(categoryID int64, requestBody dto.UpdateCategoryRequestBody) (*data.Category, error)
+	DeleteCategory(categoryID, moveToID int64) error
+	MergeCategories(categoryID int64, sourceIDs []int64) (*data.Category, error)
+	FollowCategory(userID, categoryID int64) error
+	UnfollowCategory(userID, categoryID int64) error
 }
 
-// ListCategories service retrieves a list of categories.
-func (s *service) ListCategories() ([]*data.Category, error) {
+// ListCategories service retrieves a list of categories. When tree is true, subcategories
+// are nested under their parent categories.
+func (s *service) ListCategories(tree bool) ([]*data.Category, error) {
 	categories, err := s.repo.GetAllCategories()
 	if err != nil {
 		return nil, err
 	}
+	if tree {
+		return data.CategoryTree(categories), nil
+	}
 	return categories, nil
 }
 
@@ -37,16 +50,265 @@ func (s *service) GetCategory(categoryID int64) (*data.Category, error) {
 	return category, nil
 }
 
+// GetCategoryBySlug service retrieves a category record by its slug.
+func (s *service) GetCategoryBySlug(slug string) (*data.Category, error) {
+	category, err := s.repo.GetCategoryBySlug(slug)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return category, nil
+}
+
 // ShowCategory displays details of a specifi category and its book/metadata content.
-func (s *service) ShowCategory(categoryID int64, filters data.Filters) ([]*data.Book, data.Metadata, error) {
+// Books in its subcategories are included when includeSubcategories is true.
+func (s *service) ShowCategory(categoryID int64, includeSubcategories bool, filters data.Filters) ([]*data.Book, data.Metadata, error) {
 	v := validator.New()
 	if data.ValidateFilters(v, filters); !v.Valid() {
 		ErrFailedValidation = s.failedValidation(v.Errors)
 		return nil, data.Metadata{}, ErrFailedValidation
 	}
-	books, metadata, err := s.repo.GetAllBooksForCategory(categoryID, filters)
+	_, err := s.GetCategory(categoryID)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	books, metadata, err := s.repo.GetAllBooksForCategory(categoryID, includeSubcategories, filters)
 	if err != nil {
 		return nil, data.Metadata{}, err
 	}
 	return books, metadata, nil
 }
+
+// FollowCategory service makes a user follow a category.
+func (s *service) FollowCategory(userID, categoryID int64) error {
+	_, err := s.GetCategory(categoryID)
+	if err != nil {
+		return err
+	}
+	err = s.repo.FollowCategory(userID, categoryID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrDuplicateRecord):
+			return ErrDuplicateRecord
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// UnfollowCategory service makes a user stop following a category.
+func (s *service) UnfollowCategory(userID, categoryID int64) error {
+	err := s.repo.UnfollowCategory(userID, categoryID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateCategory service creates a new category, optionally as a subcategory of another category.
+func (s *service) CreateCategory(requestBody dto.CreateCategoryRequestBody) (*data.Category, error) {
+	category := &data.Category{
+		ParentID: requestBody.ParentID,
+		Name:     strings.Join(strings.Fields(requestBody.Name), " "),
+		Slug:     requestBody.Slug,
+	}
+	if category.Slug == "" {
+		category.Slug = data.Slugify(category.Name)
+	}
+	v := validator.New()
+	if data.ValidateCategory(v, category); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	if category.ParentID != 0 {
+		_, err := s.GetCategory(category.ParentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := s.repo.CreateCategory(category)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrDuplicateRecord):
+			return nil, ErrDuplicateRecord
+		default:
+			return nil, err
+		}
+	}
+	return category, nil
+}
+
+// UpdateCategory service renames a category, changes its slug or moves it under another parent.
+func (s *service) UpdateCategory(categoryID int64, requestBody dto.UpdateCategoryRequestBody) (*data.Category, error) {
+	category, err := s.GetCategory(categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if requestBody.Name != nil {
+		category.Name = strings.Join(strings.Fields(*requestBody.Name), " ")
+	}
+	if requestBody.Slug != nil {
+		category.Slug = *requestBody.Slug
+	}
+	if requestBody.ParentID != nil {
+		category.ParentID = *requestBody.ParentID
+	}
+	v := validator.New()
+	if data.ValidateCategory(v, category); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	if requestBody.ParentID != nil && category.ParentID != 0 {
+		_, err := s.GetCategory(category.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		// Moving a category under one of its own subcategories would create a cycle
+		isDescendant, err := s.repo.IsCategoryDescendant(category.ParentID, category.ID)
+		if err != nil {
+			return nil, err
+		}
+		if isDescendant {
+			v.AddError("parent_id", "must not be a subcategory of the category")
+			ErrFailedValidation = s.failedValidation(v.Errors)
+			return nil, ErrFailedValidation
+		}
+	}
+	err = s.repo.UpdateCategory(category)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrEditConflict):
+			return nil, ErrEditConflict
+		case errors.Is(err, repository.ErrDuplicateRecord):
+			return nil, ErrDuplicateRecord
+		default:
+			return nil, err
+		}
+	}
+	return category, nil
+}
+
+// DeleteCategory service deletes a category. Its books are moved to the category with an ID of
+// moveToID, or to its parent category when moveToID is 0, and its subcategories are moved to its
+// parent. A top-level category with books can only be deleted when moveToID is set.
+func (s *service) DeleteCategory(categoryID, moveToID int64) error {
+	category, err := s.GetCategory(categoryID)
+	if err != nil {
+		return err
+	}
+	v := validator.New()
+	v.Check(moveToID >= 0, "move_to", "must not be negative")
+	v.Check(moveToID != categoryID, "move_to", "must not be the category being deleted")
+	if moveToID == 0 {
+		moveToID = category.ParentID
+	}
+	v.Check(moveToID != 0 || category.BooksCount == 0, "move_to", "must be provided for a top-level category with books")
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return ErrFailedValidation
+	}
+	if moveToID != 0 {
+		// The category's subcategories are kept, so they must not receive its books
+		isDescendant, err := s.repo.IsCategoryDescendant(moveToID, categoryID)
+		if err != nil {
+			return err
+		}
+		if isDescendant {
+			v.AddError("move_to", "must not be a subcategory of the category being deleted")
+			ErrFailedValidation = s.failedValidation(v.Errors)
+			return ErrFailedValidation
+		}
+		_, err = s.GetCategory(moveToID)
+		if err != nil {
+			return err
+		}
+	}
+	err = s.repo.DeleteCategory(categoryID, moveToID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// MergeCategories service moves the books and subcategories of the source categories to a
+// category and deletes the sources.
+func (s *service) MergeCategories(categoryID int64, sourceIDs []int64) (*data.Category, error) {
+	v := validator.New()
+	v.Check(len(sourceIDs) > 0, "source_ids", "must contain at least 1 category")
+	v.Check(len(sourceIDs) <= 50, "source_ids", "must not contain more than 50 categories")
+	v.Check(validator.UniqueInt64(sourceIDs), "source_ids", "must not contain duplicate values")
+	for _, id := range sourceIDs {
+		v.Check(id != categoryID, "source_ids", "must not contain the category being merged into")
+	}
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	_, err := s.GetCategory(categoryID)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range sourceIDs {
+		_, err := s.GetCategory(id)
+		if err != nil {
+			return nil, err
+		}
+		// The category would be deleted along with the source it belongs to
+		isDescendant, err := s.repo.IsCategoryDescendant(categoryID, id)
+		if err != nil {
+			return nil, err
+		}
+		if isDescendant {
+			v.AddError("source_ids", "must not contain a parent category of the category being merged into")
+			ErrFailedValidation = s.failedValidation(v.Errors)
+			return nil, ErrFailedValidation
+		}
+	}
+	err = s.repo.MergeCategories(categoryID, sourceIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return s.GetCategory(categoryID)
+}
+
+// resolveCategories looks up the category record for each name or slug. Names that do
+// not match an existing category resolve to nil.
+func (s *service) resolveCategories(names []string) ([]*data.Category, error) {
+	categories := make([]*data.Category, len(names))
+	for i, name := range names {
+		category, err := s.repo.GetCategoryByName(strings.TrimSpace(name))
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			category, err = s.repo.GetCategoryBySlug(data.Slugify(name))
+		}
+		if err != nil {
+			switch {
+			case errors.Is(err, repository.ErrRecordNotFound):
+				continue
+			default:
+				return nil, err
+			}
+		}
+		categories[i] = category
+	}
+	return categories, nil
+}
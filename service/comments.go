@@ -2,6 +2,7 @@ package service
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/emzola/bibliotheca/data"
 	"github.com/emzola/bibliotheca/internal/validator"
@@ -13,11 +14,13 @@ type comments interface {
 	GetComment(commentID int64) (*data.Comment, error)
 	UpdateComment(commentID int64, content *string) (*data.Comment, error)
 	DeleteComment(commentID int64) error
-	ListComments(booklistID int64) ([]*data.Comment, error)
+	ListComments(booklistID int64, userID int64, depth int, filters data.Filters) ([]*data.Comment, data.Metadata, error)
+	GetCommentThread(booklistID int64, commentID int64, userID int64, depth int) (*data.Comment, error)
 	CreateCommentReply(userID int64, booklistID int64, commentID int64, content string) (*data.Comment, error)
 }
 
-// CreateComment service creates a new comment.
+// CreateComment service creates a new comment and notifies the booklist's owner and the
+// users mentioned in it.
 func (s *service) CreateComment(userID int64, booklistID int64, username string, content string) (*data.Comment, error) {
 	comment := &data.Comment{
 		BooklistID: booklistID,
@@ -30,10 +33,31 @@ func (s *service) CreateComment(userID int64, booklistID int64, username string,
 		ErrFailedValidation = s.failedValidation(v.Errors)
 		return nil, ErrFailedValidation
 	}
-	err := s.repo.CreateComment(comment)
+	booklist, err := s.repo.GetBooklist(booklistID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	err = s.repo.CreateComment(comment)
+	if err != nil {
+		return nil, err
+	}
+	err = s.mentionInComment(comment, booklist)
 	if err != nil {
 		return nil, err
 	}
+	notification := data.Notification{
+		Event:      data.NotificationBooklistComment,
+		ActorID:    userID,
+		Message:    fmt.Sprintf("commented on your booklist \"%s\"", booklist.Name),
+		BooklistID: booklist.ID,
+		CommentID:  comment.ID,
+	}
+	s.notifyInBackground(notification, []int64{booklist.UserID}, fmt.Sprintf("/booklists/%d/comments/%d", booklist.ID, comment.ID))
 	return comment, nil
 }
 
@@ -51,7 +75,8 @@ func (s *service) GetComment(commentID int64) (*data.Comment, error) {
 	return comment, nil
 }
 
-// UpdateComment service updates the details of a comment.
+// UpdateComment service updates the details of a comment and notifies the users newly mentioned
+// in it. Deleted comments can't be updated.
 func (s *service) UpdateComment(commentID int64, content *string) (*data.Comment, error) {
 	comment, err := s.repo.GetComment(commentID)
 	if err != nil {
@@ -62,13 +87,25 @@ func (s *service) UpdateComment(commentID int64, content *string) (*data.Comment
 			return nil, err
 		}
 	}
+	if comment.Deleted {
+		return nil, ErrRecordNotFound
+	}
+	if content != nil {
+		comment.Content = *content
+	}
 	v := validator.New()
 	if data.ValidateComment(v, comment); !v.Valid() {
 		ErrFailedValidation = s.failedValidation(v.Errors)
 		return nil, ErrFailedValidation
 	}
-	if content != nil {
-		comment.Content = *content
+	booklist, err := s.repo.GetBooklist(comment.BooklistID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
 	}
 	err = s.repo.UpdateComment(comment)
 	if err != nil {
@@ -79,10 +116,15 @@ func (s *service) UpdateComment(commentID int64, content *string) (*data.Comment
 			return nil, err
 		}
 	}
+	err = s.mentionInComment(comment, booklist)
+	if err != nil {
+		return nil, err
+	}
 	return comment, nil
 }
 
-// DeleteComment service deletes a comment.
+// DeleteComment service deletes a comment. A comment with replies is replaced by a
+// placeholder so that the conversation stays readable.
 func (s *service) DeleteComment(commentID int64) error {
 	err := s.repo.DeleteComment(commentID)
 	if err != nil {
@@ -96,17 +138,102 @@ func (s *service) DeleteComment(commentID int64) error {
 	return nil
 }
 
-// ListComments service retrieves all comments for a booklist.
-func (s *service) ListComments(booklistID int64) ([]*data.Comment, error) {
-	comments, err := s.repo.GetAllComments(booklistID)
+// ListComments service retrieves a paginated list of the top-level comments of a booklist,
+// each with its replies nested below it down to depth levels. Every comment has its reactions,
+// including whether the user with userID made them.
+func (s *service) ListComments(booklistID int64, userID int64, depth int, filters data.Filters) ([]*data.Comment, data.Metadata, error) {
+	v := validator.New()
+	data.ValidateCommentDepth(v, depth)
+	if data.ValidateFilters(v, filters); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, data.Metadata{}, ErrFailedValidation
+	}
+	comments, metadata, err := s.repo.GetAllComments(booklistID, filters)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	err = s.addCommentReplies(comments, userID, depth)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	return comments, metadata, nil
+}
+
+// GetCommentThread service retrieves a comment of a booklist with its replies nested below
+// it down to depth levels. Every comment has its reactions, including whether the user with
+// userID made them.
+func (s *service) GetCommentThread(booklistID int64, commentID int64, userID int64, depth int) (*data.Comment, error) {
+	v := validator.New()
+	if data.ValidateCommentDepth(v, depth); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	comment, err := s.GetComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.BooklistID != booklistID {
+		return nil, ErrRecordNotFound
+	}
+	err = s.addCommentReplies([]*data.Comment{comment}, userID, depth)
 	if err != nil {
 		return nil, err
 	}
-	return comments, nil
+	return comment, nil
 }
 
-// CreateCommentReply service creates a creply for a comment.
+// addCommentReplies nests the replies to comments below them down to depth levels. Replies
+// are retrieved oldest first, so each reply's parent is already in the tree. The reactions to
+// and mentions in every comment in the tree are added as well, except to deleted placeholders.
+func (s *service) addCommentReplies(comments []*data.Comment, userID int64, depth int) error {
+	parentIDs := make([]int64, len(comments))
+	tree := make(map[int64]*data.Comment, len(comments))
+	for i, comment := range comments {
+		parentIDs[i] = comment.ID
+		tree[comment.ID] = comment
+	}
+	replies, err := s.repo.GetCommentReplies(parentIDs, depth)
+	if err != nil {
+		return err
+	}
+	for _, reply := range replies {
+		tree[reply.ID] = reply
+		if parent, ok := tree[reply.ParentID]; ok {
+			parent.Replies = append(parent.Replies, reply)
+		}
+	}
+	commentIDs := make([]int64, 0, len(tree))
+	for id := range tree {
+		commentIDs = append(commentIDs, id)
+	}
+	reactions, err := s.repo.GetCommentReactions(commentIDs, userID)
+	if err != nil {
+		return err
+	}
+	mentions, err := s.repo.GetCommentMentions(commentIDs)
+	if err != nil {
+		return err
+	}
+	for id, comment := range tree {
+		if !comment.Deleted {
+			comment.Reactions = reactions[id]
+			comment.Mentions = data.ResolveMentions(comment.Content, mentions[id])
+		}
+	}
+	return nil
+}
+
+// CreateCommentReply service creates a reply to a comment of a booklist, and notifies the
+// comment's author, the booklist's owner and the users mentioned in the reply. Deleted comments
+// can't be replied to.
 func (s *service) CreateCommentReply(userID int64, booklistID int64, commentID int64, content string) (*data.Comment, error) {
+	parent, err := s.GetComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if parent.BooklistID != booklistID || parent.Deleted {
+		return nil, ErrRecordNotFound
+	}
 	comment := &data.Comment{
 		ParentID:   commentID,
 		BooklistID: booklistID,
@@ -118,9 +245,37 @@ func (s *service) CreateCommentReply(userID int64, booklistID int64, commentID i
 		ErrFailedValidation = s.failedValidation(v.Errors)
 		return nil, ErrFailedValidation
 	}
-	err := s.repo.CreateReply(comment)
+	booklist, err := s.repo.GetBooklist(booklistID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	err = s.repo.CreateReply(comment)
 	if err != nil {
 		return nil, err
 	}
+	err = s.mentionInComment(comment, booklist)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/booklists/%d/comments/%d", booklist.ID, comment.ID)
+	notification := data.Notification{
+		Event:      data.NotificationCommentReply,
+		ActorID:    userID,
+		Message:    fmt.Sprintf("replied to your comment on the booklist \"%s\"", booklist.Name),
+		BooklistID: booklist.ID,
+		CommentID:  comment.ID,
+	}
+	s.notifyInBackground(notification, []int64{parent.UserID}, path)
+	// The booklist's owner is already notified of replies to their own comments
+	if booklist.UserID != parent.UserID {
+		notification.Event = data.NotificationBooklistComment
+		notification.Message = fmt.Sprintf("commented on your booklist \"%s\"", booklist.Name)
+		s.notifyInBackground(notification, []int64{booklist.UserID}, path)
+	}
 	return comment, nil
 }
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/emzola/bibliotheca/clients"
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/repository"
+	"github.com/gabriel-vasile/mimetype"
+	"golang.org/x/time/rate"
+)
+
+type covers interface {
+	BackfillCovers(ctx context.Context) error
+}
+
+// BackfillCovers service fetches a cover from the covers API for every book and request
+// that has an ISBN and no cover. Fetches are rate limited so that the API isn't flooded.
+func (s *service) BackfillCovers(ctx context.Context) error {
+	limiter := rate.NewLimiter(rate.Limit(s.config.Covers.BackfillRps), 1)
+	fetched, missing := 0, 0
+	var afterID int64
+	for {
+		books, err := s.repo.GetBooksMissingCover(afterID, 100)
+		if err != nil {
+			return err
+		}
+		if len(books) == 0 {
+			break
+		}
+		for _, book := range books {
+			afterID = book.ID
+			if err := limiter.Wait(ctx); err != nil {
+				return err
+			}
+			err := s.fetchBookCover(ctx, book)
+			switch {
+			case err == nil:
+				fetched++
+			case errors.Is(err, clients.ErrNotFound):
+				missing++
+			case errors.Is(err, repository.ErrEditConflict):
+			default:
+				s.logger.PrintError(err, map[string]string{"book_id": strconv.FormatInt(book.ID, 10)})
+			}
+		}
+	}
+	afterID = 0
+	for {
+		requests, err := s.repo.GetRequestsMissingCover(afterID, 100)
+		if err != nil {
+			return err
+		}
+		if len(requests) == 0 {
+			break
+		}
+		for _, request := range requests {
+			afterID = request.ID
+			if err := limiter.Wait(ctx); err != nil {
+				return err
+			}
+			err := s.fetchRequestCover(ctx, request)
+			switch {
+			case err == nil:
+				fetched++
+			case errors.Is(err, clients.ErrNotFound):
+				missing++
+			case errors.Is(err, repository.ErrEditConflict):
+			default:
+				s.logger.PrintError(err, map[string]string{"request_id": strconv.FormatInt(request.ID, 10)})
+			}
+		}
+	}
+	s.logger.PrintInfo("covers backfilled", map[string]string{
+		"fetched": strconv.Itoa(fetched),
+		"missing": strconv.Itoa(missing),
+	})
+	return nil
+}
+
+// fetchBookCover fetches a cover for a book by its ISBN. The cover is only set when the
+// book still has no cover, so that covers uploaded by the owner are never replaced.
+func (s *service) fetchBookCover(ctx context.Context, book *data.Book) error {
+	isbn := book.Isbn13
+	if isbn == "" {
+		isbn = book.Isbn10
+	}
+	s3Client, err := clients.NewS3Client(s.config)
+	if err != nil {
+		return err
+	}
+	coverPath, err := s.fetchCover(ctx, s3Client, isbn)
+	if err != nil {
+		return err
+	}
+	err = s.repo.SetFetchedBookCover(book.ID, coverPath, s.covers.Name())
+	if err != nil {
+		s.deleteFetchedCover(s3Client, coverPath)
+		return err
+	}
+	return nil
+}
+
+// fetchRequestCover fetches a cover for a request by its ISBN.
+func (s *service) fetchRequestCover(ctx context.Context, request *data.Request) error {
+	s3Client, err := clients.NewS3Client(s.config)
+	if err != nil {
+		return err
+	}
+	coverPath, err := s.fetchCover(ctx, s3Client, request.Isbn)
+	if err != nil {
+		return err
+	}
+	err = s.repo.SetRequestCover(request.ID, coverPath)
+	if err != nil {
+		s.deleteFetchedCover(s3Client, coverPath)
+		return err
+	}
+	return nil
+}
+
+// deleteFetchedCover deletes a fetched cover from S3 when it couldn't be set, so that covers
+// of books and requests that got a cover in the meantime aren't left in the bucket.
+func (s *service) deleteFetchedCover(s3Client *s3.Client, coverPath string) {
+	err := s.deleteCoverFromS3(s3Client, coverPath)
+	if err != nil {
+		s.logger.PrintError(err, map[string]string{"cover_path": coverPath})
+	}
+}
+
+// fetchCover fetches the cover of the edition with an ISBN from the covers API, checks that
+// it is a JPEG or PNG image and uploads it to S3 like the covers uploaded by users.
+func (s *service) fetchCover(ctx context.Context, s3Client *s3.Client, isbn string) (string, error) {
+	buffer, err := s.covers.GetCover(ctx, isbn)
+	if err != nil {
+		return "", err
+	}
+	mtype := mimetype.Detect(buffer)
+	if !validator.Mime(mtype, "image/jpeg", "image/png") {
+		return "", fmt.Errorf("cover for %s: %w", isbn, ErrUnsupportedMediaType)
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(buffer))
+	if err != nil {
+		return "", fmt.Errorf("cover for %s: %w", isbn, err)
+	}
+	// Placeholder images are a single pixel wide
+	if config.Width <= 1 || config.Height <= 1 {
+		return "", clients.ErrNotFound
+	}
+	return s.uploadFileToS3(s3Client, buffer, mtype, "cover"+mtype.Extension(), data.ScopeCover)
+}
+
+// fetchBookCoverInBackground fetches a cover for a book with an ISBN and no cover.
+func (s *service) fetchBookCoverInBackground(book *data.Book) {
+	if book.CoverPath != "" || (book.Isbn10 == "" && book.Isbn13 == "") {
+		return
+	}
+	s.background(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		err := s.fetchBookCover(ctx, book)
+		if err != nil && !errors.Is(err, clients.ErrNotFound) && !errors.Is(err, repository.ErrEditConflict) {
+			s.logger.PrintError(err, nil)
+		}
+	})
+}
+
+// fetchRequestCoverInBackground fetches a cover for a request with an ISBN and no cover.
+func (s *service) fetchRequestCoverInBackground(request *data.Request) {
+	if request.CoverPath != "" || request.Isbn == "" {
+		return
+	}
+	s.background(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		err := s.fetchRequestCover(ctx, request)
+		if err != nil && !errors.Is(err, clients.ErrNotFound) && !errors.Is(err, repository.ErrEditConflict) {
+			s.logger.PrintError(err, nil)
+		}
+	})
+}
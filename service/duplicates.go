@@ -0,0 +1,80 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/repository"
+)
+
+type duplicates interface {
+	ListBookDuplicates(user *data.User, filters data.Filters) ([]*data.BookDuplicate, data.Metadata, error)
+	MergeBooks(bookID int64, sourceIDs []int64) (*data.Book, error)
+}
+
+// ListBookDuplicates service retrieves the likely duplicates of the books of a user.
+// Admins see the likely duplicates of all books.
+func (s *service) ListBookDuplicates(user *data.User, filters data.Filters) ([]*data.BookDuplicate, data.Metadata, error) {
+	v := validator.New()
+	if data.ValidateFilters(v, filters); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, data.Metadata{}, ErrFailedValidation
+	}
+	ownerID := user.ID
+	if user.IsAdmin() {
+		ownerID = 0
+	}
+	bookDuplicates, metadata, err := s.repo.GetAllBookDuplicates(ownerID, filters)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	return bookDuplicates, metadata, nil
+}
+
+// MergeBooks service moves the reviews, favourites, downloads, booklist memberships,
+// categories, tags and fulfilled requests of the source books to a book and deletes the
+// sources. Users who reviewed more than one of the books keep a single review.
+func (s *service) MergeBooks(bookID int64, sourceIDs []int64) (*data.Book, error) {
+	v := validator.New()
+	v.Check(len(sourceIDs) > 0, "source_ids", "must contain at least 1 book")
+	v.Check(len(sourceIDs) <= 20, "source_ids", "must not contain more than 20 books")
+	v.Check(validator.UniqueInt64(sourceIDs), "source_ids", "must not contain duplicate values")
+	for _, id := range sourceIDs {
+		v.Check(id != bookID, "source_ids", "must not contain the book being merged into")
+	}
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	_, err := s.GetBook(bookID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.MergeBooks(bookID, sourceIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	book, err := s.GetBook(bookID)
+	if err != nil {
+		return nil, err
+	}
+	s.refreshBookDuplicatesInBackground(book.ID)
+	return book, nil
+}
+
+// refreshBookDuplicatesInBackground records the likely duplicates of a book without
+// holding up the response.
+func (s *service) refreshBookDuplicatesInBackground(bookID int64) {
+	s.background(func() {
+		err := s.repo.RefreshDuplicatesForBook(bookID)
+		if err != nil {
+			s.logger.PrintError(err, nil)
+		}
+	})
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/bibliotheca/clients"
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/repository"
+)
+
+type enrichments interface {
+	EnrichBook(user *data.User, bookID int64) (*data.BookEnrichment, error)
+	GetBookEnrichment(user *data.User, bookID int64) (*data.BookEnrichment, error)
+	AcceptBookEnrichment(user *data.User, bookID int64, requestBody dto.AcceptBookEnrichmentRequestBody) (*data.Book, error)
+	DeleteBookEnrichment(user *data.User, bookID int64) error
+}
+
+// EnrichBook service fetches the metadata of a book from the metadata providers by its ISBN and proposes
+// it for the book's empty fields. The proposal is only applied once the owner accepts it.
+func (s *service) EnrichBook(user *data.User, bookID int64) (*data.BookEnrichment, error) {
+	book, err := s.getManagedBook(user, bookID)
+	if err != nil {
+		return nil, err
+	}
+	v := validator.New()
+	v.Check(book.Isbn10 != "" || book.Isbn13 != "", "isbn_13", "must be set before the book can be enriched")
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	return s.enrichBook(book)
+}
+
+// GetBookEnrichment service retrieves the metadata proposed for a book with the changes it
+// would make to the book.
+func (s *service) GetBookEnrichment(user *data.User, bookID int64) (*data.BookEnrichment, error) {
+	book, err := s.getManagedBook(user, bookID)
+	if err != nil {
+		return nil, err
+	}
+	enrichment, err := s.repo.GetBookEnrichment(book.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	// The book may have been updated since, so only propose fields that are still empty
+	enrichment.Metadata = data.ProposeBookMetadata(book, enrichment.Metadata)
+	enrichment.Changes = data.BookFieldChanges(book, enrichment.Metadata)
+	return enrichment, nil
+}
+
+// AcceptBookEnrichment service applies the accepted fields of the metadata proposed for a book
+// and discards the proposal. Fields the owner has filled in since the proposal was made are left
+// as they are, and the book isn't enriched again. Subjects are added to the book as approved tags.
+func (s *service) AcceptBookEnrichment(user *data.User, bookID int64, requestBody dto.AcceptBookEnrichmentRequestBody) (*data.Book, error) {
+	book, err := s.getManagedBook(user, bookID)
+	if err != nil {
+		return nil, err
+	}
+	enrichment, err := s.repo.GetBookEnrichment(book.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	fields := requestBody.Fields
+	if len(fields) == 0 {
+		fields = data.EnrichableBookFields
+	}
+	v := validator.New()
+	for _, field := range fields {
+		v.Check(validator.In(field, data.EnrichableBookFields...), "fields", "must only contain author, publisher, year, page_count, description, isbn_10, isbn_13 or tags")
+	}
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	metadata := data.ProposeBookMetadata(book, enrichment.Metadata)
+	var updateBody dto.UpdateBookRequestBody
+	updated := false
+	for _, field := range fields {
+		switch {
+		case field == "author" && len(metadata.Authors) > 0:
+			updateBody.Author = metadata.Authors
+		case field == "publisher" && metadata.Publisher != "":
+			updateBody.Publisher = &metadata.Publisher
+		case field == "year" && metadata.Year != 0:
+			updateBody.Year = &metadata.Year
+		case field == "page_count" && metadata.PageCount != 0:
+			updateBody.PageCount = &metadata.PageCount
+		case field == "description" && metadata.Description != "":
+			updateBody.Description = &metadata.Description
+		case field == "isbn_10" && metadata.Isbn10 != "":
+			updateBody.Isbn10 = &metadata.Isbn10
+		case field == "isbn_13" && metadata.Isbn13 != "":
+			updateBody.Isbn13 = &metadata.Isbn13
+		default:
+			continue
+		}
+		updated = true
+	}
+	// Both ISBNs are replaced together, so keep the one that isn't accepted
+	if updateBody.Isbn10 != nil && updateBody.Isbn13 == nil {
+		updateBody.Isbn13 = &book.Isbn13
+	}
+	if updateBody.Isbn13 != nil && updateBody.Isbn10 == nil {
+		updateBody.Isbn10 = &book.Isbn10
+	}
+	if updated {
+		_, err = s.updateBook(book, updateBody, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if validator.In("tags", fields...) && len(metadata.Subjects) > 0 {
+		err = s.repo.AddTagsForBook(bookID, user.ID, metadata.Subjects, data.TagStatusApproved)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = s.repo.DeleteBookEnrichment(bookID)
+	if err != nil && !errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, err
+	}
+	return s.GetBook(bookID)
+}
+
+// DeleteBookEnrichment service discards the metadata proposed for a book.
+func (s *service) DeleteBookEnrichment(user *data.User, bookID int64) error {
+	book, err := s.getManagedBook(user, bookID)
+	if err != nil {
+		return err
+	}
+	err = s.repo.DeleteBookEnrichment(book.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// enrichBook fetches the metadata of a book by its ISBN and saves the metadata for
+// the book's empty fields as a proposal.
+func (s *service) enrichBook(book *data.Book) (*data.BookEnrichment, error) {
+	isbn := book.Isbn13
+	if isbn == "" {
+		isbn = book.Isbn10
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	metadata, err := s.metadata.LookupISBN(ctx, isbn)
+	if err != nil {
+		switch {
+		case errors.Is(err, clients.ErrNotFound):
+			return nil, ErrMetadataNotFound
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrMetadataUnavailable, err)
+		}
+	}
+	enrichment := &data.BookEnrichment{
+		BookID:   book.ID,
+		Source:   metadata.Source,
+		Metadata: data.ProposeBookMetadata(book, *metadata),
+	}
+	enrichment.Changes = data.BookFieldChanges(book, enrichment.Metadata)
+	if len(enrichment.Changes) == 0 {
+		return enrichment, nil
+	}
+	err = s.repo.SetBookEnrichment(enrichment)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return enrichment, nil
+}
+
+// enrichBookInBackground proposes metadata for a book once its ISBN is known, for the
+// owner to review.
+func (s *service) enrichBookInBackground(book *data.Book) {
+	if book.Isbn10 == "" && book.Isbn13 == "" {
+		return
+	}
+	s.background(func() {
+		_, err := s.enrichBook(book)
+		if err != nil && !errors.Is(err, ErrMetadataNotFound) {
+			s.logger.PrintError(err, nil)
+		}
+	})
+}
+
+// getManagedBook retrieves a book that the user owns or, for admins, any book.
+func (s *service) getManagedBook(user *data.User, bookID int64) (*data.Book, error) {
+	book, err := s.GetBook(bookID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canManageBook(user, book) {
+		return nil, ErrNotPermitted
+	}
+	return book, nil
+}
@@ -16,6 +16,9 @@ var (
 	ErrBadRequest           = errors.New("bad request")
 	ErrDuplicateRecord      = errors.New("duplicate record")
 	ErrNotPermitted         = errors.New("not permitted")
+	ErrMetadataNotFound     = errors.New("metadata not found")
+	ErrMetadataUnavailable  = errors.New("metadata unavailable")
+	ErrJobRunning           = errors.New("job already running")
 )
 
 // failedValidation loops through a validation error map and
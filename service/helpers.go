@@ -6,9 +6,7 @@ import (
 	"crypto/rand"
 	"encoding/base32"
 	"fmt"
-	"io"
 	"mime/multipart"
-	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -20,6 +18,11 @@ import (
 	"github.com/gabriel-vasile/mimetype"
 )
 
+// canManageBook checks whether a user owns a book or is an admin.
+func (s *service) canManageBook(user *data.User, book *data.Book) bool {
+	return book.UserID == user.ID || user.IsAdmin()
+}
+
 // detectMimeType detects and validates the content type of a multipart file to ensure it is supported.
 // This method is a workaround to the problem encountered when trying to detect content type directly
 // inside createbookHandler (i.e. the multipart file becomes corrupted once it's parsed to detect its mime type).
@@ -34,8 +37,8 @@ func (s *service) detectMimeType(file multipart.File, fileHeader *multipart.File
 	return buffer, mtype, nil
 }
 
-// uploadFileToS3 saves a form file to aws bucket and returns the key to the s3 file or an error if any.
-func (s *service) uploadFileToS3(client *s3.Client, buffer []byte, mtype *mimetype.MIME, fileHeader *multipart.FileHeader, scope string) (string, error) {
+// uploadFileToS3 saves a file to aws bucket and returns the key to the s3 file or an error if any.
+func (s *service) uploadFileToS3(client *s3.Client, buffer []byte, mtype *mimetype.MIME, filename string, scope string) (string, error) {
 	randomBytes := make([]byte, 16)
 	_, err := rand.Read(randomBytes)
 	if err != nil {
@@ -46,22 +49,22 @@ func (s *service) uploadFileToS3(client *s3.Client, buffer []byte, mtype *mimety
 	// TODO! Set uniqueFileName to include user id in the path e.g books/1/abc.pdf
 	switch scope {
 	case data.ScopeCover:
-		uniqueFileName = "bookcovers/" + strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(randomBytes)) + filepath.Ext(fileHeader.Filename)
+		uniqueFileName = "bookcovers/" + strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(randomBytes)) + filepath.Ext(filename)
 		_, err = uploader.Upload(context.TODO(), &s3.PutObjectInput{
 			Bucket:        aws.String(s.config.S3.Bucket),
 			Key:           aws.String(uniqueFileName),
 			Body:          bytes.NewReader(buffer),
-			ContentLength: *aws.Int64(fileHeader.Size),
+			ContentLength: *aws.Int64(int64(len(buffer))),
 			ContentType:   aws.String(mtype.String()),
 		})
 		uniqueFileName = "https://" + s.config.S3.Bucket + ".s3." + s.config.S3.Region + ".amazonaws.com/" + uniqueFileName
 	case data.ScopeBook:
-		uniqueFileName = "books/" + strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(randomBytes)) + filepath.Ext(fileHeader.Filename)
+		uniqueFileName = "books/" + strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(randomBytes)) + filepath.Ext(filename)
 		_, err = uploader.Upload(context.TODO(), &s3.PutObjectInput{
 			Bucket:             aws.String(s.config.S3.Bucket),
 			Key:                aws.String(uniqueFileName),
 			Body:               bytes.NewReader(buffer),
-			ContentLength:      *aws.Int64(fileHeader.Size),
+			ContentLength:      *aws.Int64(int64(len(buffer))),
 			ContentType:        aws.String(mtype.String()),
 			ContentDisposition: aws.String("attachment"),
 		})
@@ -72,6 +75,16 @@ func (s *service) uploadFileToS3(client *s3.Client, buffer []byte, mtype *mimety
 	return uniqueFileName, nil
 }
 
+// deleteCoverFromS3 deletes a cover uploaded by uploadFileToS3 from the aws s3 bucket.
+func (s *service) deleteCoverFromS3(client *s3.Client, coverPath string) error {
+	key := strings.TrimPrefix(coverPath, "https://"+s.config.S3.Bucket+".s3."+s.config.S3.Region+".amazonaws.com/")
+	_, err := client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
+		Bucket: aws.String(s.config.S3.Bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
 // downloadFileFromS3 downloads a file from the aws s3 bucket.
 func (s *service) downloadFileFromS3(client *s3.Client, book *data.Book) error {
 	// Set file name to follow the format: title (author[s]) ext
@@ -110,19 +123,3 @@ func (s *service) background(fn func()) {
 		fn()
 	}()
 }
-
-// fetchRemoteResource fetches data from a remote resource using a HTTP client
-func (s *service) fetchRemoteResource(client *http.Client, url string) ([]byte, error) {
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	r, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer r.Body.Close()
-	return io.ReadAll(r.Body)
-
-}
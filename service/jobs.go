@@ -0,0 +1,72 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/scheduler"
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+type jobs interface {
+	ListJobs() ([]*data.Job, error)
+	ListJobRuns(name string, filters data.Filters) ([]*data.JobRun, data.Metadata, error)
+	TriggerJob(userID int64, name string) (*data.JobRun, error)
+}
+
+// ListJobs service retrieves the scheduled jobs with their next and latest runs.
+func (s *service) ListJobs() ([]*data.Job, error) {
+	jobs := s.scheduler.Jobs()
+	latestRuns, err := s.repo.GetLatestJobRuns()
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		job.LastRun = latestRuns[job.Name]
+	}
+	return jobs, nil
+}
+
+// ListJobRuns service retrieves the run history of a scheduled job.
+func (s *service) ListJobRuns(name string, filters data.Filters) ([]*data.JobRun, data.Metadata, error) {
+	if !s.isScheduledJob(name) {
+		return nil, data.Metadata{}, ErrRecordNotFound
+	}
+	v := validator.New()
+	if data.ValidateFilters(v, filters); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, data.Metadata{}, ErrFailedValidation
+	}
+	runs, metadata, err := s.repo.GetAllJobRuns(name, filters)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	return runs, metadata, nil
+}
+
+// TriggerJob service starts a run of a scheduled job on behalf of a user, outside of its
+// schedule. The job runs in the background, so the returned run is still running.
+func (s *service) TriggerJob(userID int64, name string) (*data.JobRun, error) {
+	run, err := s.scheduler.Trigger(name, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, scheduler.ErrUnknownJob):
+			return nil, ErrRecordNotFound
+		case errors.Is(err, scheduler.ErrJobRunning):
+			return nil, ErrJobRunning
+		default:
+			return nil, err
+		}
+	}
+	return run, nil
+}
+
+// isScheduledJob checks whether a job with a name is scheduled.
+func (s *service) isScheduledJob(name string) bool {
+	for _, job := range s.scheduler.Jobs() {
+		if job.Name == name {
+			return true
+		}
+	}
+	return false
+}
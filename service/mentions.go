@@ -0,0 +1,92 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emzola/bibliotheca/data"
+)
+
+// findMentionedUsers resolves the usernames mentioned in content to users. Mentions of
+// usernames that don't belong to an activated user are left as plain text.
+func (s *service) findMentionedUsers(content string) ([]*data.MentionedUser, error) {
+	handles := data.MentionedHandles(content)
+	if len(handles) == 0 {
+		return []*data.MentionedUser{}, nil
+	}
+	users, err := s.repo.GetUsersByUsernames(handles)
+	if err != nil {
+		return nil, err
+	}
+	mentionedUsers := make([]*data.MentionedUser, len(users))
+	for i, user := range users {
+		mentionedUsers[i] = &data.MentionedUser{
+			UserID:   user.ID,
+			Username: user.Username,
+			Handle:   strings.ToLower(user.Username),
+		}
+	}
+	return mentionedUsers, nil
+}
+
+// mentionInComment records the users mentioned in a comment of a booklist, adds the mentions
+// to the comment and notifies the users who weren't mentioned by it before.
+func (s *service) mentionInComment(comment *data.Comment, booklist *data.Booklist) error {
+	users, err := s.findMentionedUsers(comment.Content)
+	if err != nil {
+		return err
+	}
+	userIDs, err := s.repo.SetCommentMentions(comment.ID, users)
+	if err != nil {
+		return err
+	}
+	comment.Mentions = data.ResolveMentions(comment.Content, users)
+	notification := data.Notification{
+		Event:      data.NotificationMention,
+		ActorID:    comment.UserID,
+		Message:    fmt.Sprintf("mentioned you in a comment on the booklist \"%s\"", booklist.Name),
+		BooklistID: booklist.ID,
+		CommentID:  comment.ID,
+	}
+	s.notifyInBackground(notification, userIDs, fmt.Sprintf("/booklists/%d/comments/%d", booklist.ID, comment.ID))
+	return nil
+}
+
+// mentionInReview records the users mentioned in a review of a book, adds the mentions to the
+// review and notifies the users who weren't mentioned by it before.
+func (s *service) mentionInReview(review *data.Review, book *data.Book) error {
+	users, err := s.findMentionedUsers(review.Comment)
+	if err != nil {
+		return err
+	}
+	userIDs, err := s.repo.SetReviewMentions(review.ID, users)
+	if err != nil {
+		return err
+	}
+	review.Mentions = data.ResolveMentions(review.Comment, users)
+	notification := data.Notification{
+		Event:    data.NotificationMention,
+		ActorID:  review.UserID,
+		Message:  fmt.Sprintf("mentioned you in a review of \"%s\"", book.Title),
+		BookID:   book.ID,
+		ReviewID: review.ID,
+	}
+	s.notifyInBackground(notification, userIDs, fmt.Sprintf("/books/%d/reviews/%d", book.ID, review.ID))
+	return nil
+}
+
+// addReviewMentions adds the mentions of users to reviews.
+func (s *service) addReviewMentions(reviews []*data.Review) error {
+	reviewIDs := make([]int64, len(reviews))
+	for i, review := range reviews {
+		reviewIDs[i] = review.ID
+	}
+	mentions, err := s.repo.GetReviewMentions(reviewIDs)
+	if err != nil {
+		return err
+	}
+	for _, review := range reviews {
+		review.Mentions = data.ResolveMentions(review.Comment, mentions[review.ID])
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/mailer"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/repository"
+)
+
+type notifications interface {
+	ListNotifications(userID int64, unread bool, filters data.Filters) ([]*data.Notification, data.Metadata, error)
+	GetUnreadNotificationCount(userID int64) (int, error)
+	MarkNotificationRead(userID int64, notificationID int64, read *bool) (*data.Notification, error)
+	MarkAllNotificationsRead(userID int64) (int64, error)
+	ListNotificationPreferences(userID int64) ([]*data.NotificationPreference, error)
+	UpdateNotificationPreferences(userID int64, preferences []*data.NotificationPreference) ([]*data.NotificationPreference, error)
+}
+
+// ListNotifications service retrieves a paginated list of a user's notifications, newest first.
+// If unread is true, only unread notifications are retrieved.
+func (s *service) ListNotifications(userID int64, unread bool, filters data.Filters) ([]*data.Notification, data.Metadata, error) {
+	v := validator.New()
+	if data.ValidateFilters(v, filters); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, data.Metadata{}, ErrFailedValidation
+	}
+	notifications, metadata, err := s.repo.GetAllNotifications(userID, unread, filters)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	return notifications, metadata, nil
+}
+
+// GetUnreadNotificationCount service retrieves the number of a user's unread notifications.
+func (s *service) GetUnreadNotificationCount(userID int64) (int, error) {
+	return s.repo.GetUnreadNotificationCount(userID)
+}
+
+// MarkNotificationRead service marks one of a user's notifications as read or unread.
+func (s *service) MarkNotificationRead(userID int64, notificationID int64, read *bool) (*data.Notification, error) {
+	v := validator.New()
+	if v.Check(read != nil, "read", "must be provided"); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	err := s.repo.MarkNotificationRead(notificationID, userID, *read)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	notification, err := s.repo.GetNotification(notificationID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return notification, nil
+}
+
+// MarkAllNotificationsRead service marks all of a user's unread notifications as read, and
+// returns the number of notifications marked.
+func (s *service) MarkAllNotificationsRead(userID int64) (int64, error) {
+	return s.repo.MarkAllNotificationsRead(userID)
+}
+
+// ListNotificationPreferences service retrieves how a user is notified of each event. Events the
+// user hasn't set a preference for have the default preference.
+func (s *service) ListNotificationPreferences(userID int64) ([]*data.NotificationPreference, error) {
+	set, err := s.repo.GetNotificationPreferences(userID)
+	if err != nil {
+		return nil, err
+	}
+	preferences := make([]*data.NotificationPreference, len(data.NotificationEvents))
+	for i, event := range data.NotificationEvents {
+		preferences[i] = data.DefaultNotificationPreference(event)
+		for _, preference := range set {
+			if preference.Event == event {
+				preferences[i] = preference
+			}
+		}
+	}
+	return preferences, nil
+}
+
+// UpdateNotificationPreferences service sets how a user is notified of some events, and returns
+// the user's preferences for all events.
+func (s *service) UpdateNotificationPreferences(userID int64, preferences []*data.NotificationPreference) ([]*data.NotificationPreference, error) {
+	for _, preference := range preferences {
+		preference.Event = strings.ToLower(strings.TrimSpace(preference.Event))
+	}
+	v := validator.New()
+	if data.ValidateNotificationPreferences(v, preferences); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	err := s.repo.UpdateNotificationPreferences(userID, preferences)
+	if err != nil {
+		return nil, err
+	}
+	return s.ListNotificationPreferences(userID)
+}
+
+// notifyInBackground notifies users of an event in a background goroutine, in the app and by
+// email as each user prefers. The user who caused the event is never notified of it. Emails
+// link to path on the website.
+func (s *service) notifyInBackground(notification data.Notification, userIDs []int64, path string) {
+	s.background(func() {
+		seen := map[int64]bool{0: true, notification.ActorID: true}
+		recipientIDs := []int64{}
+		for _, userID := range userIDs {
+			if !seen[userID] {
+				seen[userID] = true
+				recipientIDs = append(recipientIDs, userID)
+			}
+		}
+		if len(recipientIDs) == 0 {
+			return
+		}
+		recipients, err := s.repo.GetNotificationRecipients(recipientIDs, data.DefaultNotificationPreference(notification.Event))
+		if err != nil {
+			s.logger.PrintError(err, nil)
+			return
+		}
+		if notification.ActorID != 0 {
+			actor, err := s.repo.GetUserByID(notification.ActorID)
+			if err != nil {
+				s.logger.PrintError(err, nil)
+				return
+			}
+			notification.ActorName = actor.Name
+		}
+		mailer := mailer.New(s.config.SMTP.Host, s.config.SMTP.Port, s.config.SMTP.Username, s.config.SMTP.Password, s.config.SMTP.Sender)
+		for _, recipient := range recipients {
+			if recipient.InApp {
+				userNotification := notification
+				userNotification.UserID = recipient.UserID
+				err := s.repo.CreateNotification(&userNotification)
+				if err != nil {
+					s.logger.PrintError(err, map[string]string{"user_id": strconv.FormatInt(recipient.UserID, 10)})
+				}
+			}
+			if recipient.Email {
+				data := map[string]interface{}{
+					"userName":  strings.Split(recipient.UserName, " ")[0],
+					"actorName": notification.ActorName,
+					"message":   notification.Message,
+					"path":      path,
+				}
+				err := mailer.Send(recipient.UserEmail, "notification.tmpl", data)
+				if err != nil {
+					s.logger.PrintError(err, map[string]string{"user_id": strconv.FormatInt(recipient.UserID, 10)})
+				}
+			}
+		}
+	})
+}
+
+// notifyRequestFulfilments notifies the subscribers of fulfilled requests of the books that
+// fulfilled them, as each subscriber prefers. Subscribers get an in-app notification for each
+// request, but a single email listing all of their fulfilled requests.
+func (s *service) notifyRequestFulfilments(fulfilments []*data.RequestFulfilment) {
+	userIDs := make([]int64, len(fulfilments))
+	for i, fulfilment := range fulfilments {
+		userIDs[i] = fulfilment.UserID
+	}
+	recipients, err := s.repo.GetNotificationRecipients(userIDs, data.DefaultNotificationPreference(data.NotificationRequestFulfilled))
+	if err != nil {
+		s.logger.PrintError(err, nil)
+		return
+	}
+	preferences := make(map[int64]*data.NotificationRecipient, len(recipients))
+	for _, recipient := range recipients {
+		preferences[recipient.UserID] = recipient
+	}
+	mailer := mailer.New(s.config.SMTP.Host, s.config.SMTP.Port, s.config.SMTP.Username, s.config.SMTP.Password, s.config.SMTP.Sender)
+	// Fulfilments are ordered by user, so each user's requests are consecutive
+	for start := 0; start < len(fulfilments); {
+		end := start
+		for end < len(fulfilments) && fulfilments[end].UserID == fulfilments[start].UserID {
+			end++
+		}
+		user := fulfilments[start]
+		recipient, ok := preferences[user.UserID]
+		if !ok {
+			start = end
+			continue
+		}
+		if recipient.InApp {
+			for _, fulfilment := range fulfilments[start:end] {
+				notification := &data.Notification{
+					UserID:    fulfilment.UserID,
+					Event:     data.NotificationRequestFulfilled,
+					Message:   fmt.Sprintf("\"%s\" fulfils your request for \"%s\"", fulfilment.BookTitle, fulfilment.RequestTitle),
+					BookID:    fulfilment.BookID,
+					RequestID: fulfilment.RequestID,
+				}
+				err := s.repo.CreateNotification(notification)
+				if err != nil {
+					s.logger.PrintError(err, map[string]string{"user_id": strconv.FormatInt(user.UserID, 10)})
+				}
+			}
+		}
+		if recipient.Email {
+			data := map[string]interface{}{
+				"userName":   strings.Split(user.UserName, " ")[0],
+				"requests":   fulfilments[start:end],
+				"bookID":     user.BookID,
+				"bookTitle":  user.BookTitle,
+				"bookAuthor": user.BookAuthor,
+			}
+			err := mailer.Send(user.UserEmail, "request_fulfilled.tmpl", data)
+			if err != nil {
+				s.logger.PrintError(err, map[string]string{"user_id": strconv.FormatInt(user.UserID, 10)})
+			}
+		}
+		start = end
+	}
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/repository"
+)
+
+type reactions interface {
+	ReactToComment(userID int64, booklistID int64, commentID int64, reaction string) ([]*data.ReactionCount, error)
+	UnreactToComment(userID int64, booklistID int64, commentID int64, reaction string) ([]*data.ReactionCount, error)
+	ReactToReview(userID int64, bookID int64, reviewID int64, reaction string) ([]*data.ReactionCount, error)
+	UnreactToReview(userID int64, bookID int64, reviewID int64, reaction string) ([]*data.ReactionCount, error)
+}
+
+// ReactToComment service adds a user's reaction to a comment of a booklist and returns the
+// comment's reactions. Deleted comments can't be reacted to.
+func (s *service) ReactToComment(userID int64, booklistID int64, commentID int64, reaction string) ([]*data.ReactionCount, error) {
+	v := validator.New()
+	if data.ValidateReaction(v, reaction); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	comment, err := s.GetComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.BooklistID != booklistID || comment.Deleted {
+		return nil, ErrRecordNotFound
+	}
+	err = s.repo.AddCommentReaction(comment.ID, userID, reaction)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrDuplicateRecord):
+			return nil, ErrDuplicateRecord
+		default:
+			return nil, err
+		}
+	}
+	reactions, err := s.repo.GetCommentReactions([]int64{comment.ID}, userID)
+	if err != nil {
+		return nil, err
+	}
+	return reactions[comment.ID], nil
+}
+
+// UnreactToComment service removes a user's reaction from a comment of a booklist and returns
+// the comment's remaining reactions.
+func (s *service) UnreactToComment(userID int64, booklistID int64, commentID int64, reaction string) ([]*data.ReactionCount, error) {
+	comment, err := s.GetComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.BooklistID != booklistID {
+		return nil, ErrRecordNotFound
+	}
+	err = s.repo.DeleteCommentReaction(comment.ID, userID, reaction)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	reactions, err := s.repo.GetCommentReactions([]int64{comment.ID}, userID)
+	if err != nil {
+		return nil, err
+	}
+	return reactions[comment.ID], nil
+}
+
+// ReactToReview service adds a user's reaction to a review of a book and returns the
+// review's reactions.
+func (s *service) ReactToReview(userID int64, bookID int64, reviewID int64, reaction string) ([]*data.ReactionCount, error) {
+	v := validator.New()
+	if data.ValidateReaction(v, reaction); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	review, err := s.GetReview(reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.BookID != bookID {
+		return nil, ErrRecordNotFound
+	}
+	err = s.repo.AddReviewReaction(review.ID, userID, reaction)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrDuplicateRecord):
+			return nil, ErrDuplicateRecord
+		default:
+			return nil, err
+		}
+	}
+	reactions, err := s.repo.GetReviewReactions([]int64{review.ID}, userID)
+	if err != nil {
+		return nil, err
+	}
+	return reactions[review.ID], nil
+}
+
+// UnreactToReview service removes a user's reaction from a review of a book and returns the
+// review's remaining reactions.
+func (s *service) UnreactToReview(userID int64, bookID int64, reviewID int64, reaction string) ([]*data.ReactionCount, error) {
+	review, err := s.GetReview(reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.BookID != bookID {
+		return nil, ErrRecordNotFound
+	}
+	err = s.repo.DeleteReviewReaction(review.ID, userID, reaction)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	reactions, err := s.repo.GetReviewReactions([]int64{review.ID}, userID)
+	if err != nil {
+		return nil, err
+	}
+	return reactions[review.ID], nil
+}
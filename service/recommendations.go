@@ -0,0 +1,210 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+type recommendations interface {
+	ListRelatedBooks(bookID int64, limit int) ([]*data.RelatedBook, error)
+	RefreshBookSimilarities() error
+	ListUserRecommendations(userID int64, limit int) ([]*data.Recommendation, error)
+	ListTrendingBooks(window string, categoryID int64, filters data.Filters) ([]*data.TrendingBook, data.Metadata, error)
+	RefreshTrendingBooks() error
+	ListSimilarBooks(bookID int64, limit int) ([]*data.RelatedBook, error)
+	RefreshMissingBookContent() error
+}
+
+// ListRelatedBooks service retrieves the books most often downloaded or favourited by the
+// readers of a book. When there isn't enough reader data, the list is filled up with books
+// by the same authors and then books in the same category.
+func (s *service) ListRelatedBooks(bookID int64, limit int) ([]*data.RelatedBook, error) {
+	v := validator.New()
+	v.Check(limit > 0, "limit", "must be greater than zero")
+	v.Check(limit <= 50, "limit", "must be a maximum of 50")
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	_, err := s.GetBook(bookID)
+	if err != nil {
+		return nil, err
+	}
+	related, err := s.repo.GetSimilarBooks(bookID, limit)
+	if err != nil {
+		return nil, err
+	}
+	fallbacks := []func(bookID int64, excludeIDs []int64, limit int) ([]*data.RelatedBook, error){
+		s.repo.GetBooksBySameAuthors,
+		s.repo.GetBooksInSameCategory,
+	}
+	for _, fallback := range fallbacks {
+		if len(related) >= limit {
+			break
+		}
+		excludeIDs := make([]int64, 0, len(related))
+		for _, book := range related {
+			excludeIDs = append(excludeIDs, book.ID)
+		}
+		books, err := fallback(bookID, excludeIDs, limit-len(related))
+		if err != nil {
+			return nil, err
+		}
+		related = append(related, books...)
+	}
+	return related, nil
+}
+
+// RefreshBookSimilarities service recomputes the related books of every book from
+// users' downloads and favourites. It is run periodically.
+func (s *service) RefreshBookSimilarities() error {
+	return s.repo.RefreshBookSimilarities(s.config.Recommendations.MinCooccurrence, s.config.Recommendations.MaxPerBook)
+}
+
+// ListUserRecommendations service ranks books a user hasn't downloaded, favourited, reviewed
+// or uploaded. Books related to the ones the user liked come first, followed by popular books
+// in the categories the user follows and then popular books overall. Ties are broken by book
+// ID so the same data always gives the same recommendations.
+func (s *service) ListUserRecommendations(userID int64, limit int) ([]*data.Recommendation, error) {
+	v := validator.New()
+	v.Check(limit > 0, "limit", "must be greater than zero")
+	v.Check(limit <= 50, "limit", "must be a maximum of 50")
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	recommendations, err := s.repo.GetRecommendationsFromSimilarBooks(userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	fallbacks := []func(userID int64, excludeIDs []int64, limit int) ([]*data.Recommendation, error){
+		s.repo.GetPopularBooksInFollowedCategories,
+		s.repo.GetPopularBooksForUser,
+	}
+	for _, fallback := range fallbacks {
+		if len(recommendations) >= limit {
+			break
+		}
+		excludeIDs := make([]int64, 0, len(recommendations))
+		for _, recommendation := range recommendations {
+			excludeIDs = append(excludeIDs, recommendation.ID)
+		}
+		books, err := fallback(userID, excludeIDs, limit-len(recommendations))
+		if err != nil {
+			return nil, err
+		}
+		recommendations = append(recommendations, books...)
+	}
+	for _, recommendation := range recommendations {
+		switch recommendation.Reason {
+		case data.ReasonBecauseYouLiked:
+			recommendation.Explanation = fmt.Sprintf("because you liked %s", recommendation.Source)
+		case data.ReasonPopularInCategory:
+			recommendation.Explanation = fmt.Sprintf("popular in %s", recommendation.Source)
+		default:
+			recommendation.Explanation = "popular on Bibliotheca"
+		}
+	}
+	return recommendations, nil
+}
+
+// ListTrendingBooks service retrieves a paginated list of the books with the most recent activity
+// within a window, optionally within a category. Rankings are precomputed by RefreshTrendingBooks.
+func (s *service) ListTrendingBooks(window string, categoryID int64, filters data.Filters) ([]*data.TrendingBook, data.Metadata, error) {
+	v := validator.New()
+	v.Check(validator.In(window, data.TrendingWindowNames()...), "window", "must be one of "+strings.Join(data.TrendingWindowNames(), ", "))
+	v.Check(categoryID >= 0, "category_id", "must not be negative")
+	if data.ValidateFilters(v, filters); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, data.Metadata{}, ErrFailedValidation
+	}
+	if categoryID != 0 {
+		_, err := s.GetCategory(categoryID)
+		if err != nil {
+			return nil, data.Metadata{}, err
+		}
+	}
+	books, metadata, err := s.repo.GetAllTrendingBooks(window, categoryID, filters)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	return books, metadata, nil
+}
+
+// RefreshTrendingBooks service recomputes the trending rankings of every window. It is run periodically.
+func (s *service) RefreshTrendingBooks() error {
+	for _, window := range data.TrendingWindows {
+		err := s.repo.RefreshTrendingBooks(window)
+		if err != nil {
+			return fmt.Errorf("refresh %s trending books: %w", window.Name, err)
+		}
+	}
+	return nil
+}
+
+// ListSimilarBooks service retrieves the books whose authors, series, category, publisher,
+// language, title and description are most like a book's. Unlike ListRelatedBooks it doesn't
+// depend on what users do, so it also works for new books.
+func (s *service) ListSimilarBooks(bookID int64, limit int) ([]*data.RelatedBook, error) {
+	v := validator.New()
+	v.Check(limit > 0, "limit", "must be greater than zero")
+	v.Check(limit <= 50, "limit", "must be a maximum of 50")
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	_, err := s.GetBook(bookID)
+	if err != nil {
+		return nil, err
+	}
+	books, err := s.repo.GetContentSimilarBooks(bookID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return books, nil
+}
+
+// RefreshMissingBookContent service extracts the terms and computes the similar books of books
+// that haven't been processed yet, such as books uploaded before similar books existed. It is
+// run periodically.
+func (s *service) RefreshMissingBookContent() error {
+	var afterID int64
+	for {
+		books, err := s.repo.GetBooksWithoutTerms(afterID, 100)
+		if err != nil {
+			return err
+		}
+		if len(books) == 0 {
+			return nil
+		}
+		for _, book := range books {
+			err := s.refreshBookContent(book)
+			if err != nil {
+				return err
+			}
+			afterID = book.ID
+		}
+	}
+}
+
+// refreshBookContent extracts the terms of a book and recomputes its similar books.
+func (s *service) refreshBookContent(book *data.Book) error {
+	err := s.repo.SetTermsForBook(book.ID, data.BookTerms(book.Title, book.Description))
+	if err != nil {
+		return err
+	}
+	return s.repo.RefreshContentSimilaritiesForBook(book.ID, s.config.Recommendations.MaxPerBook)
+}
+
+// refreshBookContentInBackground runs refreshBookContent in a background goroutine and logs any error.
+func (s *service) refreshBookContentInBackground(book *data.Book) {
+	s.background(func() {
+		err := s.refreshBookContent(book)
+		if err != nil {
+			s.logger.PrintError(err, nil)
+		}
+	})
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/repository"
+)
+
+// seededRepository is a repository seeded with the ranked results of each recommendation query.
+// Queries return their seeded books in order, leaving out excluded books, up to their limit.
+type seededRepository struct {
+	repository.Repository
+	recommendations map[string][]*data.Recommendation
+	related         map[string][]*data.RelatedBook
+}
+
+func (r *seededRepository) recommend(reason string, excludeIDs []int64, limit int) []*data.Recommendation {
+	excluded := make(map[int64]bool)
+	for _, id := range excludeIDs {
+		excluded[id] = true
+	}
+	recommendations := []*data.Recommendation{}
+	for _, recommendation := range r.recommendations[reason] {
+		if len(recommendations) < limit && !excluded[recommendation.ID] {
+			copied := *recommendation
+			recommendations = append(recommendations, &copied)
+		}
+	}
+	return recommendations
+}
+
+func (r *seededRepository) relate(reason string, excludeIDs []int64, limit int) []*data.RelatedBook {
+	excluded := make(map[int64]bool)
+	for _, id := range excludeIDs {
+		excluded[id] = true
+	}
+	books := []*data.RelatedBook{}
+	for _, book := range r.related[reason] {
+		if len(books) < limit && !excluded[book.ID] {
+			books = append(books, book)
+		}
+	}
+	return books
+}
+
+func (r *seededRepository) GetRecommendationsFromSimilarBooks(userID int64, limit int) ([]*data.Recommendation, error) {
+	return r.recommend(data.ReasonBecauseYouLiked, nil, limit), nil
+}
+
+func (r *seededRepository) GetPopularBooksInFollowedCategories(userID int64, excludeIDs []int64, limit int) ([]*data.Recommendation, error) {
+	return r.recommend(data.ReasonPopularInCategory, excludeIDs, limit), nil
+}
+
+func (r *seededRepository) GetPopularBooksForUser(userID int64, excludeIDs []int64, limit int) ([]*data.Recommendation, error) {
+	return r.recommend(data.ReasonPopular, excludeIDs, limit), nil
+}
+
+func (r *seededRepository) GetBook(bookID int64) (*data.Book, error) {
+	return &data.Book{ID: bookID}, nil
+}
+
+func (r *seededRepository) GetSimilarBooks(bookID int64, limit int) ([]*data.RelatedBook, error) {
+	return r.relate(data.ReasonAlsoDownloaded, nil, limit), nil
+}
+
+func (r *seededRepository) GetBooksBySameAuthors(bookID int64, excludeIDs []int64, limit int) ([]*data.RelatedBook, error) {
+	return r.relate(data.ReasonSameAuthor, excludeIDs, limit), nil
+}
+
+func (r *seededRepository) GetBooksInSameCategory(bookID int64, excludeIDs []int64, limit int) ([]*data.RelatedBook, error) {
+	return r.relate(data.ReasonSameCategory, excludeIDs, limit), nil
+}
+
+func recommendation(id int64, score float64, reason, source string) *data.Recommendation {
+	return &data.Recommendation{Book: &data.Book{ID: id}, Score: score, Reason: reason, Source: source}
+}
+
+func relatedBook(id int64, score float64, reason string) *data.RelatedBook {
+	return &data.RelatedBook{Book: &data.Book{ID: id}, Score: score, Reason: reason}
+}
+
+func TestListUserRecommendations(t *testing.T) {
+	seeded := map[string][]*data.Recommendation{
+		data.ReasonBecauseYouLiked: {
+			recommendation(3, 1.5, data.ReasonBecauseYouLiked, "Dune"),
+			recommendation(1, 0.75, data.ReasonBecauseYouLiked, "Emma"),
+		},
+		data.ReasonPopularInCategory: {
+			recommendation(1, 4.8, data.ReasonPopularInCategory, "History"),
+			recommendation(5, 4.5, data.ReasonPopularInCategory, "History"),
+		},
+		data.ReasonPopular: {
+			recommendation(5, 4.9, data.ReasonPopular, ""),
+			recommendation(3, 4.7, data.ReasonPopular, ""),
+			recommendation(2, 4.2, data.ReasonPopular, ""),
+			recommendation(4, 4.2, data.ReasonPopular, ""),
+		},
+	}
+	tests := []struct {
+		name         string
+		seeded       map[string][]*data.Recommendation
+		limit        int
+		ids          []int64
+		explanations []string
+	}{
+		{
+			"Similar books fill the list",
+			seeded, 2,
+			[]int64{3, 1},
+			[]string{"because you liked Dune", "because you liked Emma"},
+		},
+		{
+			"Fallbacks follow similar books without repeats",
+			seeded, 5,
+			[]int64{3, 1, 5, 2, 4},
+			[]string{"because you liked Dune", "because you liked Emma", "popular in History", "popular on Bibliotheca", "popular on Bibliotheca"},
+		},
+		{
+			"New users get popular books",
+			map[string][]*data.Recommendation{data.ReasonPopular: seeded[data.ReasonPopular]}, 3,
+			[]int64{5, 3, 2},
+			[]string{"popular on Bibliotheca", "popular on Bibliotheca", "popular on Bibliotheca"},
+		},
+		{
+			"Fewer books than the limit",
+			map[string][]*data.Recommendation{data.ReasonPopularInCategory: seeded[data.ReasonPopularInCategory]}, 10,
+			[]int64{1, 5},
+			[]string{"popular in History", "popular in History"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{repo: &seededRepository{recommendations: tt.seeded}}
+			// The same data must always give the same recommendations
+			for run := 0; run < 2; run++ {
+				recommendations, err := s.ListUserRecommendations(1, tt.limit)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				ids := []int64{}
+				explanations := []string{}
+				for _, recommendation := range recommendations {
+					ids = append(ids, recommendation.ID)
+					explanations = append(explanations, recommendation.Explanation)
+				}
+				if !reflect.DeepEqual(ids, tt.ids) {
+					t.Errorf("expected books %v; got %v", tt.ids, ids)
+				}
+				if !reflect.DeepEqual(explanations, tt.explanations) {
+					t.Errorf("expected explanations %q; got %q", tt.explanations, explanations)
+				}
+			}
+		})
+	}
+}
+
+func TestListRelatedBooks(t *testing.T) {
+	seeded := map[string][]*data.RelatedBook{
+		data.ReasonAlsoDownloaded: {
+			relatedBook(7, 0.9, data.ReasonAlsoDownloaded),
+			relatedBook(4, 0.6, data.ReasonAlsoDownloaded),
+		},
+		data.ReasonSameAuthor: {
+			relatedBook(4, 0, data.ReasonSameAuthor),
+			relatedBook(8, 0, data.ReasonSameAuthor),
+		},
+		data.ReasonSameCategory: {
+			relatedBook(8, 0, data.ReasonSameCategory),
+			relatedBook(2, 0, data.ReasonSameCategory),
+			relatedBook(9, 0, data.ReasonSameCategory),
+		},
+	}
+	tests := []struct {
+		name    string
+		seeded  map[string][]*data.RelatedBook
+		limit   int
+		ids     []int64
+		reasons []string
+	}{
+		{
+			"Reader data fills the list",
+			seeded, 2,
+			[]int64{7, 4},
+			[]string{data.ReasonAlsoDownloaded, data.ReasonAlsoDownloaded},
+		},
+		{
+			"Same authors, then same category, without repeats",
+			seeded, 4,
+			[]int64{7, 4, 8, 2},
+			[]string{data.ReasonAlsoDownloaded, data.ReasonAlsoDownloaded, data.ReasonSameAuthor, data.ReasonSameCategory},
+		},
+		{
+			"Books without reader data",
+			map[string][]*data.RelatedBook{data.ReasonSameCategory: seeded[data.ReasonSameCategory]}, 2,
+			[]int64{8, 2},
+			[]string{data.ReasonSameCategory, data.ReasonSameCategory},
+		},
+		{
+			"Fewer books than the limit",
+			seeded, 10,
+			[]int64{7, 4, 8, 2, 9},
+			[]string{data.ReasonAlsoDownloaded, data.ReasonAlsoDownloaded, data.ReasonSameAuthor, data.ReasonSameCategory, data.ReasonSameCategory},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{repo: &seededRepository{related: tt.seeded}}
+			books, err := s.ListRelatedBooks(1, tt.limit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids := []int64{}
+			reasons := []string{}
+			for _, book := range books {
+				ids = append(ids, book.ID)
+				reasons = append(reasons, book.Reason)
+			}
+			if !reflect.DeepEqual(ids, tt.ids) {
+				t.Errorf("expected books %v; got %v", tt.ids, ids)
+			}
+			if !reflect.DeepEqual(reasons, tt.reasons) {
+				t.Errorf("expected reasons %q; got %q", tt.reasons, reasons)
+			}
+		})
+	}
+}
+
+func TestListRecommendationsInvalidLimit(t *testing.T) {
+	s := &service{repo: &seededRepository{}}
+	for _, limit := range []int{0, -1, 51} {
+		if _, err := s.ListUserRecommendations(1, limit); err == nil {
+			t.Errorf("ListUserRecommendations(%d) expected an error", limit)
+		}
+		if _, err := s.ListRelatedBooks(1, limit); err == nil {
+			t.Errorf("ListRelatedBooks(%d) expected an error", limit)
+		}
+	}
+}
@@ -1,9 +1,9 @@
 package service
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
-	"strconv"
+	"fmt"
 	"strings"
 	"time"
 
@@ -15,56 +15,136 @@ import (
 )
 
 type requests interface {
-	CreateRequest(userID int64, isbn string) (*data.Request, error)
+	CreateRequest(userID int64, requestBody dto.CreateRequestRequestBody) (*data.Request, *data.Book, bool, error)
 	GetRequest(requestID int64) (*data.Request, error)
 	ListRequests(search string, status string, filters data.Filters) ([]*data.Request, data.Metadata, error)
 	SubscribeRequest(userID int64, requestID int64) error
 	UnsubscribeRequest(userID int64, requestID int64) error
+	TransitionRequest(user *data.User, requestID int64, requestBody dto.TransitionRequestRequestBody) (*data.Request, error)
+	ExpireRequests() error
+	ListTopRequests(categoryID int64, filters data.Filters) ([]*data.TopRequest, []*data.RequestCategoryDemand, data.Metadata, error)
+	ClaimRequest(user *data.User, requestID int64) (*data.Request, error)
 }
 
-func (s *service) CreateRequest(userID int64, isbn string) (*data.Request, error) {
-	v := validator.New()
-	if data.ValidateRequestIsbn(v, isbn); !v.Valid() {
-		ErrFailedValidation = s.failedValidation(v.Errors)
-		return nil, ErrFailedValidation
+// CreateRequest service creates a book request. Books with an ISBN are looked up on the
+// metadata providers, with the details given in the request body filling in what the
+// providers don't have. Books that the providers don't know, or that have no ISBN, are
+// requested manually by their title, author and year.
+//
+// A book with an ISBN is only requested once: when the book is already in the catalogue it
+// is returned instead, and when it is already requested the user is subscribed to the
+// active request, which is returned. The boolean reports whether a new request was created.
+func (s *service) CreateRequest(userID int64, requestBody dto.CreateRequestRequestBody) (*data.Request, *data.Book, bool, error) {
+	manual := requestBody.Title != ""
+	request := &data.Request{
+		UserID:     userID,
+		Title:      strings.TrimSpace(requestBody.Title),
+		Author:     requestBody.Author,
+		Publisher:  strings.TrimSpace(requestBody.Publisher),
+		Year:       requestBody.Year,
+		Expiry:     time.Now().Add(time.Hour * 24 * 182),
+		Status:     data.RequestStatusOpen,
+		Source:     data.RequestSourceManual,
+		CategoryID: requestBody.CategoryID,
 	}
-	// Fetch JSON data for a book from openlibrary api
-	openLibAPI := &dto.OpenLibAPIRequestBody{}
-	url := "https://openlibrary.org/isbn/" + isbn + ".json"
-	client := clients.NewHTTPClient()
-	bytes, err := s.fetchRemoteResource(client, url)
-	if err != nil {
-		return nil, err
+	if request.Author == nil {
+		request.Author = []string{}
 	}
-	err = json.Unmarshal(bytes, &openLibAPI)
-	if err != nil {
-		return nil, ErrBadRequest
+	v := validator.New()
+	if request.CategoryID != 0 {
+		_, err := s.repo.GetCategory(request.CategoryID)
+		if err != nil && !errors.Is(err, repository.ErrRecordNotFound) {
+			return nil, nil, false, err
+		}
+		if v.Check(err == nil, "category_id", "must be an existing category"); !v.Valid() {
+			ErrFailedValidation = s.failedValidation(v.Errors)
+			return nil, nil, false, ErrFailedValidation
+		}
 	}
-	year, err := strconv.Atoi(strings.TrimSpace(strings.Split(openLibAPI.Date, ",")[1]))
-	if err != nil {
-		return nil, err
+	if requestBody.Isbn != "" || !manual {
+		if data.ValidateRequestIsbn(v, requestBody.Isbn); !v.Valid() {
+			ErrFailedValidation = s.failedValidation(v.Errors)
+			return nil, nil, false, ErrFailedValidation
+		}
+		// Requests are stored by their ISBN-13
+		isbn10, isbn13, _ := validator.ParseISBN(requestBody.Isbn)
+		request.Isbn = isbn13
+		isbns := []string{isbn13}
+		if isbn10 != "" {
+			isbns = append(isbns, isbn10)
+		}
+		book, err := s.repo.GetBookByIsbn(isbns)
+		switch {
+		case err == nil:
+			return nil, book, false, nil
+		case !errors.Is(err, repository.ErrRecordNotFound):
+			return nil, nil, false, err
+		}
+		existing, err := s.subscribeActiveRequest(userID, request.Isbn)
+		switch {
+		case err == nil:
+			return existing, nil, false, nil
+		case !errors.Is(err, ErrRecordNotFound):
+			return nil, nil, false, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		metadata, err := s.metadata.LookupISBN(ctx, request.Isbn)
+		if err != nil {
+			if !manual {
+				switch {
+				case errors.Is(err, clients.ErrNotFound):
+					return nil, nil, false, ErrMetadataNotFound
+				default:
+					return nil, nil, false, fmt.Errorf("%w: %s", ErrMetadataUnavailable, err)
+				}
+			}
+			// The book is requested manually with the details given by the user
+			if !errors.Is(err, clients.ErrNotFound) {
+				s.logger.PrintError(err, nil)
+			}
+		} else {
+			request.Source = metadata.Source
+			if metadata.Title != "" {
+				request.Title = metadata.Title
+			}
+			if len(metadata.Authors) > 0 {
+				request.Author = metadata.Authors
+			}
+			if metadata.Publisher != "" {
+				request.Publisher = metadata.Publisher
+			}
+			if metadata.Year != 0 {
+				request.Year = metadata.Year
+			}
+		}
 	}
-	request := &data.Request{
-		UserID:    userID,
-		Title:     openLibAPI.Title,
-		Publisher: openLibAPI.Publisher[0],
-		Isbn:      isbn,
-		Year:      int32(year),
-		Expiry:    time.Now().Add(time.Hour * 24 * 182),
-		Status:    "active",
+	if data.ValidateRequest(v, request); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, nil, false, ErrFailedValidation
 	}
-	err = s.repo.CreateRequest(request)
+	err := s.repo.CreateRequest(request)
 	if err != nil {
-		return nil, err
+		switch {
+		case errors.Is(err, repository.ErrDuplicateRecord):
+			// Another user requested the same book in the meantime
+			existing, err := s.subscribeActiveRequest(userID, request.Isbn)
+			if err != nil {
+				return nil, nil, false, err
+			}
+			return existing, nil, false, nil
+		default:
+			return nil, nil, false, err
+		}
 	}
 	// Add the new request to the users_requests table
 	err = s.repo.AddRequestForUser(userID, request.ID, request.Expiry)
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrDuplicateRecord):
-			return nil, ErrDuplicateRecord
+			return nil, nil, false, ErrDuplicateRecord
 		default:
-			return nil, err
+			return nil, nil, false, err
 		}
 	}
 	// Update request waitlist
@@ -73,15 +153,35 @@ func (s *service) CreateRequest(userID int64, isbn string) (*data.Request, error
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrEditConflict):
-			return nil, ErrEditConflict
+			return nil, nil, false, ErrEditConflict
+		default:
+			return nil, nil, false, err
+		}
+	}
+	s.fetchRequestCoverInBackground(request)
+	return request, nil, true, nil
+}
+
+// subscribeActiveRequest subscribes a user to the active request for an ISBN-13, and returns
+// the request with its history. Users already subscribed to the request stay subscribed.
+func (s *service) subscribeActiveRequest(userID int64, isbn string) (*data.Request, error) {
+	request, err := s.repo.GetActiveRequestByIsbn(isbn)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
 		default:
 			return nil, err
 		}
 	}
-	return request, nil
+	err = s.SubscribeRequest(userID, request.ID)
+	if err != nil && !errors.Is(err, ErrDuplicateRecord) {
+		return nil, err
+	}
+	return s.GetRequest(request.ID)
 }
 
-// GetRequest retrieves a request record.
+// GetRequest retrieves a request record with its history.
 func (s *service) GetRequest(requestID int64) (*data.Request, error) {
 	request, err := s.repo.GetRequest(requestID)
 	if err != nil {
@@ -92,18 +192,127 @@ func (s *service) GetRequest(requestID int64) (*data.Request, error) {
 			return nil, err
 		}
 	}
+	request.Events, err = s.repo.GetRequestEvents(request.ID)
+	if err != nil {
+		return nil, err
+	}
 	return request, nil
 }
 
+// TransitionRequest service changes the status of a request and records the change in the
+// request's history. Any user can claim an active request by moving it to in_progress, and
+// the claimant or an admin can release it or mark it fulfilled by a book. Only admins can
+// reject or expire requests, and reopen rejected ones. The requester or an admin can reopen
+// a fulfilled or expired request.
+func (s *service) TransitionRequest(user *data.User, requestID int64, requestBody dto.TransitionRequestRequestBody) (*data.Request, error) {
+	request, err := s.repo.GetRequest(requestID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	status := strings.ToLower(strings.TrimSpace(requestBody.Status))
+	reason := strings.TrimSpace(requestBody.Reason)
+	v := validator.New()
+	if data.ValidateRequestTransition(v, request, status, reason); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	if !s.canTransitionRequest(user, request, status) {
+		return nil, ErrNotPermitted
+	}
+	event := &data.RequestEvent{
+		RequestID:  request.ID,
+		UserID:     user.ID,
+		FromStatus: request.Status,
+		ToStatus:   status,
+		Reason:     reason,
+	}
+	switch status {
+	case data.RequestStatusInProgress:
+		request.ClaimedBy = user.ID
+	case data.RequestStatusFulfilled:
+		v.Check(requestBody.BookID > 0, "book_id", "must be provided when fulfilling a request")
+		if requestBody.BookID > 0 {
+			_, err := s.repo.GetBook(requestBody.BookID)
+			if err != nil && !errors.Is(err, repository.ErrRecordNotFound) {
+				return nil, err
+			}
+			v.Check(err == nil, "book_id", "must be an existing book")
+		}
+		if !v.Valid() {
+			ErrFailedValidation = s.failedValidation(v.Errors)
+			return nil, ErrFailedValidation
+		}
+		request.BookID = requestBody.BookID
+	default:
+		request.ClaimedBy = 0
+		if status == data.RequestStatusReopened {
+			request.BookID = 0
+		}
+	}
+	request.Status = status
+	err = s.repo.TransitionRequest(request, event)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrEditConflict):
+			return nil, ErrEditConflict
+		case errors.Is(err, repository.ErrDuplicateRecord):
+			v.AddError("status", "cannot reopen a request while another request for its isbn is active")
+			ErrFailedValidation = s.failedValidation(v.Errors)
+			return nil, ErrFailedValidation
+		default:
+			return nil, err
+		}
+	}
+	if status == data.RequestStatusFulfilled {
+		s.background(func() {
+			fulfilments, err := s.repo.GetRequestFulfilments(request.ID)
+			if err != nil {
+				s.logger.PrintError(err, nil)
+				return
+			}
+			s.notifyRequestFulfilments(fulfilments)
+		})
+	}
+	request.Events, err = s.repo.GetRequestEvents(request.ID)
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// canTransitionRequest checks whether a user may move a request to a status.
+func (s *service) canTransitionRequest(user *data.User, request *data.Request, status string) bool {
+	if user.IsAdmin() {
+		return true
+	}
+	switch status {
+	case data.RequestStatusInProgress:
+		return true
+	case data.RequestStatusOpen, data.RequestStatusFulfilled:
+		return request.ClaimedBy == user.ID
+	case data.RequestStatusReopened:
+		return request.Status != data.RequestStatusRejected && request.UserID == user.ID
+	default:
+		return false
+	}
+}
+
 // ListRequests retrieves a paginated list of all requests.
 // Records can be filtered and sorted.
 func (s *service) ListRequests(search string, status string, filters data.Filters) ([]*data.Request, data.Metadata, error) {
+	status = strings.ToLower(strings.TrimSpace(status))
 	v := validator.New()
+	data.ValidateRequestStatusFilter(v, status)
 	if data.ValidateFilters(v, filters); !v.Valid() {
 		ErrFailedValidation = s.failedValidation(v.Errors)
 		return nil, data.Metadata{}, ErrFailedValidation
 	}
-	requests, metadata, err := s.repo.GetAllRequests(search, status, filters)
+	requests, metadata, err := s.repo.GetAllRequests(search, data.RequestStatusFilter(status), filters)
 	if err != nil {
 		return nil, data.Metadata{}, err
 	}
@@ -181,3 +390,57 @@ func (s *service) UnsubscribeRequest(userID int64, requestID int64) error {
 	}
 	return nil
 }
+
+// fulfilRequestsInBackground marks the active requests that a book matches as fulfilled in a
+// background goroutine, and notifies each subscriber of the fulfilled requests of the book.
+func (s *service) fulfilRequestsInBackground(bookID int64) {
+	s.background(func() {
+		fulfilments, err := s.repo.FulfilRequestsForBook(bookID)
+		if err != nil {
+			s.logger.PrintError(err, nil)
+			return
+		}
+		s.notifyRequestFulfilments(fulfilments)
+	})
+}
+
+// ExpireRequests service marks the open requests past their expiry as expired. It runs as a
+// scheduled job.
+func (s *service) ExpireRequests() error {
+	_, err := s.repo.ExpireRequests()
+	return err
+}
+
+// ListTopRequests service retrieves a paginated list of the most wanted active requests,
+// optionally within a category, along with how wanted the requests of each category are.
+// Requests are ranked by their subscribers, each subscription losing half of its weight
+// every RequestScoreHalfLife.
+func (s *service) ListTopRequests(categoryID int64, filters data.Filters) ([]*data.TopRequest, []*data.RequestCategoryDemand, data.Metadata, error) {
+	v := validator.New()
+	v.Check(categoryID >= 0, "category_id", "must not be negative")
+	if data.ValidateFilters(v, filters); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, nil, data.Metadata{}, ErrFailedValidation
+	}
+	if categoryID != 0 {
+		_, err := s.GetCategory(categoryID)
+		if err != nil {
+			return nil, nil, data.Metadata{}, err
+		}
+	}
+	requests, metadata, err := s.repo.GetTopRequests(categoryID, data.RequestScoreHalfLife, filters)
+	if err != nil {
+		return nil, nil, data.Metadata{}, err
+	}
+	categories, err := s.repo.GetRequestCategoryDemand(data.RequestScoreHalfLife)
+	if err != nil {
+		return nil, nil, data.Metadata{}, err
+	}
+	return requests, categories, metadata, nil
+}
+
+// ClaimRequest service marks an active request as in progress by a user, so that other
+// contributors know that someone is working on uploading the book.
+func (s *service) ClaimRequest(user *data.User, requestID int64) (*data.Request, error) {
+	return s.TransitionRequest(user, requestID, dto.TransitionRequestRequestBody{Status: data.RequestStatusInProgress})
+}
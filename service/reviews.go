@@ -2,6 +2,7 @@ package service
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/emzola/bibliotheca/data"
 	"github.com/emzola/bibliotheca/internal/validator"
@@ -13,12 +14,15 @@ type reviews interface {
 	GetReview(reviewID int64) (*data.Review, error)
 	UpdateReview(reviewID int64, bookID int64, rating *int8, comment *string) (*data.Review, error)
 	DeleteReview(reviewID int64, bookID int64) error
-	ListReviews(filters data.Filters) (data.Rating, []*data.Review, data.Metadata, error)
+	ListReviews(bookID int64, userID int64, filters data.Filters) (data.Rating, []*data.Review, data.Metadata, error)
+	VoteReview(userID int64, bookID int64, reviewID int64, helpful *bool) (*data.Review, error)
+	UnvoteReview(userID int64, bookID int64, reviewID int64) (*data.Review, error)
+	RefreshBookPopularity() error
 }
 
 func (s *service) CreateReview(userID int64, bookID int64, username string, rating int8, comment string) (*data.Review, error) {
 	// First check whether a review from user already exists. If it does, do not process further request
-	exists := s.repo.ReviewExistsForUser(bookID, userID)
+	exists := s.repo.ReviewExistsForUser(userID, bookID)
 	if exists {
 		return nil, ErrDuplicateRecord
 	}
@@ -33,7 +37,7 @@ func (s *service) CreateReview(userID int64, bookID int64, username string, rati
 			return nil, err
 		}
 	}
-	// Create review as usual
+	// Create review as usual. The book's ratings and popularity are updated along with it
 	review := &data.Review{
 		BookID:   bookID,
 		UserID:   userID,
@@ -50,16 +54,18 @@ func (s *service) CreateReview(userID int64, bookID int64, username string, rati
 	if err != nil {
 		return nil, err
 	}
-	// Get ratings and Update the popularity field of a book
-	ratings, err := s.repo.GetReviewRatings()
+	err = s.mentionInReview(review, book)
 	if err != nil {
 		return nil, err
 	}
-	book.Popularity = ratings.Average
-	err = s.repo.UpdateBook(book)
-	if err != nil {
-		return nil, err
+	notification := data.Notification{
+		Event:    data.NotificationBookReview,
+		ActorID:  userID,
+		Message:  fmt.Sprintf("reviewed your book \"%s\"", book.Title),
+		BookID:   book.ID,
+		ReviewID: review.ID,
 	}
+	s.notifyInBackground(notification, []int64{book.UserID}, fmt.Sprintf("/books/%d/reviews/%d", book.ID, review.ID))
 	return review, nil
 }
 
@@ -74,6 +80,10 @@ func (s *service) GetReview(reviewID int64) (*data.Review, error) {
 			return nil, err
 		}
 	}
+	err = s.addReviewMentions([]*data.Review{review})
+	if err != nil {
+		return nil, err
+	}
 	return review, nil
 }
 
@@ -87,6 +97,9 @@ func (s *service) UpdateReview(reviewID int64, bookID int64, rating *int8, comme
 			return nil, err
 		}
 	}
+	if review.BookID != bookID {
+		return nil, ErrRecordNotFound
+	}
 	if rating != nil {
 		review.Rating = *rating
 	}
@@ -98,31 +111,26 @@ func (s *service) UpdateReview(reviewID int64, bookID int64, rating *int8, comme
 		ErrFailedValidation = s.failedValidation(v.Errors)
 		return nil, ErrFailedValidation
 	}
-	err = s.repo.UpdateReview(review)
+	book, err := s.repo.GetBook(bookID)
 	if err != nil {
 		switch {
-		case errors.Is(err, repository.ErrEditConflict):
-			return nil, ErrEditConflict
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
 		default:
 			return nil, err
 		}
 	}
-	// Get ratings and update the popularity field of a book
-	ratings, err := s.repo.GetReviewRatings()
-	if err != nil {
-		return nil, err
-	}
-	book, err := s.repo.GetBook(bookID)
+	// The book's ratings and popularity are updated along with the review
+	err = s.repo.UpdateReview(review)
 	if err != nil {
 		switch {
-		case errors.Is(err, repository.ErrRecordNotFound):
-			return nil, ErrRecordNotFound
+		case errors.Is(err, repository.ErrEditConflict):
+			return nil, ErrEditConflict
 		default:
 			return nil, err
 		}
 	}
-	book.Popularity = ratings.Average
-	err = s.repo.UpdateBook(book)
+	err = s.mentionInReview(review, book)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +138,15 @@ func (s *service) UpdateReview(reviewID int64, bookID int64, rating *int8, comme
 }
 
 func (s *service) DeleteReview(reviewID int64, bookID int64) error {
-	err := s.repo.DeleteReview(reviewID)
+	review, err := s.GetReview(reviewID)
+	if err != nil {
+		return err
+	}
+	if review.BookID != bookID {
+		return ErrRecordNotFound
+	}
+	// The book's ratings and popularity are updated along with the review
+	err = s.repo.DeleteReview(review.ID)
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrRecordNotFound):
@@ -139,38 +155,98 @@ func (s *service) DeleteReview(reviewID int64, bookID int64) error {
 			return err
 		}
 	}
-	// Also get ratings and update the popularity field of a book
-	book, err := s.repo.GetBook(bookID)
+	return nil
+}
+
+// ListReviews service retrieves the ratings of a book and a paginated list of its reviews. Every
+// review has its mentions and reactions, including whether the user with userID made them.
+func (s *service) ListReviews(bookID int64, userID int64, filters data.Filters) (data.Rating, []*data.Review, data.Metadata, error) {
+	v := validator.New()
+	if data.ValidateFilters(v, filters); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return data.Rating{}, nil, data.Metadata{}, ErrFailedValidation
+	}
+	ratings, err := s.repo.GetBookRatings(bookID)
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrRecordNotFound):
-			return ErrRecordNotFound
+			return data.Rating{}, nil, data.Metadata{}, ErrRecordNotFound
 		default:
-			return err
+			return data.Rating{}, nil, data.Metadata{}, err
 		}
 	}
-	ratings, err := s.repo.GetReviewRatings()
+	reviews, metadata, err := s.repo.GetAllReviews(bookID, filters)
 	if err != nil {
-		return err
+		return data.Rating{}, nil, data.Metadata{}, err
+	}
+	reviewIDs := make([]int64, len(reviews))
+	for i, review := range reviews {
+		reviewIDs[i] = review.ID
 	}
-	book.Popularity = ratings.Average
-	err = s.repo.UpdateBook(book)
+	reactions, err := s.repo.GetReviewReactions(reviewIDs, userID)
 	if err != nil {
-		return err
+		return data.Rating{}, nil, data.Metadata{}, err
 	}
-	return nil
+	for _, review := range reviews {
+		review.Reactions = reactions[review.ID]
+	}
+	err = s.addReviewMentions(reviews)
+	if err != nil {
+		return data.Rating{}, nil, data.Metadata{}, err
+	}
+	return ratings, reviews, metadata, nil
 }
 
-// ListReviews service retrieves a paginated list of all reviews for a book.
-func (s *service) ListReviews(filters data.Filters) (data.Rating, []*data.Review, data.Metadata, error) {
+// VoteReview service records whether a user found a review of a book helpful, replacing the
+// user's previous vote. Users can't vote on their own reviews.
+func (s *service) VoteReview(userID int64, bookID int64, reviewID int64, helpful *bool) (*data.Review, error) {
 	v := validator.New()
-	if data.ValidateFilters(v, filters); !v.Valid() {
+	if v.Check(helpful != nil, "helpful", "must be provided"); !v.Valid() {
 		ErrFailedValidation = s.failedValidation(v.Errors)
-		return data.Rating{}, nil, data.Metadata{}, ErrFailedValidation
+		return nil, ErrFailedValidation
 	}
-	ratings, reviews, metadata, err := s.repo.GetAllReviews(filters)
+	review, err := s.GetReview(reviewID)
 	if err != nil {
-		return data.Rating{}, nil, data.Metadata{}, err
+		return nil, err
 	}
-	return ratings, reviews, metadata, nil
+	if review.BookID != bookID {
+		return nil, ErrRecordNotFound
+	}
+	if review.UserID == userID {
+		return nil, ErrNotPermitted
+	}
+	err = s.repo.VoteReview(review.ID, userID, *helpful)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetReview(review.ID)
+}
+
+// UnvoteReview service removes a user's vote on a review of a book.
+func (s *service) UnvoteReview(userID int64, bookID int64, reviewID int64) (*data.Review, error) {
+	review, err := s.GetReview(reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.BookID != bookID {
+		return nil, ErrRecordNotFound
+	}
+	err = s.repo.DeleteReviewVote(review.ID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return s.GetReview(review.ID)
+}
+
+// RefreshBookPopularity service recomputes the popularity of books as the average of all ratings
+// moves. A book's popularity is updated whenever it's reviewed, so this only catches up books that
+// weren't reviewed recently. It runs as a scheduled job every day.
+func (s *service) RefreshBookPopularity() error {
+	_, err := s.repo.RefreshBookPopularity()
+	return err
 }
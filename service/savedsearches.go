@@ -0,0 +1,198 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/mailer"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/repository"
+)
+
+type savedSearches interface {
+	CreateSavedSearch(userID int64, requestBody dto.CreateSavedSearchRequestBody) (*data.SavedSearch, error)
+	ListSavedSearches(userID int64) ([]*data.SavedSearch, error)
+	UpdateSavedSearch(userID, savedSearchID int64, requestBody dto.UpdateSavedSearchRequestBody) (*data.SavedSearch, error)
+	DeleteSavedSearch(userID, savedSearchID int64) error
+	NotifySavedSearchMatches() error
+}
+
+// CreateSavedSearch service saves a ListBooks query of a user under a name.
+func (s *service) CreateSavedSearch(userID int64, requestBody dto.CreateSavedSearchRequestBody) (*data.SavedSearch, error) {
+	savedSearch := &data.SavedSearch{
+		UserID:    userID,
+		Name:      strings.TrimSpace(requestBody.Name),
+		Search:    strings.TrimSpace(requestBody.Search),
+		FromYear:  requestBody.FromYear,
+		ToYear:    requestBody.ToYear,
+		Extension: requestBody.Extension,
+		Notify:    requestBody.Notify,
+	}
+	if savedSearch.Extension == nil {
+		savedSearch.Extension = []string{}
+	}
+	v := validator.New()
+	// Normalize the filters as ListBooks does, so the search matches the same books
+	savedSearch.Language, savedSearch.Tags, savedSearch.Isbns = normalizeBookSearch(v, requestBody.Language, requestBody.Tags, strings.TrimSpace(requestBody.Isbn))
+	if data.ValidateSavedSearch(v, savedSearch); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	savedSearches, err := s.repo.GetAllSavedSearchesForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(savedSearches) >= data.MaxSavedSearchesPerUser {
+		v.AddError("name", "you can't save more than 50 searches, delete a saved search first")
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	err = s.repo.CreateSavedSearch(savedSearch)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrDuplicateRecord):
+			return nil, ErrDuplicateRecord
+		default:
+			return nil, err
+		}
+	}
+	return savedSearch, nil
+}
+
+// ListSavedSearches service retrieves the saved searches of a user.
+func (s *service) ListSavedSearches(userID int64) ([]*data.SavedSearch, error) {
+	savedSearches, err := s.repo.GetAllSavedSearchesForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	return savedSearches, nil
+}
+
+// UpdateSavedSearch service renames a saved search of a user or turns its notifications on or off.
+func (s *service) UpdateSavedSearch(userID, savedSearchID int64, requestBody dto.UpdateSavedSearchRequestBody) (*data.SavedSearch, error) {
+	savedSearch, err := s.repo.GetSavedSearch(savedSearchID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	if requestBody.Name != nil {
+		savedSearch.Name = strings.TrimSpace(*requestBody.Name)
+	}
+	if requestBody.Notify != nil {
+		savedSearch.Notify = *requestBody.Notify
+	}
+	v := validator.New()
+	if data.ValidateSavedSearch(v, savedSearch); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	err = s.repo.UpdateSavedSearch(savedSearch)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrEditConflict):
+			return nil, ErrEditConflict
+		case errors.Is(err, repository.ErrDuplicateRecord):
+			return nil, ErrDuplicateRecord
+		default:
+			return nil, err
+		}
+	}
+	return savedSearch, nil
+}
+
+// DeleteSavedSearch service deletes a saved search of a user.
+func (s *service) DeleteSavedSearch(userID, savedSearchID int64) error {
+	err := s.repo.DeleteSavedSearch(savedSearchID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// savedSearchNotification defines the books of a saved search listed in a notification email.
+type savedSearchNotification struct {
+	Name  string
+	Books []*data.SavedSearchMatch
+}
+
+// savedSearchDigest defines the saved searches with new books listed in the single notification
+// email a user gets.
+type savedSearchDigest struct {
+	UserID    int64
+	UserName  string
+	UserEmail string
+	Searches  []*savedSearchNotification
+}
+
+// groupSavedSearchMatches groups matches into one digest per user, with the books of each saved
+// search listed together. Matches must be ordered by user and saved search.
+func groupSavedSearchMatches(matches []*data.SavedSearchMatch) []*savedSearchDigest {
+	digests := []*savedSearchDigest{}
+	var digest *savedSearchDigest
+	var search *savedSearchNotification
+	for _, match := range matches {
+		if digest == nil || digest.UserID != match.UserID {
+			digest = &savedSearchDigest{UserID: match.UserID, UserName: match.UserName, UserEmail: match.UserEmail}
+			digests = append(digests, digest)
+			search = nil
+		}
+		if search == nil || search.Books[0].SavedSearchID != match.SavedSearchID {
+			search = &savedSearchNotification{Name: match.SavedSearchName}
+			digest.Searches = append(digest.Searches, search)
+		}
+		search.Books = append(search.Books, match)
+	}
+	return digests
+}
+
+// NotifySavedSearchMatches service emails each user a single message listing the new books that
+// matched their saved searches since they were last notified. It is run periodically.
+func (s *service) NotifySavedSearchMatches() error {
+	before := time.Now()
+	matches, err := s.repo.GetPendingSavedSearchMatches(before)
+	if err != nil {
+		return err
+	}
+	mailer := mailer.New(s.config.SMTP.Host, s.config.SMTP.Port, s.config.SMTP.Username, s.config.SMTP.Password, s.config.SMTP.Sender)
+	for _, digest := range groupSavedSearchMatches(matches) {
+		data := map[string]interface{}{
+			"userName": strings.Split(digest.UserName, " ")[0],
+			"searches": digest.Searches,
+		}
+		err := mailer.Send(digest.UserEmail, "saved_search_matches.tmpl", data)
+		if err != nil {
+			// Leave the matches pending so they are sent on the next run
+			s.logger.PrintError(err, map[string]string{"user_id": strconv.FormatInt(digest.UserID, 10)})
+		} else {
+			err = s.repo.MarkSavedSearchMatchesNotified(digest.UserID, before)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addSavedSearchMatchesInBackground records the saved searches a book matches in a background
+// goroutine. Users are notified about the matches by NotifySavedSearchMatches.
+func (s *service) addSavedSearchMatchesInBackground(bookID int64) {
+	s.background(func() {
+		err := s.repo.AddSavedSearchMatchesForBook(bookID)
+		if err != nil {
+			s.logger.PrintError(err, nil)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/internal/validator"
+)
+
+func TestNormalizeBookSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		language []string
+		tags     []string
+		isbn     string
+		wantLang []string
+		wantTags []string
+		isbns    []string
+		valid    bool
+	}{
+		{"No filters", nil, nil, "", []string{}, []string{}, []string{}, true},
+		{"Language names and codes", []string{"English", "fra"}, nil, "", []string{"en", "fr"}, []string{}, []string{}, true},
+		{"Unknown language", []string{"klingonese"}, nil, "", []string{"klingonese"}, []string{}, []string{}, false},
+		{"Tags", nil, []string{" Science Fiction ", "HISTORY"}, "", []string{}, []string{"science-fiction", "history"}, []string{}, true},
+		{"ISBN-10 in both forms", nil, nil, "0-306-40615-2", []string{}, []string{}, []string{"0306406152", "9780306406157"}, true},
+		{"ISBN-13 without ISBN-10", nil, nil, "979-10-90636-07-1", []string{}, []string{}, []string{"9791090636071"}, true},
+		{"Invalid ISBN", nil, nil, "0306406153", []string{}, []string{}, []string{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validator.New()
+			language, tags, isbns := normalizeBookSearch(v, tt.language, tt.tags, tt.isbn)
+			if v.Valid() != tt.valid {
+				t.Fatalf("expected valid %t; got %t (%v)", tt.valid, v.Valid(), v.Errors)
+			}
+			if !tt.valid {
+				return
+			}
+			if !reflect.DeepEqual(language, tt.wantLang) {
+				t.Errorf("expected languages %q; got %q", tt.wantLang, language)
+			}
+			if !reflect.DeepEqual(tags, tt.wantTags) {
+				t.Errorf("expected tags %q; got %q", tt.wantTags, tags)
+			}
+			if !reflect.DeepEqual(isbns, tt.isbns) {
+				t.Errorf("expected ISBNs %q; got %q", tt.isbns, isbns)
+			}
+		})
+	}
+}
+
+func TestGroupSavedSearchMatches(t *testing.T) {
+	match := func(userID, savedSearchID, bookID int64) *data.SavedSearchMatch {
+		return &data.SavedSearchMatch{
+			UserID:          userID,
+			UserEmail:       "user@example.com",
+			SavedSearchID:   savedSearchID,
+			SavedSearchName: "search",
+			BookID:          bookID,
+		}
+	}
+	tests := []struct {
+		name    string
+		matches []*data.SavedSearchMatch
+		// want lists, for each user in order, the book IDs of each of their saved searches
+		want map[int64][][]int64
+		size int
+	}{
+		{"No matches", nil, map[int64][][]int64{}, 0},
+		{"Single match", []*data.SavedSearchMatch{match(1, 10, 100)}, map[int64][][]int64{1: {{100}}}, 1},
+		{
+			"Several books of one search",
+			[]*data.SavedSearchMatch{match(1, 10, 100), match(1, 10, 101)},
+			map[int64][][]int64{1: {{100, 101}}},
+			1,
+		},
+		{
+			"Several searches of one user",
+			[]*data.SavedSearchMatch{match(1, 10, 100), match(1, 11, 100), match(1, 11, 102)},
+			map[int64][][]int64{1: {{100}, {100, 102}}},
+			1,
+		},
+		{
+			"Several users",
+			[]*data.SavedSearchMatch{match(1, 10, 100), match(2, 20, 100), match(2, 21, 101), match(3, 30, 102)},
+			map[int64][][]int64{1: {{100}}, 2: {{100}, {101}}, 3: {{102}}},
+			3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			digests := groupSavedSearchMatches(tt.matches)
+			if len(digests) != tt.size {
+				t.Fatalf("expected %d digests; got %d", tt.size, len(digests))
+			}
+			got := make(map[int64][][]int64)
+			for _, digest := range digests {
+				if _, ok := got[digest.UserID]; ok {
+					t.Fatalf("expected one digest for user %d", digest.UserID)
+				}
+				searches := [][]int64{}
+				for _, search := range digest.Searches {
+					bookIDs := []int64{}
+					for _, book := range search.Books {
+						if book.UserID != digest.UserID {
+							t.Errorf("expected books of user %d; got a book of user %d", digest.UserID, book.UserID)
+						}
+						bookIDs = append(bookIDs, book.BookID)
+					}
+					searches = append(searches, bookIDs)
+				}
+				got[digest.UserID] = searches
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v; got %v", tt.want, got)
+			}
+		})
+	}
+}
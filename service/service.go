@@ -3,18 +3,30 @@ package service
 import (
 	"sync"
 
+	"github.com/emzola/bibliotheca/clients"
 	"github.com/emzola/bibliotheca/config"
 	"github.com/emzola/bibliotheca/internal/jsonlog"
+	"github.com/emzola/bibliotheca/internal/scheduler"
 	"github.com/emzola/bibliotheca/repository"
 )
 
 type Service interface {
 	books
+	authors
+	recommendations
+	savedSearches
+	tags
+	duplicates
+	enrichments
+	covers
+	jobs
 	reviews
 	categories
 	requests
 	booklists
 	comments
+	reactions
+	notifications
 	users
 	tokens
 	failedValidation(map[string]string) error
@@ -22,18 +34,29 @@ type Service interface {
 
 // Services defines a service layer.
 type service struct {
-	config config.Config
-	wg     sync.WaitGroup
-	logger *jsonlog.Logger
-	repo   repository.Repository
+	config    config.Config
+	wg        sync.WaitGroup
+	logger    *jsonlog.Logger
+	repo      repository.Repository
+	metadata  clients.MetadataProvider
+	covers    *clients.CoversClient
+	scheduler *scheduler.Scheduler
 }
 
 // New creates a new instance of Service.
-func New(cfg config.Config, wg *sync.WaitGroup, logger *jsonlog.Logger, repo repository.Repository) *service {
+func New(cfg config.Config, wg *sync.WaitGroup, logger *jsonlog.Logger, repo repository.Repository, scheduler *scheduler.Scheduler) *service {
 	return &service{
 		config: cfg,
 		wg:     sync.WaitGroup{},
 		logger: logger,
 		repo:   repo,
+		// Providers are queried in order, so more complete catalogues should come first
+		metadata: clients.NewProviderChain(
+			cfg.Metadata.ProviderTimeout,
+			cfg.Metadata.CacheTTL,
+			clients.NewOpenLibraryClient(cfg.OpenLibrary.BaseURL),
+		),
+		covers:    clients.NewCoversClient(cfg.Covers.BaseURL),
+		scheduler: scheduler,
 	}
 }
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"errors"
+	"math"
+
+	"github.com/emzola/bibliotheca/data"
+	"github.com/emzola/bibliotheca/data/dto"
+	"github.com/emzola/bibliotheca/internal/validator"
+	"github.com/emzola/bibliotheca/repository"
+)
+
+type tags interface {
+	SuggestBookTags(user *data.User, bookID int64, requestBody dto.SuggestBookTagsRequestBody) ([]*data.BookTag, error)
+	ModerateBookTag(user *data.User, bookID int64, tag string, requestBody dto.ModerateBookTagRequestBody) (*data.BookTag, error)
+	DeleteBookTag(user *data.User, bookID int64, tag string) error
+	ListTags(prefix string, limit int) ([]*data.Tag, error)
+	TagCloud(limit int) ([]*data.Tag, error)
+	ListPendingBookTags(user *data.User, filters data.Filters) ([]*data.BookTag, data.Metadata, error)
+}
+
+// SuggestBookTags service adds tags to a book. Tags added by the book's owner or an admin are
+// approved straight away, while tags suggested by other users await moderation.
+func (s *service) SuggestBookTags(user *data.User, bookID int64, requestBody dto.SuggestBookTagsRequestBody) ([]*data.BookTag, error) {
+	book, err := s.repo.GetBook(bookID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	tags := make([]string, len(requestBody.Tags))
+	for i, tag := range requestBody.Tags {
+		tags[i] = data.NormalizeTag(tag)
+	}
+	v := validator.New()
+	if data.ValidateTags(v, tags); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	status := data.TagStatusPending
+	if s.canManageBook(user, book) {
+		status = data.TagStatusApproved
+	}
+	err = s.repo.AddTagsForBook(book.ID, user.ID, tags, status)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	bookTags := make([]*data.BookTag, len(tags))
+	for i, tag := range tags {
+		bookTags[i], err = s.repo.GetBookTag(book.ID, tag)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bookTags, nil
+}
+
+// ModerateBookTag service approves or rejects a tag suggested for a book. Only the
+// book's owner or an admin can moderate its tags.
+func (s *service) ModerateBookTag(user *data.User, bookID int64, tag string, requestBody dto.ModerateBookTagRequestBody) (*data.BookTag, error) {
+	book, err := s.repo.GetBook(bookID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	if !s.canManageBook(user, book) {
+		return nil, ErrNotPermitted
+	}
+	v := validator.New()
+	if data.ValidateTagStatus(v, requestBody.Status); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	tag = data.NormalizeTag(tag)
+	err = s.repo.UpdateBookTagStatus(book.ID, tag, requestBody.Status)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	bookTag, err := s.repo.GetBookTag(book.ID, tag)
+	if err != nil {
+		return nil, err
+	}
+	return bookTag, nil
+}
+
+// DeleteBookTag service removes a tag from a book. Only the book's owner or an admin
+// can remove its tags.
+func (s *service) DeleteBookTag(user *data.User, bookID int64, tag string) error {
+	book, err := s.repo.GetBook(bookID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	if !s.canManageBook(user, book) {
+		return ErrNotPermitted
+	}
+	err = s.repo.DeleteTagForBook(book.ID, data.NormalizeTag(tag))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return ErrRecordNotFound
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// ListTags service retrieves the most used tags starting with prefix, for autocomplete.
+func (s *service) ListTags(prefix string, limit int) ([]*data.Tag, error) {
+	v := validator.New()
+	v.Check(limit >= 1 && limit <= 50, "limit", "must be between 1 and 50")
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	tags, err := s.repo.GetTagsByPrefix(data.NormalizeTag(prefix), limit)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// TagCloud service retrieves the most used tags, each weighted between 0 and 1 on a
+// logarithmic scale of its usage relative to the most used tag.
+func (s *service) TagCloud(limit int) ([]*data.Tag, error) {
+	v := validator.New()
+	v.Check(limit >= 1 && limit <= 200, "limit", "must be between 1 and 200")
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	tags, err := s.repo.GetPopularTags(limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return tags, nil
+	}
+	// Tags are sorted by usage, so the first tag is the most used one
+	max := math.Log1p(float64(tags[0].UsageCount))
+	for _, tag := range tags {
+		tag.Weight = math.Log1p(float64(tag.UsageCount)) / max
+	}
+	return tags, nil
+}
+
+// ListPendingBookTags service retrieves the tags awaiting moderation on the books of a user.
+// Admins see the pending tags on all books.
+func (s *service) ListPendingBookTags(user *data.User, filters data.Filters) ([]*data.BookTag, data.Metadata, error) {
+	v := validator.New()
+	if data.ValidateFilters(v, filters); !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, data.Metadata{}, ErrFailedValidation
+	}
+	ownerID := user.ID
+	if user.IsAdmin() {
+		ownerID = 0
+	}
+	bookTags, metadata, err := s.repo.GetAllPendingBookTags(ownerID, filters)
+	if err != nil {
+		return nil, data.Metadata{}, err
+	}
+	return bookTags, metadata, nil
+}
@@ -16,6 +16,7 @@ type tokens interface {
 	CreateAuthenticationToken(email string, password string) (*data.Token, error)
 	DeleteAuthenticationToken(userID int64) error
 	CreatePasswordResetToken(email string) error
+	DeleteExpiredTokens() error
 }
 
 // CreateActivationToken service creates a new activation token.
@@ -144,3 +145,9 @@ func (s *service) CreatePasswordResetToken(email string) error {
 	})
 	return nil
 }
+
+// DeleteExpiredTokens service deletes the tokens that have expired. It runs as a scheduled job.
+func (s *service) DeleteExpiredTokens() error {
+	_, err := s.repo.DeleteExpiredTokens()
+	return err
+}
@@ -12,10 +12,11 @@ import (
 )
 
 type users interface {
-	RegisterUser(name string, email string, password string) (*data.User, error)
+	RegisterUser(name string, username string, email string, password string) (*data.User, error)
 	ActivateUser(token string) (*data.User, error)
 	ShowUser(userID int64) (*data.User, error)
-	UpdateUser(ID int64, name *string, email *string) (*data.User, error)
+	LookupUser(username string) (*data.PublicUser, error)
+	UpdateUser(ID int64, name *string, username *string, email *string) (*data.User, error)
 	UpdateUserPassword(ID int64, old string, new string, confirm string) (*data.User, error)
 	DeleteUser(ID int64) error
 	ResetUserPassword(password string, token string) error
@@ -26,12 +27,14 @@ type users interface {
 	ListUserBooks(userID int64, filters data.Filters) ([]*data.Book, data.Metadata, error)
 	ListUserFavouriteBooks(userID int64, filters data.Filters) ([]*data.Book, data.Metadata, error)
 	ListUserDownloads(userID int64, fromDate string, toDate string, filters data.Filters) ([]*data.Book, data.Metadata, error)
+	ResetDownloadCounts() error
 }
 
 // RegisterUser service registers a new user.
-func (s *service) RegisterUser(name string, email string, password string) (*data.User, error) {
+func (s *service) RegisterUser(name string, username string, email string, password string) (*data.User, error) {
 	user := &data.User{
 		Name:      name,
+		Username:  username,
 		Email:     email,
 		Activated: false,
 	}
@@ -51,6 +54,10 @@ func (s *service) RegisterUser(name string, email string, password string) (*dat
 			v.AddError("email", "a user with this email address already exists")
 			ErrDuplicateRecord = s.failedValidation(v.Errors)
 			return nil, ErrDuplicateRecord
+		case errors.Is(err, repository.ErrDuplicateUsername):
+			v.AddError("username", "a user with this username already exists")
+			ErrDuplicateRecord = s.failedValidation(v.Errors)
+			return nil, ErrDuplicateRecord
 		default:
 			return nil, err
 		}
@@ -128,8 +135,28 @@ func (s *service) ShowUser(userID int64) (*data.User, error) {
 	return user, nil
 }
 
+// LookupUser service shows the public details of the user with a username.
+func (s *service) LookupUser(username string) (*data.PublicUser, error) {
+	v := validator.New()
+	v.Check(username != "", "username", "must be provided")
+	if !v.Valid() {
+		ErrFailedValidation = s.failedValidation(v.Errors)
+		return nil, ErrFailedValidation
+	}
+	user, err := s.repo.GetUserByUsername(strings.TrimPrefix(username, "@"))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecordNotFound):
+			return nil, ErrRecordNotFound
+		default:
+			return nil, err
+		}
+	}
+	return user, nil
+}
+
 // UpdateUser service updates the details of a specific user.
-func (s *service) UpdateUser(ID int64, name *string, email *string) (*data.User, error) {
+func (s *service) UpdateUser(ID int64, name *string, username *string, email *string) (*data.User, error) {
 	user, err := s.repo.GetUserByID(ID)
 	if err != nil {
 		switch {
@@ -142,11 +169,15 @@ func (s *service) UpdateUser(ID int64, name *string, email *string) (*data.User,
 	if name != nil {
 		user.Name = *name
 	}
+	if username != nil {
+		user.Username = *username
+	}
 	if email != nil {
 		user.Email = *email
 	}
 	v := validator.New()
 	data.ValidateName(v, user.Name)
+	data.ValidateUsername(v, user.Username)
 	data.ValidateEmail(v, user.Email)
 	if !v.Valid() {
 		ErrFailedValidation = s.failedValidation(v.Errors)
@@ -159,6 +190,10 @@ func (s *service) UpdateUser(ID int64, name *string, email *string) (*data.User,
 			v.AddError("email", "a user with this email address already exists")
 			ErrFailedValidation = s.failedValidation(v.Errors)
 			return nil, ErrFailedValidation
+		case errors.Is(err, repository.ErrDuplicateUsername):
+			v.AddError("username", "a user with this username already exists")
+			ErrFailedValidation = s.failedValidation(v.Errors)
+			return nil, ErrFailedValidation
 		case errors.Is(err, repository.ErrRecordNotFound):
 			return nil, ErrRecordNotFound
 		default:
@@ -357,12 +392,14 @@ func (s *service) ListUserBooklist(userID int64, filters data.Filters) ([]*data.
 // ListUserRequests service retrieves a paginated list of all user requests.
 // Records can be filtered and sorted.
 func (s *service) ListUserRequests(userID int64, status string, filters data.Filters) ([]*data.Request, data.Metadata, error) {
+	status = strings.ToLower(strings.TrimSpace(status))
 	v := validator.New()
+	data.ValidateRequestStatusFilter(v, status)
 	if data.ValidateFilters(v, filters); !v.Valid() {
 		ErrFailedValidation = s.failedValidation(v.Errors)
 		return nil, data.Metadata{}, ErrFailedValidation
 	}
-	requests, metadata, err := s.repo.GetAllRequestsForUser(userID, status, filters)
+	requests, metadata, err := s.repo.GetAllRequestsForUser(userID, data.RequestStatusFilter(status), filters)
 	if err != nil {
 		return nil, data.Metadata{}, err
 	}
@@ -413,3 +450,10 @@ func (s *service) ListUserDownloads(userID int64, fromDate string, toDate string
 	}
 	return books, metadata, nil
 }
+
+// ResetDownloadCounts service resets the daily download count of all users. It runs as a
+// scheduled job every day.
+func (s *service) ResetDownloadCounts() error {
+	_, err := s.repo.ResetDownloadCounts()
+	return err
+}